@@ -0,0 +1,189 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Restricted AppBundles, and every AppBundle under the strict_acls FeatureFlag, are gated by an
+// auditable request/approval loop: a caller files an AccessRequest, the bundle owner grants or
+// denies it, and a grant produces a Permission asset that checkBundleReadAccess consults on
+// reads. Both assets are keyed by descriptor, bundle and the normalized identity of the
+// requester. AccessRequests are returned only to their requester, the bundle and descriptor
+// owners and admins, see canReadAccessRequest.
+
+func (ac *assetContext) getAppBundle(app_descriptor_key_part string, app_bundle_key_part string) (*AppBundle, error) {
+	appBundleBytesFromStore, err := ac.getAppBundleForDescriptorByKey(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, err
+	}
+	appBundle := &AppBundle{}
+	if err := proto.Unmarshal(appBundleBytesFromStore, appBundle); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal AppBundle, err = %s", err.Error())
+	}
+	return appBundle, nil
+}
+
+// isRestricted reports whether appBundle is gated by AccessRequests. With the strict_acls
+// FeatureFlag every AppBundle is treated as restricted.
+func (ac *assetContext) isRestricted(appBundle *AppBundle) bool {
+	return appBundle.Restricted || ac.featureFlags.GetStrictAcls()
+}
+
+// checkBundleReadAccess returns an error if the caller may not read appBundle.
+func (ac *assetContext) checkBundleReadAccess(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+	if !ac.isRestricted(appBundle) || bytes.Equal(appBundle.Owner, ac.creator) {
+		return nil
+	}
+	found, err := ac.getAsset(COMPOSITE_KEY_PERMISSION_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part, ac.identity}, &Permission{})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("Access denied to restricted AppBundle %s of AppDescriptor %s", app_bundle_key_part, app_descriptor_key_part)
+	}
+	return nil
+}
+
+// canReadAccessRequest reports whether the caller may read accessRequest, stored under
+// key_parts. Its justification is the requester's to share, so only the requester, the owners
+// of the AppBundle and AppDescriptor it names, and admins may read it.
+func (ac *assetContext) canReadAccessRequest(key_parts []string, accessRequest *AccessRequest) (bool, error) {
+	if bytes.Equal(accessRequest.Requester, ac.creator) {
+		return true, nil
+	}
+	admin, err := ac.isAdmin()
+	if err != nil || admin {
+		return admin, err
+	}
+	if len(key_parts) != 3 {
+		return false, nil
+	}
+	appBundle := &AppBundle{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts[:2], appBundle); err != nil {
+		return false, err
+	}
+	appDescriptor := &AppDescriptor{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, key_parts[:1], appDescriptor); err != nil {
+		return false, err
+	}
+	return bytes.Equal(appBundle.Owner, ac.creator) || bytes.Equal(appDescriptor.Owner, ac.creator), nil
+}
+
+func (ac *assetContext) requestAccess() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	justification := ""
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		justification = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to requestAccess")
+	}
+
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in requestAccess: %s", err)
+	}
+	if !ac.isRestricted(appBundle) {
+		return nil, fmt.Errorf("AppBundle %s of AppDescriptor %s is not restricted", app_bundle_key_part, app_descriptor_key_part)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err == nil {
+		return nil, fmt.Errorf("Caller already has access to AppBundle %s of AppDescriptor %s", app_bundle_key_part, app_descriptor_key_part)
+	}
+
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part, ac.identity}
+	existing := &AccessRequest{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE, key_parts, existing)
+	if err != nil {
+		return nil, fmt.Errorf("Error in requestAccess: %s", err)
+	}
+	if found && existing.Status == AccessRequest_PENDING {
+		return nil, fmt.Errorf("An AccessRequest for AppBundle %s of AppDescriptor %s is already pending", app_bundle_key_part, app_descriptor_key_part)
+	}
+
+	requested_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in requestAccess: %s", err)
+	}
+
+	accessRequest := &AccessRequest{
+		Requester:     ac.creator,
+		DescriptorId:  app_descriptor_key_part,
+		BundleKey:     app_bundle_key_part,
+		Justification: justification,
+		Status:        AccessRequest_PENDING,
+		RequestedAt:   requested_at,
+	}
+	return ac.putAsset(COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE, key_parts, accessRequest)
+}
+
+// decideAccess implements grantAccess and denyAccess. <requester> is the normalized identity
+// under which the AccessRequest was filed.
+func (ac *assetContext) decideAccess(decision AccessRequest_Status) ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	requester := ""
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		requester = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to %s", ac.function)
+	}
+
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if !bytes.Equal(appBundle.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppBundle %s may decide AccessRequests for it", app_bundle_key_part)
+	}
+
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part, requester}
+	accessRequest := &AccessRequest{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE, key_parts, accessRequest)
+	if err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if !found || accessRequest.Status != AccessRequest_PENDING {
+		return nil, fmt.Errorf("No pending AccessRequest from %s for AppBundle %s of AppDescriptor %s", requester, app_bundle_key_part, app_descriptor_key_part)
+	}
+
+	decided_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	accessRequest.Status = decision
+	accessRequest.DecidedBy = ac.creator
+	accessRequest.DecidedAt = decided_at
+
+	if decision == AccessRequest_GRANTED {
+		permission := &Permission{
+			Grantee:      accessRequest.Requester,
+			DescriptorId: app_descriptor_key_part,
+			BundleKey:    app_bundle_key_part,
+			GrantedBy:    ac.creator,
+			GrantedAt:    decided_at,
+		}
+		if _, err := ac.putAsset(COMPOSITE_KEY_PERMISSION_OBJECTTYPE, key_parts, permission); err != nil {
+			return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+		}
+	}
+	return ac.putAsset(COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE, key_parts, accessRequest)
+}
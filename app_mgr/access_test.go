@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// Under strict_acls every AppBundle is restricted, so members who do not own one must be able
+// to request access to it, including those created without the restricted flag.
+func TestStrictAclsAccessRequests(t *testing.T) {
+	owner := testIdentity(t, "bundle-owner")
+	reader := testIdentity(t, "reader")
+	denied := testIdentity(t, "denied-reader")
+
+	s := newTestRegistry(t)
+	s.Creator = owner
+	s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "strict"})
+	s.mustInvoke(t, "createAppBundle", "b", &AppBundle{DescriptorId: "d", Artifacts: [][]byte{[]byte("artifact")}})
+
+	// Without the flag the bundle is readable and there is nothing to request
+	s.Creator = reader
+	s.mustInvoke(t, "getAppBundleForDescriptor", "d", "b")
+	if message := s.mustFail(t, "requestAccess", "d", "b", "please"); !strings.Contains(message, "is not restricted") {
+		t.Fatalf("requestAccess of an unrestricted bundle failed with %q", message)
+	}
+
+	s.Creator = testIdentity(t, "registry-admin")
+	s.mustInvoke(t, "setFeatureFlag", "strict_acls", "true")
+
+	steps := []struct {
+		caller   []byte
+		function string
+		args     []interface{}
+		fails    string // A substring of the error, or empty if the step succeeds
+	}{
+		{reader, "getAppBundleForDescriptor", []interface{}{"d", "b"}, "Access denied"},
+		{reader, "requestAccess", []interface{}{"d", "b", "please"}, ""},
+		{denied, "requestAccess", []interface{}{"d", "b", "me too"}, ""},
+		{reader, "requestAccess", []interface{}{"d", "b", "please"}, "already pending"},
+		{owner, "requestAccess", []interface{}{"d", "b", "mine"}, "already has access"},
+		{reader, "grantAccess", []interface{}{"d", "b", normalizeIdentity(reader)}, "Only the owner"},
+		{owner, "grantAccess", []interface{}{"d", "b", normalizeIdentity(reader)}, ""},
+		{owner, "denyAccess", []interface{}{"d", "b", normalizeIdentity(denied)}, ""},
+		{reader, "getAppBundleForDescriptor", []interface{}{"d", "b"}, ""},
+		{denied, "getAppBundleForDescriptor", []interface{}{"d", "b"}, "Access denied"},
+		{owner, "getAppBundleForDescriptor", []interface{}{"d", "b"}, ""},
+	}
+	for i, step := range steps {
+		s.Creator = step.caller
+		if len(step.fails) == 0 {
+			s.mustInvoke(t, step.function, step.args...)
+			continue
+		}
+		if message := s.mustFail(t, step.function, step.args...); !strings.Contains(message, step.fails) {
+			t.Fatalf("step %d: %s failed with %q, want %q", i, step.function, message, step.fails)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// Members other than its owner annotate an AppDescriptor, AppBundle or Collection with claims
+// about it, e.g. "validated-on-network-Y", recording only the SHA-256 digest of the payload
+// backing the claim. Annotations are keyed under the asset they annotate, then by type and
+// annotator, so annotating again, e.g. after a new validation run, replaces the annotator's
+// earlier annotation of that type, while the ledger's history of the key keeps every version
+// for audit. The owner moderates the annotations of its asset: a HIDDEN annotation stays on the
+// ledger and is returned to the owner only, and annotating again does not make it VISIBLE.
+// Annotations follow their AppDescriptor when it is renamed.
+
+// getAnnotatedAsset returns the asset of namespace at key_parts, resolved as by getOwnedAsset,
+// provided the caller may read it.
+func (ac *assetContext) getAnnotatedAsset(namespace string, key_parts []string) (ownedAsset, []string, error) {
+	asset, key_parts, err := ac.getOwnedAsset(namespace, key_parts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if appBundle, ok := asset.(*AppBundle); ok {
+		if err := ac.checkBundleReadAccess(key_parts[0], key_parts[1], appBundle); err != nil {
+			return nil, nil, err
+		}
+	}
+	return asset, key_parts, nil
+}
+
+func (ac *assetContext) AnnotateAsset(request *AnnotateAssetRequest) (*Annotation, error) {
+	namespace := request.ObjectType
+	annotation_type := request.AnnotationType
+	payload_hash := request.PayloadHash
+	if len(annotation_type) == 0 {
+		return nil, fmt.Errorf("Error in annotateAsset: the annotation type must be non-empty")
+	}
+	if len(payload_hash) != sha256.Size {
+		return nil, fmt.Errorf("Error in annotateAsset: payload_hash must be a SHA-256 digest of %d bytes, got %d", sha256.Size, len(payload_hash))
+	}
+
+	asset, key_parts, err := ac.getAnnotatedAsset(namespace, request.KeyParts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in annotateAsset: %s", err)
+	}
+	if bytes.Equal(asset.GetOwner(), ac.creator) {
+		return nil, fmt.Errorf("The owner of %s (%v) may not annotate it", namespace, key_parts)
+	}
+
+	annotation_key_parts := append(append([]string{namespace}, key_parts...), annotation_type, ac.identity)
+	annotation := &Annotation{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, annotation_key_parts, annotation); err != nil {
+		return nil, fmt.Errorf("Error in annotateAsset: %s", err)
+	}
+	annotated_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in annotateAsset: %s", err)
+	}
+	// The status and moderated_at set by the owner are kept
+	annotation.ObjectType = namespace
+	annotation.KeyParts = key_parts
+	annotation.Type = annotation_type
+	annotation.PayloadHash = payload_hash
+	annotation.Annotator = ac.creator
+	annotation.AnnotatorId = ac.identity
+	annotation.AnnotatorMspid = ac.mspId
+	annotation.AnnotatedAt = annotated_at
+	annotation.TxId = ac.stub.GetTxID()
+	if _, err := ac.putAsset(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, annotation_key_parts, annotation); err != nil {
+		return nil, err
+	}
+	return annotation, nil
+}
+
+// GetAnnotations returns a page of the annotations of an asset, the HIDDEN ones only to its
+// owner. Anyone may annotate an asset, so the page stops at the registry's QueryLimits.
+func (ac *assetContext) GetAnnotations(request *AnnotationsRequest) (*Annotations, error) {
+	namespace := request.ObjectType
+	asset, key_parts, err := ac.getAnnotatedAsset(namespace, request.KeyParts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAnnotations: %s", err)
+	}
+	owner := bytes.Equal(asset.GetOwner(), ac.creator)
+
+	query_results, err := ac.query(&Query{ObjectType: Query_ANNOTATION, KeyParts: append([]string{namespace}, key_parts...), ReturnValues: true, Bookmark: request.Bookmark})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAnnotations: %s", err)
+	}
+	annotations := &Annotations{HasMore: query_results.HasMore, Bookmark: query_results.Bookmark}
+	for _, entry := range query_results.Results {
+		annotation := &Annotation{}
+		if err := proto.Unmarshal(entry.Value, annotation); err != nil {
+			return nil, fmt.Errorf("Error in getAnnotations, cannot unmarshal Annotation: %s", err)
+		}
+		if annotation.Status == Annotation_HIDDEN && !owner {
+			annotations.Hidden++
+			continue
+		}
+		annotations.Annotations = append(annotations.Annotations, annotation)
+	}
+	return annotations, nil
+}
+
+func (ac *assetContext) ModerateAnnotation(request *ModerateAnnotationRequest) (*Annotation, error) {
+	namespace := request.ObjectType
+	annotation_type := request.AnnotationType
+	annotator_id := request.AnnotatorId
+
+	asset, key_parts, err := ac.getOwnedAsset(namespace, request.KeyParts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in moderateAnnotation: %s", err)
+	}
+	if !bytes.Equal(asset.GetOwner(), ac.creator) {
+		return nil, fmt.Errorf("Only the owner of %s (%v) may moderate its annotations", namespace, key_parts)
+	}
+
+	annotation_key_parts := append(append([]string{namespace}, key_parts...), annotation_type, annotator_id)
+	annotation := &Annotation{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, annotation_key_parts, annotation)
+	if err != nil {
+		return nil, fmt.Errorf("Error in moderateAnnotation: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in moderateAnnotation: %s (%v) has no %s annotation by %s", namespace, key_parts, annotation_type, annotator_id)
+	}
+	moderated_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in moderateAnnotation: %s", err)
+	}
+	annotation.Status = request.Status
+	annotation.ModeratedAt = moderated_at
+	if _, err := ac.putAsset(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, annotation_key_parts, annotation); err != nil {
+		return nil, err
+	}
+	return annotation, nil
+}
+
+// moveAnnotations re-keys the annotations of a renamed AppDescriptor and of its AppBundles.
+func (ac *assetContext) moveAnnotations(old_key_part string, new_key_part string) error {
+	for _, namespace := range []string{COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE} {
+		stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, []string{namespace, old_key_part})
+		if err != nil {
+			return fmt.Errorf("Error reading %s of %s: %s", COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, old_key_part, err)
+		}
+		var moved []*queryresult.KV
+		for stateQueryIterator.HasNext() {
+			kv, err := stateQueryIterator.Next()
+			if err != nil {
+				stateQueryIterator.Close()
+				return fmt.Errorf("Error reading %s of %s: %s", COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, old_key_part, err)
+			}
+			moved = append(moved, kv)
+		}
+		stateQueryIterator.Close()
+
+		for _, kv := range moved {
+			_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+			if err != nil {
+				return fmt.Errorf("Error splitting composite key %s: %s", kv.Key, err)
+			}
+			annotation := &Annotation{}
+			if err := proto.Unmarshal(kv.Value, annotation); err != nil {
+				return fmt.Errorf("Cannot unmarshal Annotation %v: %s", key_parts, err)
+			}
+			if err := ac.stub.DelState(kv.Key); err != nil {
+				return fmt.Errorf("Could not delete state for key %s: %s", kv.Key, err)
+			}
+			annotation.KeyParts[0] = new_key_part
+			key_parts[1] = new_key_part
+			if _, err := ac.putAsset(COMPOSITE_KEY_ANNOTATION_OBJECTTYPE, key_parts, annotation); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// getApiDescriptor describes the registry's functions for teams fronting the chaincode with
+// a REST gateway, as JSON in the style of an OpenAPI document: each function with its
+// middleware flags, whether it is a query function, its arguments and response, and a JSON
+// schema definition for every message those refer to. The description is derived from the
+// handlers, the AppRegistry service and payloadSchemas, so it cannot drift from what dispatch
+// accepts. Functions generated from the service are fully described; for the others only the
+// message arguments are known. Message schemas describe the encoding/json form of the
+// generated structs, the form the gateway reads and writes; JSON documents in state use the
+// proto3 JSON mapping instead.
+
+// apiDescriptor is the document returned by getApiDescriptor.
+type apiDescriptor struct {
+	Functions   map[string]*apiFunction `json:"functions"`
+	Definitions map[string]*jsonSchema  `json:"definitions"`
+}
+
+type apiFunction struct {
+	Write     bool `json:"write,omitempty"` // Submit as a transaction rather than evaluate
+	Admin     bool `json:"admin,omitempty"`
+	Wrapper   bool `json:"wrapper,omitempty"`
+	Migration bool `json:"migration,omitempty"`
+	Query     bool `json:"query,omitempty"` // No side effects, evaluate rather than submit
+	// Set when arguments lists every argument, otherwise only the message arguments
+	Complete  bool           `json:"complete,omitempty"`
+	Arguments []*apiArgument `json:"arguments,omitempty"`
+	Response  *jsonSchema    `json:"response,omitempty"`
+}
+
+// apiArgument describes the positional argument at index, where index 0 is the function name.
+// The arguments after a repeated one, which takes a variable number of arguments, are indexed
+// back from the last argument, as -1, -2..., and transient arguments have index 0.
+type apiArgument struct {
+	Index int    `json:"index"`
+	Name  string `json:"name,omitempty"`
+	// Passed in the transient map under name rather than as an argument
+	Transient bool `json:"transient,omitempty"`
+	// How the argument is passed: "string", "bytes", "decimal", "bool", "enum" by name,
+	// or "proto" for a marshaled message
+	Encoding string      `json:"encoding"`
+	Schema   *jsonSchema `json:"schema"`
+	// Fields that must be set, see payloadSchema
+	Required []string `json:"required,omitempty"`
+}
+
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	EnumNames            []string               `json:"x-enumNames,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+}
+
+// apiDefinitions builds the definitions of an apiDescriptor as message types are referenced.
+type apiDefinitions map[string]*jsonSchema
+
+// messageRef returns a reference to the definition of messageType, a generated struct type,
+// adding the definitions of it and the messages it refers to.
+func (definitions apiDefinitions) messageRef(messageType reflect.Type) *jsonSchema {
+	name := strings.TrimPrefix(proto.MessageName(reflect.New(messageType).Interface().(proto.Message)), "main.")
+	ref := &jsonSchema{Ref: "#/definitions/" + name}
+	if _, ok := definitions[name]; ok {
+		return ref
+	}
+	definition := &jsonSchema{Type: "object", Properties: make(map[string]*jsonSchema)}
+	definitions[name] = definition
+	for i, prop := range proto.GetProperties(messageType).Prop {
+		if prop.Tag > 0 {
+			definition.Properties[prop.OrigName] = definitions.fieldSchema(messageType.Field(i), prop)
+		}
+	}
+	return ref
+}
+
+func (definitions apiDefinitions) fieldSchema(field reflect.StructField, prop *proto.Properties) *jsonSchema {
+	switch {
+	case field.Type.Kind() == reflect.Map:
+		valueProp := &proto.Properties{}
+		valueProp.Parse(field.Tag.Get("protobuf_val"))
+		return &jsonSchema{Type: "object", AdditionalProperties: definitions.valueSchema(field.Type.Elem(), valueProp)}
+	case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() != reflect.Uint8:
+		return &jsonSchema{Type: "array", Items: definitions.valueSchema(field.Type.Elem(), prop)}
+	default:
+		return definitions.valueSchema(field.Type, prop)
+	}
+}
+
+// valueSchema describes a single value of a field of valueType.
+func (definitions apiDefinitions) valueSchema(valueType reflect.Type, prop *proto.Properties) *jsonSchema {
+	if len(prop.Enum) > 0 {
+		schema := &jsonSchema{Type: "integer", Format: "int32"}
+		values := proto.EnumValueMap(prop.Enum)
+		for name := range values {
+			schema.EnumNames = append(schema.EnumNames, name)
+		}
+		sort.Slice(schema.EnumNames, func(i, j int) bool { return values[schema.EnumNames[i]] < values[schema.EnumNames[j]] })
+		for _, name := range schema.EnumNames {
+			schema.Enum = append(schema.Enum, values[name])
+		}
+		return schema
+	}
+	switch valueType.Kind() {
+	case reflect.Ptr:
+		return definitions.messageRef(valueType.Elem())
+	case reflect.Slice:
+		// encoding/json encodes []byte in base64
+		return &jsonSchema{Type: "string", Format: "byte"}
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Float32:
+		return &jsonSchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &jsonSchema{Type: "number", Format: "double"}
+	default:
+		return &jsonSchema{Type: "integer", Format: valueType.Kind().String()}
+	}
+}
+
+// requestArguments describes the arguments of function, a function generated from the
+// AppRegistry service, one per field of its request in the order they are passed.
+func (definitions apiDefinitions) requestArguments(function string) []*apiArgument {
+	var arguments []*apiArgument
+	for _, field := range requestFields(function) {
+		prop := field.prop
+		argument := &apiArgument{Index: field.arg, Name: prop.OrigName, Transient: field.transient, Schema: definitions.fieldSchema(field.field, prop)}
+		switch kind := field.field.Type.Kind(); {
+		case len(prop.Enum) > 0:
+			// Enum arguments are passed by name
+			argument.Encoding = "enum"
+			argument.Schema = &jsonSchema{Type: "string"}
+			for _, name := range definitions.valueSchema(field.field.Type, prop).EnumNames {
+				argument.Schema.Enum = append(argument.Schema.Enum, name)
+			}
+		case kind == reflect.Ptr:
+			argument.Encoding = "proto"
+		case kind == reflect.String:
+			argument.Encoding = "string"
+		case kind == reflect.Slice && field.field.Type.Elem().Kind() == reflect.String:
+			// A repeated string takes the arguments up to those of the fields after it
+			argument.Encoding = "string"
+		case kind == reflect.Slice:
+			argument.Encoding = "bytes"
+		case kind == reflect.Bool:
+			argument.Encoding = "bool"
+		default:
+			argument.Encoding = "decimal"
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments
+}
+
+// describeApi builds the apiDescriptor of every function in handlers.
+func describeApi() *apiDescriptor {
+	definitions := make(apiDefinitions)
+	descriptor := &apiDescriptor{Functions: make(map[string]*apiFunction), Definitions: definitions}
+	for function, h := range handlers {
+		apiFunction := &apiFunction{Write: h.write, Admin: h.admin, Wrapper: h.wrapper, Migration: h.migration, Query: h.isQuery()}
+		if newResponse, ok := serviceResponses[function]; ok {
+			apiFunction.Complete = true
+			apiFunction.Arguments = definitions.requestArguments(function)
+			apiFunction.Response = definitions.messageRef(reflect.TypeOf(newResponse()).Elem())
+		}
+		for _, schema := range payloadSchemasFor(function) {
+			var argument *apiArgument
+			for _, known := range apiFunction.Arguments {
+				if known.Index == schema.arg {
+					argument = known
+				}
+			}
+			if argument == nil {
+				argument = &apiArgument{Index: schema.arg, Encoding: "proto", Schema: definitions.messageRef(reflect.TypeOf(schema.message).Elem())}
+				apiFunction.Arguments = append(apiFunction.Arguments, argument)
+			}
+			argument.Required = schema.required
+		}
+		if !apiFunction.Complete {
+			sort.Slice(apiFunction.Arguments, func(i, j int) bool { return apiFunction.Arguments[i].Index < apiFunction.Arguments[j].Index })
+		}
+		descriptor.Functions[function] = apiFunction
+	}
+	return descriptor
+}
+
+func (ac *assetContext) getApiDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getApiDescriptor")
+	}
+
+	apiDescriptorBytes, err := json.Marshal(describeApi())
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling the API descriptor in getApiDescriptor: %s", err)
+	}
+	return apiDescriptorBytes, nil
+}
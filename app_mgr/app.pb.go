@@ -9,11 +9,142 @@ It is generated from these files:
 
 It has these top-level messages:
 	AppBundle
+	Platform
+	ChaincodePackage
 	AppBundleKeySet
 	AppDescriptor
+	DescriptorSnapshot
+	SupportInfo
+	RoyaltySplit
+	RoyaltySplits
+	PricingTier
+	PricingTiers
+	FieldCommitment
+	FieldCommitments
+	VerificationResult
+	DescriptorPrivateDetails
 	AppDescriptors
+	Collection
+	Pin
+	Watch
+	Reservation
+	Lock
+	AccessRequest
+	Permission
+	Promotion
+	Rollout
+	AssetEnvelope
+	SignedAssetEnvelope
+	CollectionHash
+	RegistryChecksum
+	KeyList
+	BundleKey
+	BundleKeyList
+	BulkGetResult
+	BundleSummary
+	SignatureLink
+	SignatureChain
+	DescriptorWithBundles
+	AssociationResult
+	ExistsResult
+	StateWrite
+	DryRunResult
+	ScriptOperation
+	Script
+	ScriptResult
+	StateRead
+	SimulationResult
+	DemoDataset
+	Precondition
+	Preconditions
+	RateLimit
+	RegistryConfig
+	BootstrapConfig
+	ConfigHistory
+	FeatureFlags
+	ScanPolicy
+	TokenChaincode
+	TokenPayment
+	QueryLimits
+	RateCounter
+	FunctionCounter
+	FunctionStats
+	MigrationState
+	BackfillResult
+	IntegrityReport
+	BundleIntegrityReport
+	ColdCopies
+	OwnershipChallenge
+	OwnershipProof
+	BundleGates
+	GateReport
+	ReadinessReport
+	ResponseWarning
+	ResponseMetadata
+	ConsumerCheckpoint
+	ArtifactChunk
+	RepairRecord
+	OwnershipReassignment
+	Alias
+	ComplianceAttestation
+	ScanResult
+	Sbom
+	SbomComponent
+	ComponentUsage
+	ArtifactLicenseException
+	PolicyRule
+	PolicyRules
+	ComplianceAttestations
+	Annotation
+	Annotations
+	PrivateBundleRecord
+	Auction
+	Bid
+	License
+	Offer
+	UsageRecord
+	Invoice
+	RoyaltyShare
+	RoyaltyEntry
+	RoyaltyStatement
+	InvoiceGenerationResult
+	SettlementRecord
+	Featured
+	FeaturedDescriptors
+	ActivityReport
+	TrendingDescriptors
+	DescriptorRollup
+	RollupProgress
+	RegistryEvent
+	EventJournalEntry
+	RegistryDigest
+	QueryFunctions
+	RichQueryResult
 	Query
 	QueryResult
+	Empty
+	DescriptorRequest
+	AuctionRequest
+	OfferRequest
+	OpenAuctionRequest
+	PlaceBidRequest
+	RevealBidRequest
+	GetLicenseRequest
+	MakeOfferRequest
+	CounterOfferRequest
+	SetPricingTiersRequest
+	SetFeaturedRequest
+	ReportActivityRequest
+	GetTrendingDescriptorsRequest
+	AcquireLockRequest
+	LockRequest
+	RecordConsumerCheckpointRequest
+	ConsumerCheckpointRequest
+	CreatePrivateAppBundleRequest
+	CreateConfidentialAppBundleRequest
+	AnnotateAssetRequest
+	AnnotationsRequest
+	ModerateAnnotationRequest
 */
 package main
 
@@ -32,26 +163,626 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ValidationProfile selects how strictly the writes to a namespace are validated.
+type ValidationProfile int32
+
+const (
+	ValidationProfile_STANDARD ValidationProfile = 0
+	// AppBundles must carry verified owner endorsements, and associated AppBundles a
+	// ComplianceAttestation too.
+	ValidationProfile_STRICT ValidationProfile = 1
+	// For demo channels: payload schemas, the scan gate, the artifact license policy and
+	// PolicyRules are not checked.
+	ValidationProfile_LENIENT ValidationProfile = 2
+)
+
+var ValidationProfile_name = map[int32]string{
+	0: "STANDARD",
+	1: "STRICT",
+	2: "LENIENT",
+}
+var ValidationProfile_value = map[string]int32{
+	"STANDARD": 0,
+	"STRICT":   1,
+	"LENIENT":  2,
+}
+
+func (x ValidationProfile) String() string {
+	return proto.EnumName(ValidationProfile_name, int32(x))
+}
+func (ValidationProfile) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Where the content is kept: HOT bundles hold it on-chain; COLD bundles, demoted by
+// demoteToCold, keep only the content digests and external_uris[i], the location of the
+// content named as content_digests[i], their artifacts and deployment specs being empty.
+type AppBundle_RetentionTier int32
+
+const (
+	AppBundle_HOT  AppBundle_RetentionTier = 0
+	AppBundle_COLD AppBundle_RetentionTier = 1
+)
+
+var AppBundle_RetentionTier_name = map[int32]string{
+	0: "HOT",
+	1: "COLD",
+}
+var AppBundle_RetentionTier_value = map[string]int32{
+	"HOT":  0,
+	"COLD": 1,
+}
+
+func (x AppBundle_RetentionTier) String() string {
+	return proto.EnumName(AppBundle_RetentionTier_name, int32(x))
+}
+func (AppBundle_RetentionTier) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
+
+type Platform_Architecture int32
+
+const (
+	Platform_ARCHITECTURE_UNSPECIFIED Platform_Architecture = 0
+	Platform_AMD64                    Platform_Architecture = 1
+	Platform_ARM64                    Platform_Architecture = 2
+	Platform_S390X                    Platform_Architecture = 3
+)
+
+var Platform_Architecture_name = map[int32]string{
+	0: "ARCHITECTURE_UNSPECIFIED",
+	1: "AMD64",
+	2: "ARM64",
+	3: "S390X",
+}
+var Platform_Architecture_value = map[string]int32{
+	"ARCHITECTURE_UNSPECIFIED": 0,
+	"AMD64":                    1,
+	"ARM64":                    2,
+	"S390X":                    3,
+}
+
+func (x Platform_Architecture) String() string {
+	return proto.EnumName(Platform_Architecture_name, int32(x))
+}
+func (Platform_Architecture) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+
+// Numbered as the ChaincodeSpec.Type of the deployment spec.
+type ChaincodePackage_Language int32
+
+const (
+	ChaincodePackage_UNDEFINED ChaincodePackage_Language = 0
+	ChaincodePackage_GOLANG    ChaincodePackage_Language = 1
+	ChaincodePackage_NODE      ChaincodePackage_Language = 2
+	ChaincodePackage_JAVA      ChaincodePackage_Language = 4
+)
+
+var ChaincodePackage_Language_name = map[int32]string{
+	0: "UNDEFINED",
+	1: "GOLANG",
+	2: "NODE",
+	4: "JAVA",
+}
+var ChaincodePackage_Language_value = map[string]int32{
+	"UNDEFINED": 0,
+	"GOLANG":    1,
+	"NODE":      2,
+	"JAVA":      4,
+}
+
+func (x ChaincodePackage_Language) String() string {
+	return proto.EnumName(ChaincodePackage_Language_name, int32(x))
+}
+func (ChaincodePackage_Language) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 0}
+}
+
+type SupportInfo_SlaTier int32
+
+const (
+	// Best effort, no commitment.
+	SupportInfo_NONE     SupportInfo_SlaTier = 0
+	SupportInfo_BASIC    SupportInfo_SlaTier = 1
+	SupportInfo_STANDARD SupportInfo_SlaTier = 2
+	SupportInfo_PREMIUM  SupportInfo_SlaTier = 3
+)
+
+var SupportInfo_SlaTier_name = map[int32]string{
+	0: "NONE",
+	1: "BASIC",
+	2: "STANDARD",
+	3: "PREMIUM",
+}
+var SupportInfo_SlaTier_value = map[string]int32{
+	"NONE":     0,
+	"BASIC":    1,
+	"STANDARD": 2,
+	"PREMIUM":  3,
+}
+
+func (x SupportInfo_SlaTier) String() string {
+	return proto.EnumName(SupportInfo_SlaTier_name, int32(x))
+}
+func (SupportInfo_SlaTier) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
+
+type AccessRequest_Status int32
+
+const (
+	AccessRequest_PENDING AccessRequest_Status = 0
+	AccessRequest_GRANTED AccessRequest_Status = 1
+	AccessRequest_DENIED  AccessRequest_Status = 2
+)
+
+var AccessRequest_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "GRANTED",
+	2: "DENIED",
+}
+var AccessRequest_Status_value = map[string]int32{
+	"PENDING": 0,
+	"GRANTED": 1,
+	"DENIED":  2,
+}
+
+func (x AccessRequest_Status) String() string {
+	return proto.EnumName(AccessRequest_Status_name, int32(x))
+}
+func (AccessRequest_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{21, 0} }
+
+type Promotion_Environment int32
+
+const (
+	Promotion_DEV     Promotion_Environment = 0
+	Promotion_STAGING Promotion_Environment = 1
+	Promotion_PROD    Promotion_Environment = 2
+)
+
+var Promotion_Environment_name = map[int32]string{
+	0: "DEV",
+	1: "STAGING",
+	2: "PROD",
+}
+var Promotion_Environment_value = map[string]int32{
+	"DEV":     0,
+	"STAGING": 1,
+	"PROD":    2,
+}
+
+func (x Promotion_Environment) String() string {
+	return proto.EnumName(Promotion_Environment_name, int32(x))
+}
+func (Promotion_Environment) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{23, 0} }
+
+type Rollout_Status int32
+
+const (
+	Rollout_PLANNED     Rollout_Status = 0
+	Rollout_IN_PROGRESS Rollout_Status = 1
+	Rollout_HALTED      Rollout_Status = 2
+	Rollout_COMPLETED   Rollout_Status = 3
+)
+
+var Rollout_Status_name = map[int32]string{
+	0: "PLANNED",
+	1: "IN_PROGRESS",
+	2: "HALTED",
+	3: "COMPLETED",
+}
+var Rollout_Status_value = map[string]int32{
+	"PLANNED":     0,
+	"IN_PROGRESS": 1,
+	"HALTED":      2,
+	"COMPLETED":   3,
+}
+
+func (x Rollout_Status) String() string {
+	return proto.EnumName(Rollout_Status_name, int32(x))
+}
+func (Rollout_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{24, 0} }
+
+type RegistryConfig_PauseMode int32
+
+const (
+	RegistryConfig_RUNNING       RegistryConfig_PauseMode = 0
+	RegistryConfig_WRITES_PAUSED RegistryConfig_PauseMode = 1
+	RegistryConfig_ALL_PAUSED    RegistryConfig_PauseMode = 2
+	// Only admins may call functions, and only admin, migration and allowlisted ones.
+	RegistryConfig_MAINTENANCE RegistryConfig_PauseMode = 3
+)
+
+var RegistryConfig_PauseMode_name = map[int32]string{
+	0: "RUNNING",
+	1: "WRITES_PAUSED",
+	2: "ALL_PAUSED",
+	3: "MAINTENANCE",
+}
+var RegistryConfig_PauseMode_value = map[string]int32{
+	"RUNNING":       0,
+	"WRITES_PAUSED": 1,
+	"ALL_PAUSED":    2,
+	"MAINTENANCE":   3,
+}
+
+func (x RegistryConfig_PauseMode) String() string {
+	return proto.EnumName(RegistryConfig_PauseMode_name, int32(x))
+}
+func (RegistryConfig_PauseMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{50, 0}
+}
+
+type RegistryConfig_StorageEncoding int32
+
+const (
+	RegistryConfig_PROTO RegistryConfig_StorageEncoding = 0
+	// The proto bytes, plus a JSON document under the companion <object_type>_JSON key.
+	RegistryConfig_PROTO_AND_JSON RegistryConfig_StorageEncoding = 1
+	RegistryConfig_JSON           RegistryConfig_StorageEncoding = 2
+)
+
+var RegistryConfig_StorageEncoding_name = map[int32]string{
+	0: "PROTO",
+	1: "PROTO_AND_JSON",
+	2: "JSON",
+}
+var RegistryConfig_StorageEncoding_value = map[string]int32{
+	"PROTO":          0,
+	"PROTO_AND_JSON": 1,
+	"JSON":           2,
+}
+
+func (x RegistryConfig_StorageEncoding) String() string {
+	return proto.EnumName(RegistryConfig_StorageEncoding_name, int32(x))
+}
+func (RegistryConfig_StorageEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{50, 1}
+}
+
+type ScanResult_Verdict int32
+
+const (
+	ScanResult_FAIL ScanResult_Verdict = 0
+	ScanResult_PASS ScanResult_Verdict = 1
+)
+
+var ScanResult_Verdict_name = map[int32]string{
+	0: "FAIL",
+	1: "PASS",
+}
+var ScanResult_Verdict_value = map[string]int32{
+	"FAIL": 0,
+	"PASS": 1,
+}
+
+func (x ScanResult_Verdict) String() string {
+	return proto.EnumName(ScanResult_Verdict_name, int32(x))
+}
+func (ScanResult_Verdict) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{79, 0} }
+
+type Sbom_Format int32
+
+const (
+	Sbom_SPDX      Sbom_Format = 0
+	Sbom_CYCLONEDX Sbom_Format = 1
+)
+
+var Sbom_Format_name = map[int32]string{
+	0: "SPDX",
+	1: "CYCLONEDX",
+}
+var Sbom_Format_value = map[string]int32{
+	"SPDX":      0,
+	"CYCLONEDX": 1,
+}
+
+func (x Sbom_Format) String() string {
+	return proto.EnumName(Sbom_Format_name, int32(x))
+}
+func (Sbom_Format) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{80, 0} }
+
+type PolicyRule_Predicate_Op int32
+
+const (
+	// The field is set to a non-zero value, or a repeated field is non-empty.
+	PolicyRule_Predicate_PRESENT PolicyRule_Predicate_Op = 0
+	PolicyRule_Predicate_ABSENT  PolicyRule_Predicate_Op = 1
+	// Compare the value of a scalar field, enums by name.
+	PolicyRule_Predicate_EQUALS     PolicyRule_Predicate_Op = 2
+	PolicyRule_Predicate_NOT_EQUALS PolicyRule_Predicate_Op = 3
+	// Bound the number of elements of a repeated field.
+	PolicyRule_Predicate_MIN_COUNT PolicyRule_Predicate_Op = 4
+	PolicyRule_Predicate_MAX_COUNT PolicyRule_Predicate_Op = 5
+)
+
+var PolicyRule_Predicate_Op_name = map[int32]string{
+	0: "PRESENT",
+	1: "ABSENT",
+	2: "EQUALS",
+	3: "NOT_EQUALS",
+	4: "MIN_COUNT",
+	5: "MAX_COUNT",
+}
+var PolicyRule_Predicate_Op_value = map[string]int32{
+	"PRESENT":    0,
+	"ABSENT":     1,
+	"EQUALS":     2,
+	"NOT_EQUALS": 3,
+	"MIN_COUNT":  4,
+	"MAX_COUNT":  5,
+}
+
+func (x PolicyRule_Predicate_Op) String() string {
+	return proto.EnumName(PolicyRule_Predicate_Op_name, int32(x))
+}
+func (PolicyRule_Predicate_Op) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{84, 0, 0}
+}
+
+type Annotation_Status int32
+
+const (
+	Annotation_VISIBLE Annotation_Status = 0
+	// Hidden by the owner of the asset, and returned to the owner only.
+	Annotation_HIDDEN Annotation_Status = 1
+)
+
+var Annotation_Status_name = map[int32]string{
+	0: "VISIBLE",
+	1: "HIDDEN",
+}
+var Annotation_Status_value = map[string]int32{
+	"VISIBLE": 0,
+	"HIDDEN":  1,
+}
+
+func (x Annotation_Status) String() string {
+	return proto.EnumName(Annotation_Status_name, int32(x))
+}
+func (Annotation_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{87, 0} }
+
+type Auction_Status int32
+
+const (
+	Auction_OPEN   Auction_Status = 0
+	Auction_CLOSED Auction_Status = 1
+)
+
+var Auction_Status_name = map[int32]string{
+	0: "OPEN",
+	1: "CLOSED",
+}
+var Auction_Status_value = map[string]int32{
+	"OPEN":   0,
+	"CLOSED": 1,
+}
+
+func (x Auction_Status) String() string {
+	return proto.EnumName(Auction_Status_name, int32(x))
+}
+func (Auction_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{90, 0} }
+
+type Offer_Status int32
+
+const (
+	Offer_OPEN     Offer_Status = 0
+	Offer_ACCEPTED Offer_Status = 1
+	Offer_REJECTED Offer_Status = 2
+)
+
+var Offer_Status_name = map[int32]string{
+	0: "OPEN",
+	1: "ACCEPTED",
+	2: "REJECTED",
+}
+var Offer_Status_value = map[string]int32{
+	"OPEN":     0,
+	"ACCEPTED": 1,
+	"REJECTED": 2,
+}
+
+func (x Offer_Status) String() string {
+	return proto.EnumName(Offer_Status_name, int32(x))
+}
+func (Offer_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{93, 0} }
+
+type Offer_Party int32
+
+const (
+	Offer_PUBLISHER Offer_Party = 0
+	Offer_CONSUMER  Offer_Party = 1
+)
+
+var Offer_Party_name = map[int32]string{
+	0: "PUBLISHER",
+	1: "CONSUMER",
+}
+var Offer_Party_value = map[string]int32{
+	"PUBLISHER": 0,
+	"CONSUMER":  1,
+}
+
+func (x Offer_Party) String() string {
+	return proto.EnumName(Offer_Party_name, int32(x))
+}
+func (Offer_Party) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{93, 1} }
+
+type Invoice_Status int32
+
+const (
+	Invoice_OPEN    Invoice_Status = 0
+	Invoice_SETTLED Invoice_Status = 1
+)
+
+var Invoice_Status_name = map[int32]string{
+	0: "OPEN",
+	1: "SETTLED",
+}
+var Invoice_Status_value = map[string]int32{
+	"OPEN":    0,
+	"SETTLED": 1,
+}
+
+func (x Invoice_Status) String() string {
+	return proto.EnumName(Invoice_Status_name, int32(x))
+}
+func (Invoice_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{95, 0} }
+
+type ActivityReport_Kind int32
+
+const (
+	ActivityReport_VIEW     ActivityReport_Kind = 0
+	ActivityReport_DOWNLOAD ActivityReport_Kind = 1
+	ActivityReport_INSTALL  ActivityReport_Kind = 2
+)
+
+var ActivityReport_Kind_name = map[int32]string{
+	0: "VIEW",
+	1: "DOWNLOAD",
+	2: "INSTALL",
+}
+var ActivityReport_Kind_value = map[string]int32{
+	"VIEW":     0,
+	"DOWNLOAD": 1,
+	"INSTALL":  2,
+}
+
+func (x ActivityReport_Kind) String() string {
+	return proto.EnumName(ActivityReport_Kind_name, int32(x))
+}
+func (ActivityReport_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{103, 0} }
+
 type Query_ObjectType int32
 
 const (
-	Query_APP_DESCRIPTOR Query_ObjectType = 0
-	Query_APP_BUNDLE     Query_ObjectType = 1
+	Query_APP_DESCRIPTOR             Query_ObjectType = 0
+	Query_APP_BUNDLE                 Query_ObjectType = 1
+	Query_COLLECTION                 Query_ObjectType = 2
+	Query_PIN                        Query_ObjectType = 3
+	Query_ACCESS_REQUEST             Query_ObjectType = 4
+	Query_PERMISSION                 Query_ObjectType = 5
+	Query_PROMOTION                  Query_ObjectType = 6
+	Query_CONFIG                     Query_ObjectType = 7
+	Query_RATE_COUNTER               Query_ObjectType = 8
+	Query_PRIVATE_BUNDLE_RECORD      Query_ObjectType = 9
+	Query_AUCTION                    Query_ObjectType = 10
+	Query_BID                        Query_ObjectType = 11
+	Query_LICENSE                    Query_ObjectType = 12
+	Query_OFFER                      Query_ObjectType = 13
+	Query_USAGE                      Query_ObjectType = 14
+	Query_INVOICE                    Query_ObjectType = 15
+	Query_SETTLEMENT                 Query_ObjectType = 16
+	Query_ROYALTY                    Query_ObjectType = 17
+	Query_FEATURED                   Query_ObjectType = 18
+	Query_ACTIVITY                   Query_ObjectType = 19
+	Query_ROLLUP                     Query_ObjectType = 20
+	Query_ROLLUP_PROGRESS            Query_ObjectType = 21
+	Query_REPAIR                     Query_ObjectType = 22
+	Query_ALIAS                      Query_ObjectType = 23
+	Query_COMPLIANCE_ATTESTATION     Query_ObjectType = 24
+	Query_SCAN_RESULT                Query_ObjectType = 25
+	Query_SBOM                       Query_ObjectType = 26
+	Query_SBOM_COMPONENT             Query_ObjectType = 27
+	Query_ARTIFACT_LICENSE_EXCEPTION Query_ObjectType = 28
+	Query_ROLLOUT                    Query_ObjectType = 29
+	Query_WATCH                      Query_ObjectType = 30
+	Query_RESERVATION                Query_ObjectType = 31
+	Query_FUNCTION_STATS             Query_ObjectType = 32
+	Query_OWNERSHIP_CHALLENGE        Query_ObjectType = 33
+	Query_OWNERSHIP_PROOF            Query_ObjectType = 34
+	Query_BUNDLE_GATES               Query_ObjectType = 35
+	Query_CONSUMER_CHECKPOINT        Query_ObjectType = 36
+	Query_LOCK                       Query_ObjectType = 37
+	Query_EVENT_JOURNAL              Query_ObjectType = 38
+	Query_ANNOTATION                 Query_ObjectType = 40
+	Query_ROYALTY_TOTAL              Query_ObjectType = 41
 )
 
 var Query_ObjectType_name = map[int32]string{
-	0: "APP_DESCRIPTOR",
-	1: "APP_BUNDLE",
+	0:  "APP_DESCRIPTOR",
+	1:  "APP_BUNDLE",
+	2:  "COLLECTION",
+	3:  "PIN",
+	4:  "ACCESS_REQUEST",
+	5:  "PERMISSION",
+	6:  "PROMOTION",
+	7:  "CONFIG",
+	8:  "RATE_COUNTER",
+	9:  "PRIVATE_BUNDLE_RECORD",
+	10: "AUCTION",
+	11: "BID",
+	12: "LICENSE",
+	13: "OFFER",
+	14: "USAGE",
+	15: "INVOICE",
+	16: "SETTLEMENT",
+	17: "ROYALTY",
+	18: "FEATURED",
+	19: "ACTIVITY",
+	20: "ROLLUP",
+	21: "ROLLUP_PROGRESS",
+	22: "REPAIR",
+	23: "ALIAS",
+	24: "COMPLIANCE_ATTESTATION",
+	25: "SCAN_RESULT",
+	26: "SBOM",
+	27: "SBOM_COMPONENT",
+	28: "ARTIFACT_LICENSE_EXCEPTION",
+	29: "ROLLOUT",
+	30: "WATCH",
+	31: "RESERVATION",
+	32: "FUNCTION_STATS",
+	33: "OWNERSHIP_CHALLENGE",
+	34: "OWNERSHIP_PROOF",
+	35: "BUNDLE_GATES",
+	36: "CONSUMER_CHECKPOINT",
+	37: "LOCK",
+	38: "EVENT_JOURNAL",
+	40: "ANNOTATION",
+	41: "ROYALTY_TOTAL",
 }
 var Query_ObjectType_value = map[string]int32{
-	"APP_DESCRIPTOR": 0,
-	"APP_BUNDLE":     1,
+	"APP_DESCRIPTOR":             0,
+	"APP_BUNDLE":                 1,
+	"COLLECTION":                 2,
+	"PIN":                        3,
+	"ACCESS_REQUEST":             4,
+	"PERMISSION":                 5,
+	"PROMOTION":                  6,
+	"CONFIG":                     7,
+	"RATE_COUNTER":               8,
+	"PRIVATE_BUNDLE_RECORD":      9,
+	"AUCTION":                    10,
+	"BID":                        11,
+	"LICENSE":                    12,
+	"OFFER":                      13,
+	"USAGE":                      14,
+	"INVOICE":                    15,
+	"SETTLEMENT":                 16,
+	"ROYALTY":                    17,
+	"FEATURED":                   18,
+	"ACTIVITY":                   19,
+	"ROLLUP":                     20,
+	"ROLLUP_PROGRESS":            21,
+	"REPAIR":                     22,
+	"ALIAS":                      23,
+	"COMPLIANCE_ATTESTATION":     24,
+	"SCAN_RESULT":                25,
+	"SBOM":                       26,
+	"SBOM_COMPONENT":             27,
+	"ARTIFACT_LICENSE_EXCEPTION": 28,
+	"ROLLOUT":                    29,
+	"WATCH":                      30,
+	"RESERVATION":                31,
+	"FUNCTION_STATS":             32,
+	"OWNERSHIP_CHALLENGE":        33,
+	"OWNERSHIP_PROOF":            34,
+	"BUNDLE_GATES":               35,
+	"CONSUMER_CHECKPOINT":        36,
+	"LOCK":                       37,
+	"EVENT_JOURNAL":              38,
+	"ANNOTATION":                 40,
+	"ROYALTY_TOTAL":              41,
 }
 
 func (x Query_ObjectType) String() string {
 	return proto.EnumName(Query_ObjectType_name, int32(x))
 }
-func (Query_ObjectType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 0} }
+func (Query_ObjectType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{112, 0} }
 
 type AppBundle struct {
 	Owner                    []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -61,6 +792,40 @@ type AppBundle struct {
 	// The endorsements of the above deployment spec, the owner's signature over
 	// artifacts[] + chaincode_deployment_spec[] + Endorsement.endorser.
 	OwnerEndorsements [][]byte `protobuf:"bytes,5,rep,name=owner_endorsements,json=ownerEndorsements,proto3" json:"owner_endorsements,omitempty"`
+	// Restricted bundles may only be read by their owner or by identities
+	// holding a Permission granted through the access-request workflow.
+	Restricted bool `protobuf:"varint,6,opt,name=restricted" json:"restricted,omitempty"`
+	// The normalized owner, see normalizeIdentity.
+	OwnerId string `protobuf:"bytes,7,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	// Transaction timestamp of creation, in seconds since the epoch.
+	CreatedAt int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// MSP IDs of the organizations that submitted the creation and the last change.
+	CreatedMspId string `protobuf:"bytes,9,opt,name=created_msp_id,json=createdMspId" json:"created_msp_id,omitempty"`
+	UpdatedMspId string `protobuf:"bytes,10,opt,name=updated_msp_id,json=updatedMspId" json:"updated_msp_id,omitempty"`
+	// SPDX license identifiers, artifact_licenses[i] being the license of artifacts[i]; may be
+	// empty, otherwise one per artifact.
+	ArtifactLicenses []string `protobuf:"bytes,11,rep,name=artifact_licenses,json=artifactLicenses" json:"artifact_licenses,omitempty"`
+	// chaincode_packages[i] describes chaincode_deployment_specs[i]; see ChaincodePackage.
+	ChaincodePackages []*ChaincodePackage `protobuf:"bytes,12,rep,name=chaincode_packages,json=chaincodePackages" json:"chaincode_packages,omitempty"`
+	// The platforms the bundle was built for; empty if it runs on any.
+	TargetPlatforms []*Platform `protobuf:"bytes,13,rep,name=target_platforms,json=targetPlatforms" json:"target_platforms,omitempty"`
+	// The oldest Fabric release the bundle runs on, as MAJOR.MINOR or MAJOR.MINOR.PATCH, e.g.
+	// "1.4.2"; empty if any.
+	MinFabricVersion string `protobuf:"bytes,14,opt,name=min_fabric_version,json=minFabricVersion" json:"min_fabric_version,omitempty"`
+	// SHA-256 digests of the artifacts followed by the chaincode deployment specs, recorded at
+	// creation, and the Merkle root over them; see bundleintegrity.go.
+	ContentDigests [][]byte                `protobuf:"bytes,15,rep,name=content_digests,json=contentDigests,proto3" json:"content_digests,omitempty"`
+	MerkleRoot     []byte                  `protobuf:"bytes,16,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	RetentionTier  AppBundle_RetentionTier `protobuf:"varint,17,opt,name=retention_tier,json=retentionTier,enum=main.AppBundle_RetentionTier" json:"retention_tier,omitempty"`
+	ExternalUris   []string                `protobuf:"bytes,18,rep,name=external_uris,json=externalUris" json:"external_uris,omitempty"`
+	// The publications this AppBundle was republished from, the original first, see
+	// republishBundle; empty for an original publication.
+	SignatureChain []*SignatureLink `protobuf:"bytes,19,rep,name=signature_chain,json=signatureChain" json:"signature_chain,omitempty"`
+	// A DER RFC 3161 TimeStampToken whose message imprint is the merkle_root, see
+	// timestamptoken.go.
+	TimestampToken []byte `protobuf:"bytes,20,opt,name=timestamp_token,json=timestampToken,proto3" json:"timestamp_token,omitempty"`
+	// The genTime of the timestamp_token, in seconds since the epoch.
+	TimestampedAt int64 `protobuf:"varint,21,opt,name=timestamped_at,json=timestampedAt" json:"timestamped_at,omitempty"`
 }
 
 func (m *AppBundle) Reset()                    { *m = AppBundle{} }
@@ -103,206 +868,7753 @@ func (m *AppBundle) GetOwnerEndorsements() [][]byte {
 	return nil
 }
 
-type AppBundleKeySet struct {
-	DescriptorId string   `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
-	BundleKeys   []string `protobuf:"bytes,2,rep,name=bundle_keys,json=bundleKeys" json:"bundle_keys,omitempty"`
+func (m *AppBundle) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
 }
 
-func (m *AppBundleKeySet) Reset()                    { *m = AppBundleKeySet{} }
-func (m *AppBundleKeySet) String() string            { return proto.CompactTextString(m) }
-func (*AppBundleKeySet) ProtoMessage()               {}
-func (*AppBundleKeySet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
-
-func (m *AppBundleKeySet) GetDescriptorId() string {
+func (m *AppBundle) GetOwnerId() string {
 	if m != nil {
-		return m.DescriptorId
+		return m.OwnerId
 	}
 	return ""
 }
 
-func (m *AppBundleKeySet) GetBundleKeys() []string {
+func (m *AppBundle) GetCreatedAt() int64 {
 	if m != nil {
-		return m.BundleKeys
+		return m.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-type AppDescriptor struct {
-	Owner       []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	BundleId    string `protobuf:"bytes,3,opt,name=bundle_id,json=bundleId" json:"bundle_id,omitempty"`
+func (m *AppBundle) GetCreatedMspId() string {
+	if m != nil {
+		return m.CreatedMspId
+	}
+	return ""
 }
 
-func (m *AppDescriptor) Reset()                    { *m = AppDescriptor{} }
-func (m *AppDescriptor) String() string            { return proto.CompactTextString(m) }
-func (*AppDescriptor) ProtoMessage()               {}
-func (*AppDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (m *AppBundle) GetUpdatedMspId() string {
+	if m != nil {
+		return m.UpdatedMspId
+	}
+	return ""
+}
 
-func (m *AppDescriptor) GetOwner() []byte {
+func (m *AppBundle) GetArtifactLicenses() []string {
 	if m != nil {
-		return m.Owner
+		return m.ArtifactLicenses
 	}
 	return nil
 }
 
-func (m *AppDescriptor) GetDescription() string {
+func (m *AppBundle) GetChaincodePackages() []*ChaincodePackage {
 	if m != nil {
-		return m.Description
+		return m.ChaincodePackages
 	}
-	return ""
+	return nil
 }
 
-func (m *AppDescriptor) GetBundleId() string {
+func (m *AppBundle) GetTargetPlatforms() []*Platform {
 	if m != nil {
-		return m.BundleId
+		return m.TargetPlatforms
 	}
-	return ""
+	return nil
 }
 
-type AppDescriptors struct {
-	Descriptors map[string]*AppDescriptor `protobuf:"bytes,3,rep,name=descriptors" json:"descriptors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (m *AppBundle) GetMinFabricVersion() string {
+	if m != nil {
+		return m.MinFabricVersion
+	}
+	return ""
 }
 
-func (m *AppDescriptors) Reset()                    { *m = AppDescriptors{} }
-func (m *AppDescriptors) String() string            { return proto.CompactTextString(m) }
-func (*AppDescriptors) ProtoMessage()               {}
-func (*AppDescriptors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
-
-func (m *AppDescriptors) GetDescriptors() map[string]*AppDescriptor {
+func (m *AppBundle) GetContentDigests() [][]byte {
 	if m != nil {
-		return m.Descriptors
+		return m.ContentDigests
 	}
 	return nil
 }
 
-type Query struct {
-	ObjectType   Query_ObjectType `protobuf:"varint,1,opt,name=object_type,json=objectType,enum=main.Query_ObjectType" json:"object_type,omitempty"`
-	KeyParts     []string         `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
-	Offset       uint32           `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
-	ReturnValues bool             `protobuf:"varint,4,opt,name=return_values,json=returnValues" json:"return_values,omitempty"`
-	MaxCount     uint32           `protobuf:"varint,5,opt,name=max_count,json=maxCount" json:"max_count,omitempty"`
+func (m *AppBundle) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
 }
 
-func (m *Query) Reset()                    { *m = Query{} }
-func (m *Query) String() string            { return proto.CompactTextString(m) }
-func (*Query) ProtoMessage()               {}
-func (*Query) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
-
-func (m *Query) GetObjectType() Query_ObjectType {
+func (m *AppBundle) GetRetentionTier() AppBundle_RetentionTier {
 	if m != nil {
-		return m.ObjectType
+		return m.RetentionTier
 	}
-	return Query_APP_DESCRIPTOR
+	return AppBundle_HOT
 }
 
-func (m *Query) GetKeyParts() []string {
+func (m *AppBundle) GetExternalUris() []string {
 	if m != nil {
-		return m.KeyParts
+		return m.ExternalUris
 	}
 	return nil
 }
 
-func (m *Query) GetOffset() uint32 {
+func (m *AppBundle) GetSignatureChain() []*SignatureLink {
 	if m != nil {
-		return m.Offset
+		return m.SignatureChain
 	}
-	return 0
+	return nil
 }
 
-func (m *Query) GetReturnValues() bool {
+func (m *AppBundle) GetTimestampToken() []byte {
 	if m != nil {
-		return m.ReturnValues
+		return m.TimestampToken
 	}
-	return false
+	return nil
 }
 
-func (m *Query) GetMaxCount() uint32 {
+func (m *AppBundle) GetTimestampedAt() int64 {
 	if m != nil {
-		return m.MaxCount
+		return m.TimestampedAt
 	}
 	return 0
 }
 
-type QueryResult struct {
-	Query   *Query            `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
-	HasMore bool              `protobuf:"varint,2,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
-	Results map[string][]byte `protobuf:"bytes,3,rep,name=results" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// Platform is a target operating system and CPU architecture.
+type Platform struct {
+	// As GOOS, e.g. "linux"; empty for any.
+	Os           string                `protobuf:"bytes,1,opt,name=os" json:"os,omitempty"`
+	Architecture Platform_Architecture `protobuf:"varint,2,opt,name=architecture,enum=main.Platform_Architecture" json:"architecture,omitempty"`
 }
 
-func (m *QueryResult) Reset()                    { *m = QueryResult{} }
-func (m *QueryResult) String() string            { return proto.CompactTextString(m) }
-func (*QueryResult) ProtoMessage()               {}
-func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *Platform) Reset()                    { *m = Platform{} }
+func (m *Platform) String() string            { return proto.CompactTextString(m) }
+func (*Platform) ProtoMessage()               {}
+func (*Platform) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
-func (m *QueryResult) GetQuery() *Query {
+func (m *Platform) GetOs() string {
 	if m != nil {
-		return m.Query
+		return m.Os
+	}
+	return ""
+}
+
+func (m *Platform) GetArchitecture() Platform_Architecture {
+	if m != nil {
+		return m.Architecture
+	}
+	return Platform_ARCHITECTURE_UNSPECIFIED
+}
+
+// ChaincodePackage describes a chaincode deployment spec of an AppBundle. The name, version and
+// language are read from the spec when the AppBundle is created; the runtime is given by the
+// owner, since a deployment spec does not record it.
+type ChaincodePackage struct {
+	Name     string                    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Version  string                    `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	Language ChaincodePackage_Language `protobuf:"varint,3,opt,name=language,enum=main.ChaincodePackage_Language" json:"language,omitempty"`
+	// The runtime the package was built for, e.g. "go1.12", "node10" or "java11".
+	Runtime string `protobuf:"bytes,4,opt,name=runtime" json:"runtime,omitempty"`
+}
+
+func (m *ChaincodePackage) Reset()                    { *m = ChaincodePackage{} }
+func (m *ChaincodePackage) String() string            { return proto.CompactTextString(m) }
+func (*ChaincodePackage) ProtoMessage()               {}
+func (*ChaincodePackage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ChaincodePackage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChaincodePackage) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ChaincodePackage) GetLanguage() ChaincodePackage_Language {
+	if m != nil {
+		return m.Language
+	}
+	return ChaincodePackage_UNDEFINED
+}
+
+func (m *ChaincodePackage) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+type AppBundleKeySet struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	// In key order, the order bookmarks page through.
+	BundleKeys []string `protobuf:"bytes,2,rep,name=bundle_keys,json=bundleKeys" json:"bundle_keys,omitempty"`
+	// Set when the query limits truncated the results; pass bookmark to get the rest.
+	HasMore  bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	Bookmark string `protobuf:"bytes,4,opt,name=bookmark" json:"bookmark,omitempty"`
+	// The content hash of each AppBundle, in the order of bundle_keys.
+	BundleHashes [][]byte `protobuf:"bytes,5,rep,name=bundle_hashes,json=bundleHashes,proto3" json:"bundle_hashes,omitempty"`
+	// Set when the key set holds all the AppBundles of the descriptor, see CollectionHash.
+	CollectionHash []byte `protobuf:"bytes,6,opt,name=collection_hash,json=collectionHash,proto3" json:"collection_hash,omitempty"`
+}
+
+func (m *AppBundleKeySet) Reset()                    { *m = AppBundleKeySet{} }
+func (m *AppBundleKeySet) String() string            { return proto.CompactTextString(m) }
+func (*AppBundleKeySet) ProtoMessage()               {}
+func (*AppBundleKeySet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *AppBundleKeySet) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *AppBundleKeySet) GetBundleKeys() []string {
+	if m != nil {
+		return m.BundleKeys
 	}
 	return nil
 }
 
-func (m *QueryResult) GetHasMore() bool {
+func (m *AppBundleKeySet) GetHasMore() bool {
 	if m != nil {
 		return m.HasMore
 	}
 	return false
 }
 
-func (m *QueryResult) GetResults() map[string][]byte {
+func (m *AppBundleKeySet) GetBookmark() string {
 	if m != nil {
-		return m.Results
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *AppBundleKeySet) GetBundleHashes() [][]byte {
+	if m != nil {
+		return m.BundleHashes
+	}
+	return nil
+}
+
+func (m *AppBundleKeySet) GetCollectionHash() []byte {
+	if m != nil {
+		return m.CollectionHash
+	}
+	return nil
+}
+
+type AppDescriptor struct {
+	Owner       []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	BundleId    string `protobuf:"bytes,3,opt,name=bundle_id,json=bundleId" json:"bundle_id,omitempty"`
+	// Optional key of the AppDescriptor this descriptor is a variant of.
+	ParentDescriptorKey string `protobuf:"bytes,4,opt,name=parent_descriptor_key,json=parentDescriptorKey" json:"parent_descriptor_key,omitempty"`
+	// The bundle currently promoted to each environment, keyed by Promotion.Environment name.
+	EnvironmentBundleIds map[string]string `protobuf:"bytes,5,rep,name=environment_bundle_ids,json=environmentBundleIds" json:"environment_bundle_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The normalized owner, see normalizeIdentity.
+	OwnerId string `protobuf:"bytes,6,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	// Transaction timestamp of creation, in seconds since the epoch.
+	CreatedAt int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	Tags      []string `protobuf:"bytes,8,rep,name=tags" json:"tags,omitempty"`
+	// The private data collection holding the descriptor's DescriptorPrivateDetails, if any.
+	PrivateCollection string `protobuf:"bytes,9,opt,name=private_collection,json=privateCollection" json:"private_collection,omitempty"`
+	// Never stored publicly: set by getAppDescriptor for callers authorized to see it.
+	PrivateDetails *DescriptorPrivateDetails `protobuf:"bytes,10,opt,name=private_details,json=privateDetails" json:"private_details,omitempty"`
+	// Commitments to sensitive values kept in private data or off-chain.
+	FieldCommitments []*FieldCommitment `protobuf:"bytes,11,rep,name=field_commitments,json=fieldCommitments" json:"field_commitments,omitempty"`
+	PricingTiers     []*PricingTier     `protobuf:"bytes,12,rep,name=pricing_tiers,json=pricingTiers" json:"pricing_tiers,omitempty"`
+	// Shares of settled revenue owed to parties other than the owner, who receives the rest.
+	RoyaltySplits []*RoyaltySplit `protobuf:"bytes,13,rep,name=royalty_splits,json=royaltySplits" json:"royalty_splits,omitempty"`
+	// MSP IDs of the organizations that submitted the creation and the last change.
+	CreatedMspId string `protobuf:"bytes,14,opt,name=created_msp_id,json=createdMspId" json:"created_msp_id,omitempty"`
+	UpdatedMspId string `protobuf:"bytes,15,opt,name=updated_msp_id,json=updatedMspId" json:"updated_msp_id,omitempty"`
+	// Where consumers report issues, see setDescriptorSupport.
+	Support *SupportInfo `protobuf:"bytes,16,opt,name=support" json:"support,omitempty"`
+}
+
+func (m *AppDescriptor) Reset()                    { *m = AppDescriptor{} }
+func (m *AppDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*AppDescriptor) ProtoMessage()               {}
+func (*AppDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *AppDescriptor) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetBundleId() string {
+	if m != nil {
+		return m.BundleId
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetParentDescriptorKey() string {
+	if m != nil {
+		return m.ParentDescriptorKey
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetEnvironmentBundleIds() map[string]string {
+	if m != nil {
+		return m.EnvironmentBundleIds
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AppDescriptor) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetPrivateCollection() string {
+	if m != nil {
+		return m.PrivateCollection
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetPrivateDetails() *DescriptorPrivateDetails {
+	if m != nil {
+		return m.PrivateDetails
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetFieldCommitments() []*FieldCommitment {
+	if m != nil {
+		return m.FieldCommitments
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetPricingTiers() []*PricingTier {
+	if m != nil {
+		return m.PricingTiers
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetRoyaltySplits() []*RoyaltySplit {
+	if m != nil {
+		return m.RoyaltySplits
+	}
+	return nil
+}
+
+func (m *AppDescriptor) GetCreatedMspId() string {
+	if m != nil {
+		return m.CreatedMspId
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetUpdatedMspId() string {
+	if m != nil {
+		return m.UpdatedMspId
+	}
+	return ""
+}
+
+func (m *AppDescriptor) GetSupport() *SupportInfo {
+	if m != nil {
+		return m.Support
+	}
+	return nil
+}
+
+// DescriptorSnapshot is an AppDescriptor as it was stored at a past time, see
+// getDescriptorAsOf.
+type DescriptorSnapshot struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	// The requested time, in seconds since the epoch.
+	AsOf int64 `protobuf:"varint,2,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
+	// The transaction that wrote the snapshot, and its timestamp in seconds since the epoch.
+	TxId          string         `protobuf:"bytes,3,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	Timestamp     int64          `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	AppDescriptor *AppDescriptor `protobuf:"bytes,5,opt,name=app_descriptor,json=appDescriptor" json:"app_descriptor,omitempty"`
+}
+
+func (m *DescriptorSnapshot) Reset()                    { *m = DescriptorSnapshot{} }
+func (m *DescriptorSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*DescriptorSnapshot) ProtoMessage()               {}
+func (*DescriptorSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DescriptorSnapshot) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *DescriptorSnapshot) GetAsOf() int64 {
+	if m != nil {
+		return m.AsOf
+	}
+	return 0
+}
+
+func (m *DescriptorSnapshot) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *DescriptorSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DescriptorSnapshot) GetAppDescriptor() *AppDescriptor {
+	if m != nil {
+		return m.AppDescriptor
+	}
+	return nil
+}
+
+// SupportInfo tells the consumers of an AppDescriptor where to report issues and what service
+// level to expect.
+type SupportInfo struct {
+	// An https URL, at most MAX_SUPPORT_URL_LENGTH bytes.
+	SupportUrl string `protobuf:"bytes,1,opt,name=support_url,json=supportUrl" json:"support_url,omitempty"`
+	// The SHA-256 digest of the security contact's address, so reporters who know the address
+	// can confirm it without it being published on the ledger.
+	SecurityContactHash []byte              `protobuf:"bytes,2,opt,name=security_contact_hash,json=securityContactHash,proto3" json:"security_contact_hash,omitempty"`
+	SlaTier             SupportInfo_SlaTier `protobuf:"varint,3,opt,name=sla_tier,json=slaTier,enum=main.SupportInfo_SlaTier" json:"sla_tier,omitempty"`
+}
+
+func (m *SupportInfo) Reset()                    { *m = SupportInfo{} }
+func (m *SupportInfo) String() string            { return proto.CompactTextString(m) }
+func (*SupportInfo) ProtoMessage()               {}
+func (*SupportInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *SupportInfo) GetSupportUrl() string {
+	if m != nil {
+		return m.SupportUrl
+	}
+	return ""
+}
+
+func (m *SupportInfo) GetSecurityContactHash() []byte {
+	if m != nil {
+		return m.SecurityContactHash
+	}
+	return nil
+}
+
+func (m *SupportInfo) GetSlaTier() SupportInfo_SlaTier {
+	if m != nil {
+		return m.SlaTier
+	}
+	return SupportInfo_NONE
+}
+
+// RoyaltySplit entitles party to basis_points hundredths of a percent of revenue.
+type RoyaltySplit struct {
+	Party       []byte `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints" json:"basis_points,omitempty"`
+}
+
+func (m *RoyaltySplit) Reset()                    { *m = RoyaltySplit{} }
+func (m *RoyaltySplit) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltySplit) ProtoMessage()               {}
+func (*RoyaltySplit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *RoyaltySplit) GetParty() []byte {
+	if m != nil {
+		return m.Party
+	}
+	return nil
+}
+
+func (m *RoyaltySplit) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+type RoyaltySplits struct {
+	Splits []*RoyaltySplit `protobuf:"bytes,1,rep,name=splits" json:"splits,omitempty"`
+}
+
+func (m *RoyaltySplits) Reset()                    { *m = RoyaltySplits{} }
+func (m *RoyaltySplits) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltySplits) ProtoMessage()               {}
+func (*RoyaltySplits) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *RoyaltySplits) GetSplits() []*RoyaltySplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+// PricingTier prices one metered unit of use, e.g. tier "standard" at 25 cents per "request".
+type PricingTier struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Unit string `protobuf:"bytes,2,opt,name=unit" json:"unit,omitempty"`
+	// In the minor unit of the currency, e.g. cents.
+	UnitPrice uint64 `protobuf:"varint,3,opt,name=unit_price,json=unitPrice" json:"unit_price,omitempty"`
+	// ISO 4217 alphabetic code, e.g. "USD".
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+}
+
+func (m *PricingTier) Reset()                    { *m = PricingTier{} }
+func (m *PricingTier) String() string            { return proto.CompactTextString(m) }
+func (*PricingTier) ProtoMessage()               {}
+func (*PricingTier) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *PricingTier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PricingTier) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *PricingTier) GetUnitPrice() uint64 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *PricingTier) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+type PricingTiers struct {
+	Tiers []*PricingTier `protobuf:"bytes,1,rep,name=tiers" json:"tiers,omitempty"`
+}
+
+func (m *PricingTiers) Reset()                    { *m = PricingTiers{} }
+func (m *PricingTiers) String() string            { return proto.CompactTextString(m) }
+func (*PricingTiers) ProtoMessage()               {}
+func (*PricingTiers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *PricingTiers) GetTiers() []*PricingTier {
+	if m != nil {
+		return m.Tiers
 	}
 	return nil
 }
 
+// FieldCommitment commits to the value of a sensitive field without revealing it: commitment
+// is the SHA-256 digest of the 4-byte big-endian salt length, the salt and the value. The salt
+// is random, at least 16 bytes, and is disclosed along with the value.
+type FieldCommitment struct {
+	Field      string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *FieldCommitment) Reset()                    { *m = FieldCommitment{} }
+func (m *FieldCommitment) String() string            { return proto.CompactTextString(m) }
+func (*FieldCommitment) ProtoMessage()               {}
+func (*FieldCommitment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *FieldCommitment) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+type FieldCommitments struct {
+	Commitments []*FieldCommitment `protobuf:"bytes,1,rep,name=commitments" json:"commitments,omitempty"`
+}
+
+func (m *FieldCommitments) Reset()                    { *m = FieldCommitments{} }
+func (m *FieldCommitments) String() string            { return proto.CompactTextString(m) }
+func (*FieldCommitments) ProtoMessage()               {}
+func (*FieldCommitments) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *FieldCommitments) GetCommitments() []*FieldCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+type VerificationResult struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
+}
+
+func (m *VerificationResult) Reset()                    { *m = VerificationResult{} }
+func (m *VerificationResult) String() string            { return proto.CompactTextString(m) }
+func (*VerificationResult) ProtoMessage()               {}
+func (*VerificationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *VerificationResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+// DescriptorPrivateDetails is the part of an AppDescriptor kept in its private_collection.
+type DescriptorPrivateDetails struct {
+	Pricing  string   `protobuf:"bytes,1,opt,name=pricing" json:"pricing,omitempty"`
+	Contacts []string `protobuf:"bytes,2,rep,name=contacts" json:"contacts,omitempty"`
+}
+
+func (m *DescriptorPrivateDetails) Reset()                    { *m = DescriptorPrivateDetails{} }
+func (m *DescriptorPrivateDetails) String() string            { return proto.CompactTextString(m) }
+func (*DescriptorPrivateDetails) ProtoMessage()               {}
+func (*DescriptorPrivateDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *DescriptorPrivateDetails) GetPricing() string {
+	if m != nil {
+		return m.Pricing
+	}
+	return ""
+}
+
+func (m *DescriptorPrivateDetails) GetContacts() []string {
+	if m != nil {
+		return m.Contacts
+	}
+	return nil
+}
+
+type AppDescriptors struct {
+	// In key order, the order bookmarks page through.
+	Descriptors []*AppDescriptors_Entry `protobuf:"bytes,3,rep,name=descriptors" json:"descriptors,omitempty"`
+	// Set when the query limits truncated the results; pass bookmark to get the rest.
+	HasMore  bool   `protobuf:"varint,4,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	Bookmark string `protobuf:"bytes,5,opt,name=bookmark" json:"bookmark,omitempty"`
+	// Set when the listing holds every AppDescriptor, see CollectionHash.
+	CollectionHash []byte `protobuf:"bytes,6,opt,name=collection_hash,json=collectionHash,proto3" json:"collection_hash,omitempty"`
+}
+
+func (m *AppDescriptors) Reset()                    { *m = AppDescriptors{} }
+func (m *AppDescriptors) String() string            { return proto.CompactTextString(m) }
+func (*AppDescriptors) ProtoMessage()               {}
+func (*AppDescriptors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *AppDescriptors) GetDescriptors() []*AppDescriptors_Entry {
+	if m != nil {
+		return m.Descriptors
+	}
+	return nil
+}
+
+func (m *AppDescriptors) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *AppDescriptors) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *AppDescriptors) GetCollectionHash() []byte {
+	if m != nil {
+		return m.CollectionHash
+	}
+	return nil
+}
+
+// Entry has the wire format of the map entries descriptors used to hold, so clients
+// generated before it became a list read it unchanged.
+type AppDescriptors_Entry struct {
+	Key           string         `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	AppDescriptor *AppDescriptor `protobuf:"bytes,2,opt,name=app_descriptor,json=appDescriptor" json:"app_descriptor,omitempty"`
+	// The content hash of the AppDescriptor, see CollectionHash.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *AppDescriptors_Entry) Reset()                    { *m = AppDescriptors_Entry{} }
+func (m *AppDescriptors_Entry) String() string            { return proto.CompactTextString(m) }
+func (*AppDescriptors_Entry) ProtoMessage()               {}
+func (*AppDescriptors_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15, 0} }
+
+func (m *AppDescriptors_Entry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AppDescriptors_Entry) GetAppDescriptor() *AppDescriptor {
+	if m != nil {
+		return m.AppDescriptor
+	}
+	return nil
+}
+
+func (m *AppDescriptors_Entry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type Collection struct {
+	Owner          []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	DescriptorKeys []string `protobuf:"bytes,3,rep,name=descriptor_keys,json=descriptorKeys" json:"descriptor_keys,omitempty"`
+	// The normalized owner, see normalizeIdentity.
+	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	// Transaction timestamp of creation, in seconds since the epoch.
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// MSP IDs of the organizations that submitted the creation and the last change.
+	CreatedMspId string `protobuf:"bytes,6,opt,name=created_msp_id,json=createdMspId" json:"created_msp_id,omitempty"`
+	UpdatedMspId string `protobuf:"bytes,7,opt,name=updated_msp_id,json=updatedMspId" json:"updated_msp_id,omitempty"`
+}
+
+func (m *Collection) Reset()                    { *m = Collection{} }
+func (m *Collection) String() string            { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()               {}
+func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *Collection) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Collection) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Collection) GetDescriptorKeys() []string {
+	if m != nil {
+		return m.DescriptorKeys
+	}
+	return nil
+}
+
+func (m *Collection) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *Collection) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Collection) GetCreatedMspId() string {
+	if m != nil {
+		return m.CreatedMspId
+	}
+	return ""
+}
+
+func (m *Collection) GetUpdatedMspId() string {
+	if m != nil {
+		return m.UpdatedMspId
+	}
+	return ""
+}
+
+type Pin struct {
+	Owner         []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	DescriptorKey string `protobuf:"bytes,2,opt,name=descriptor_key,json=descriptorKey" json:"descriptor_key,omitempty"`
+}
+
+func (m *Pin) Reset()                    { *m = Pin{} }
+func (m *Pin) String() string            { return proto.CompactTextString(m) }
+func (*Pin) ProtoMessage()               {}
+func (*Pin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *Pin) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Pin) GetDescriptorKey() string {
+	if m != nil {
+		return m.DescriptorKey
+	}
+	return ""
+}
+
+// Watch subscribes an identity to the changes of an AppDescriptor and its AppBundles.
+type Watch struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Watcher      []byte `protobuf:"bytes,2,opt,name=watcher,proto3" json:"watcher,omitempty"`
+	// The normalized watcher, see normalizeIdentity.
+	WatcherId string `protobuf:"bytes,3,opt,name=watcher_id,json=watcherId" json:"watcher_id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *Watch) Reset()                    { *m = Watch{} }
+func (m *Watch) String() string            { return proto.CompactTextString(m) }
+func (*Watch) ProtoMessage()               {}
+func (*Watch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *Watch) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Watch) GetWatcher() []byte {
+	if m != nil {
+		return m.Watcher
+	}
+	return nil
+}
+
+func (m *Watch) GetWatcherId() string {
+	if m != nil {
+		return m.WatcherId
+	}
+	return ""
+}
+
+func (m *Watch) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// A Reservation holds the key of an AppDescriptor that does not exist yet for the identity that
+// reserved it, until the transaction timestamp reaches expires_at; see reserveDescriptorKey.
+type Reservation struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	ReservedBy   []byte `protobuf:"bytes,2,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// In seconds since the epoch.
+	ReservedAt int64 `protobuf:"varint,3,opt,name=reserved_at,json=reservedAt" json:"reserved_at,omitempty"`
+	ExpiresAt  int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+}
+
+func (m *Reservation) Reset()                    { *m = Reservation{} }
+func (m *Reservation) String() string            { return proto.CompactTextString(m) }
+func (*Reservation) ProtoMessage()               {}
+func (*Reservation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *Reservation) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Reservation) GetReservedBy() []byte {
+	if m != nil {
+		return m.ReservedBy
+	}
+	return nil
+}
+
+func (m *Reservation) GetReservedAt() int64 {
+	if m != nil {
+		return m.ReservedAt
+	}
+	return 0
+}
+
+func (m *Reservation) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// A Lock gives its holder exclusive use of a resource until the transaction timestamp reaches
+// expires_at, see acquireLock.
+type Lock struct {
+	Resource string `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
+	Holder   []byte `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// The normalized holder, see normalizeIdentity.
+	HolderId string `protobuf:"bytes,3,opt,name=holder_id,json=holderId" json:"holder_id,omitempty"`
+	// In seconds since the epoch.
+	AcquiredAt int64 `protobuf:"varint,4,opt,name=acquired_at,json=acquiredAt" json:"acquired_at,omitempty"`
+	ExpiresAt  int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+}
+
+func (m *Lock) Reset()                    { *m = Lock{} }
+func (m *Lock) String() string            { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()               {}
+func (*Lock) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Lock) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *Lock) GetHolder() []byte {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
+}
+
+func (m *Lock) GetHolderId() string {
+	if m != nil {
+		return m.HolderId
+	}
+	return ""
+}
+
+func (m *Lock) GetAcquiredAt() int64 {
+	if m != nil {
+		return m.AcquiredAt
+	}
+	return 0
+}
+
+func (m *Lock) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type AccessRequest struct {
+	Requester     []byte               `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	DescriptorId  string               `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey     string               `protobuf:"bytes,3,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Justification string               `protobuf:"bytes,4,opt,name=justification" json:"justification,omitempty"`
+	Status        AccessRequest_Status `protobuf:"varint,5,opt,name=status,enum=main.AccessRequest_Status" json:"status,omitempty"`
+	DecidedBy     []byte               `protobuf:"bytes,6,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// Transaction timestamps, in seconds since the epoch.
+	RequestedAt int64 `protobuf:"varint,7,opt,name=requested_at,json=requestedAt" json:"requested_at,omitempty"`
+	DecidedAt   int64 `protobuf:"varint,8,opt,name=decided_at,json=decidedAt" json:"decided_at,omitempty"`
+}
+
+func (m *AccessRequest) Reset()                    { *m = AccessRequest{} }
+func (m *AccessRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccessRequest) ProtoMessage()               {}
+func (*AccessRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *AccessRequest) GetRequester() []byte {
+	if m != nil {
+		return m.Requester
+	}
+	return nil
+}
+
+func (m *AccessRequest) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *AccessRequest) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *AccessRequest) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *AccessRequest) GetStatus() AccessRequest_Status {
+	if m != nil {
+		return m.Status
+	}
+	return AccessRequest_PENDING
+}
+
+func (m *AccessRequest) GetDecidedBy() []byte {
+	if m != nil {
+		return m.DecidedBy
+	}
+	return nil
+}
+
+func (m *AccessRequest) GetRequestedAt() int64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *AccessRequest) GetDecidedAt() int64 {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return 0
+}
+
+type Permission struct {
+	Grantee      []byte `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	DescriptorId string `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,3,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	GrantedBy    []byte `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	GrantedAt    int64  `protobuf:"varint,5,opt,name=granted_at,json=grantedAt" json:"granted_at,omitempty"`
+}
+
+func (m *Permission) Reset()                    { *m = Permission{} }
+func (m *Permission) String() string            { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()               {}
+func (*Permission) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *Permission) GetGrantee() []byte {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+func (m *Permission) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Permission) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *Permission) GetGrantedBy() []byte {
+	if m != nil {
+		return m.GrantedBy
+	}
+	return nil
+}
+
+func (m *Permission) GetGrantedAt() int64 {
+	if m != nil {
+		return m.GrantedAt
+	}
+	return 0
+}
+
+type Promotion struct {
+	DescriptorId string                `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string                `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Environment  Promotion_Environment `protobuf:"varint,3,opt,name=environment,enum=main.Promotion_Environment" json:"environment,omitempty"`
+	PromotedBy   []byte                `protobuf:"bytes,4,opt,name=promoted_by,json=promotedBy,proto3" json:"promoted_by,omitempty"`
+	PromotedAt   int64                 `protobuf:"varint,5,opt,name=promoted_at,json=promotedAt" json:"promoted_at,omitempty"`
+}
+
+func (m *Promotion) Reset()                    { *m = Promotion{} }
+func (m *Promotion) String() string            { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()               {}
+func (*Promotion) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *Promotion) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Promotion) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *Promotion) GetEnvironment() Promotion_Environment {
+	if m != nil {
+		return m.Environment
+	}
+	return Promotion_DEV
+}
+
+func (m *Promotion) GetPromotedBy() []byte {
+	if m != nil {
+		return m.PromotedBy
+	}
+	return nil
+}
+
+func (m *Promotion) GetPromotedAt() int64 {
+	if m != nil {
+		return m.PromotedAt
+	}
+	return 0
+}
+
+// Rollout is the staged rollout plan of an AppBundle to its consumers, and its progress.
+type Rollout struct {
+	DescriptorId string           `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string           `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Stages       []*Rollout_Stage `protobuf:"bytes,3,rep,name=stages" json:"stages,omitempty"`
+	// The number of stages started.
+	StartedStages uint32         `protobuf:"varint,4,opt,name=started_stages,json=startedStages" json:"started_stages,omitempty"`
+	Status        Rollout_Status `protobuf:"varint,5,opt,name=status,enum=main.Rollout_Status" json:"status,omitempty"`
+	// Oldest first.
+	Updates   []*Rollout_Update `protobuf:"bytes,6,rep,name=updates" json:"updates,omitempty"`
+	CreatedBy []byte            `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt int64             `protobuf:"varint,8,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *Rollout) Reset()                    { *m = Rollout{} }
+func (m *Rollout) String() string            { return proto.CompactTextString(m) }
+func (*Rollout) ProtoMessage()               {}
+func (*Rollout) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *Rollout) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Rollout) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *Rollout) GetStages() []*Rollout_Stage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *Rollout) GetStartedStages() uint32 {
+	if m != nil {
+		return m.StartedStages
+	}
+	return 0
+}
+
+func (m *Rollout) GetStatus() Rollout_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Rollout_PLANNED
+}
+
+func (m *Rollout) GetUpdates() []*Rollout_Update {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *Rollout) GetCreatedBy() []byte {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return nil
+}
+
+func (m *Rollout) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// Stage is a cohort of consumers: either a percentage of them, or the named organizations.
+type Rollout_Stage struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// 1 to 100, increasing from stage to stage.
+	Percentage uint32   `protobuf:"varint,2,opt,name=percentage" json:"percentage,omitempty"`
+	MspIds     []string `protobuf:"bytes,3,rep,name=msp_ids,json=mspIds" json:"msp_ids,omitempty"`
+	// Set by advanceRollout when the stage starts.
+	StartedAt int64 `protobuf:"varint,4,opt,name=started_at,json=startedAt" json:"started_at,omitempty"`
+}
+
+func (m *Rollout_Stage) Reset()                    { *m = Rollout_Stage{} }
+func (m *Rollout_Stage) String() string            { return proto.CompactTextString(m) }
+func (*Rollout_Stage) ProtoMessage()               {}
+func (*Rollout_Stage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24, 0} }
+
+func (m *Rollout_Stage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Rollout_Stage) GetPercentage() uint32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *Rollout_Stage) GetMspIds() []string {
+	if m != nil {
+		return m.MspIds
+	}
+	return nil
+}
+
+func (m *Rollout_Stage) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+// Update records a change of status.
+type Rollout_Update struct {
+	Status Rollout_Status `protobuf:"varint,1,opt,name=status,enum=main.Rollout_Status" json:"status,omitempty"`
+	// The stage started, or the current stage when halted.
+	Stage     string `protobuf:"bytes,2,opt,name=stage" json:"stage,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+	UpdatedBy []byte `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+}
+
+func (m *Rollout_Update) Reset()                    { *m = Rollout_Update{} }
+func (m *Rollout_Update) String() string            { return proto.CompactTextString(m) }
+func (*Rollout_Update) ProtoMessage()               {}
+func (*Rollout_Update) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24, 1} }
+
+func (m *Rollout_Update) GetStatus() Rollout_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Rollout_PLANNED
+}
+
+func (m *Rollout_Update) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *Rollout_Update) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Rollout_Update) GetUpdatedBy() []byte {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return nil
+}
+
+func (m *Rollout_Update) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// AssetEnvelope is a portable copy of an asset exported from one channel so it can be
+// mirrored to another by a client relay.
+type AssetEnvelope struct {
+	ObjectType    Query_ObjectType `protobuf:"varint,1,opt,name=object_type,json=objectType,enum=main.Query_ObjectType" json:"object_type,omitempty"`
+	KeyParts      []string         `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Value         []byte           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	SourceChannel string           `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel" json:"source_channel,omitempty"`
+	SourceTxId    string           `protobuf:"bytes,5,opt,name=source_tx_id,json=sourceTxId" json:"source_tx_id,omitempty"`
+	// The serialized identity of the asset owner that exported it.
+	ExportedBy []byte `protobuf:"bytes,6,opt,name=exported_by,json=exportedBy,proto3" json:"exported_by,omitempty"`
+	ExportedAt int64  `protobuf:"varint,7,opt,name=exported_at,json=exportedAt" json:"exported_at,omitempty"`
+}
+
+func (m *AssetEnvelope) Reset()                    { *m = AssetEnvelope{} }
+func (m *AssetEnvelope) String() string            { return proto.CompactTextString(m) }
+func (*AssetEnvelope) ProtoMessage()               {}
+func (*AssetEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *AssetEnvelope) GetObjectType() Query_ObjectType {
+	if m != nil {
+		return m.ObjectType
+	}
+	return Query_APP_DESCRIPTOR
+}
+
+func (m *AssetEnvelope) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *AssetEnvelope) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AssetEnvelope) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *AssetEnvelope) GetSourceTxId() string {
+	if m != nil {
+		return m.SourceTxId
+	}
+	return ""
+}
+
+func (m *AssetEnvelope) GetExportedBy() []byte {
+	if m != nil {
+		return m.ExportedBy
+	}
+	return nil
+}
+
+func (m *AssetEnvelope) GetExportedAt() int64 {
+	if m != nil {
+		return m.ExportedAt
+	}
+	return 0
+}
+
+// SignedAssetEnvelope carries a marshaled AssetEnvelope and the exporter's ECDSA signature
+// over its SHA-256 digest.
+type SignedAssetEnvelope struct {
+	Envelope  []byte `protobuf:"bytes,1,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedAssetEnvelope) Reset()                    { *m = SignedAssetEnvelope{} }
+func (m *SignedAssetEnvelope) String() string            { return proto.CompactTextString(m) }
+func (*SignedAssetEnvelope) ProtoMessage()               {}
+func (*SignedAssetEnvelope) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *SignedAssetEnvelope) GetEnvelope() []byte {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (m *SignedAssetEnvelope) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// CollectionHash lets clients and gateways fetch a listing only if it changed since they last
+// read it. The content hash of an asset is the SHA-256 digest of its stored value, the
+// expected_hash of a Precondition on it; the hash of a collection, the assets of a namespace
+// under some key parts, is the SHA-256 digest of the length-prefixed composite key and content
+// hash of each of its assets in key order.
+type CollectionHash struct {
+	Namespace string   `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	KeyParts  []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Hash      []byte   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	KeyCount  uint64   `protobuf:"varint,4,opt,name=key_count,json=keyCount" json:"key_count,omitempty"`
+}
+
+func (m *CollectionHash) Reset()                    { *m = CollectionHash{} }
+func (m *CollectionHash) String() string            { return proto.CompactTextString(m) }
+func (*CollectionHash) ProtoMessage()               {}
+func (*CollectionHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *CollectionHash) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CollectionHash) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *CollectionHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *CollectionHash) GetKeyCount() uint64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+type RegistryChecksum struct {
+	// The object type namespace that was hashed, empty for the whole registry.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Digest    []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	KeyCount  uint64 `protobuf:"varint,3,opt,name=key_count,json=keyCount" json:"key_count,omitempty"`
+}
+
+func (m *RegistryChecksum) Reset()                    { *m = RegistryChecksum{} }
+func (m *RegistryChecksum) String() string            { return proto.CompactTextString(m) }
+func (*RegistryChecksum) ProtoMessage()               {}
+func (*RegistryChecksum) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *RegistryChecksum) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RegistryChecksum) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *RegistryChecksum) GetKeyCount() uint64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+type KeyList struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *KeyList) Reset()                    { *m = KeyList{} }
+func (m *KeyList) String() string            { return proto.CompactTextString(m) }
+func (*KeyList) ProtoMessage()               {}
+func (*KeyList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *KeyList) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type BundleKey struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+}
+
+func (m *BundleKey) Reset()                    { *m = BundleKey{} }
+func (m *BundleKey) String() string            { return proto.CompactTextString(m) }
+func (*BundleKey) ProtoMessage()               {}
+func (*BundleKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *BundleKey) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *BundleKey) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+type BundleKeyList struct {
+	Keys []*BundleKey `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *BundleKeyList) Reset()                    { *m = BundleKeyList{} }
+func (m *BundleKeyList) String() string            { return proto.CompactTextString(m) }
+func (*BundleKeyList) ProtoMessage()               {}
+func (*BundleKeyList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *BundleKeyList) GetKeys() []*BundleKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// BulkGetResult has one entry per requested key, in request order.
+type BulkGetResult struct {
+	Entries []*BulkGetResult_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *BulkGetResult) Reset()                    { *m = BulkGetResult{} }
+func (m *BulkGetResult) String() string            { return proto.CompactTextString(m) }
+func (*BulkGetResult) ProtoMessage()               {}
+func (*BulkGetResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *BulkGetResult) GetEntries() []*BulkGetResult_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type BulkGetResult_Entry struct {
+	KeyParts []string `protobuf:"bytes,1,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Found    bool     `protobuf:"varint,2,opt,name=found" json:"found,omitempty"`
+	Value    []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Set when the asset exists but could not be returned, e.g. a restricted AppBundle.
+	Error string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	// The SHA-256 digest of value, set with it.
+	Hash []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *BulkGetResult_Entry) Reset()                    { *m = BulkGetResult_Entry{} }
+func (m *BulkGetResult_Entry) String() string            { return proto.CompactTextString(m) }
+func (*BulkGetResult_Entry) ProtoMessage()               {}
+func (*BulkGetResult_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32, 0} }
+
+func (m *BulkGetResult_Entry) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *BulkGetResult_Entry) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *BulkGetResult_Entry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *BulkGetResult_Entry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *BulkGetResult_Entry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// BundleSummary describes an AppBundle without its content. Only bundle_key, restricted,
+// associated and environments are set for a restricted AppBundle the caller may not read.
+type BundleSummary struct {
+	BundleKey  string `protobuf:"bytes,1,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Restricted bool   `protobuf:"varint,2,opt,name=restricted" json:"restricted,omitempty"`
+	// Set when the AppBundle is the bundle_id of its AppDescriptor.
+	Associated bool `protobuf:"varint,3,opt,name=associated" json:"associated,omitempty"`
+	// The environments the AppBundle is promoted to, in name order.
+	Environments      []string                `protobuf:"bytes,4,rep,name=environments" json:"environments,omitempty"`
+	OwnerId           string                  `protobuf:"bytes,5,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	CreatedAt         int64                   `protobuf:"varint,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	ArtifactCount     uint32                  `protobuf:"varint,7,opt,name=artifact_count,json=artifactCount" json:"artifact_count,omitempty"`
+	ChaincodePackages []*ChaincodePackage     `protobuf:"bytes,8,rep,name=chaincode_packages,json=chaincodePackages" json:"chaincode_packages,omitempty"`
+	TargetPlatforms   []*Platform             `protobuf:"bytes,9,rep,name=target_platforms,json=targetPlatforms" json:"target_platforms,omitempty"`
+	MinFabricVersion  string                  `protobuf:"bytes,10,opt,name=min_fabric_version,json=minFabricVersion" json:"min_fabric_version,omitempty"`
+	MerkleRoot        []byte                  `protobuf:"bytes,11,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	RetentionTier     AppBundle_RetentionTier `protobuf:"varint,12,opt,name=retention_tier,json=retentionTier,enum=main.AppBundle_RetentionTier" json:"retention_tier,omitempty"`
+}
+
+func (m *BundleSummary) Reset()                    { *m = BundleSummary{} }
+func (m *BundleSummary) String() string            { return proto.CompactTextString(m) }
+func (*BundleSummary) ProtoMessage()               {}
+func (*BundleSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *BundleSummary) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *BundleSummary) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *BundleSummary) GetAssociated() bool {
+	if m != nil {
+		return m.Associated
+	}
+	return false
+}
+
+func (m *BundleSummary) GetEnvironments() []string {
+	if m != nil {
+		return m.Environments
+	}
+	return nil
+}
+
+func (m *BundleSummary) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *BundleSummary) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BundleSummary) GetArtifactCount() uint32 {
+	if m != nil {
+		return m.ArtifactCount
+	}
+	return 0
+}
+
+func (m *BundleSummary) GetChaincodePackages() []*ChaincodePackage {
+	if m != nil {
+		return m.ChaincodePackages
+	}
+	return nil
+}
+
+func (m *BundleSummary) GetTargetPlatforms() []*Platform {
+	if m != nil {
+		return m.TargetPlatforms
+	}
+	return nil
+}
+
+func (m *BundleSummary) GetMinFabricVersion() string {
+	if m != nil {
+		return m.MinFabricVersion
+	}
+	return ""
+}
+
+func (m *BundleSummary) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *BundleSummary) GetRetentionTier() AppBundle_RetentionTier {
+	if m != nil {
+		return m.RetentionTier
+	}
+	return AppBundle_HOT
+}
+
+// SignatureLink is a publication of an AppBundle: where it was published, by whom, and the
+// owner endorsements its publisher signed the artifacts and chaincode deployment specs with.
+type SignatureLink struct {
+	DescriptorId      string   `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey         string   `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Publisher         []byte   `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	PublisherId       string   `protobuf:"bytes,4,opt,name=publisher_id,json=publisherId" json:"publisher_id,omitempty"`
+	OwnerEndorsements [][]byte `protobuf:"bytes,5,rep,name=owner_endorsements,json=ownerEndorsements,proto3" json:"owner_endorsements,omitempty"`
+	// The created_at of the published AppBundle.
+	PublishedAt int64 `protobuf:"varint,6,opt,name=published_at,json=publishedAt" json:"published_at,omitempty"`
+}
+
+func (m *SignatureLink) Reset()                    { *m = SignatureLink{} }
+func (m *SignatureLink) String() string            { return proto.CompactTextString(m) }
+func (*SignatureLink) ProtoMessage()               {}
+func (*SignatureLink) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *SignatureLink) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *SignatureLink) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *SignatureLink) GetPublisher() []byte {
+	if m != nil {
+		return m.Publisher
+	}
+	return nil
+}
+
+func (m *SignatureLink) GetPublisherId() string {
+	if m != nil {
+		return m.PublisherId
+	}
+	return ""
+}
+
+func (m *SignatureLink) GetOwnerEndorsements() [][]byte {
+	if m != nil {
+		return m.OwnerEndorsements
+	}
+	return nil
+}
+
+func (m *SignatureLink) GetPublishedAt() int64 {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return 0
+}
+
+// SignatureChain is the provenance of an AppBundle, see getSignatureChain.
+type SignatureChain struct {
+	// The original publication first, the AppBundle itself last.
+	Links []*SignatureLink `protobuf:"bytes,1,rep,name=links" json:"links,omitempty"`
+	// Set when the original publisher's endorsements, and any later ones, verify.
+	Verified          bool   `protobuf:"varint,2,opt,name=verified" json:"verified,omitempty"`
+	VerificationError string `protobuf:"bytes,3,opt,name=verification_error,json=verificationError" json:"verification_error,omitempty"`
+}
+
+func (m *SignatureChain) Reset()                    { *m = SignatureChain{} }
+func (m *SignatureChain) String() string            { return proto.CompactTextString(m) }
+func (*SignatureChain) ProtoMessage()               {}
+func (*SignatureChain) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *SignatureChain) GetLinks() []*SignatureLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *SignatureChain) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *SignatureChain) GetVerificationError() string {
+	if m != nil {
+		return m.VerificationError
+	}
+	return ""
+}
+
+// DescriptorWithBundles is an AppDescriptor with summaries of its AppBundles, see
+// getDescriptorWithBundles.
+type DescriptorWithBundles struct {
+	AppDescriptor *AppDescriptor `protobuf:"bytes,1,opt,name=app_descriptor,json=appDescriptor" json:"app_descriptor,omitempty"`
+	// In key order.
+	Bundles []*BundleSummary `protobuf:"bytes,2,rep,name=bundles" json:"bundles,omitempty"`
+	// Set when the query limits truncated the bundles; pass bookmark to get the following ones.
+	HasMore  bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	Bookmark string `protobuf:"bytes,4,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *DescriptorWithBundles) Reset()                    { *m = DescriptorWithBundles{} }
+func (m *DescriptorWithBundles) String() string            { return proto.CompactTextString(m) }
+func (*DescriptorWithBundles) ProtoMessage()               {}
+func (*DescriptorWithBundles) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *DescriptorWithBundles) GetAppDescriptor() *AppDescriptor {
+	if m != nil {
+		return m.AppDescriptor
+	}
+	return nil
+}
+
+func (m *DescriptorWithBundles) GetBundles() []*BundleSummary {
+	if m != nil {
+		return m.Bundles
+	}
+	return nil
+}
+
+func (m *DescriptorWithBundles) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *DescriptorWithBundles) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+// AssociationResult has one entry per association made by associateDescriptorsWithBundles, in
+// request order, with the bundle_id the AppDescriptor had before, e.g. to roll a release back.
+type AssociationResult struct {
+	Entries []*AssociationResult_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *AssociationResult) Reset()                    { *m = AssociationResult{} }
+func (m *AssociationResult) String() string            { return proto.CompactTextString(m) }
+func (*AssociationResult) ProtoMessage()               {}
+func (*AssociationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *AssociationResult) GetEntries() []*AssociationResult_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type AssociationResult_Entry struct {
+	DescriptorId     string         `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	PreviousBundleId string         `protobuf:"bytes,2,opt,name=previous_bundle_id,json=previousBundleId" json:"previous_bundle_id,omitempty"`
+	AppDescriptor    *AppDescriptor `protobuf:"bytes,3,opt,name=app_descriptor,json=appDescriptor" json:"app_descriptor,omitempty"`
+}
+
+func (m *AssociationResult_Entry) Reset()                    { *m = AssociationResult_Entry{} }
+func (m *AssociationResult_Entry) String() string            { return proto.CompactTextString(m) }
+func (*AssociationResult_Entry) ProtoMessage()               {}
+func (*AssociationResult_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37, 0} }
+
+func (m *AssociationResult_Entry) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *AssociationResult_Entry) GetPreviousBundleId() string {
+	if m != nil {
+		return m.PreviousBundleId
+	}
+	return ""
+}
+
+func (m *AssociationResult_Entry) GetAppDescriptor() *AppDescriptor {
+	if m != nil {
+		return m.AppDescriptor
+	}
+	return nil
+}
+
+type ExistsResult struct {
+	Exists bool `protobuf:"varint,1,opt,name=exists" json:"exists,omitempty"`
+}
+
+func (m *ExistsResult) Reset()                    { *m = ExistsResult{} }
+func (m *ExistsResult) String() string            { return proto.CompactTextString(m) }
+func (*ExistsResult) ProtoMessage()               {}
+func (*ExistsResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *ExistsResult) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type StateWrite struct {
+	ObjectType string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Value      []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	IsDelete   bool     `protobuf:"varint,4,opt,name=is_delete,json=isDelete" json:"is_delete,omitempty"`
+}
+
+func (m *StateWrite) Reset()                    { *m = StateWrite{} }
+func (m *StateWrite) String() string            { return proto.CompactTextString(m) }
+func (*StateWrite) ProtoMessage()               {}
+func (*StateWrite) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *StateWrite) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *StateWrite) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *StateWrite) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateWrite) GetIsDelete() bool {
+	if m != nil {
+		return m.IsDelete
+	}
+	return false
+}
+
+// DryRunResult is what a function would have returned and written had it not been run with
+// validateOnly.
+type DryRunResult struct {
+	Result []byte        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Writes []*StateWrite `protobuf:"bytes,2,rep,name=writes" json:"writes,omitempty"`
+}
+
+func (m *DryRunResult) Reset()                    { *m = DryRunResult{} }
+func (m *DryRunResult) String() string            { return proto.CompactTextString(m) }
+func (*DryRunResult) ProtoMessage()               {}
+func (*DryRunResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *DryRunResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *DryRunResult) GetWrites() []*StateWrite {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+type ScriptOperation struct {
+	Function string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	// An argument of the form "$<n>" is replaced by the key (first argument) of step n, counting from 1.
+	// An argument starting with "$$" is passed with its first "$" removed, so "$$1" passes "$1".
+	Args [][]byte `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *ScriptOperation) Reset()                    { *m = ScriptOperation{} }
+func (m *ScriptOperation) String() string            { return proto.CompactTextString(m) }
+func (*ScriptOperation) ProtoMessage()               {}
+func (*ScriptOperation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *ScriptOperation) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *ScriptOperation) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type Script struct {
+	Operations []*ScriptOperation `protobuf:"bytes,1,rep,name=operations" json:"operations,omitempty"`
+}
+
+func (m *Script) Reset()                    { *m = Script{} }
+func (m *Script) String() string            { return proto.CompactTextString(m) }
+func (*Script) ProtoMessage()               {}
+func (*Script) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *Script) GetOperations() []*ScriptOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type ScriptResult struct {
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *ScriptResult) Reset()                    { *m = ScriptResult{} }
+func (m *ScriptResult) String() string            { return proto.CompactTextString(m) }
+func (*ScriptResult) ProtoMessage()               {}
+func (*ScriptResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ScriptResult) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// StateRead is a key read from the ledger by a simulated Script, or the prefix of a range of
+// keys when is_range is set. Keys the Script wrote before reading them are not read from the
+// ledger.
+type StateRead struct {
+	ObjectType string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	IsRange    bool     `protobuf:"varint,3,opt,name=is_range,json=isRange" json:"is_range,omitempty"`
+	// Whether the key exists, for a key.
+	Found bool `protobuf:"varint,4,opt,name=found" json:"found,omitempty"`
+	// The number of keys in the range, for a range, or in the page, for a page of one.
+	RangeCount uint32 `protobuf:"varint,5,opt,name=range_count,json=rangeCount" json:"range_count,omitempty"`
+	// The private data collection read, for a private data read.
+	Collection string `protobuf:"bytes,6,opt,name=collection" json:"collection,omitempty"`
+	// The page size and the bookmark the page starts at, for a paginated range.
+	PageSize int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	Bookmark string `protobuf:"bytes,8,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *StateRead) Reset()                    { *m = StateRead{} }
+func (m *StateRead) String() string            { return proto.CompactTextString(m) }
+func (*StateRead) ProtoMessage()               {}
+func (*StateRead) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *StateRead) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *StateRead) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *StateRead) GetIsRange() bool {
+	if m != nil {
+		return m.IsRange
+	}
+	return false
+}
+
+func (m *StateRead) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *StateRead) GetRangeCount() uint32 {
+	if m != nil {
+		return m.RangeCount
+	}
+	return 0
+}
+
+func (m *StateRead) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *StateRead) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *StateRead) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+// SimulationResult is what a Script would have returned, read and written had it not been
+// run with simulateScript. Reads and writes are in first-access order.
+type SimulationResult struct {
+	Results [][]byte      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Reads   []*StateRead  `protobuf:"bytes,2,rep,name=reads" json:"reads,omitempty"`
+	Writes  []*StateWrite `protobuf:"bytes,3,rep,name=writes" json:"writes,omitempty"`
+}
+
+func (m *SimulationResult) Reset()                    { *m = SimulationResult{} }
+func (m *SimulationResult) String() string            { return proto.CompactTextString(m) }
+func (*SimulationResult) ProtoMessage()               {}
+func (*SimulationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *SimulationResult) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetReads() []*StateRead {
+	if m != nil {
+		return m.Reads
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetWrites() []*StateWrite {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+// DemoDataset counts the assets loadDemoDataset created from the fixtures of seed, and the
+// Workers whose operators their RoyaltySplits pay.
+type DemoDataset struct {
+	Seed        int64  `protobuf:"varint,1,opt,name=seed" json:"seed,omitempty"`
+	Descriptors uint32 `protobuf:"varint,2,opt,name=descriptors" json:"descriptors,omitempty"`
+	Bundles     uint32 `protobuf:"varint,3,opt,name=bundles" json:"bundles,omitempty"`
+	Licenses    uint32 `protobuf:"varint,4,opt,name=licenses" json:"licenses,omitempty"`
+	Workers     uint32 `protobuf:"varint,5,opt,name=workers" json:"workers,omitempty"`
+}
+
+func (m *DemoDataset) Reset()                    { *m = DemoDataset{} }
+func (m *DemoDataset) String() string            { return proto.CompactTextString(m) }
+func (*DemoDataset) ProtoMessage()               {}
+func (*DemoDataset) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *DemoDataset) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+func (m *DemoDataset) GetDescriptors() uint32 {
+	if m != nil {
+		return m.Descriptors
+	}
+	return 0
+}
+
+func (m *DemoDataset) GetBundles() uint32 {
+	if m != nil {
+		return m.Bundles
+	}
+	return 0
+}
+
+func (m *DemoDataset) GetLicenses() uint32 {
+	if m != nil {
+		return m.Licenses
+	}
+	return 0
+}
+
+func (m *DemoDataset) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+// Precondition on the current value of a key: expected_hash is the SHA-256 digest of the
+// stored bytes, or empty to require that the key does not exist.
+type Precondition struct {
+	ObjectType   Query_ObjectType `protobuf:"varint,1,opt,name=object_type,json=objectType,enum=main.Query_ObjectType" json:"object_type,omitempty"`
+	KeyParts     []string         `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	ExpectedHash []byte           `protobuf:"bytes,3,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+}
+
+func (m *Precondition) Reset()                    { *m = Precondition{} }
+func (m *Precondition) String() string            { return proto.CompactTextString(m) }
+func (*Precondition) ProtoMessage()               {}
+func (*Precondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *Precondition) GetObjectType() Query_ObjectType {
+	if m != nil {
+		return m.ObjectType
+	}
+	return Query_APP_DESCRIPTOR
+}
+
+func (m *Precondition) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *Precondition) GetExpectedHash() []byte {
+	if m != nil {
+		return m.ExpectedHash
+	}
+	return nil
+}
+
+type Preconditions struct {
+	Preconditions []*Precondition `protobuf:"bytes,1,rep,name=preconditions" json:"preconditions,omitempty"`
+}
+
+func (m *Preconditions) Reset()                    { *m = Preconditions{} }
+func (m *Preconditions) String() string            { return proto.CompactTextString(m) }
+func (*Preconditions) ProtoMessage()               {}
+func (*Preconditions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *Preconditions) GetPreconditions() []*Precondition {
+	if m != nil {
+		return m.Preconditions
+	}
+	return nil
+}
+
+type RateLimit struct {
+	// The maximum number of writes per identity per window, zero for no limit.
+	MaxWrites     uint32 `protobuf:"varint,1,opt,name=max_writes,json=maxWrites" json:"max_writes,omitempty"`
+	WindowSeconds int64  `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds" json:"window_seconds,omitempty"`
+}
+
+func (m *RateLimit) Reset()                    { *m = RateLimit{} }
+func (m *RateLimit) String() string            { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()               {}
+func (*RateLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *RateLimit) GetMaxWrites() uint32 {
+	if m != nil {
+		return m.MaxWrites
+	}
+	return 0
+}
+
+func (m *RateLimit) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// RegistryConfig holds the registry-wide settings managed by admins.
+type RegistryConfig struct {
+	// Serialized identities of the registry admins.
+	Admins         [][]byte   `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	WriteRateLimit *RateLimit `protobuf:"bytes,2,opt,name=write_rate_limit,json=writeRateLimit" json:"write_rate_limit,omitempty"`
+	// Set by admins during an incident; admin functions remain callable.
+	PauseMode                   RegistryConfig_PauseMode `protobuf:"varint,3,opt,name=pause_mode,json=pauseMode,enum=main.RegistryConfig_PauseMode" json:"pause_mode,omitempty"`
+	MaintenanceAllowedFunctions []string                 `protobuf:"bytes,4,rep,name=maintenance_allowed_functions,json=maintenanceAllowedFunctions" json:"maintenance_allowed_functions,omitempty"`
+	// How assets are written; reads accept either encoding.
+	StorageEncoding RegistryConfig_StorageEncoding `protobuf:"varint,5,opt,name=storage_encoding,json=storageEncoding,enum=main.RegistryConfig_StorageEncoding" json:"storage_encoding,omitempty"`
+	QueryLimits     *QueryLimits                   `protobuf:"bytes,6,opt,name=query_limits,json=queryLimits" json:"query_limits,omitempty"`
+	// When set, markInvoiceSettled verifies payments with this token chaincode.
+	TokenChaincode *TokenChaincode `protobuf:"bytes,7,opt,name=token_chaincode,json=tokenChaincode" json:"token_chaincode,omitempty"`
+	ScanPolicy     *ScanPolicy     `protobuf:"bytes,8,opt,name=scan_policy,json=scanPolicy" json:"scan_policy,omitempty"`
+	// SPDX license identifiers artifacts may carry; empty allows any license.
+	AllowedArtifactLicenses []string `protobuf:"bytes,9,rep,name=allowed_artifact_licenses,json=allowedArtifactLicenses" json:"allowed_artifact_licenses,omitempty"`
+	// The ValidationProfile of each namespace that does not use STANDARD, by object type name.
+	ValidationProfiles map[string]ValidationProfile `protobuf:"bytes,10,rep,name=validation_profiles,json=validationProfiles" json:"validation_profiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=main.ValidationProfile"`
+	// In name order.
+	PolicyRules  []*PolicyRule `protobuf:"bytes,11,rep,name=policy_rules,json=policyRules" json:"policy_rules,omitempty"`
+	FeatureFlags *FeatureFlags `protobuf:"bytes,12,opt,name=feature_flags,json=featureFlags" json:"feature_flags,omitempty"`
+	// Incremented by every change, for updateConfig's optimistic concurrency check.
+	Version   uint64 `protobuf:"varint,13,opt,name=version" json:"version,omitempty"`
+	UpdatedBy []byte `protobuf:"bytes,14,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,15,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	// The admins appointed for a single namespace, by object type name. They may call the
+	// admin functions listed in namespaceAdminFunctions, for their namespace only.
+	NamespaceAdmins map[string]*RegistryConfig_NamespaceAdmins `protobuf:"bytes,16,rep,name=namespace_admins,json=namespaceAdmins" json:"namespace_admins,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The client's trace ID of the last change, see traced.
+	TraceId string `protobuf:"bytes,17,opt,name=trace_id,json=traceId" json:"trace_id,omitempty"`
+	// The migration advice returned in a ResponseWarning by each deprecated function, by
+	// function name, see deprecation.go.
+	DeprecatedFunctions map[string]string `protobuf:"bytes,18,rep,name=deprecated_functions,json=deprecatedFunctions" json:"deprecated_functions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The CA certificates of the MSPs of the channels assets are imported from, by MSP ID.
+	// importAssetFromChannel only accepts an AssetEnvelope whose exporter's certificate chains
+	// to the trust roots of the exporter's MSP, see replication.go.
+	ReplicationTrustRoots map[string]*RegistryConfig_TrustRoots `protobuf:"bytes,19,rep,name=replication_trust_roots,json=replicationTrustRoots" json:"replication_trust_roots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *RegistryConfig) Reset()                    { *m = RegistryConfig{} }
+func (m *RegistryConfig) String() string            { return proto.CompactTextString(m) }
+func (*RegistryConfig) ProtoMessage()               {}
+func (*RegistryConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *RegistryConfig) GetAdmins() [][]byte {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetWriteRateLimit() *RateLimit {
+	if m != nil {
+		return m.WriteRateLimit
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetPauseMode() RegistryConfig_PauseMode {
+	if m != nil {
+		return m.PauseMode
+	}
+	return RegistryConfig_RUNNING
+}
+
+func (m *RegistryConfig) GetMaintenanceAllowedFunctions() []string {
+	if m != nil {
+		return m.MaintenanceAllowedFunctions
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetStorageEncoding() RegistryConfig_StorageEncoding {
+	if m != nil {
+		return m.StorageEncoding
+	}
+	return RegistryConfig_PROTO
+}
+
+func (m *RegistryConfig) GetQueryLimits() *QueryLimits {
+	if m != nil {
+		return m.QueryLimits
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetTokenChaincode() *TokenChaincode {
+	if m != nil {
+		return m.TokenChaincode
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetScanPolicy() *ScanPolicy {
+	if m != nil {
+		return m.ScanPolicy
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetAllowedArtifactLicenses() []string {
+	if m != nil {
+		return m.AllowedArtifactLicenses
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetValidationProfiles() map[string]ValidationProfile {
+	if m != nil {
+		return m.ValidationProfiles
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetPolicyRules() []*PolicyRule {
+	if m != nil {
+		return m.PolicyRules
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetFeatureFlags() *FeatureFlags {
+	if m != nil {
+		return m.FeatureFlags
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RegistryConfig) GetUpdatedBy() []byte {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *RegistryConfig) GetNamespaceAdmins() map[string]*RegistryConfig_NamespaceAdmins {
+	if m != nil {
+		return m.NamespaceAdmins
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *RegistryConfig) GetDeprecatedFunctions() map[string]string {
+	if m != nil {
+		return m.DeprecatedFunctions
+	}
+	return nil
+}
+
+func (m *RegistryConfig) GetReplicationTrustRoots() map[string]*RegistryConfig_TrustRoots {
+	if m != nil {
+		return m.ReplicationTrustRoots
+	}
+	return nil
+}
+
+type RegistryConfig_NamespaceAdmins struct {
+	// PEM encoded CA certificates.
+	Admins [][]byte `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (m *RegistryConfig_NamespaceAdmins) Reset()         { *m = RegistryConfig_NamespaceAdmins{} }
+func (m *RegistryConfig_NamespaceAdmins) String() string { return proto.CompactTextString(m) }
+func (*RegistryConfig_NamespaceAdmins) ProtoMessage()    {}
+func (*RegistryConfig_NamespaceAdmins) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{50, 1}
+}
+
+func (m *RegistryConfig_NamespaceAdmins) GetAdmins() [][]byte {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+type RegistryConfig_TrustRoots struct {
+	Certificates [][]byte `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+}
+
+func (m *RegistryConfig_TrustRoots) Reset()                    { *m = RegistryConfig_TrustRoots{} }
+func (m *RegistryConfig_TrustRoots) String() string            { return proto.CompactTextString(m) }
+func (*RegistryConfig_TrustRoots) ProtoMessage()               {}
+func (*RegistryConfig_TrustRoots) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50, 4} }
+
+func (m *RegistryConfig_TrustRoots) GetCertificates() [][]byte {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+// BootstrapConfig is the optional argument of Init, the settings a deployment starts with.
+// On upgrade, the fields that are set replace those of the RegistryConfig.
+type BootstrapConfig struct {
+	// Serialized identities of the registry admins; empty makes the instantiating identity the
+	// only admin of a new registry, and keeps the admins on upgrade.
+	Admins             [][]byte                     `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	ValidationProfiles map[string]ValidationProfile `protobuf:"bytes,2,rep,name=validation_profiles,json=validationProfiles" json:"validation_profiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=main.ValidationProfile"`
+	WriteRateLimit     *RateLimit                   `protobuf:"bytes,3,opt,name=write_rate_limit,json=writeRateLimit" json:"write_rate_limit,omitempty"`
+	QueryLimits        *QueryLimits                 `protobuf:"bytes,4,opt,name=query_limits,json=queryLimits" json:"query_limits,omitempty"`
+	FeatureFlags       *FeatureFlags                `protobuf:"bytes,5,opt,name=feature_flags,json=featureFlags" json:"feature_flags,omitempty"`
+}
+
+func (m *BootstrapConfig) Reset()                    { *m = BootstrapConfig{} }
+func (m *BootstrapConfig) String() string            { return proto.CompactTextString(m) }
+func (*BootstrapConfig) ProtoMessage()               {}
+func (*BootstrapConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *BootstrapConfig) GetAdmins() [][]byte {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *BootstrapConfig) GetValidationProfiles() map[string]ValidationProfile {
+	if m != nil {
+		return m.ValidationProfiles
+	}
+	return nil
+}
+
+func (m *BootstrapConfig) GetWriteRateLimit() *RateLimit {
+	if m != nil {
+		return m.WriteRateLimit
+	}
+	return nil
+}
+
+func (m *BootstrapConfig) GetQueryLimits() *QueryLimits {
+	if m != nil {
+		return m.QueryLimits
+	}
+	return nil
+}
+
+func (m *BootstrapConfig) GetFeatureFlags() *FeatureFlags {
+	if m != nil {
+		return m.FeatureFlags
+	}
+	return nil
+}
+
+// ConfigHistory lists the committed versions of the RegistryConfig, most recent first.
+type ConfigHistory struct {
+	Entries []*ConfigHistory_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *ConfigHistory) Reset()                    { *m = ConfigHistory{} }
+func (m *ConfigHistory) String() string            { return proto.CompactTextString(m) }
+func (*ConfigHistory) ProtoMessage()               {}
+func (*ConfigHistory) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *ConfigHistory) GetEntries() []*ConfigHistory_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ConfigHistory_Entry struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	// In seconds since the epoch.
+	Timestamp int64           `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Config    *RegistryConfig `protobuf:"bytes,3,opt,name=config" json:"config,omitempty"`
+}
+
+func (m *ConfigHistory_Entry) Reset()                    { *m = ConfigHistory_Entry{} }
+func (m *ConfigHistory_Entry) String() string            { return proto.CompactTextString(m) }
+func (*ConfigHistory_Entry) ProtoMessage()               {}
+func (*ConfigHistory_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52, 0} }
+
+func (m *ConfigHistory_Entry) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *ConfigHistory_Entry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ConfigHistory_Entry) GetConfig() *RegistryConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// FeatureFlags toggle behaviors per deployment. Every flag defaults to off, leaving the
+// behavior of a deployment that never set it unchanged.
+type FeatureFlags struct {
+	// No RegistryEvents are emitted.
+	EventsDisabled bool `protobuf:"varint,1,opt,name=events_disabled,json=eventsDisabled" json:"events_disabled,omitempty"`
+	// Assets are stored as proto bytes whatever the storage_encoding.
+	JsonEncodingDisabled bool `protobuf:"varint,2,opt,name=json_encoding_disabled,json=jsonEncodingDisabled" json:"json_encoding_disabled,omitempty"`
+	// Every AppBundle is read as if it were restricted, and the listing queries of callers
+	// other than admins return only their own assets, see mineOnly.
+	StrictAcls bool `protobuf:"varint,3,opt,name=strict_acls,json=strictAcls" json:"strict_acls,omitempty"`
+	// The write rate limit is not enforced.
+	QuotasDisabled bool `protobuf:"varint,4,opt,name=quotas_disabled,json=quotasDisabled" json:"quotas_disabled,omitempty"`
+	// Committed invocations of write functions are counted, see getFunctionStats.
+	FunctionStats bool `protobuf:"varint,5,opt,name=function_stats,json=functionStats" json:"function_stats,omitempty"`
+	// Changes in these namespaces, by object type name, are left out of RegistryEvents; see
+	// silenceEventNamespace. In name order.
+	SilencedEventNamespaces []string `protobuf:"bytes,6,rep,name=silenced_event_namespaces,json=silencedEventNamespaces" json:"silenced_event_namespaces,omitempty"`
+	// The changes of every transaction are journaled by namespace, for emitDigest.
+	EventJournal bool `protobuf:"varint,7,opt,name=event_journal,json=eventJournal" json:"event_journal,omitempty"`
+}
+
+func (m *FeatureFlags) Reset()                    { *m = FeatureFlags{} }
+func (m *FeatureFlags) String() string            { return proto.CompactTextString(m) }
+func (*FeatureFlags) ProtoMessage()               {}
+func (*FeatureFlags) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *FeatureFlags) GetEventsDisabled() bool {
+	if m != nil {
+		return m.EventsDisabled
+	}
+	return false
+}
+
+func (m *FeatureFlags) GetJsonEncodingDisabled() bool {
+	if m != nil {
+		return m.JsonEncodingDisabled
+	}
+	return false
+}
+
+func (m *FeatureFlags) GetStrictAcls() bool {
+	if m != nil {
+		return m.StrictAcls
+	}
+	return false
+}
+
+func (m *FeatureFlags) GetQuotasDisabled() bool {
+	if m != nil {
+		return m.QuotasDisabled
+	}
+	return false
+}
+
+func (m *FeatureFlags) GetFunctionStats() bool {
+	if m != nil {
+		return m.FunctionStats
+	}
+	return false
+}
+
+func (m *FeatureFlags) GetSilencedEventNamespaces() []string {
+	if m != nil {
+		return m.SilencedEventNamespaces
+	}
+	return nil
+}
+
+func (m *FeatureFlags) GetEventJournal() bool {
+	if m != nil {
+		return m.EventJournal
+	}
+	return false
+}
+
+// ScanPolicy gates associateDescriptorWithBundle on security scans of the AppBundle.
+type ScanPolicy struct {
+	// In registration order.
+	Scanners []*ScanPolicy_Scanner `protobuf:"bytes,1,rep,name=scanners" json:"scanners,omitempty"`
+	// The number of registered scanners whose latest verdict must be PASS; 0 disables the gate.
+	RequiredPasses uint32 `protobuf:"varint,2,opt,name=required_passes,json=requiredPasses" json:"required_passes,omitempty"`
+}
+
+func (m *ScanPolicy) Reset()                    { *m = ScanPolicy{} }
+func (m *ScanPolicy) String() string            { return proto.CompactTextString(m) }
+func (*ScanPolicy) ProtoMessage()               {}
+func (*ScanPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *ScanPolicy) GetScanners() []*ScanPolicy_Scanner {
+	if m != nil {
+		return m.Scanners
+	}
+	return nil
+}
+
+func (m *ScanPolicy) GetRequiredPasses() uint32 {
+	if m != nil {
+		return m.RequiredPasses
+	}
+	return 0
+}
+
+type ScanPolicy_Scanner struct {
+	ScannerId string `protobuf:"bytes,1,opt,name=scanner_id,json=scannerId" json:"scanner_id,omitempty"`
+	// The serialized identity that records the scanner's results.
+	Identity []byte `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *ScanPolicy_Scanner) Reset()                    { *m = ScanPolicy_Scanner{} }
+func (m *ScanPolicy_Scanner) String() string            { return proto.CompactTextString(m) }
+func (*ScanPolicy_Scanner) ProtoMessage()               {}
+func (*ScanPolicy_Scanner) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54, 0} }
+
+func (m *ScanPolicy_Scanner) GetScannerId() string {
+	if m != nil {
+		return m.ScannerId
+	}
+	return ""
+}
+
+func (m *ScanPolicy_Scanner) GetIdentity() []byte {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+// TokenChaincode must implement ["getPayment", <payment_ref>], returning a TokenPayment.
+type TokenChaincode struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Empty for the registry's own channel.
+	Channel string `protobuf:"bytes,2,opt,name=channel" json:"channel,omitempty"`
+}
+
+func (m *TokenChaincode) Reset()                    { *m = TokenChaincode{} }
+func (m *TokenChaincode) String() string            { return proto.CompactTextString(m) }
+func (*TokenChaincode) ProtoMessage()               {}
+func (*TokenChaincode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *TokenChaincode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenChaincode) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type TokenPayment struct {
+	Payer        []byte `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Payee        []byte `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	Amount       uint64 `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+}
+
+func (m *TokenPayment) Reset()                    { *m = TokenPayment{} }
+func (m *TokenPayment) String() string            { return proto.CompactTextString(m) }
+func (*TokenPayment) ProtoMessage()               {}
+func (*TokenPayment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *TokenPayment) GetPayer() []byte {
+	if m != nil {
+		return m.Payer
+	}
+	return nil
+}
+
+func (m *TokenPayment) GetPayee() []byte {
+	if m != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+func (m *TokenPayment) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenPayment) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+// QueryLimits caps what a single query may accumulate; zero selects the default.
+type QueryLimits struct {
+	MaxResults uint32 `protobuf:"varint,1,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// The total size of the keys and values in the results.
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes" json:"max_bytes,omitempty"`
+	// The size above which the payloads of compressed invocations are compressed.
+	CompressionThreshold uint64 `protobuf:"varint,3,opt,name=compression_threshold,json=compressionThreshold" json:"compression_threshold,omitempty"`
+}
+
+func (m *QueryLimits) Reset()                    { *m = QueryLimits{} }
+func (m *QueryLimits) String() string            { return proto.CompactTextString(m) }
+func (*QueryLimits) ProtoMessage()               {}
+func (*QueryLimits) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *QueryLimits) GetMaxResults() uint32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *QueryLimits) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *QueryLimits) GetCompressionThreshold() uint64 {
+	if m != nil {
+		return m.CompressionThreshold
+	}
+	return 0
+}
+
+type RateCounter struct {
+	WindowStart int64  `protobuf:"varint,1,opt,name=window_start,json=windowStart" json:"window_start,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *RateCounter) Reset()                    { *m = RateCounter{} }
+func (m *RateCounter) String() string            { return proto.CompactTextString(m) }
+func (*RateCounter) ProtoMessage()               {}
+func (*RateCounter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *RateCounter) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *RateCounter) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// FunctionCounter counts the committed invocations of a write function by one identity.
+type FunctionCounter struct {
+	Function string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	// The normalized caller, see normalizeIdentity.
+	CallerId    string `protobuf:"bytes,2,opt,name=caller_id,json=callerId" json:"caller_id,omitempty"`
+	Invocations uint64 `protobuf:"varint,3,opt,name=invocations" json:"invocations,omitempty"`
+	// In seconds since the epoch.
+	LastInvokedAt int64 `protobuf:"varint,4,opt,name=last_invoked_at,json=lastInvokedAt" json:"last_invoked_at,omitempty"`
+}
+
+func (m *FunctionCounter) Reset()                    { *m = FunctionCounter{} }
+func (m *FunctionCounter) String() string            { return proto.CompactTextString(m) }
+func (*FunctionCounter) ProtoMessage()               {}
+func (*FunctionCounter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *FunctionCounter) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *FunctionCounter) GetCallerId() string {
+	if m != nil {
+		return m.CallerId
+	}
+	return ""
+}
+
+func (m *FunctionCounter) GetInvocations() uint64 {
+	if m != nil {
+		return m.Invocations
+	}
+	return 0
+}
+
+func (m *FunctionCounter) GetLastInvokedAt() int64 {
+	if m != nil {
+		return m.LastInvokedAt
+	}
+	return 0
+}
+
+// FunctionStats sums the FunctionCounters of each write function, in function name order.
+type FunctionStats struct {
+	Functions []*FunctionStats_Function `protobuf:"bytes,1,rep,name=functions" json:"functions,omitempty"`
+}
+
+func (m *FunctionStats) Reset()                    { *m = FunctionStats{} }
+func (m *FunctionStats) String() string            { return proto.CompactTextString(m) }
+func (*FunctionStats) ProtoMessage()               {}
+func (*FunctionStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *FunctionStats) GetFunctions() []*FunctionStats_Function {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+type FunctionStats_Function struct {
+	Function    string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	Invocations uint64 `protobuf:"varint,2,opt,name=invocations" json:"invocations,omitempty"`
+	// The number of identities that invoked the function.
+	Callers       uint32 `protobuf:"varint,3,opt,name=callers" json:"callers,omitempty"`
+	LastInvokedAt int64  `protobuf:"varint,4,opt,name=last_invoked_at,json=lastInvokedAt" json:"last_invoked_at,omitempty"`
+}
+
+func (m *FunctionStats_Function) Reset()                    { *m = FunctionStats_Function{} }
+func (m *FunctionStats_Function) String() string            { return proto.CompactTextString(m) }
+func (*FunctionStats_Function) ProtoMessage()               {}
+func (*FunctionStats_Function) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60, 0} }
+
+func (m *FunctionStats_Function) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *FunctionStats_Function) GetInvocations() uint64 {
+	if m != nil {
+		return m.Invocations
+	}
+	return 0
+}
+
+func (m *FunctionStats_Function) GetCallers() uint32 {
+	if m != nil {
+		return m.Callers
+	}
+	return 0
+}
+
+func (m *FunctionStats_Function) GetLastInvokedAt() int64 {
+	if m != nil {
+		return m.LastInvokedAt
+	}
+	return 0
+}
+
+// MigrationState records the schema version of the stored data and the progress of the
+// migration step upgrading it to the next version.
+type MigrationState struct {
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
+	// The last composite key processed by the pending step, empty if it has not started.
+	Bookmark string `protobuf:"bytes,2,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *MigrationState) Reset()                    { *m = MigrationState{} }
+func (m *MigrationState) String() string            { return proto.CompactTextString(m) }
+func (*MigrationState) ProtoMessage()               {}
+func (*MigrationState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *MigrationState) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *MigrationState) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+// BackfillResult reports the progress of a backfill batch; pass bookmark to the next call
+// until done is set.
+type BackfillResult struct {
+	Field        string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Bookmark     string `protobuf:"bytes,3,opt,name=bookmark" json:"bookmark,omitempty"`
+	Done         bool   `protobuf:"varint,4,opt,name=done" json:"done,omitempty"`
+	UpdatedCount uint32 `protobuf:"varint,5,opt,name=updated_count,json=updatedCount" json:"updated_count,omitempty"`
+}
+
+func (m *BackfillResult) Reset()                    { *m = BackfillResult{} }
+func (m *BackfillResult) String() string            { return proto.CompactTextString(m) }
+func (*BackfillResult) ProtoMessage()               {}
+func (*BackfillResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *BackfillResult) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *BackfillResult) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *BackfillResult) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *BackfillResult) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *BackfillResult) GetUpdatedCount() uint32 {
+	if m != nil {
+		return m.UpdatedCount
+	}
+	return 0
+}
+
+// IntegrityReport is a batch of the referential-integrity check of one namespace.
+type IntegrityReport struct {
+	Namespace    string                       `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Bookmark     string                       `protobuf:"bytes,2,opt,name=bookmark" json:"bookmark,omitempty"`
+	Done         bool                         `protobuf:"varint,3,opt,name=done" json:"done,omitempty"`
+	CheckedCount uint32                       `protobuf:"varint,4,opt,name=checked_count,json=checkedCount" json:"checked_count,omitempty"`
+	Violations   []*IntegrityReport_Violation `protobuf:"bytes,5,rep,name=violations" json:"violations,omitempty"`
+}
+
+func (m *IntegrityReport) Reset()                    { *m = IntegrityReport{} }
+func (m *IntegrityReport) String() string            { return proto.CompactTextString(m) }
+func (*IntegrityReport) ProtoMessage()               {}
+func (*IntegrityReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *IntegrityReport) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *IntegrityReport) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *IntegrityReport) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *IntegrityReport) GetCheckedCount() uint32 {
+	if m != nil {
+		return m.CheckedCount
+	}
+	return 0
+}
+
+func (m *IntegrityReport) GetViolations() []*IntegrityReport_Violation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+type IntegrityReport_Violation struct {
+	KeyParts []string `protobuf:"bytes,1,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	// The field holding the broken reference, or the key part.
+	Field   string `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *IntegrityReport_Violation) Reset()                    { *m = IntegrityReport_Violation{} }
+func (m *IntegrityReport_Violation) String() string            { return proto.CompactTextString(m) }
+func (*IntegrityReport_Violation) ProtoMessage()               {}
+func (*IntegrityReport_Violation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63, 0} }
+
+func (m *IntegrityReport_Violation) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *IntegrityReport_Violation) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *IntegrityReport_Violation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// BundleIntegrityReport is the result of re-hashing the content of an AppBundle.
+type BundleIntegrityReport struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	// Set when the AppBundle has recorded digests and they, and the Merkle root, all match.
+	Passed bool `protobuf:"varint,3,opt,name=passed" json:"passed,omitempty"`
+	// Unset for AppBundles stored before digests were recorded, until backfilled.
+	Recorded bool `protobuf:"varint,4,opt,name=recorded" json:"recorded,omitempty"`
+	// A description of each mismatch, e.g. "artifacts[1]: digest mismatch".
+	Failures []string `protobuf:"bytes,5,rep,name=failures" json:"failures,omitempty"`
+	// The Merkle root of the content as now stored.
+	MerkleRoot []byte `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+}
+
+func (m *BundleIntegrityReport) Reset()                    { *m = BundleIntegrityReport{} }
+func (m *BundleIntegrityReport) String() string            { return proto.CompactTextString(m) }
+func (*BundleIntegrityReport) ProtoMessage()               {}
+func (*BundleIntegrityReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *BundleIntegrityReport) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *BundleIntegrityReport) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *BundleIntegrityReport) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *BundleIntegrityReport) GetRecorded() bool {
+	if m != nil {
+		return m.Recorded
+	}
+	return false
+}
+
+func (m *BundleIntegrityReport) GetFailures() []string {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *BundleIntegrityReport) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+// ColdCopies locates the external copies of the content of an AppBundle to be demoted to COLD,
+// copies[i] being the copy of the content named as content_digests[i]; see demoteToCold.
+type ColdCopies struct {
+	Copies []*ColdCopies_Copy `protobuf:"bytes,1,rep,name=copies" json:"copies,omitempty"`
+}
+
+func (m *ColdCopies) Reset()                    { *m = ColdCopies{} }
+func (m *ColdCopies) String() string            { return proto.CompactTextString(m) }
+func (*ColdCopies) ProtoMessage()               {}
+func (*ColdCopies) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *ColdCopies) GetCopies() []*ColdCopies_Copy {
+	if m != nil {
+		return m.Copies
+	}
+	return nil
+}
+
+type ColdCopies_Copy struct {
+	Uri string `protobuf:"bytes,1,opt,name=uri" json:"uri,omitempty"`
+	// The SHA-256 digest of the external copy.
+	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *ColdCopies_Copy) Reset()                    { *m = ColdCopies_Copy{} }
+func (m *ColdCopies_Copy) String() string            { return proto.CompactTextString(m) }
+func (*ColdCopies_Copy) ProtoMessage()               {}
+func (*ColdCopies_Copy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
+
+func (m *ColdCopies_Copy) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *ColdCopies_Copy) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// OwnershipChallenge is a nonce issued by issueOwnershipChallenge for the owner of an asset to
+// sign; see ownershipproof.go.
+type OwnershipChallenge struct {
+	// Hex encoded; the owner signs these hex characters.
+	Nonce      string   `protobuf:"bytes,1,opt,name=nonce" json:"nonce,omitempty"`
+	ObjectType string   `protobuf:"bytes,2,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,3,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	IssuedBy   []byte   `protobuf:"bytes,4,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	IssuedAt   int64    `protobuf:"varint,5,opt,name=issued_at,json=issuedAt" json:"issued_at,omitempty"`
+	ExpiresAt  int64    `protobuf:"varint,6,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+}
+
+func (m *OwnershipChallenge) Reset()                    { *m = OwnershipChallenge{} }
+func (m *OwnershipChallenge) String() string            { return proto.CompactTextString(m) }
+func (*OwnershipChallenge) ProtoMessage()               {}
+func (*OwnershipChallenge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *OwnershipChallenge) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *OwnershipChallenge) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *OwnershipChallenge) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *OwnershipChallenge) GetIssuedBy() []byte {
+	if m != nil {
+		return m.IssuedBy
+	}
+	return nil
+}
+
+func (m *OwnershipChallenge) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *OwnershipChallenge) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// OwnershipProof records that the owner of an asset signed an OwnershipChallenge. signature is
+// the owner's ASN.1 ECDSA signature over the SHA-256 digest of the nonce, so anyone can verify
+// it with the certificate in owner, without reading the ledger's Fabric identities.
+type OwnershipProof struct {
+	Nonce      string   `protobuf:"bytes,1,opt,name=nonce" json:"nonce,omitempty"`
+	ObjectType string   `protobuf:"bytes,2,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,3,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	// The serialized identity owning the asset when the proof was made.
+	Owner     []byte `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerId   string `protobuf:"bytes,5,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	ProvenAt  int64  `protobuf:"varint,7,opt,name=proven_at,json=provenAt" json:"proven_at,omitempty"`
+	TxId      string `protobuf:"bytes,8,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+}
+
+func (m *OwnershipProof) Reset()                    { *m = OwnershipProof{} }
+func (m *OwnershipProof) String() string            { return proto.CompactTextString(m) }
+func (*OwnershipProof) ProtoMessage()               {}
+func (*OwnershipProof) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *OwnershipProof) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *OwnershipProof) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *OwnershipProof) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *OwnershipProof) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *OwnershipProof) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *OwnershipProof) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *OwnershipProof) GetProvenAt() int64 {
+	if m != nil {
+		return m.ProvenAt
+	}
+	return 0
+}
+
+func (m *OwnershipProof) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+// BundleGates records the holds placed on an AppBundle: conditions tracked outside the
+// registry, such as a pending release approval or an open security advisory, that block its
+// association until released; see gates.go.
+type BundleGates struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	// In name order.
+	Holds []*BundleGates_Hold `protobuf:"bytes,3,rep,name=holds" json:"holds,omitempty"`
+}
+
+func (m *BundleGates) Reset()                    { *m = BundleGates{} }
+func (m *BundleGates) String() string            { return proto.CompactTextString(m) }
+func (*BundleGates) ProtoMessage()               {}
+func (*BundleGates) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *BundleGates) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *BundleGates) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *BundleGates) GetHolds() []*BundleGates_Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+type BundleGates_Hold struct {
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	PlacedBy []byte `protobuf:"bytes,3,opt,name=placed_by,json=placedBy,proto3" json:"placed_by,omitempty"`
+	PlacedAt int64  `protobuf:"varint,4,opt,name=placed_at,json=placedAt" json:"placed_at,omitempty"`
+}
+
+func (m *BundleGates_Hold) Reset()                    { *m = BundleGates_Hold{} }
+func (m *BundleGates_Hold) String() string            { return proto.CompactTextString(m) }
+func (*BundleGates_Hold) ProtoMessage()               {}
+func (*BundleGates_Hold) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68, 0} }
+
+func (m *BundleGates_Hold) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BundleGates_Hold) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BundleGates_Hold) GetPlacedBy() []byte {
+	if m != nil {
+		return m.PlacedBy
+	}
+	return nil
+}
+
+func (m *BundleGates_Hold) GetPlacedAt() int64 {
+	if m != nil {
+		return m.PlacedAt
+	}
+	return 0
+}
+
+// GateReport is the evaluation of every association gate of an AppBundle, in the order
+// associateDescriptorWithBundle evaluates them.
+type GateReport struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	// Set when every gate passed, so the AppBundle may be associated.
+	Passed bool               `protobuf:"varint,3,opt,name=passed" json:"passed,omitempty"`
+	Gates  []*GateReport_Gate `protobuf:"bytes,4,rep,name=gates" json:"gates,omitempty"`
+}
+
+func (m *GateReport) Reset()                    { *m = GateReport{} }
+func (m *GateReport) String() string            { return proto.CompactTextString(m) }
+func (*GateReport) ProtoMessage()               {}
+func (*GateReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *GateReport) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *GateReport) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *GateReport) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *GateReport) GetGates() []*GateReport_Gate {
+	if m != nil {
+		return m.Gates
+	}
+	return nil
+}
+
+type GateReport_Gate struct {
+	Name   string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed" json:"passed,omitempty"`
+	// Why the gate is closed, unless passed.
+	Failure string `protobuf:"bytes,3,opt,name=failure" json:"failure,omitempty"`
+}
+
+func (m *GateReport_Gate) Reset()                    { *m = GateReport_Gate{} }
+func (m *GateReport_Gate) String() string            { return proto.CompactTextString(m) }
+func (*GateReport_Gate) ProtoMessage()               {}
+func (*GateReport_Gate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69, 0} }
+
+func (m *GateReport_Gate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GateReport_Gate) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *GateReport_Gate) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+// ReadinessReport tells the publisher of an AppDescriptor what prevents an AppBundle from going
+// live, see getReadiness.
+type ReadinessReport struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	// The AppBundle evaluated: the one named, else the associated one, else the newest.
+	BundleKey string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	// Set when the AppBundle is the one associated with the descriptor.
+	Associated bool `protobuf:"varint,3,opt,name=associated" json:"associated,omitempty"`
+	// Set when every gate passed, so the AppBundle may be associated.
+	Ready bool `protobuf:"varint,4,opt,name=ready" json:"ready,omitempty"`
+	// In evaluation order, as getBundleGates reports them.
+	Gates []*GateReport_Gate `protobuf:"bytes,5,rep,name=gates" json:"gates,omitempty"`
+	// Every blocker of every closed gate, in gate order.
+	Blockers []*ReadinessReport_Blocker `protobuf:"bytes,6,rep,name=blockers" json:"blockers,omitempty"`
+}
+
+func (m *ReadinessReport) Reset()                    { *m = ReadinessReport{} }
+func (m *ReadinessReport) String() string            { return proto.CompactTextString(m) }
+func (*ReadinessReport) ProtoMessage()               {}
+func (*ReadinessReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *ReadinessReport) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ReadinessReport) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ReadinessReport) GetAssociated() bool {
+	if m != nil {
+		return m.Associated
+	}
+	return false
+}
+
+func (m *ReadinessReport) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *ReadinessReport) GetGates() []*GateReport_Gate {
+	if m != nil {
+		return m.Gates
+	}
+	return nil
+}
+
+func (m *ReadinessReport) GetBlockers() []*ReadinessReport_Blocker {
+	if m != nil {
+		return m.Blockers
+	}
+	return nil
+}
+
+// A Blocker is one thing keeping an association gate closed.
+type ReadinessReport_Blocker struct {
+	Gate string `protobuf:"bytes,1,opt,name=gate" json:"gate,omitempty"`
+	// What blocks the gate: the name of a hold, the scanner_id of a scan not passed, the
+	// name of a broken PolicyRule, or "signatures" or "attestations" for the strict gate.
+	// Empty when the gate reports a single failure.
+	Subject string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *ReadinessReport_Blocker) Reset()                    { *m = ReadinessReport_Blocker{} }
+func (m *ReadinessReport_Blocker) String() string            { return proto.CompactTextString(m) }
+func (*ReadinessReport_Blocker) ProtoMessage()               {}
+func (*ReadinessReport_Blocker) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70, 0} }
+
+func (m *ReadinessReport_Blocker) GetGate() string {
+	if m != nil {
+		return m.Gate
+	}
+	return ""
+}
+
+func (m *ReadinessReport_Blocker) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ReadinessReport_Blocker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ResponseWarning is a machine-readable migration nudge, returned when a call uses a deprecated
+// function or a legacy encoding that still works but will be removed.
+type ResponseWarning struct {
+	// E.g. "DEPRECATED_FUNCTION" or "RAW_CREATOR_OWNER".
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	// The function the warning applies to, which may be one run by a wrapper.
+	Function string `protobuf:"bytes,2,opt,name=function" json:"function,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ResponseWarning) Reset()                    { *m = ResponseWarning{} }
+func (m *ResponseWarning) String() string            { return proto.CompactTextString(m) }
+func (*ResponseWarning) ProtoMessage()               {}
+func (*ResponseWarning) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *ResponseWarning) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *ResponseWarning) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *ResponseWarning) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// ResponseMetadata is the JSON message of a successful response that carries warnings or a
+// compressed payload; the message of any other response is just its trace ID.
+type ResponseMetadata struct {
+	TraceId  string             `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty"`
+	Warnings []*ResponseWarning `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
+	// "gzip" when the payload is compressed, see compressed.
+	ContentEncoding string `protobuf:"bytes,3,opt,name=content_encoding,json=contentEncoding" json:"content_encoding,omitempty"`
+}
+
+func (m *ResponseMetadata) Reset()                    { *m = ResponseMetadata{} }
+func (m *ResponseMetadata) String() string            { return proto.CompactTextString(m) }
+func (*ResponseMetadata) ProtoMessage()               {}
+func (*ResponseMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *ResponseMetadata) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *ResponseMetadata) GetWarnings() []*ResponseWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *ResponseMetadata) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ""
+}
+
+// ConsumerCheckpoint is the replay position of an off-chain consumer of RegistryEvents, see
+// recordConsumerCheckpoint.
+type ConsumerCheckpoint struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	// The identity that first recorded the checkpoint, the only one that may move it.
+	Owner []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The normalized owner, see normalizeIdentity.
+	OwnerId string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId" json:"owner_id,omitempty"`
+	// The block and transaction of the last event processed.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	TxId        string `protobuf:"bytes,5,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	RecordedAt  int64  `protobuf:"varint,6,opt,name=recorded_at,json=recordedAt" json:"recorded_at,omitempty"`
+}
+
+func (m *ConsumerCheckpoint) Reset()                    { *m = ConsumerCheckpoint{} }
+func (m *ConsumerCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*ConsumerCheckpoint) ProtoMessage()               {}
+func (*ConsumerCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *ConsumerCheckpoint) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerCheckpoint) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ConsumerCheckpoint) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *ConsumerCheckpoint) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *ConsumerCheckpoint) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *ConsumerCheckpoint) GetRecordedAt() int64 {
+	if m != nil {
+		return m.RecordedAt
+	}
+	return 0
+}
+
+// ArtifactChunk is a range of the bytes of an artifact or chaincode deployment spec of an
+// AppBundle, see getArtifactChunk.
+type ArtifactChunk struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	// As in BundleIntegrityReport, e.g. "artifacts[0]" or "chaincode_deployment_specs[1]".
+	ArtifactName string `protobuf:"bytes,3,opt,name=artifact_name,json=artifactName" json:"artifact_name,omitempty"`
+	Offset       uint64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Data         []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// The size of the whole artifact.
+	TotalSize uint64 `protobuf:"varint,6,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+	// Set when the chunk ends the artifact.
+	Eof bool `protobuf:"varint,7,opt,name=eof" json:"eof,omitempty"`
+	// The digest recorded for the whole artifact at creation, to check the reassembled bytes
+	// against; unset if none was recorded.
+	Digest []byte `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *ArtifactChunk) Reset()                    { *m = ArtifactChunk{} }
+func (m *ArtifactChunk) String() string            { return proto.CompactTextString(m) }
+func (*ArtifactChunk) ProtoMessage()               {}
+func (*ArtifactChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ArtifactChunk) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ArtifactChunk) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ArtifactChunk) GetArtifactName() string {
+	if m != nil {
+		return m.ArtifactName
+	}
+	return ""
+}
+
+func (m *ArtifactChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ArtifactChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ArtifactChunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ArtifactChunk) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+func (m *ArtifactChunk) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// RepairRecord is the audit record of an admin repair, keyed by the repair's transaction ID.
+type RepairRecord struct {
+	Function string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	// The repaired asset.
+	Namespace string   `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	KeyParts  []string `protobuf:"bytes,3,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Reason    string   `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	// The marshaled asset before and after the repair.
+	Before          []byte `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After           []byte `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	RepairedBy      []byte `protobuf:"bytes,7,opt,name=repaired_by,json=repairedBy,proto3" json:"repaired_by,omitempty"`
+	RepairedByMspId string `protobuf:"bytes,8,opt,name=repaired_by_msp_id,json=repairedByMspId" json:"repaired_by_msp_id,omitempty"`
+	RepairedAt      int64  `protobuf:"varint,9,opt,name=repaired_at,json=repairedAt" json:"repaired_at,omitempty"`
+	// The client's trace ID of the repair, see traced.
+	TraceId string `protobuf:"bytes,10,opt,name=trace_id,json=traceId" json:"trace_id,omitempty"`
+}
+
+func (m *RepairRecord) Reset()                    { *m = RepairRecord{} }
+func (m *RepairRecord) String() string            { return proto.CompactTextString(m) }
+func (*RepairRecord) ProtoMessage()               {}
+func (*RepairRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *RepairRecord) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *RepairRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetBefore() []byte {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *RepairRecord) GetAfter() []byte {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *RepairRecord) GetRepairedBy() []byte {
+	if m != nil {
+		return m.RepairedBy
+	}
+	return nil
+}
+
+func (m *RepairRecord) GetRepairedByMspId() string {
+	if m != nil {
+		return m.RepairedByMspId
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetRepairedAt() int64 {
+	if m != nil {
+		return m.RepairedAt
+	}
+	return 0
+}
+
+func (m *RepairRecord) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+// OwnershipReassignment is a batch of the transfer of a departing member's assets.
+type OwnershipReassignment struct {
+	FromOwnerId string `protobuf:"bytes,1,opt,name=from_owner_id,json=fromOwnerId" json:"from_owner_id,omitempty"`
+	ToOwnerId   string `protobuf:"bytes,2,opt,name=to_owner_id,json=toOwnerId" json:"to_owner_id,omitempty"`
+	// The composite key of the last asset scanned, to pass as the bookmark of the next batch.
+	Bookmark        string `protobuf:"bytes,3,opt,name=bookmark" json:"bookmark,omitempty"`
+	Done            bool   `protobuf:"varint,4,opt,name=done" json:"done,omitempty"`
+	ReassignedCount uint32 `protobuf:"varint,5,opt,name=reassigned_count,json=reassignedCount" json:"reassigned_count,omitempty"`
+	// The namespaces of records that name identities but are not reassigned, see ownership.go.
+	UnreassignedNamespaces []string `protobuf:"bytes,6,rep,name=unreassigned_namespaces,json=unreassignedNamespaces" json:"unreassigned_namespaces,omitempty"`
+}
+
+func (m *OwnershipReassignment) Reset()                    { *m = OwnershipReassignment{} }
+func (m *OwnershipReassignment) String() string            { return proto.CompactTextString(m) }
+func (*OwnershipReassignment) ProtoMessage()               {}
+func (*OwnershipReassignment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *OwnershipReassignment) GetFromOwnerId() string {
+	if m != nil {
+		return m.FromOwnerId
+	}
+	return ""
+}
+
+func (m *OwnershipReassignment) GetToOwnerId() string {
+	if m != nil {
+		return m.ToOwnerId
+	}
+	return ""
+}
+
+func (m *OwnershipReassignment) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *OwnershipReassignment) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *OwnershipReassignment) GetReassignedCount() uint32 {
+	if m != nil {
+		return m.ReassignedCount
+	}
+	return 0
+}
+
+func (m *OwnershipReassignment) GetUnreassignedNamespaces() []string {
+	if m != nil {
+		return m.UnreassignedNamespaces
+	}
+	return nil
+}
+
+// Alias is left at the old key of a renamed AppDescriptor, so references to it still resolve.
+type Alias struct {
+	TargetKey string `protobuf:"bytes,1,opt,name=target_key,json=targetKey" json:"target_key,omitempty"`
+	CreatedBy []byte `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *Alias) Reset()                    { *m = Alias{} }
+func (m *Alias) String() string            { return proto.CompactTextString(m) }
+func (*Alias) ProtoMessage()               {}
+func (*Alias) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *Alias) GetTargetKey() string {
+	if m != nil {
+		return m.TargetKey
+	}
+	return ""
+}
+
+func (m *Alias) GetCreatedBy() []byte {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return nil
+}
+
+func (m *Alias) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// ComplianceAttestation is an auditor's attestation, such as a SOC2 or ISO 27001 report,
+// covering an AppBundle.
+type ComplianceAttestation struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Type         string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// SHA-256 digest of the attestation document, which is held off-chain.
+	DocumentHash []byte `protobuf:"bytes,4,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	// The MSP ID of the auditing organization, checked against the submitting certificate.
+	Attestor   string `protobuf:"bytes,5,opt,name=attestor" json:"attestor,omitempty"`
+	AttestedBy []byte `protobuf:"bytes,6,opt,name=attested_by,json=attestedBy,proto3" json:"attested_by,omitempty"`
+	AttestedAt int64  `protobuf:"varint,7,opt,name=attested_at,json=attestedAt" json:"attested_at,omitempty"`
+}
+
+func (m *ComplianceAttestation) Reset()                    { *m = ComplianceAttestation{} }
+func (m *ComplianceAttestation) String() string            { return proto.CompactTextString(m) }
+func (*ComplianceAttestation) ProtoMessage()               {}
+func (*ComplianceAttestation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *ComplianceAttestation) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ComplianceAttestation) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ComplianceAttestation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ComplianceAttestation) GetDocumentHash() []byte {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return nil
+}
+
+func (m *ComplianceAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *ComplianceAttestation) GetAttestedBy() []byte {
+	if m != nil {
+		return m.AttestedBy
+	}
+	return nil
+}
+
+func (m *ComplianceAttestation) GetAttestedAt() int64 {
+	if m != nil {
+		return m.AttestedAt
+	}
+	return 0
+}
+
+// ScanResult is the latest verdict of a registered security scanner on an AppBundle.
+type ScanResult struct {
+	DescriptorId string             `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string             `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	ScannerId    string             `protobuf:"bytes,3,opt,name=scanner_id,json=scannerId" json:"scanner_id,omitempty"`
+	Verdict      ScanResult_Verdict `protobuf:"varint,4,opt,name=verdict,enum=main.ScanResult_Verdict" json:"verdict,omitempty"`
+	// Digest of the scan report, which is held off-chain.
+	ReportHash []byte `protobuf:"bytes,5,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	RecordedBy []byte `protobuf:"bytes,6,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	RecordedAt int64  `protobuf:"varint,7,opt,name=recorded_at,json=recordedAt" json:"recorded_at,omitempty"`
+}
+
+func (m *ScanResult) Reset()                    { *m = ScanResult{} }
+func (m *ScanResult) String() string            { return proto.CompactTextString(m) }
+func (*ScanResult) ProtoMessage()               {}
+func (*ScanResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *ScanResult) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ScanResult) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ScanResult) GetScannerId() string {
+	if m != nil {
+		return m.ScannerId
+	}
+	return ""
+}
+
+func (m *ScanResult) GetVerdict() ScanResult_Verdict {
+	if m != nil {
+		return m.Verdict
+	}
+	return ScanResult_FAIL
+}
+
+func (m *ScanResult) GetReportHash() []byte {
+	if m != nil {
+		return m.ReportHash
+	}
+	return nil
+}
+
+func (m *ScanResult) GetRecordedBy() []byte {
+	if m != nil {
+		return m.RecordedBy
+	}
+	return nil
+}
+
+func (m *ScanResult) GetRecordedAt() int64 {
+	if m != nil {
+		return m.RecordedAt
+	}
+	return 0
+}
+
+// Sbom is the software bill of materials of an AppBundle, held either inline or as a hash and
+// URI of the off-chain document.
+type Sbom struct {
+	DescriptorId string      `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string      `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Format       Sbom_Format `protobuf:"varint,3,opt,name=format,enum=main.Sbom_Format" json:"format,omitempty"`
+	Document     []byte      `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	DocumentHash []byte      `protobuf:"bytes,5,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	Uri          string      `protobuf:"bytes,6,opt,name=uri" json:"uri,omitempty"`
+	// Package URLs of the declared components, e.g. "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1".
+	Components []string `protobuf:"bytes,7,rep,name=components" json:"components,omitempty"`
+	AttachedBy []byte   `protobuf:"bytes,8,opt,name=attached_by,json=attachedBy,proto3" json:"attached_by,omitempty"`
+	AttachedAt int64    `protobuf:"varint,9,opt,name=attached_at,json=attachedAt" json:"attached_at,omitempty"`
+}
+
+func (m *Sbom) Reset()                    { *m = Sbom{} }
+func (m *Sbom) String() string            { return proto.CompactTextString(m) }
+func (*Sbom) ProtoMessage()               {}
+func (*Sbom) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *Sbom) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Sbom) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *Sbom) GetFormat() Sbom_Format {
+	if m != nil {
+		return m.Format
+	}
+	return Sbom_SPDX
+}
+
+func (m *Sbom) GetDocument() []byte {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *Sbom) GetDocumentHash() []byte {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return nil
+}
+
+func (m *Sbom) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *Sbom) GetComponents() []string {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func (m *Sbom) GetAttachedBy() []byte {
+	if m != nil {
+		return m.AttachedBy
+	}
+	return nil
+}
+
+func (m *Sbom) GetAttachedAt() int64 {
+	if m != nil {
+		return m.AttachedAt
+	}
+	return 0
+}
+
+// SbomComponent indexes a component declared by an Sbom.
+type SbomComponent struct {
+	// The package URL as declared.
+	Purl string `protobuf:"bytes,1,opt,name=purl" json:"purl,omitempty"`
+}
+
+func (m *SbomComponent) Reset()                    { *m = SbomComponent{} }
+func (m *SbomComponent) String() string            { return proto.CompactTextString(m) }
+func (*SbomComponent) ProtoMessage()               {}
+func (*SbomComponent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *SbomComponent) GetPurl() string {
+	if m != nil {
+		return m.Purl
+	}
+	return ""
+}
+
+// ComponentUsage lists the AppBundles whose Sboms declare a component.
+type ComponentUsage struct {
+	// In version order, then by descriptor and bundle.
+	Entries []*ComponentUsage_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	HasMore bool                    `protobuf:"varint,2,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+}
+
+func (m *ComponentUsage) Reset()                    { *m = ComponentUsage{} }
+func (m *ComponentUsage) String() string            { return proto.CompactTextString(m) }
+func (*ComponentUsage) ProtoMessage()               {}
+func (*ComponentUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *ComponentUsage) GetEntries() []*ComponentUsage_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ComponentUsage) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+type ComponentUsage_Entry struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Purl         string `protobuf:"bytes,3,opt,name=purl" json:"purl,omitempty"`
+}
+
+func (m *ComponentUsage_Entry) Reset()                    { *m = ComponentUsage_Entry{} }
+func (m *ComponentUsage_Entry) String() string            { return proto.CompactTextString(m) }
+func (*ComponentUsage_Entry) ProtoMessage()               {}
+func (*ComponentUsage_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82, 0} }
+
+func (m *ComponentUsage_Entry) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ComponentUsage_Entry) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ComponentUsage_Entry) GetPurl() string {
+	if m != nil {
+		return m.Purl
+	}
+	return ""
+}
+
+// ArtifactLicenseException is an admin's approval for an AppBundle to carry an artifact license
+// the registry does not allow.
+type ArtifactLicenseException struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	License      string `protobuf:"bytes,3,opt,name=license" json:"license,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	ApprovedBy   []byte `protobuf:"bytes,5,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt   int64  `protobuf:"varint,6,opt,name=approved_at,json=approvedAt" json:"approved_at,omitempty"`
+}
+
+func (m *ArtifactLicenseException) Reset()                    { *m = ArtifactLicenseException{} }
+func (m *ArtifactLicenseException) String() string            { return proto.CompactTextString(m) }
+func (*ArtifactLicenseException) ProtoMessage()               {}
+func (*ArtifactLicenseException) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *ArtifactLicenseException) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *ArtifactLicenseException) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *ArtifactLicenseException) GetLicense() string {
+	if m != nil {
+		return m.License
+	}
+	return ""
+}
+
+func (m *ArtifactLicenseException) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ArtifactLicenseException) GetApprovedBy() []byte {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return nil
+}
+
+func (m *ArtifactLicenseException) GetApprovedAt() int64 {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return 0
+}
+
+// PolicyRule is a declarative governance rule, checked by the write functions it lists against
+// the asset they create or associate: the AppDescriptor for createAppDescriptor, and the
+// AppBundle for createAppBundle, createConfidentialAppBundle, createPrivateAppBundle and
+// associateDescriptorWithBundle.
+type PolicyRule struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Functions   []string `protobuf:"bytes,2,rep,name=functions" json:"functions,omitempty"`
+	Description string   `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	// All must hold.
+	Predicates []*PolicyRule_Predicate `protobuf:"bytes,4,rep,name=predicates" json:"predicates,omitempty"`
+	// ComplianceAttestation types the AppBundle must carry; only associateDescriptorWithBundle
+	// can check these, since attestations are attached to existing bundles.
+	RequiredAttestations []string `protobuf:"bytes,5,rep,name=required_attestations,json=requiredAttestations" json:"required_attestations,omitempty"`
+	// The minimum number of owner_endorsements of the AppBundle.
+	MinEndorsements uint32 `protobuf:"varint,6,opt,name=min_endorsements,json=minEndorsements" json:"min_endorsements,omitempty"`
+}
+
+func (m *PolicyRule) Reset()                    { *m = PolicyRule{} }
+func (m *PolicyRule) String() string            { return proto.CompactTextString(m) }
+func (*PolicyRule) ProtoMessage()               {}
+func (*PolicyRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *PolicyRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PolicyRule) GetFunctions() []string {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *PolicyRule) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PolicyRule) GetPredicates() []*PolicyRule_Predicate {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+func (m *PolicyRule) GetRequiredAttestations() []string {
+	if m != nil {
+		return m.RequiredAttestations
+	}
+	return nil
+}
+
+func (m *PolicyRule) GetMinEndorsements() uint32 {
+	if m != nil {
+		return m.MinEndorsements
+	}
+	return 0
+}
+
+type PolicyRule_Predicate struct {
+	// The name of a field of the asset, as declared in app.proto.
+	Field string                  `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	Op    PolicyRule_Predicate_Op `protobuf:"varint,2,opt,name=op,enum=main.PolicyRule_Predicate_Op" json:"op,omitempty"`
+	Value string                  `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *PolicyRule_Predicate) Reset()                    { *m = PolicyRule_Predicate{} }
+func (m *PolicyRule_Predicate) String() string            { return proto.CompactTextString(m) }
+func (*PolicyRule_Predicate) ProtoMessage()               {}
+func (*PolicyRule_Predicate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84, 0} }
+
+func (m *PolicyRule_Predicate) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PolicyRule_Predicate) GetOp() PolicyRule_Predicate_Op {
+	if m != nil {
+		return m.Op
+	}
+	return PolicyRule_Predicate_PRESENT
+}
+
+func (m *PolicyRule_Predicate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PolicyRules struct {
+	// In name order.
+	Rules []*PolicyRule `protobuf:"bytes,1,rep,name=rules" json:"rules,omitempty"`
+}
+
+func (m *PolicyRules) Reset()                    { *m = PolicyRules{} }
+func (m *PolicyRules) String() string            { return proto.CompactTextString(m) }
+func (*PolicyRules) ProtoMessage()               {}
+func (*PolicyRules) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *PolicyRules) GetRules() []*PolicyRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type ComplianceAttestations struct {
+	// In type order, then by attestor.
+	Attestations []*ComplianceAttestation `protobuf:"bytes,1,rep,name=attestations" json:"attestations,omitempty"`
+}
+
+func (m *ComplianceAttestations) Reset()                    { *m = ComplianceAttestations{} }
+func (m *ComplianceAttestations) String() string            { return proto.CompactTextString(m) }
+func (*ComplianceAttestations) ProtoMessage()               {}
+func (*ComplianceAttestations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *ComplianceAttestations) GetAttestations() []*ComplianceAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+// Annotation is a claim a member other than the owner makes about an asset, e.g. that an
+// AppBundle was validated on another network. The claim itself is held off-chain.
+type Annotation struct {
+	ObjectType string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Type       string   `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// SHA-256 digest of the annotation's payload.
+	PayloadHash []byte `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	Annotator   []byte `protobuf:"bytes,5,opt,name=annotator,proto3" json:"annotator,omitempty"`
+	// The normalized annotator, see normalizeIdentity.
+	AnnotatorId    string `protobuf:"bytes,6,opt,name=annotator_id,json=annotatorId" json:"annotator_id,omitempty"`
+	AnnotatorMspid string `protobuf:"bytes,7,opt,name=annotator_mspid,json=annotatorMspid" json:"annotator_mspid,omitempty"`
+	// In seconds since the epoch.
+	AnnotatedAt int64             `protobuf:"varint,8,opt,name=annotated_at,json=annotatedAt" json:"annotated_at,omitempty"`
+	TxId        string            `protobuf:"bytes,9,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	Status      Annotation_Status `protobuf:"varint,10,opt,name=status,enum=main.Annotation_Status" json:"status,omitempty"`
+	// When the owner last changed the status, in seconds since the epoch.
+	ModeratedAt int64 `protobuf:"varint,11,opt,name=moderated_at,json=moderatedAt" json:"moderated_at,omitempty"`
+}
+
+func (m *Annotation) Reset()                    { *m = Annotation{} }
+func (m *Annotation) String() string            { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()               {}
+func (*Annotation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *Annotation) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *Annotation) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *Annotation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Annotation) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+func (m *Annotation) GetAnnotator() []byte {
+	if m != nil {
+		return m.Annotator
+	}
+	return nil
+}
+
+func (m *Annotation) GetAnnotatorId() string {
+	if m != nil {
+		return m.AnnotatorId
+	}
+	return ""
+}
+
+func (m *Annotation) GetAnnotatorMspid() string {
+	if m != nil {
+		return m.AnnotatorMspid
+	}
+	return ""
+}
+
+func (m *Annotation) GetAnnotatedAt() int64 {
+	if m != nil {
+		return m.AnnotatedAt
+	}
+	return 0
+}
+
+func (m *Annotation) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *Annotation) GetStatus() Annotation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Annotation_VISIBLE
+}
+
+func (m *Annotation) GetModeratedAt() int64 {
+	if m != nil {
+		return m.ModeratedAt
+	}
+	return 0
+}
+
+type Annotations struct {
+	// In type order, then by annotator_id.
+	Annotations []*Annotation `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty"`
+	// The HIDDEN annotations left out.
+	Hidden uint32 `protobuf:"varint,2,opt,name=hidden" json:"hidden,omitempty"`
+	// Set when the query limits truncated the annotations; pass bookmark to get the rest.
+	HasMore  bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	Bookmark string `protobuf:"bytes,4,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *Annotations) Reset()                    { *m = Annotations{} }
+func (m *Annotations) String() string            { return proto.CompactTextString(m) }
+func (*Annotations) ProtoMessage()               {}
+func (*Annotations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *Annotations) GetAnnotations() []*Annotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *Annotations) GetHidden() uint32 {
+	if m != nil {
+		return m.Hidden
+	}
+	return 0
+}
+
+func (m *Annotations) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *Annotations) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+// PrivateBundleRecord is the public record of an AppBundle kept in its owner org's implicit
+// private data collection. createPrivateAppBundle returns one, without storing it, for the
+// AppBundle it kept in the named collection.
+type PrivateBundleRecord struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	BundleKey    string `protobuf:"bytes,2,opt,name=bundle_key,json=bundleKey" json:"bundle_key,omitempty"`
+	Collection   string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
+	OwnerMspid   string `protobuf:"bytes,4,opt,name=owner_mspid,json=ownerMspid" json:"owner_mspid,omitempty"`
+	Owner        []byte `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// SHA-256 digest of the marshaled AppBundle.
+	BundleHash []byte `protobuf:"bytes,6,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *PrivateBundleRecord) Reset()                    { *m = PrivateBundleRecord{} }
+func (m *PrivateBundleRecord) String() string            { return proto.CompactTextString(m) }
+func (*PrivateBundleRecord) ProtoMessage()               {}
+func (*PrivateBundleRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *PrivateBundleRecord) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *PrivateBundleRecord) GetBundleKey() string {
+	if m != nil {
+		return m.BundleKey
+	}
+	return ""
+}
+
+func (m *PrivateBundleRecord) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *PrivateBundleRecord) GetOwnerMspid() string {
+	if m != nil {
+		return m.OwnerMspid
+	}
+	return ""
+}
+
+func (m *PrivateBundleRecord) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *PrivateBundleRecord) GetBundleHash() []byte {
+	if m != nil {
+		return m.BundleHash
+	}
+	return nil
+}
+
+func (m *PrivateBundleRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// Auction sells an exclusive License for an AppDescriptor by sealed commit-reveal bidding.
+type Auction struct {
+	DescriptorId string         `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Seller       []byte         `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Status       Auction_Status `protobuf:"varint,3,opt,name=status,enum=main.Auction_Status" json:"status,omitempty"`
+	// Transaction timestamps, in seconds since the epoch: bids are placed before
+	// bid_deadline and revealed before reveal_deadline.
+	OpenedAt       int64  `protobuf:"varint,4,opt,name=opened_at,json=openedAt" json:"opened_at,omitempty"`
+	BidDeadline    int64  `protobuf:"varint,5,opt,name=bid_deadline,json=bidDeadline" json:"bid_deadline,omitempty"`
+	RevealDeadline int64  `protobuf:"varint,6,opt,name=reveal_deadline,json=revealDeadline" json:"reveal_deadline,omitempty"`
+	Winner         []byte `protobuf:"bytes,7,opt,name=winner,proto3" json:"winner,omitempty"`
+	WinningAmount  uint64 `protobuf:"varint,8,opt,name=winning_amount,json=winningAmount" json:"winning_amount,omitempty"`
+	// Why the highest revealed bid was not awarded the License, if the descriptor could no
+	// longer be licensed exclusively when the Auction closed.
+	UnawardedReason string `protobuf:"bytes,9,opt,name=unawarded_reason,json=unawardedReason" json:"unawarded_reason,omitempty"`
+}
+
+func (m *Auction) Reset()                    { *m = Auction{} }
+func (m *Auction) String() string            { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()               {}
+func (*Auction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *Auction) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Auction) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *Auction) GetStatus() Auction_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Auction_OPEN
+}
+
+func (m *Auction) GetOpenedAt() int64 {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return 0
+}
+
+func (m *Auction) GetBidDeadline() int64 {
+	if m != nil {
+		return m.BidDeadline
+	}
+	return 0
+}
+
+func (m *Auction) GetRevealDeadline() int64 {
+	if m != nil {
+		return m.RevealDeadline
+	}
+	return 0
+}
+
+func (m *Auction) GetWinner() []byte {
+	if m != nil {
+		return m.Winner
+	}
+	return nil
+}
+
+func (m *Auction) GetWinningAmount() uint64 {
+	if m != nil {
+		return m.WinningAmount
+	}
+	return 0
+}
+
+func (m *Auction) GetUnawardedReason() string {
+	if m != nil {
+		return m.UnawardedReason
+	}
+	return ""
+}
+
+// Bid is a sealed bid: commitment is a FieldCommitment style digest of the decimal amount.
+type Bid struct {
+	Bidder     []byte `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Revealed   bool   `protobuf:"varint,3,opt,name=revealed" json:"revealed,omitempty"`
+	Amount     uint64 `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	PlacedAt   int64  `protobuf:"varint,5,opt,name=placed_at,json=placedAt" json:"placed_at,omitempty"`
+}
+
+func (m *Bid) Reset()                    { *m = Bid{} }
+func (m *Bid) String() string            { return proto.CompactTextString(m) }
+func (*Bid) ProtoMessage()               {}
+func (*Bid) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *Bid) GetBidder() []byte {
+	if m != nil {
+		return m.Bidder
+	}
+	return nil
+}
+
+func (m *Bid) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *Bid) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *Bid) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Bid) GetPlacedAt() int64 {
+	if m != nil {
+		return m.PlacedAt
+	}
+	return 0
+}
+
+// License grants licensee the use of an AppDescriptor, awarded by an Auction or an accepted Offer.
+type License struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Licensee     []byte `protobuf:"bytes,2,opt,name=licensee,proto3" json:"licensee,omitempty"`
+	Exclusive    bool   `protobuf:"varint,3,opt,name=exclusive" json:"exclusive,omitempty"`
+	AuctionId    string `protobuf:"bytes,4,opt,name=auction_id,json=auctionId" json:"auction_id,omitempty"`
+	Amount       uint64 `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	GrantedAt    int64  `protobuf:"varint,6,opt,name=granted_at,json=grantedAt" json:"granted_at,omitempty"`
+	LicenseeId   string `protobuf:"bytes,7,opt,name=licensee_id,json=licenseeId" json:"licensee_id,omitempty"`
+	OfferId      string `protobuf:"bytes,8,opt,name=offer_id,json=offerId" json:"offer_id,omitempty"`
+}
+
+func (m *License) Reset()                    { *m = License{} }
+func (m *License) String() string            { return proto.CompactTextString(m) }
+func (*License) ProtoMessage()               {}
+func (*License) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *License) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *License) GetLicensee() []byte {
+	if m != nil {
+		return m.Licensee
+	}
+	return nil
+}
+
+func (m *License) GetExclusive() bool {
+	if m != nil {
+		return m.Exclusive
+	}
+	return false
+}
+
+func (m *License) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *License) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *License) GetGrantedAt() int64 {
+	if m != nil {
+		return m.GrantedAt
+	}
+	return 0
+}
+
+func (m *License) GetLicenseeId() string {
+	if m != nil {
+		return m.LicenseeId
+	}
+	return ""
+}
+
+func (m *License) GetOfferId() string {
+	if m != nil {
+		return m.OfferId
+	}
+	return ""
+}
+
+// Offer is a License negotiation between a consumer and the publisher of an AppDescriptor.
+// The parties alternate counters until the awaited party accepts or either party rejects.
+type Offer struct {
+	DescriptorId string       `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Consumer     []byte       `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Publisher    []byte       `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Status       Offer_Status `protobuf:"varint,4,opt,name=status,enum=main.Offer_Status" json:"status,omitempty"`
+	Awaiting     Offer_Party  `protobuf:"varint,5,opt,name=awaiting,enum=main.Offer_Party" json:"awaiting,omitempty"`
+	Amount       uint64       `protobuf:"varint,6,opt,name=amount" json:"amount,omitempty"`
+	Exclusive    bool         `protobuf:"varint,7,opt,name=exclusive" json:"exclusive,omitempty"`
+	Round        uint32       `protobuf:"varint,8,opt,name=round" json:"round,omitempty"`
+	CreatedAt    int64        `protobuf:"varint,9,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UpdatedAt    int64        `protobuf:"varint,10,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+}
+
+func (m *Offer) Reset()                    { *m = Offer{} }
+func (m *Offer) String() string            { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()               {}
+func (*Offer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *Offer) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Offer) GetConsumer() []byte {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+func (m *Offer) GetPublisher() []byte {
+	if m != nil {
+		return m.Publisher
+	}
+	return nil
+}
+
+func (m *Offer) GetStatus() Offer_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Offer_OPEN
+}
+
+func (m *Offer) GetAwaiting() Offer_Party {
+	if m != nil {
+		return m.Awaiting
+	}
+	return Offer_PUBLISHER
+}
+
+func (m *Offer) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Offer) GetExclusive() bool {
+	if m != nil {
+		return m.Exclusive
+	}
+	return false
+}
+
+func (m *Offer) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Offer) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Offer) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// UsageRecord is a licensee's report of metered use of an AppDescriptor, priced by the
+// PricingTier in effect when it was recorded.
+type UsageRecord struct {
+	DescriptorId string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Consumer     []byte `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	ConsumerId   string `protobuf:"bytes,3,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	Tier         string `protobuf:"bytes,4,opt,name=tier" json:"tier,omitempty"`
+	Unit         string `protobuf:"bytes,5,opt,name=unit" json:"unit,omitempty"`
+	Quantity     uint64 `protobuf:"varint,6,opt,name=quantity" json:"quantity,omitempty"`
+	UnitPrice    uint64 `protobuf:"varint,7,opt,name=unit_price,json=unitPrice" json:"unit_price,omitempty"`
+	CurrencyCode string `protobuf:"bytes,8,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+	RecordedAt   int64  `protobuf:"varint,9,opt,name=recorded_at,json=recordedAt" json:"recorded_at,omitempty"`
+}
+
+func (m *UsageRecord) Reset()                    { *m = UsageRecord{} }
+func (m *UsageRecord) String() string            { return proto.CompactTextString(m) }
+func (*UsageRecord) ProtoMessage()               {}
+func (*UsageRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *UsageRecord) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetConsumer() []byte {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+func (m *UsageRecord) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *UsageRecord) GetUnitPrice() uint64 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *UsageRecord) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetRecordedAt() int64 {
+	if m != nil {
+		return m.RecordedAt
+	}
+	return 0
+}
+
+// Invoice bills a consumer for a month of usage of one AppDescriptor in one currency.
+type Invoice struct {
+	// The calendar month, YYYY-MM in UTC.
+	Period       string          `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	DescriptorId string          `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Publisher    []byte          `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Consumer     []byte          `protobuf:"bytes,4,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	ConsumerId   string          `protobuf:"bytes,5,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	CurrencyCode string          `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+	Lines        []*Invoice_Line `protobuf:"bytes,7,rep,name=lines" json:"lines,omitempty"`
+	Total        uint64          `protobuf:"varint,8,opt,name=total" json:"total,omitempty"`
+	Status       Invoice_Status  `protobuf:"varint,9,opt,name=status,enum=main.Invoice_Status" json:"status,omitempty"`
+	GeneratedAt  int64           `protobuf:"varint,10,opt,name=generated_at,json=generatedAt" json:"generated_at,omitempty"`
+	PaymentRef   string          `protobuf:"bytes,11,opt,name=payment_ref,json=paymentRef" json:"payment_ref,omitempty"`
+	SettledBy    []byte          `protobuf:"bytes,12,opt,name=settled_by,json=settledBy,proto3" json:"settled_by,omitempty"`
+	SettledAt    int64           `protobuf:"varint,13,opt,name=settled_at,json=settledAt" json:"settled_at,omitempty"`
+	// The division of total among the parties, fixed when the Invoice is generated.
+	RoyaltyShares []*RoyaltyShare `protobuf:"bytes,14,rep,name=royalty_shares,json=royaltyShares" json:"royalty_shares,omitempty"`
+}
+
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *Invoice) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *Invoice) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *Invoice) GetPublisher() []byte {
+	if m != nil {
+		return m.Publisher
+	}
+	return nil
+}
+
+func (m *Invoice) GetConsumer() []byte {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+func (m *Invoice) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *Invoice) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *Invoice) GetLines() []*Invoice_Line {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *Invoice) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Invoice) GetStatus() Invoice_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Invoice_OPEN
+}
+
+func (m *Invoice) GetGeneratedAt() int64 {
+	if m != nil {
+		return m.GeneratedAt
+	}
+	return 0
+}
+
+func (m *Invoice) GetPaymentRef() string {
+	if m != nil {
+		return m.PaymentRef
+	}
+	return ""
+}
+
+func (m *Invoice) GetSettledBy() []byte {
+	if m != nil {
+		return m.SettledBy
+	}
+	return nil
+}
+
+func (m *Invoice) GetSettledAt() int64 {
+	if m != nil {
+		return m.SettledAt
+	}
+	return 0
+}
+
+func (m *Invoice) GetRoyaltyShares() []*RoyaltyShare {
+	if m != nil {
+		return m.RoyaltyShares
+	}
+	return nil
+}
+
+type Invoice_Line struct {
+	Tier      string `protobuf:"bytes,1,opt,name=tier" json:"tier,omitempty"`
+	Unit      string `protobuf:"bytes,2,opt,name=unit" json:"unit,omitempty"`
+	UnitPrice uint64 `protobuf:"varint,3,opt,name=unit_price,json=unitPrice" json:"unit_price,omitempty"`
+	Quantity  uint64 `protobuf:"varint,4,opt,name=quantity" json:"quantity,omitempty"`
+	Amount    uint64 `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *Invoice_Line) Reset()                    { *m = Invoice_Line{} }
+func (m *Invoice_Line) String() string            { return proto.CompactTextString(m) }
+func (*Invoice_Line) ProtoMessage()               {}
+func (*Invoice_Line) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95, 0} }
+
+func (m *Invoice_Line) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *Invoice_Line) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *Invoice_Line) GetUnitPrice() uint64 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *Invoice_Line) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Invoice_Line) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RoyaltyShare struct {
+	Party []byte `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	// The normalized party, see normalizeIdentity.
+	PartyId string `protobuf:"bytes,2,opt,name=party_id,json=partyId" json:"party_id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *RoyaltyShare) Reset()                    { *m = RoyaltyShare{} }
+func (m *RoyaltyShare) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltyShare) ProtoMessage()               {}
+func (*RoyaltyShare) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *RoyaltyShare) GetParty() []byte {
+	if m != nil {
+		return m.Party
+	}
+	return nil
+}
+
+func (m *RoyaltyShare) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *RoyaltyShare) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// RoyaltyEntry records a party's share of a settled Invoice.
+type RoyaltyEntry struct {
+	Period       string `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	DescriptorId string `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	ConsumerId   string `protobuf:"bytes,3,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+	Amount       uint64 `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	PaymentRef   string `protobuf:"bytes,6,opt,name=payment_ref,json=paymentRef" json:"payment_ref,omitempty"`
+}
+
+func (m *RoyaltyEntry) Reset()                    { *m = RoyaltyEntry{} }
+func (m *RoyaltyEntry) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltyEntry) ProtoMessage()               {}
+func (*RoyaltyEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *RoyaltyEntry) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *RoyaltyEntry) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *RoyaltyEntry) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *RoyaltyEntry) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *RoyaltyEntry) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RoyaltyEntry) GetPaymentRef() string {
+	if m != nil {
+		return m.PaymentRef
+	}
+	return ""
+}
+
+// RoyaltyStatement is a page of a party's RoyaltyEntries for a period, with the totals of the
+// whole period. The totals are kept as each Invoice is settled, in a RoyaltyStatement without
+// entries stored under ROYALTY_TOTAL.
+type RoyaltyStatement struct {
+	PartyId string          `protobuf:"bytes,1,opt,name=party_id,json=partyId" json:"party_id,omitempty"`
+	Period  string          `protobuf:"bytes,2,opt,name=period" json:"period,omitempty"`
+	Entries []*RoyaltyEntry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
+	// One per currency, in currency code order.
+	Totals []*RoyaltyStatement_Total `protobuf:"bytes,4,rep,name=totals" json:"totals,omitempty"`
+	// Set when the query limits truncated the entries; pass bookmark to get the rest.
+	HasMore  bool   `protobuf:"varint,5,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	Bookmark string `protobuf:"bytes,6,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *RoyaltyStatement) Reset()                    { *m = RoyaltyStatement{} }
+func (m *RoyaltyStatement) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltyStatement) ProtoMessage()               {}
+func (*RoyaltyStatement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *RoyaltyStatement) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *RoyaltyStatement) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *RoyaltyStatement) GetEntries() []*RoyaltyEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *RoyaltyStatement) GetTotals() []*RoyaltyStatement_Total {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+func (m *RoyaltyStatement) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *RoyaltyStatement) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+type RoyaltyStatement_Total struct {
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+	Amount       uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *RoyaltyStatement_Total) Reset()                    { *m = RoyaltyStatement_Total{} }
+func (m *RoyaltyStatement_Total) String() string            { return proto.CompactTextString(m) }
+func (*RoyaltyStatement_Total) ProtoMessage()               {}
+func (*RoyaltyStatement_Total) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98, 0} }
+
+func (m *RoyaltyStatement_Total) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *RoyaltyStatement_Total) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type InvoiceGenerationResult struct {
+	Period       string `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	CreatedCount uint32 `protobuf:"varint,2,opt,name=created_count,json=createdCount" json:"created_count,omitempty"`
+	// Invoices that already existed from an earlier run.
+	SkippedCount uint32 `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount" json:"skipped_count,omitempty"`
+}
+
+func (m *InvoiceGenerationResult) Reset()                    { *m = InvoiceGenerationResult{} }
+func (m *InvoiceGenerationResult) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceGenerationResult) ProtoMessage()               {}
+func (*InvoiceGenerationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *InvoiceGenerationResult) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *InvoiceGenerationResult) GetCreatedCount() uint32 {
+	if m != nil {
+		return m.CreatedCount
+	}
+	return 0
+}
+
+func (m *InvoiceGenerationResult) GetSkippedCount() uint32 {
+	if m != nil {
+		return m.SkippedCount
+	}
+	return 0
+}
+
+// SettlementRecord maps a payment_ref to the Invoice it settled, so no payment settles two.
+type SettlementRecord struct {
+	Period       string `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	DescriptorId string `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	ConsumerId   string `protobuf:"bytes,3,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode" json:"currency_code,omitempty"`
+}
+
+func (m *SettlementRecord) Reset()                    { *m = SettlementRecord{} }
+func (m *SettlementRecord) String() string            { return proto.CompactTextString(m) }
+func (*SettlementRecord) ProtoMessage()               {}
+func (*SettlementRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *SettlementRecord) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *SettlementRecord) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *SettlementRecord) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *SettlementRecord) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+// Featured marks an AppDescriptor for the storefront; lower ranks are shown first.
+type Featured struct {
+	Rank       uint32 `protobuf:"varint,1,opt,name=rank" json:"rank,omitempty"`
+	FeaturedBy []byte `protobuf:"bytes,2,opt,name=featured_by,json=featuredBy,proto3" json:"featured_by,omitempty"`
+	FeaturedAt int64  `protobuf:"varint,3,opt,name=featured_at,json=featuredAt" json:"featured_at,omitempty"`
+}
+
+func (m *Featured) Reset()                    { *m = Featured{} }
+func (m *Featured) String() string            { return proto.CompactTextString(m) }
+func (*Featured) ProtoMessage()               {}
+func (*Featured) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *Featured) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *Featured) GetFeaturedBy() []byte {
+	if m != nil {
+		return m.FeaturedBy
+	}
+	return nil
+}
+
+func (m *Featured) GetFeaturedAt() int64 {
+	if m != nil {
+		return m.FeaturedAt
+	}
+	return 0
+}
+
+type FeaturedDescriptors struct {
+	// In rank order, ties broken by descriptor_id.
+	Entries []*FeaturedDescriptors_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	HasMore bool                         `protobuf:"varint,2,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+}
+
+func (m *FeaturedDescriptors) Reset()                    { *m = FeaturedDescriptors{} }
+func (m *FeaturedDescriptors) String() string            { return proto.CompactTextString(m) }
+func (*FeaturedDescriptors) ProtoMessage()               {}
+func (*FeaturedDescriptors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *FeaturedDescriptors) GetEntries() []*FeaturedDescriptors_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *FeaturedDescriptors) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+type FeaturedDescriptors_Entry struct {
+	DescriptorId  string         `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	Rank          uint32         `protobuf:"varint,2,opt,name=rank" json:"rank,omitempty"`
+	AppDescriptor *AppDescriptor `protobuf:"bytes,3,opt,name=app_descriptor,json=appDescriptor" json:"app_descriptor,omitempty"`
+}
+
+func (m *FeaturedDescriptors_Entry) Reset()                    { *m = FeaturedDescriptors_Entry{} }
+func (m *FeaturedDescriptors_Entry) String() string            { return proto.CompactTextString(m) }
+func (*FeaturedDescriptors_Entry) ProtoMessage()               {}
+func (*FeaturedDescriptors_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102, 0} }
+
+func (m *FeaturedDescriptors_Entry) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *FeaturedDescriptors_Entry) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *FeaturedDescriptors_Entry) GetAppDescriptor() *AppDescriptor {
+	if m != nil {
+		return m.AppDescriptor
+	}
+	return nil
+}
+
+// ActivityReport is an identity's report of activity on an AppDescriptor within an hour.
+type ActivityReport struct {
+	Kind       ActivityReport_Kind `protobuf:"varint,1,opt,name=kind,enum=main.ActivityReport_Kind" json:"kind,omitempty"`
+	Reporter   []byte              `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReportedAt int64               `protobuf:"varint,3,opt,name=reported_at,json=reportedAt" json:"reported_at,omitempty"`
+}
+
+func (m *ActivityReport) Reset()                    { *m = ActivityReport{} }
+func (m *ActivityReport) String() string            { return proto.CompactTextString(m) }
+func (*ActivityReport) ProtoMessage()               {}
+func (*ActivityReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *ActivityReport) GetKind() ActivityReport_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return ActivityReport_VIEW
+}
+
+func (m *ActivityReport) GetReporter() []byte {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
+func (m *ActivityReport) GetReportedAt() int64 {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return 0
+}
+
+type TrendingDescriptors struct {
+	// Most active first, ties broken by descriptor_id.
+	Entries []*TrendingDescriptors_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *TrendingDescriptors) Reset()                    { *m = TrendingDescriptors{} }
+func (m *TrendingDescriptors) String() string            { return proto.CompactTextString(m) }
+func (*TrendingDescriptors) ProtoMessage()               {}
+func (*TrendingDescriptors) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *TrendingDescriptors) GetEntries() []*TrendingDescriptors_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type TrendingDescriptors_Entry struct {
+	DescriptorId  string `protobuf:"bytes,1,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	ActivityCount uint32 `protobuf:"varint,2,opt,name=activity_count,json=activityCount" json:"activity_count,omitempty"`
+	// The support of the AppDescriptor, if it still exists.
+	Support *SupportInfo `protobuf:"bytes,3,opt,name=support" json:"support,omitempty"`
+}
+
+func (m *TrendingDescriptors_Entry) Reset()                    { *m = TrendingDescriptors_Entry{} }
+func (m *TrendingDescriptors_Entry) String() string            { return proto.CompactTextString(m) }
+func (*TrendingDescriptors_Entry) ProtoMessage()               {}
+func (*TrendingDescriptors_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104, 0} }
+
+func (m *TrendingDescriptors_Entry) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *TrendingDescriptors_Entry) GetActivityCount() uint32 {
+	if m != nil {
+		return m.ActivityCount
+	}
+	return 0
+}
+
+func (m *TrendingDescriptors_Entry) GetSupport() *SupportInfo {
+	if m != nil {
+		return m.Support
+	}
+	return nil
+}
+
+// DescriptorRollup summarizes a month of an AppDescriptor's ActivityReports and invoiced
+// UsageRecords, which rollupActivity then deletes.
+type DescriptorRollup struct {
+	Period           string                        `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	DescriptorId     string                        `protobuf:"bytes,2,opt,name=descriptor_id,json=descriptorId" json:"descriptor_id,omitempty"`
+	ViewCount        uint64                        `protobuf:"varint,3,opt,name=view_count,json=viewCount" json:"view_count,omitempty"`
+	DownloadCount    uint64                        `protobuf:"varint,4,opt,name=download_count,json=downloadCount" json:"download_count,omitempty"`
+	InstallCount     uint64                        `protobuf:"varint,5,opt,name=install_count,json=installCount" json:"install_count,omitempty"`
+	UsageRecordCount uint64                        `protobuf:"varint,6,opt,name=usage_record_count,json=usageRecordCount" json:"usage_record_count,omitempty"`
+	Usage            []*DescriptorRollup_TierUsage `protobuf:"bytes,7,rep,name=usage" json:"usage,omitempty"`
+}
+
+func (m *DescriptorRollup) Reset()                    { *m = DescriptorRollup{} }
+func (m *DescriptorRollup) String() string            { return proto.CompactTextString(m) }
+func (*DescriptorRollup) ProtoMessage()               {}
+func (*DescriptorRollup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+func (m *DescriptorRollup) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *DescriptorRollup) GetDescriptorId() string {
+	if m != nil {
+		return m.DescriptorId
+	}
+	return ""
+}
+
+func (m *DescriptorRollup) GetViewCount() uint64 {
+	if m != nil {
+		return m.ViewCount
+	}
+	return 0
+}
+
+func (m *DescriptorRollup) GetDownloadCount() uint64 {
+	if m != nil {
+		return m.DownloadCount
+	}
+	return 0
+}
+
+func (m *DescriptorRollup) GetInstallCount() uint64 {
+	if m != nil {
+		return m.InstallCount
+	}
+	return 0
+}
+
+func (m *DescriptorRollup) GetUsageRecordCount() uint64 {
+	if m != nil {
+		return m.UsageRecordCount
+	}
+	return 0
+}
+
+func (m *DescriptorRollup) GetUsage() []*DescriptorRollup_TierUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type DescriptorRollup_TierUsage struct {
+	Tier     string `protobuf:"bytes,1,opt,name=tier" json:"tier,omitempty"`
+	Unit     string `protobuf:"bytes,2,opt,name=unit" json:"unit,omitempty"`
+	Quantity uint64 `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+}
+
+func (m *DescriptorRollup_TierUsage) Reset()         { *m = DescriptorRollup_TierUsage{} }
+func (m *DescriptorRollup_TierUsage) String() string { return proto.CompactTextString(m) }
+func (*DescriptorRollup_TierUsage) ProtoMessage()    {}
+func (*DescriptorRollup_TierUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{105, 0}
+}
+
+func (m *DescriptorRollup_TierUsage) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *DescriptorRollup_TierUsage) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *DescriptorRollup_TierUsage) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+// RollupProgress tracks rollupActivity through a period, one batch per transaction.
+type RollupProgress struct {
+	Period string `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"`
+	// The hour of the period whose ActivityReports are being rolled up.
+	Hour uint32 `protobuf:"varint,2,opt,name=hour" json:"hour,omitempty"`
+	// The last composite key processed in the current scan.
+	Bookmark      string `protobuf:"bytes,3,opt,name=bookmark" json:"bookmark,omitempty"`
+	ActivityDone  bool   `protobuf:"varint,4,opt,name=activity_done,json=activityDone" json:"activity_done,omitempty"`
+	UsageDone     bool   `protobuf:"varint,5,opt,name=usage_done,json=usageDone" json:"usage_done,omitempty"`
+	Done          bool   `protobuf:"varint,6,opt,name=done" json:"done,omitempty"`
+	RolledUpCount uint64 `protobuf:"varint,7,opt,name=rolled_up_count,json=rolledUpCount" json:"rolled_up_count,omitempty"`
+	// UsageRecords kept because no Invoice covers them yet.
+	RetainedCount uint64 `protobuf:"varint,8,opt,name=retained_count,json=retainedCount" json:"retained_count,omitempty"`
+}
+
+func (m *RollupProgress) Reset()                    { *m = RollupProgress{} }
+func (m *RollupProgress) String() string            { return proto.CompactTextString(m) }
+func (*RollupProgress) ProtoMessage()               {}
+func (*RollupProgress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *RollupProgress) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *RollupProgress) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *RollupProgress) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *RollupProgress) GetActivityDone() bool {
+	if m != nil {
+		return m.ActivityDone
+	}
+	return false
+}
+
+func (m *RollupProgress) GetUsageDone() bool {
+	if m != nil {
+		return m.UsageDone
+	}
+	return false
+}
+
+func (m *RollupProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *RollupProgress) GetRolledUpCount() uint64 {
+	if m != nil {
+		return m.RolledUpCount
+	}
+	return 0
+}
+
+func (m *RollupProgress) GetRetainedCount() uint64 {
+	if m != nil {
+		return m.RetainedCount
+	}
+	return 0
+}
+
+// RegistryEvent is the payload of the chaincode event emitted by every transaction that
+// changes public state, listing the changed keys in first-write order.
+type RegistryEvent struct {
+	Function string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	TxId     string `protobuf:"bytes,2,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	// The normalized creator, see normalizeIdentity.
+	CreatorId string `protobuf:"bytes,3,opt,name=creator_id,json=creatorId" json:"creator_id,omitempty"`
+	// Transaction timestamp, in seconds since the epoch.
+	Timestamp int64                   `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Changes   []*RegistryEvent_Change `protobuf:"bytes,5,rep,name=changes" json:"changes,omitempty"`
+	// The MSP ID of the organization that submitted the transaction.
+	CreatorMspId string `protobuf:"bytes,6,opt,name=creator_msp_id,json=creatorMspId" json:"creator_msp_id,omitempty"`
+	// The client's trace ID of the invocation, see traced.
+	TraceId string `protobuf:"bytes,7,opt,name=trace_id,json=traceId" json:"trace_id,omitempty"`
+}
+
+func (m *RegistryEvent) Reset()                    { *m = RegistryEvent{} }
+func (m *RegistryEvent) String() string            { return proto.CompactTextString(m) }
+func (*RegistryEvent) ProtoMessage()               {}
+func (*RegistryEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+func (m *RegistryEvent) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *RegistryEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *RegistryEvent) GetCreatorId() string {
+	if m != nil {
+		return m.CreatorId
+	}
+	return ""
+}
+
+func (m *RegistryEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RegistryEvent) GetChanges() []*RegistryEvent_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *RegistryEvent) GetCreatorMspId() string {
+	if m != nil {
+		return m.CreatorMspId
+	}
+	return ""
+}
+
+func (m *RegistryEvent) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+type RegistryEvent_Change struct {
+	// The Query.ObjectType name of the composite key.
+	ObjectType string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Deleted    bool     `protobuf:"varint,3,opt,name=deleted" json:"deleted,omitempty"`
+	// For changes to an AppDescriptor or its AppBundles, the watcher_ids of its Watches.
+	WatcherIds []string `protobuf:"bytes,4,rep,name=watcher_ids,json=watcherIds" json:"watcher_ids,omitempty"`
+}
+
+func (m *RegistryEvent_Change) Reset()                    { *m = RegistryEvent_Change{} }
+func (m *RegistryEvent_Change) String() string            { return proto.CompactTextString(m) }
+func (*RegistryEvent_Change) ProtoMessage()               {}
+func (*RegistryEvent_Change) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107, 0} }
+
+func (m *RegistryEvent_Change) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *RegistryEvent_Change) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *RegistryEvent_Change) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *RegistryEvent_Change) GetWatcherIds() []string {
+	if m != nil {
+		return m.WatcherIds
+	}
+	return nil
+}
+
+// EventJournalEntry records the changes a transaction made in a namespace, keyed by its
+// timestamp and tx_id, see emitDigest.
+type EventJournalEntry struct {
+	Function  string `protobuf:"bytes,2,opt,name=function" json:"function,omitempty"`
+	TxId      string `protobuf:"bytes,3,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	CreatorId string `protobuf:"bytes,4,opt,name=creator_id,json=creatorId" json:"creator_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	// In first-write order, without watcher_ids.
+	Changes []*RegistryEvent_Change `protobuf:"bytes,6,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *EventJournalEntry) Reset()                    { *m = EventJournalEntry{} }
+func (m *EventJournalEntry) String() string            { return proto.CompactTextString(m) }
+func (*EventJournalEntry) ProtoMessage()               {}
+func (*EventJournalEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *EventJournalEntry) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *EventJournalEntry) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *EventJournalEntry) GetCreatorId() string {
+	if m != nil {
+		return m.CreatorId
+	}
+	return ""
+}
+
+func (m *EventJournalEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *EventJournalEntry) GetChanges() []*RegistryEvent_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// RegistryDigest is the payload of the event emitted by emitDigest, summarizing the journaled
+// changes of a namespace after a cursor.
+type RegistryDigest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	// The cursor the digest continues from: it summarizes the transactions journaled after
+	// since_tx_id at since_timestamp, or after since_timestamp if since_tx_id is empty.
+	SinceTimestamp int64  `protobuf:"varint,2,opt,name=since_timestamp,json=sinceTimestamp" json:"since_timestamp,omitempty"`
+	SinceTxId      string `protobuf:"bytes,3,opt,name=since_tx_id,json=sinceTxId" json:"since_tx_id,omitempty"`
+	Transactions   uint32 `protobuf:"varint,4,opt,name=transactions" json:"transactions,omitempty"`
+	// The timestamps of the first and last transactions summarized.
+	FromTimestamp int64 `protobuf:"varint,5,opt,name=from_timestamp,json=fromTimestamp" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,6,opt,name=to_timestamp,json=toTimestamp" json:"to_timestamp,omitempty"`
+	// In function name order.
+	Functions []*RegistryDigest_Function `protobuf:"bytes,7,rep,name=functions" json:"functions,omitempty"`
+	// In key order.
+	Changes []*RegistryDigest_Change `protobuf:"bytes,8,rep,name=changes" json:"changes,omitempty"`
+	// Set when more journaled transactions follow the last one summarized.
+	HasMore bool `protobuf:"varint,9,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	// The last transaction summarized: with to_timestamp, the cursor of the next digest.
+	ToTxId string `protobuf:"bytes,10,opt,name=to_tx_id,json=toTxId" json:"to_tx_id,omitempty"`
+}
+
+func (m *RegistryDigest) Reset()                    { *m = RegistryDigest{} }
+func (m *RegistryDigest) String() string            { return proto.CompactTextString(m) }
+func (*RegistryDigest) ProtoMessage()               {}
+func (*RegistryDigest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+
+func (m *RegistryDigest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RegistryDigest) GetSinceTimestamp() int64 {
+	if m != nil {
+		return m.SinceTimestamp
+	}
+	return 0
+}
+
+func (m *RegistryDigest) GetSinceTxId() string {
+	if m != nil {
+		return m.SinceTxId
+	}
+	return ""
+}
+
+func (m *RegistryDigest) GetTransactions() uint32 {
+	if m != nil {
+		return m.Transactions
+	}
+	return 0
+}
+
+func (m *RegistryDigest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *RegistryDigest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+func (m *RegistryDigest) GetFunctions() []*RegistryDigest_Function {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *RegistryDigest) GetChanges() []*RegistryDigest_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *RegistryDigest) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *RegistryDigest) GetToTxId() string {
+	if m != nil {
+		return m.ToTxId
+	}
+	return ""
+}
+
+type RegistryDigest_Function struct {
+	Function     string `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	Transactions uint32 `protobuf:"varint,2,opt,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *RegistryDigest_Function) Reset()                    { *m = RegistryDigest_Function{} }
+func (m *RegistryDigest_Function) String() string            { return proto.CompactTextString(m) }
+func (*RegistryDigest_Function) ProtoMessage()               {}
+func (*RegistryDigest_Function) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109, 0} }
+
+func (m *RegistryDigest_Function) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *RegistryDigest_Function) GetTransactions() uint32 {
+	if m != nil {
+		return m.Transactions
+	}
+	return 0
+}
+
+type RegistryDigest_Change struct {
+	KeyParts []string `protobuf:"bytes,1,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	// Set when the last change deleted the key.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted" json:"deleted,omitempty"`
+	// The number of transactions that changed the key.
+	Transactions uint32 `protobuf:"varint,3,opt,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *RegistryDigest_Change) Reset()                    { *m = RegistryDigest_Change{} }
+func (m *RegistryDigest_Change) String() string            { return proto.CompactTextString(m) }
+func (*RegistryDigest_Change) ProtoMessage()               {}
+func (*RegistryDigest_Change) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109, 1} }
+
+func (m *RegistryDigest_Change) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *RegistryDigest_Change) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *RegistryDigest_Change) GetTransactions() uint32 {
+	if m != nil {
+		return m.Transactions
+	}
+	return 0
+}
+
+// QueryFunctions lists the functions without side effects, in name order.
+type QueryFunctions struct {
+	Functions []string `protobuf:"bytes,1,rep,name=functions" json:"functions,omitempty"`
+}
+
+func (m *QueryFunctions) Reset()                    { *m = QueryFunctions{} }
+func (m *QueryFunctions) String() string            { return proto.CompactTextString(m) }
+func (*QueryFunctions) ProtoMessage()               {}
+func (*QueryFunctions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+func (m *QueryFunctions) GetFunctions() []string {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// RichQueryResult is a page of the results of a CouchDB selector query.
+type RichQueryResult struct {
+	Entries []*BulkGetResult_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	// Pass to the next call to get the following page.
+	Bookmark string `protobuf:"bytes,2,opt,name=bookmark" json:"bookmark,omitempty"`
+	// Fewer than page_size records fetched means there are no more results.
+	FetchedRecordsCount int32 `protobuf:"varint,3,opt,name=fetched_records_count,json=fetchedRecordsCount" json:"fetched_records_count,omitempty"`
+	// Set when the state database does not support selector queries (LevelDB), so the page
+	// was filtered from a scan of page_size keys and may hold fewer entries even if more follow.
+	Scanned bool `protobuf:"varint,4,opt,name=scanned" json:"scanned,omitempty"`
+}
+
+func (m *RichQueryResult) Reset()                    { *m = RichQueryResult{} }
+func (m *RichQueryResult) String() string            { return proto.CompactTextString(m) }
+func (*RichQueryResult) ProtoMessage()               {}
+func (*RichQueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
+
+func (m *RichQueryResult) GetEntries() []*BulkGetResult_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *RichQueryResult) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *RichQueryResult) GetFetchedRecordsCount() int32 {
+	if m != nil {
+		return m.FetchedRecordsCount
+	}
+	return 0
+}
+
+func (m *RichQueryResult) GetScanned() bool {
+	if m != nil {
+		return m.Scanned
+	}
+	return false
+}
+
+type Query struct {
+	ObjectType   Query_ObjectType `protobuf:"varint,1,opt,name=object_type,json=objectType,enum=main.Query_ObjectType" json:"object_type,omitempty"`
+	KeyParts     []string         `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Offset       uint32           `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	ReturnValues bool             `protobuf:"varint,4,opt,name=return_values,json=returnValues" json:"return_values,omitempty"`
+	MaxCount     uint32           `protobuf:"varint,5,opt,name=max_count,json=maxCount" json:"max_count,omitempty"`
+	// The results start after this composite key.
+	Bookmark string `protobuf:"bytes,6,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (m *Query) String() string            { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *Query) GetObjectType() Query_ObjectType {
+	if m != nil {
+		return m.ObjectType
+	}
+	return Query_APP_DESCRIPTOR
+}
+
+func (m *Query) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *Query) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Query) GetReturnValues() bool {
+	if m != nil {
+		return m.ReturnValues
+	}
+	return false
+}
+
+func (m *Query) GetMaxCount() uint32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *Query) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+type QueryResult struct {
+	Query *Query `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	// Set when the query limits truncated the results.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+	// In composite key order, the order bookmarks page through.
+	Results []*QueryResult_Entry `protobuf:"bytes,3,rep,name=results" json:"results,omitempty"`
+	// The composite key of the last result, to pass as the bookmark of the next query.
+	Bookmark string `protobuf:"bytes,4,opt,name=bookmark" json:"bookmark,omitempty"`
+	// Set when the results hold the whole collection, i.e. the query had no bookmark and the
+	// results were not truncated.
+	CollectionHash []byte `protobuf:"bytes,5,opt,name=collection_hash,json=collectionHash,proto3" json:"collection_hash,omitempty"`
+}
+
+func (m *QueryResult) Reset()                    { *m = QueryResult{} }
+func (m *QueryResult) String() string            { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()               {}
+func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+func (m *QueryResult) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *QueryResult) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *QueryResult) GetResults() []*QueryResult_Entry {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryResult) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+func (m *QueryResult) GetCollectionHash() []byte {
+	if m != nil {
+		return m.CollectionHash
+	}
+	return nil
+}
+
+// Entry has the wire format of the map entries results used to hold.
+type QueryResult_Entry struct {
+	// The last key part of the composite key.
+	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The content hash of the asset, set even when its value is not returned.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryResult_Entry) Reset()                    { *m = QueryResult_Entry{} }
+func (m *QueryResult_Entry) String() string            { return proto.CompactTextString(m) }
+func (*QueryResult_Entry) ProtoMessage()               {}
+func (*QueryResult_Entry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113, 0} }
+
+func (m *QueryResult_Entry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *QueryResult_Entry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryResult_Entry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+type DescriptorRequest struct {
+	AppDescriptorKey string `protobuf:"bytes,1,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+}
+
+func (m *DescriptorRequest) Reset()                    { *m = DescriptorRequest{} }
+func (m *DescriptorRequest) String() string            { return proto.CompactTextString(m) }
+func (*DescriptorRequest) ProtoMessage()               {}
+func (*DescriptorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+func (m *DescriptorRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+type AuctionRequest struct {
+	AuctionKey string `protobuf:"bytes,1,opt,name=auction_key,json=auctionKey" json:"auction_key,omitempty"`
+}
+
+func (m *AuctionRequest) Reset()                    { *m = AuctionRequest{} }
+func (m *AuctionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuctionRequest) ProtoMessage()               {}
+func (*AuctionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *AuctionRequest) GetAuctionKey() string {
+	if m != nil {
+		return m.AuctionKey
+	}
+	return ""
+}
+
+type OfferRequest struct {
+	OfferKey string `protobuf:"bytes,1,opt,name=offer_key,json=offerKey" json:"offer_key,omitempty"`
+}
+
+func (m *OfferRequest) Reset()                    { *m = OfferRequest{} }
+func (m *OfferRequest) String() string            { return proto.CompactTextString(m) }
+func (*OfferRequest) ProtoMessage()               {}
+func (*OfferRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
+
+func (m *OfferRequest) GetOfferKey() string {
+	if m != nil {
+		return m.OfferKey
+	}
+	return ""
+}
+
+type OpenAuctionRequest struct {
+	AuctionKey       string `protobuf:"bytes,1,opt,name=auction_key,json=auctionKey" json:"auction_key,omitempty"`
+	AppDescriptorKey string `protobuf:"bytes,2,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	BidSeconds       int64  `protobuf:"varint,3,opt,name=bid_seconds,json=bidSeconds" json:"bid_seconds,omitempty"`
+	RevealSeconds    int64  `protobuf:"varint,4,opt,name=reveal_seconds,json=revealSeconds" json:"reveal_seconds,omitempty"`
+}
+
+func (m *OpenAuctionRequest) Reset()                    { *m = OpenAuctionRequest{} }
+func (m *OpenAuctionRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenAuctionRequest) ProtoMessage()               {}
+func (*OpenAuctionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *OpenAuctionRequest) GetAuctionKey() string {
+	if m != nil {
+		return m.AuctionKey
+	}
+	return ""
+}
+
+func (m *OpenAuctionRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *OpenAuctionRequest) GetBidSeconds() int64 {
+	if m != nil {
+		return m.BidSeconds
+	}
+	return 0
+}
+
+func (m *OpenAuctionRequest) GetRevealSeconds() int64 {
+	if m != nil {
+		return m.RevealSeconds
+	}
+	return 0
+}
+
+type PlaceBidRequest struct {
+	AuctionKey string `protobuf:"bytes,1,opt,name=auction_key,json=auctionKey" json:"auction_key,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *PlaceBidRequest) Reset()                    { *m = PlaceBidRequest{} }
+func (m *PlaceBidRequest) String() string            { return proto.CompactTextString(m) }
+func (*PlaceBidRequest) ProtoMessage()               {}
+func (*PlaceBidRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+func (m *PlaceBidRequest) GetAuctionKey() string {
+	if m != nil {
+		return m.AuctionKey
+	}
+	return ""
+}
+
+func (m *PlaceBidRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+type RevealBidRequest struct {
+	AuctionKey string `protobuf:"bytes,1,opt,name=auction_key,json=auctionKey" json:"auction_key,omitempty"`
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	Salt       []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *RevealBidRequest) Reset()                    { *m = RevealBidRequest{} }
+func (m *RevealBidRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevealBidRequest) ProtoMessage()               {}
+func (*RevealBidRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *RevealBidRequest) GetAuctionKey() string {
+	if m != nil {
+		return m.AuctionKey
+	}
+	return ""
+}
+
+func (m *RevealBidRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RevealBidRequest) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+type GetLicenseRequest struct {
+	AppDescriptorKey string `protobuf:"bytes,1,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	// Optional, defaults to the caller.
+	LicenseeId string `protobuf:"bytes,2,opt,name=licensee_id,json=licenseeId" json:"licensee_id,omitempty"`
+}
+
+func (m *GetLicenseRequest) Reset()                    { *m = GetLicenseRequest{} }
+func (m *GetLicenseRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLicenseRequest) ProtoMessage()               {}
+func (*GetLicenseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *GetLicenseRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *GetLicenseRequest) GetLicenseeId() string {
+	if m != nil {
+		return m.LicenseeId
+	}
+	return ""
+}
+
+type MakeOfferRequest struct {
+	OfferKey         string `protobuf:"bytes,1,opt,name=offer_key,json=offerKey" json:"offer_key,omitempty"`
+	AppDescriptorKey string `protobuf:"bytes,2,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	Amount           uint64 `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Exclusive        bool   `protobuf:"varint,4,opt,name=exclusive" json:"exclusive,omitempty"`
+}
+
+func (m *MakeOfferRequest) Reset()                    { *m = MakeOfferRequest{} }
+func (m *MakeOfferRequest) String() string            { return proto.CompactTextString(m) }
+func (*MakeOfferRequest) ProtoMessage()               {}
+func (*MakeOfferRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
+
+func (m *MakeOfferRequest) GetOfferKey() string {
+	if m != nil {
+		return m.OfferKey
+	}
+	return ""
+}
+
+func (m *MakeOfferRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *MakeOfferRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MakeOfferRequest) GetExclusive() bool {
+	if m != nil {
+		return m.Exclusive
+	}
+	return false
+}
+
+type CounterOfferRequest struct {
+	OfferKey  string `protobuf:"bytes,1,opt,name=offer_key,json=offerKey" json:"offer_key,omitempty"`
+	Amount    uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	Exclusive bool   `protobuf:"varint,3,opt,name=exclusive" json:"exclusive,omitempty"`
+}
+
+func (m *CounterOfferRequest) Reset()                    { *m = CounterOfferRequest{} }
+func (m *CounterOfferRequest) String() string            { return proto.CompactTextString(m) }
+func (*CounterOfferRequest) ProtoMessage()               {}
+func (*CounterOfferRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+func (m *CounterOfferRequest) GetOfferKey() string {
+	if m != nil {
+		return m.OfferKey
+	}
+	return ""
+}
+
+func (m *CounterOfferRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CounterOfferRequest) GetExclusive() bool {
+	if m != nil {
+		return m.Exclusive
+	}
+	return false
+}
+
+type SetPricingTiersRequest struct {
+	AppDescriptorKey string        `protobuf:"bytes,1,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	PricingTiers     *PricingTiers `protobuf:"bytes,2,opt,name=pricing_tiers,json=pricingTiers" json:"pricing_tiers,omitempty"`
+}
+
+func (m *SetPricingTiersRequest) Reset()                    { *m = SetPricingTiersRequest{} }
+func (m *SetPricingTiersRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPricingTiersRequest) ProtoMessage()               {}
+func (*SetPricingTiersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+func (m *SetPricingTiersRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *SetPricingTiersRequest) GetPricingTiers() *PricingTiers {
+	if m != nil {
+		return m.PricingTiers
+	}
+	return nil
+}
+
+type SetFeaturedRequest struct {
+	AppDescriptorKey string `protobuf:"bytes,1,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	Rank             uint32 `protobuf:"varint,2,opt,name=rank" json:"rank,omitempty"`
+}
+
+func (m *SetFeaturedRequest) Reset()                    { *m = SetFeaturedRequest{} }
+func (m *SetFeaturedRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetFeaturedRequest) ProtoMessage()               {}
+func (*SetFeaturedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
+
+func (m *SetFeaturedRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *SetFeaturedRequest) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+type ReportActivityRequest struct {
+	AppDescriptorKey string `protobuf:"bytes,1,opt,name=app_descriptor_key,json=appDescriptorKey" json:"app_descriptor_key,omitempty"`
+	// Optional, defaults to VIEW.
+	Kind ActivityReport_Kind `protobuf:"varint,2,opt,name=kind,enum=main.ActivityReport_Kind" json:"kind,omitempty"`
+}
+
+func (m *ReportActivityRequest) Reset()                    { *m = ReportActivityRequest{} }
+func (m *ReportActivityRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReportActivityRequest) ProtoMessage()               {}
+func (*ReportActivityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *ReportActivityRequest) GetAppDescriptorKey() string {
+	if m != nil {
+		return m.AppDescriptorKey
+	}
+	return ""
+}
+
+func (m *ReportActivityRequest) GetKind() ActivityReport_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return ActivityReport_VIEW
+}
+
+type GetTrendingDescriptorsRequest struct {
+	WindowHours uint32 `protobuf:"varint,1,opt,name=window_hours,json=windowHours" json:"window_hours,omitempty"`
+	// Optional, zero for the default.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *GetTrendingDescriptorsRequest) Reset()         { *m = GetTrendingDescriptorsRequest{} }
+func (m *GetTrendingDescriptorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrendingDescriptorsRequest) ProtoMessage()    {}
+func (*GetTrendingDescriptorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{127}
+}
+
+func (m *GetTrendingDescriptorsRequest) GetWindowHours() uint32 {
+	if m != nil {
+		return m.WindowHours
+	}
+	return 0
+}
+
+func (m *GetTrendingDescriptorsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AcquireLockRequest struct {
+	Resource   string `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
+	TtlSeconds int64  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds" json:"ttl_seconds,omitempty"`
+}
+
+func (m *AcquireLockRequest) Reset()                    { *m = AcquireLockRequest{} }
+func (m *AcquireLockRequest) String() string            { return proto.CompactTextString(m) }
+func (*AcquireLockRequest) ProtoMessage()               {}
+func (*AcquireLockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *AcquireLockRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *AcquireLockRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+type LockRequest struct {
+	Resource string `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
+}
+
+func (m *LockRequest) Reset()                    { *m = LockRequest{} }
+func (m *LockRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()               {}
+func (*LockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+func (m *LockRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+type RecordConsumerCheckpointRequest struct {
+	ConsumerId  string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	TxId        string `protobuf:"bytes,3,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+}
+
+func (m *RecordConsumerCheckpointRequest) Reset()         { *m = RecordConsumerCheckpointRequest{} }
+func (m *RecordConsumerCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordConsumerCheckpointRequest) ProtoMessage()    {}
+func (*RecordConsumerCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{130}
+}
+
+func (m *RecordConsumerCheckpointRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *RecordConsumerCheckpointRequest) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *RecordConsumerCheckpointRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type ConsumerCheckpointRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+}
+
+func (m *ConsumerCheckpointRequest) Reset()                    { *m = ConsumerCheckpointRequest{} }
+func (m *ConsumerCheckpointRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConsumerCheckpointRequest) ProtoMessage()               {}
+func (*ConsumerCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+func (m *ConsumerCheckpointRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type CreatePrivateAppBundleRequest struct {
+	Collection   string `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	AppBundleKey string `protobuf:"bytes,2,opt,name=app_bundle_key,json=appBundleKey" json:"app_bundle_key,omitempty"`
+	// Transient, so the AppBundle is not recorded in the transaction.
+	AppBundle *AppBundle `protobuf:"bytes,3,opt,name=app_bundle,json=appBundle" json:"app_bundle,omitempty"`
+}
+
+func (m *CreatePrivateAppBundleRequest) Reset()         { *m = CreatePrivateAppBundleRequest{} }
+func (m *CreatePrivateAppBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePrivateAppBundleRequest) ProtoMessage()    {}
+func (*CreatePrivateAppBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{132}
+}
+
+func (m *CreatePrivateAppBundleRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *CreatePrivateAppBundleRequest) GetAppBundleKey() string {
+	if m != nil {
+		return m.AppBundleKey
+	}
+	return ""
+}
+
+func (m *CreatePrivateAppBundleRequest) GetAppBundle() *AppBundle {
+	if m != nil {
+		return m.AppBundle
+	}
+	return nil
+}
+
+type CreateConfidentialAppBundleRequest struct {
+	AppBundleKey string `protobuf:"bytes,1,opt,name=app_bundle_key,json=appBundleKey" json:"app_bundle_key,omitempty"`
+	// Transient, so the AppBundle is not recorded in the transaction.
+	AppBundle *AppBundle `protobuf:"bytes,2,opt,name=app_bundle,json=appBundle" json:"app_bundle,omitempty"`
+}
+
+func (m *CreateConfidentialAppBundleRequest) Reset()         { *m = CreateConfidentialAppBundleRequest{} }
+func (m *CreateConfidentialAppBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateConfidentialAppBundleRequest) ProtoMessage()    {}
+func (*CreateConfidentialAppBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{133}
+}
+
+func (m *CreateConfidentialAppBundleRequest) GetAppBundleKey() string {
+	if m != nil {
+		return m.AppBundleKey
+	}
+	return ""
+}
+
+func (m *CreateConfidentialAppBundleRequest) GetAppBundle() *AppBundle {
+	if m != nil {
+		return m.AppBundle
+	}
+	return nil
+}
+
+type AnnotateAssetRequest struct {
+	ObjectType     string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts       []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	AnnotationType string   `protobuf:"bytes,3,opt,name=annotation_type,json=annotationType" json:"annotation_type,omitempty"`
+	// SHA-256 digest of the annotation's payload.
+	PayloadHash []byte `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash" json:"payload_hash,omitempty"`
+}
+
+func (m *AnnotateAssetRequest) Reset()                    { *m = AnnotateAssetRequest{} }
+func (m *AnnotateAssetRequest) String() string            { return proto.CompactTextString(m) }
+func (*AnnotateAssetRequest) ProtoMessage()               {}
+func (*AnnotateAssetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+func (m *AnnotateAssetRequest) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *AnnotateAssetRequest) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *AnnotateAssetRequest) GetAnnotationType() string {
+	if m != nil {
+		return m.AnnotationType
+	}
+	return ""
+}
+
+func (m *AnnotateAssetRequest) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+type AnnotationsRequest struct {
+	ObjectType string   `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts   []string `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	Bookmark   string   `protobuf:"bytes,3,opt,name=bookmark" json:"bookmark,omitempty"`
+}
+
+func (m *AnnotationsRequest) Reset()                    { *m = AnnotationsRequest{} }
+func (m *AnnotationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AnnotationsRequest) ProtoMessage()               {}
+func (*AnnotationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *AnnotationsRequest) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *AnnotationsRequest) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *AnnotationsRequest) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
+type ModerateAnnotationRequest struct {
+	ObjectType     string            `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	KeyParts       []string          `protobuf:"bytes,2,rep,name=key_parts,json=keyParts" json:"key_parts,omitempty"`
+	AnnotationType string            `protobuf:"bytes,3,opt,name=annotation_type,json=annotationType" json:"annotation_type,omitempty"`
+	AnnotatorId    string            `protobuf:"bytes,4,opt,name=annotator_id,json=annotatorId" json:"annotator_id,omitempty"`
+	Status         Annotation_Status `protobuf:"varint,5,opt,name=status,enum=main.Annotation_Status" json:"status,omitempty"`
+}
+
+func (m *ModerateAnnotationRequest) Reset()                    { *m = ModerateAnnotationRequest{} }
+func (m *ModerateAnnotationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ModerateAnnotationRequest) ProtoMessage()               {}
+func (*ModerateAnnotationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+func (m *ModerateAnnotationRequest) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *ModerateAnnotationRequest) GetKeyParts() []string {
+	if m != nil {
+		return m.KeyParts
+	}
+	return nil
+}
+
+func (m *ModerateAnnotationRequest) GetAnnotationType() string {
+	if m != nil {
+		return m.AnnotationType
+	}
+	return ""
+}
+
+func (m *ModerateAnnotationRequest) GetAnnotatorId() string {
+	if m != nil {
+		return m.AnnotatorId
+	}
+	return ""
+}
+
+func (m *ModerateAnnotationRequest) GetStatus() Annotation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Annotation_VISIBLE
+}
+
 func init() {
 	proto.RegisterType((*AppBundle)(nil), "main.AppBundle")
+	proto.RegisterType((*Platform)(nil), "main.Platform")
+	proto.RegisterType((*ChaincodePackage)(nil), "main.ChaincodePackage")
 	proto.RegisterType((*AppBundleKeySet)(nil), "main.AppBundleKeySet")
 	proto.RegisterType((*AppDescriptor)(nil), "main.AppDescriptor")
+	proto.RegisterType((*DescriptorSnapshot)(nil), "main.DescriptorSnapshot")
+	proto.RegisterType((*SupportInfo)(nil), "main.SupportInfo")
+	proto.RegisterType((*RoyaltySplit)(nil), "main.RoyaltySplit")
+	proto.RegisterType((*RoyaltySplits)(nil), "main.RoyaltySplits")
+	proto.RegisterType((*PricingTier)(nil), "main.PricingTier")
+	proto.RegisterType((*PricingTiers)(nil), "main.PricingTiers")
+	proto.RegisterType((*FieldCommitment)(nil), "main.FieldCommitment")
+	proto.RegisterType((*FieldCommitments)(nil), "main.FieldCommitments")
+	proto.RegisterType((*VerificationResult)(nil), "main.VerificationResult")
+	proto.RegisterType((*DescriptorPrivateDetails)(nil), "main.DescriptorPrivateDetails")
 	proto.RegisterType((*AppDescriptors)(nil), "main.AppDescriptors")
+	proto.RegisterType((*AppDescriptors_Entry)(nil), "main.AppDescriptors.Entry")
+	proto.RegisterType((*Collection)(nil), "main.Collection")
+	proto.RegisterType((*Pin)(nil), "main.Pin")
+	proto.RegisterType((*Watch)(nil), "main.Watch")
+	proto.RegisterType((*Reservation)(nil), "main.Reservation")
+	proto.RegisterType((*Lock)(nil), "main.Lock")
+	proto.RegisterType((*AccessRequest)(nil), "main.AccessRequest")
+	proto.RegisterType((*Permission)(nil), "main.Permission")
+	proto.RegisterType((*Promotion)(nil), "main.Promotion")
+	proto.RegisterType((*Rollout)(nil), "main.Rollout")
+	proto.RegisterType((*Rollout_Stage)(nil), "main.Rollout.Stage")
+	proto.RegisterType((*Rollout_Update)(nil), "main.Rollout.Update")
+	proto.RegisterType((*AssetEnvelope)(nil), "main.AssetEnvelope")
+	proto.RegisterType((*SignedAssetEnvelope)(nil), "main.SignedAssetEnvelope")
+	proto.RegisterType((*CollectionHash)(nil), "main.CollectionHash")
+	proto.RegisterType((*RegistryChecksum)(nil), "main.RegistryChecksum")
+	proto.RegisterType((*KeyList)(nil), "main.KeyList")
+	proto.RegisterType((*BundleKey)(nil), "main.BundleKey")
+	proto.RegisterType((*BundleKeyList)(nil), "main.BundleKeyList")
+	proto.RegisterType((*BulkGetResult)(nil), "main.BulkGetResult")
+	proto.RegisterType((*BulkGetResult_Entry)(nil), "main.BulkGetResult.Entry")
+	proto.RegisterType((*BundleSummary)(nil), "main.BundleSummary")
+	proto.RegisterType((*SignatureLink)(nil), "main.SignatureLink")
+	proto.RegisterType((*SignatureChain)(nil), "main.SignatureChain")
+	proto.RegisterType((*DescriptorWithBundles)(nil), "main.DescriptorWithBundles")
+	proto.RegisterType((*AssociationResult)(nil), "main.AssociationResult")
+	proto.RegisterType((*AssociationResult_Entry)(nil), "main.AssociationResult.Entry")
+	proto.RegisterType((*ExistsResult)(nil), "main.ExistsResult")
+	proto.RegisterType((*StateWrite)(nil), "main.StateWrite")
+	proto.RegisterType((*DryRunResult)(nil), "main.DryRunResult")
+	proto.RegisterType((*ScriptOperation)(nil), "main.ScriptOperation")
+	proto.RegisterType((*Script)(nil), "main.Script")
+	proto.RegisterType((*ScriptResult)(nil), "main.ScriptResult")
+	proto.RegisterType((*StateRead)(nil), "main.StateRead")
+	proto.RegisterType((*SimulationResult)(nil), "main.SimulationResult")
+	proto.RegisterType((*DemoDataset)(nil), "main.DemoDataset")
+	proto.RegisterType((*Precondition)(nil), "main.Precondition")
+	proto.RegisterType((*Preconditions)(nil), "main.Preconditions")
+	proto.RegisterType((*RateLimit)(nil), "main.RateLimit")
+	proto.RegisterType((*RegistryConfig)(nil), "main.RegistryConfig")
+	proto.RegisterType((*RegistryConfig_NamespaceAdmins)(nil), "main.RegistryConfig.NamespaceAdmins")
+	proto.RegisterType((*RegistryConfig_TrustRoots)(nil), "main.RegistryConfig.TrustRoots")
+	proto.RegisterType((*BootstrapConfig)(nil), "main.BootstrapConfig")
+	proto.RegisterType((*ConfigHistory)(nil), "main.ConfigHistory")
+	proto.RegisterType((*ConfigHistory_Entry)(nil), "main.ConfigHistory.Entry")
+	proto.RegisterType((*FeatureFlags)(nil), "main.FeatureFlags")
+	proto.RegisterType((*ScanPolicy)(nil), "main.ScanPolicy")
+	proto.RegisterType((*ScanPolicy_Scanner)(nil), "main.ScanPolicy.Scanner")
+	proto.RegisterType((*TokenChaincode)(nil), "main.TokenChaincode")
+	proto.RegisterType((*TokenPayment)(nil), "main.TokenPayment")
+	proto.RegisterType((*QueryLimits)(nil), "main.QueryLimits")
+	proto.RegisterType((*RateCounter)(nil), "main.RateCounter")
+	proto.RegisterType((*FunctionCounter)(nil), "main.FunctionCounter")
+	proto.RegisterType((*FunctionStats)(nil), "main.FunctionStats")
+	proto.RegisterType((*FunctionStats_Function)(nil), "main.FunctionStats.Function")
+	proto.RegisterType((*MigrationState)(nil), "main.MigrationState")
+	proto.RegisterType((*BackfillResult)(nil), "main.BackfillResult")
+	proto.RegisterType((*IntegrityReport)(nil), "main.IntegrityReport")
+	proto.RegisterType((*IntegrityReport_Violation)(nil), "main.IntegrityReport.Violation")
+	proto.RegisterType((*BundleIntegrityReport)(nil), "main.BundleIntegrityReport")
+	proto.RegisterType((*ColdCopies)(nil), "main.ColdCopies")
+	proto.RegisterType((*ColdCopies_Copy)(nil), "main.ColdCopies.Copy")
+	proto.RegisterType((*OwnershipChallenge)(nil), "main.OwnershipChallenge")
+	proto.RegisterType((*OwnershipProof)(nil), "main.OwnershipProof")
+	proto.RegisterType((*BundleGates)(nil), "main.BundleGates")
+	proto.RegisterType((*BundleGates_Hold)(nil), "main.BundleGates.Hold")
+	proto.RegisterType((*GateReport)(nil), "main.GateReport")
+	proto.RegisterType((*GateReport_Gate)(nil), "main.GateReport.Gate")
+	proto.RegisterType((*ReadinessReport)(nil), "main.ReadinessReport")
+	proto.RegisterType((*ReadinessReport_Blocker)(nil), "main.ReadinessReport.Blocker")
+	proto.RegisterType((*ResponseWarning)(nil), "main.ResponseWarning")
+	proto.RegisterType((*ResponseMetadata)(nil), "main.ResponseMetadata")
+	proto.RegisterType((*ConsumerCheckpoint)(nil), "main.ConsumerCheckpoint")
+	proto.RegisterType((*ArtifactChunk)(nil), "main.ArtifactChunk")
+	proto.RegisterType((*RepairRecord)(nil), "main.RepairRecord")
+	proto.RegisterType((*OwnershipReassignment)(nil), "main.OwnershipReassignment")
+	proto.RegisterType((*Alias)(nil), "main.Alias")
+	proto.RegisterType((*ComplianceAttestation)(nil), "main.ComplianceAttestation")
+	proto.RegisterType((*ScanResult)(nil), "main.ScanResult")
+	proto.RegisterType((*Sbom)(nil), "main.Sbom")
+	proto.RegisterType((*SbomComponent)(nil), "main.SbomComponent")
+	proto.RegisterType((*ComponentUsage)(nil), "main.ComponentUsage")
+	proto.RegisterType((*ComponentUsage_Entry)(nil), "main.ComponentUsage.Entry")
+	proto.RegisterType((*ArtifactLicenseException)(nil), "main.ArtifactLicenseException")
+	proto.RegisterType((*PolicyRule)(nil), "main.PolicyRule")
+	proto.RegisterType((*PolicyRule_Predicate)(nil), "main.PolicyRule.Predicate")
+	proto.RegisterType((*PolicyRules)(nil), "main.PolicyRules")
+	proto.RegisterType((*ComplianceAttestations)(nil), "main.ComplianceAttestations")
+	proto.RegisterType((*Annotation)(nil), "main.Annotation")
+	proto.RegisterType((*Annotations)(nil), "main.Annotations")
+	proto.RegisterType((*PrivateBundleRecord)(nil), "main.PrivateBundleRecord")
+	proto.RegisterType((*Auction)(nil), "main.Auction")
+	proto.RegisterType((*Bid)(nil), "main.Bid")
+	proto.RegisterType((*License)(nil), "main.License")
+	proto.RegisterType((*Offer)(nil), "main.Offer")
+	proto.RegisterType((*UsageRecord)(nil), "main.UsageRecord")
+	proto.RegisterType((*Invoice)(nil), "main.Invoice")
+	proto.RegisterType((*Invoice_Line)(nil), "main.Invoice.Line")
+	proto.RegisterType((*RoyaltyShare)(nil), "main.RoyaltyShare")
+	proto.RegisterType((*RoyaltyEntry)(nil), "main.RoyaltyEntry")
+	proto.RegisterType((*RoyaltyStatement)(nil), "main.RoyaltyStatement")
+	proto.RegisterType((*RoyaltyStatement_Total)(nil), "main.RoyaltyStatement.Total")
+	proto.RegisterType((*InvoiceGenerationResult)(nil), "main.InvoiceGenerationResult")
+	proto.RegisterType((*SettlementRecord)(nil), "main.SettlementRecord")
+	proto.RegisterType((*Featured)(nil), "main.Featured")
+	proto.RegisterType((*FeaturedDescriptors)(nil), "main.FeaturedDescriptors")
+	proto.RegisterType((*FeaturedDescriptors_Entry)(nil), "main.FeaturedDescriptors.Entry")
+	proto.RegisterType((*ActivityReport)(nil), "main.ActivityReport")
+	proto.RegisterType((*TrendingDescriptors)(nil), "main.TrendingDescriptors")
+	proto.RegisterType((*TrendingDescriptors_Entry)(nil), "main.TrendingDescriptors.Entry")
+	proto.RegisterType((*DescriptorRollup)(nil), "main.DescriptorRollup")
+	proto.RegisterType((*DescriptorRollup_TierUsage)(nil), "main.DescriptorRollup.TierUsage")
+	proto.RegisterType((*RollupProgress)(nil), "main.RollupProgress")
+	proto.RegisterType((*RegistryEvent)(nil), "main.RegistryEvent")
+	proto.RegisterType((*RegistryEvent_Change)(nil), "main.RegistryEvent.Change")
+	proto.RegisterType((*EventJournalEntry)(nil), "main.EventJournalEntry")
+	proto.RegisterType((*RegistryDigest)(nil), "main.RegistryDigest")
+	proto.RegisterType((*RegistryDigest_Function)(nil), "main.RegistryDigest.Function")
+	proto.RegisterType((*RegistryDigest_Change)(nil), "main.RegistryDigest.Change")
+	proto.RegisterType((*QueryFunctions)(nil), "main.QueryFunctions")
+	proto.RegisterType((*RichQueryResult)(nil), "main.RichQueryResult")
 	proto.RegisterType((*Query)(nil), "main.Query")
 	proto.RegisterType((*QueryResult)(nil), "main.QueryResult")
+	proto.RegisterType((*QueryResult_Entry)(nil), "main.QueryResult.Entry")
+	proto.RegisterType((*Empty)(nil), "main.Empty")
+	proto.RegisterType((*DescriptorRequest)(nil), "main.DescriptorRequest")
+	proto.RegisterType((*AuctionRequest)(nil), "main.AuctionRequest")
+	proto.RegisterType((*OfferRequest)(nil), "main.OfferRequest")
+	proto.RegisterType((*OpenAuctionRequest)(nil), "main.OpenAuctionRequest")
+	proto.RegisterType((*PlaceBidRequest)(nil), "main.PlaceBidRequest")
+	proto.RegisterType((*RevealBidRequest)(nil), "main.RevealBidRequest")
+	proto.RegisterType((*GetLicenseRequest)(nil), "main.GetLicenseRequest")
+	proto.RegisterType((*MakeOfferRequest)(nil), "main.MakeOfferRequest")
+	proto.RegisterType((*CounterOfferRequest)(nil), "main.CounterOfferRequest")
+	proto.RegisterType((*SetPricingTiersRequest)(nil), "main.SetPricingTiersRequest")
+	proto.RegisterType((*SetFeaturedRequest)(nil), "main.SetFeaturedRequest")
+	proto.RegisterType((*ReportActivityRequest)(nil), "main.ReportActivityRequest")
+	proto.RegisterType((*GetTrendingDescriptorsRequest)(nil), "main.GetTrendingDescriptorsRequest")
+	proto.RegisterType((*AcquireLockRequest)(nil), "main.AcquireLockRequest")
+	proto.RegisterType((*LockRequest)(nil), "main.LockRequest")
+	proto.RegisterType((*RecordConsumerCheckpointRequest)(nil), "main.RecordConsumerCheckpointRequest")
+	proto.RegisterType((*ConsumerCheckpointRequest)(nil), "main.ConsumerCheckpointRequest")
+	proto.RegisterType((*CreatePrivateAppBundleRequest)(nil), "main.CreatePrivateAppBundleRequest")
+	proto.RegisterType((*CreateConfidentialAppBundleRequest)(nil), "main.CreateConfidentialAppBundleRequest")
+	proto.RegisterType((*AnnotateAssetRequest)(nil), "main.AnnotateAssetRequest")
+	proto.RegisterType((*AnnotationsRequest)(nil), "main.AnnotationsRequest")
+	proto.RegisterType((*ModerateAnnotationRequest)(nil), "main.ModerateAnnotationRequest")
+	proto.RegisterEnum("main.ValidationProfile", ValidationProfile_name, ValidationProfile_value)
+	proto.RegisterEnum("main.AppBundle_RetentionTier", AppBundle_RetentionTier_name, AppBundle_RetentionTier_value)
+	proto.RegisterEnum("main.Platform_Architecture", Platform_Architecture_name, Platform_Architecture_value)
+	proto.RegisterEnum("main.ChaincodePackage_Language", ChaincodePackage_Language_name, ChaincodePackage_Language_value)
+	proto.RegisterEnum("main.SupportInfo_SlaTier", SupportInfo_SlaTier_name, SupportInfo_SlaTier_value)
+	proto.RegisterEnum("main.AccessRequest_Status", AccessRequest_Status_name, AccessRequest_Status_value)
+	proto.RegisterEnum("main.Promotion_Environment", Promotion_Environment_name, Promotion_Environment_value)
+	proto.RegisterEnum("main.Rollout_Status", Rollout_Status_name, Rollout_Status_value)
+	proto.RegisterEnum("main.RegistryConfig_PauseMode", RegistryConfig_PauseMode_name, RegistryConfig_PauseMode_value)
+	proto.RegisterEnum("main.RegistryConfig_StorageEncoding", RegistryConfig_StorageEncoding_name, RegistryConfig_StorageEncoding_value)
+	proto.RegisterEnum("main.ScanResult_Verdict", ScanResult_Verdict_name, ScanResult_Verdict_value)
+	proto.RegisterEnum("main.Sbom_Format", Sbom_Format_name, Sbom_Format_value)
+	proto.RegisterEnum("main.PolicyRule_Predicate_Op", PolicyRule_Predicate_Op_name, PolicyRule_Predicate_Op_value)
+	proto.RegisterEnum("main.Annotation_Status", Annotation_Status_name, Annotation_Status_value)
+	proto.RegisterEnum("main.Auction_Status", Auction_Status_name, Auction_Status_value)
+	proto.RegisterEnum("main.Offer_Status", Offer_Status_name, Offer_Status_value)
+	proto.RegisterEnum("main.Offer_Party", Offer_Party_name, Offer_Party_value)
+	proto.RegisterEnum("main.Invoice_Status", Invoice_Status_name, Invoice_Status_value)
+	proto.RegisterEnum("main.ActivityReport_Kind", ActivityReport_Kind_name, ActivityReport_Kind_value)
 	proto.RegisterEnum("main.Query_ObjectType", Query_ObjectType_name, Query_ObjectType_value)
 }
 
 func init() { proto.RegisterFile("app.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
-	0x10, 0xfd, 0xdc, 0xd4, 0xad, 0x3d, 0x76, 0xf3, 0x85, 0xa5, 0xaa, 0x4c, 0x41, 0x10, 0x8c, 0x90,
-	0xc2, 0x05, 0x16, 0x0a, 0x17, 0x54, 0x15, 0x37, 0x6d, 0x13, 0xa1, 0x8a, 0x9f, 0x86, 0x4d, 0x81,
-	0x4b, 0xcb, 0xb1, 0xa7, 0xaa, 0x49, 0xe2, 0x5d, 0x76, 0xd7, 0x50, 0xbf, 0x14, 0xef, 0xc0, 0x23,
-	0xf0, 0x18, 0xbc, 0x05, 0xda, 0x75, 0x7e, 0x5c, 0x28, 0x57, 0xd9, 0x99, 0x73, 0xce, 0xec, 0xce,
-	0x39, 0x91, 0xc1, 0x4d, 0x38, 0x8f, 0xb8, 0x60, 0x8a, 0x91, 0xcd, 0x79, 0x92, 0x17, 0xe1, 0x4f,
-	0x0b, 0xdc, 0x23, 0xce, 0x8f, 0xcb, 0x22, 0x9b, 0x21, 0xd9, 0x05, 0x9b, 0x7d, 0x2b, 0x50, 0x04,
-	0x56, 0xd7, 0xea, 0xf9, 0xb4, 0x2e, 0xc8, 0x23, 0xd8, 0xc9, 0x50, 0xa6, 0x22, 0xe7, 0x8a, 0x89,
-	0x38, 0xcf, 0x82, 0x8d, 0xae, 0xd5, 0x73, 0xa9, 0xbf, 0x6e, 0x9e, 0x66, 0xe4, 0x1e, 0xb8, 0x89,
-	0x50, 0xf9, 0x45, 0x92, 0x2a, 0x19, 0xb4, 0xba, 0xad, 0x9e, 0x4f, 0xd7, 0x0d, 0xf2, 0x12, 0xf6,
-	0xd3, 0xcb, 0x24, 0x2f, 0x52, 0x96, 0x61, 0x9c, 0x21, 0x9f, 0xb1, 0x6a, 0x8e, 0x85, 0x8a, 0x25,
-	0xc7, 0x54, 0x06, 0x9b, 0x86, 0x1e, 0xac, 0x18, 0x83, 0x15, 0x61, 0xac, 0x71, 0xf2, 0x14, 0x88,
-	0x79, 0x49, 0x8c, 0x45, 0xc6, 0x84, 0x44, 0x8d, 0xc8, 0xc0, 0x36, 0xaa, 0x5b, 0x06, 0x19, 0x36,
-	0x80, 0xf0, 0x13, 0xfc, 0xbf, 0x5a, 0xe9, 0x35, 0x56, 0x63, 0x54, 0x7f, 0xaf, 0x60, 0xdd, 0xb0,
-	0xc2, 0x03, 0xf0, 0x26, 0x46, 0x14, 0x4f, 0xb1, 0x92, 0xc1, 0x46, 0xb7, 0xd5, 0x73, 0x29, 0x4c,
-	0x96, 0x73, 0x64, 0x98, 0xc1, 0xce, 0x11, 0xe7, 0x83, 0x95, 0xe6, 0x1f, 0x7e, 0x75, 0xc1, 0x5b,
-	0xce, 0xcd, 0x59, 0xb1, 0x70, 0xab, 0xd9, 0x22, 0x77, 0xc1, 0x5d, 0xdc, 0x94, 0x67, 0x41, 0xcb,
-	0xe0, 0x4e, 0xdd, 0x38, 0xcd, 0xc2, 0xef, 0x16, 0xb4, 0xaf, 0x5d, 0x23, 0xc9, 0xab, 0xf5, 0x44,
-	0x26, 0x6a, 0x7b, 0xbd, 0xfe, 0xe3, 0x48, 0x27, 0x18, 0x5d, 0xa7, 0x46, 0x8d, 0xf3, 0xb0, 0x50,
-	0xa2, 0xa2, 0x4d, 0xe5, 0xfe, 0x18, 0x3a, 0x7f, 0x12, 0x48, 0x07, 0x5a, 0x53, 0xac, 0x16, 0x8e,
-	0xe8, 0x23, 0x79, 0x02, 0xf6, 0xd7, 0x64, 0x56, 0xa2, 0x79, 0xba, 0xd7, 0xbf, 0x7d, 0xc3, 0x45,
-	0xb4, 0x66, 0x1c, 0x6e, 0x1c, 0x58, 0xe1, 0x2f, 0x0b, 0xec, 0xf7, 0x25, 0x8a, 0x8a, 0xbc, 0x00,
-	0x8f, 0x4d, 0x3e, 0x63, 0xaa, 0x62, 0x55, 0x71, 0x34, 0x23, 0xdb, 0xfd, 0xbd, 0x5a, 0x6e, 0x18,
-	0xd1, 0x99, 0x81, 0xcf, 0x2b, 0x8e, 0x14, 0xd8, 0xea, 0xac, 0x0d, 0x99, 0x62, 0x15, 0xf3, 0x44,
-	0xa8, 0xa5, 0xf1, 0xce, 0x14, 0xab, 0x91, 0xae, 0xc9, 0x1e, 0x6c, 0xb1, 0x8b, 0x0b, 0x89, 0xca,
-	0x58, 0xb5, 0x43, 0x17, 0x95, 0x0e, 0x55, 0xa0, 0x2a, 0x45, 0x11, 0x9b, 0xb7, 0xe8, 0xff, 0x91,
-	0xd5, 0x73, 0xa8, 0x5f, 0x37, 0x3f, 0x9a, 0x9e, 0x9e, 0x3c, 0x4f, 0xae, 0xe2, 0x94, 0x95, 0x85,
-	0x0a, 0x6c, 0xa3, 0x77, 0xe6, 0xc9, 0xd5, 0x89, 0xae, 0xc3, 0x67, 0x00, 0xeb, 0x07, 0x11, 0x02,
-	0xed, 0xa3, 0xd1, 0x28, 0x1e, 0x0c, 0xc7, 0x27, 0xf4, 0x74, 0x74, 0x7e, 0x46, 0x3b, 0xff, 0x91,
-	0x36, 0x80, 0xee, 0x1d, 0x7f, 0x78, 0x37, 0x78, 0x33, 0xec, 0x58, 0xe1, 0x0f, 0x0b, 0x3c, 0xb3,
-	0x09, 0x45, 0x59, 0xce, 0x14, 0x79, 0x08, 0xf6, 0x17, 0x5d, 0x9a, 0x5d, 0xbd, 0xbe, 0xd7, 0xd8,
-	0x95, 0xd6, 0x08, 0xb9, 0x03, 0xce, 0x65, 0x22, 0xe3, 0x39, 0x13, 0xb5, 0xa1, 0x0e, 0xdd, 0xbe,
-	0x4c, 0xe4, 0x5b, 0x26, 0x90, 0x1c, 0xc0, 0xb6, 0x30, 0x73, 0x96, 0x99, 0xde, 0x6f, 0xea, 0x0d,
-	0x12, 0xd5, 0x3f, 0x8b, 0x30, 0x97, 0xf4, 0xfd, 0x43, 0xf0, 0x9b, 0xc0, 0x0d, 0x21, 0xee, 0x36,
-	0x43, 0xf4, 0x1b, 0x79, 0x4d, 0xb6, 0xcc, 0x07, 0xe0, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0x34, 0x6a, 0xa3, 0x0d, 0x04, 0x00, 0x00,
+	// 9371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0xbd, 0x5d, 0x8c, 0x24, 0x49,
+	0x92, 0x10, 0xbc, 0x91, 0xff, 0x69, 0xf9, 0x53, 0xd9, 0xd1, 0xdd, 0xd5, 0xd9, 0x39, 0xd3, 0x33,
+	0x3d, 0x31, 0x33, 0xbb, 0x3d, 0x3b, 0x3d, 0xb5, 0xbb, 0x3d, 0xbd, 0xb3, 0x37, 0xb3, 0xb7, 0xdf,
+	0x7e, 0x51, 0x59, 0x59, 0xd5, 0x39, 0x93, 0x95, 0x99, 0x1b, 0x99, 0xd5, 0x3d, 0xab, 0x13, 0x17,
+	0x17, 0x95, 0xe9, 0x55, 0x15, 0x5b, 0x99, 0x11, 0x31, 0x11, 0x91, 0xdd, 0x5d, 0xcb, 0x9d, 0x38,
+	0x04, 0x3a, 0xc1, 0x21, 0x21, 0xa4, 0x83, 0x83, 0xbb, 0x17, 0x7e, 0x24, 0x10, 0x3f, 0x02, 0xc1,
+	0x03, 0x42, 0x88, 0x9f, 0x05, 0xc4, 0x13, 0x82, 0x97, 0x43, 0x42, 0x48, 0xdc, 0x03, 0x12, 0x3a,
+	0x10, 0x0f, 0x88, 0xdf, 0x07, 0xc4, 0x0b, 0xc8, 0xfc, 0x27, 0xc2, 0x23, 0xf2, 0xa7, 0xaa, 0xa7,
+	0x7b, 0xe0, 0xa9, 0xd2, 0xcd, 0x2d, 0xfc, 0xc7, 0xdc, 0xdc, 0xcc, 0xdc, 0xcc, 0xdc, 0x0b, 0xca,
+	0x96, 0xe7, 0xed, 0x78, 0xbe, 0x1b, 0xba, 0x6a, 0x6e, 0x6e, 0xd9, 0x8e, 0xf6, 0xf7, 0x8a, 0x50,
+	0xd6, 0x3d, 0x6f, 0x77, 0xe1, 0x4c, 0x67, 0x44, 0xbd, 0x01, 0x79, 0xf7, 0x99, 0x43, 0xfc, 0xa6,
+	0x72, 0x57, 0xb9, 0x57, 0x35, 0x58, 0x41, 0x7d, 0x1b, 0x6a, 0x53, 0x12, 0x4c, 0x7c, 0xdb, 0x0b,
+	0x5d, 0xdf, 0xb4, 0xa7, 0xcd, 0xcc, 0x5d, 0xe5, 0x5e, 0xd9, 0xa8, 0xc6, 0xc0, 0xee, 0x54, 0x7d,
+	0x1d, 0xca, 0x96, 0x1f, 0xda, 0x27, 0xd6, 0x24, 0x0c, 0x9a, 0xd9, 0xbb, 0xd9, 0x7b, 0x55, 0x23,
+	0x06, 0xa8, 0x3f, 0x0f, 0xad, 0xc9, 0x99, 0x65, 0x3b, 0x13, 0x77, 0x4a, 0xcc, 0x29, 0xf1, 0x66,
+	0xee, 0xc5, 0x9c, 0x38, 0xa1, 0x19, 0x78, 0x64, 0x12, 0x34, 0x73, 0x14, 0xbd, 0x19, 0x61, 0xec,
+	0x45, 0x08, 0x23, 0xac, 0x57, 0x3f, 0x00, 0x95, 0x8e, 0xc4, 0x24, 0xce, 0xd4, 0xf5, 0x03, 0x82,
+	0x35, 0x41, 0x33, 0x4f, 0xbf, 0xba, 0x46, 0x6b, 0x3a, 0x52, 0x85, 0xfa, 0x06, 0x80, 0x4f, 0x82,
+	0xd0, 0xb7, 0x27, 0x21, 0x99, 0x36, 0x0b, 0x77, 0x95, 0x7b, 0x25, 0x43, 0x82, 0xa8, 0xb7, 0xa1,
+	0xc4, 0x9a, 0xb3, 0xa7, 0xcd, 0x22, 0x9d, 0x4a, 0x91, 0x96, 0xbb, 0x53, 0xf5, 0x0e, 0xc0, 0xc4,
+	0x27, 0x56, 0x48, 0xa6, 0xa6, 0x15, 0x36, 0x4b, 0x77, 0x95, 0x7b, 0x59, 0xa3, 0xcc, 0x21, 0x7a,
+	0xa8, 0xbe, 0x03, 0x75, 0x51, 0x3d, 0x0f, 0x3c, 0xfc, 0xbe, 0xcc, 0x48, 0xc1, 0xa1, 0x87, 0x81,
+	0xd7, 0x9d, 0x22, 0xd6, 0xc2, 0x9b, 0xca, 0x58, 0xc0, 0xb0, 0x38, 0x94, 0x61, 0xbd, 0x0f, 0xd7,
+	0x04, 0x7d, 0xcc, 0x99, 0x3d, 0x21, 0x4e, 0x40, 0x82, 0x66, 0xe5, 0x6e, 0xf6, 0x5e, 0xd9, 0x68,
+	0x88, 0x8a, 0x1e, 0x87, 0xab, 0x1d, 0x50, 0x63, 0xfa, 0x79, 0xd6, 0xe4, 0xdc, 0x3a, 0x25, 0x41,
+	0xb3, 0x7a, 0x37, 0x7b, 0xaf, 0xf2, 0x60, 0x7b, 0x07, 0x57, 0x72, 0xa7, 0x2d, 0xea, 0x87, 0xac,
+	0xda, 0xb8, 0x36, 0x49, 0x41, 0x02, 0xf5, 0x63, 0x68, 0x84, 0x96, 0x7f, 0x4a, 0x42, 0xd3, 0x9b,
+	0x59, 0xe1, 0x89, 0xeb, 0xcf, 0x83, 0x66, 0x8d, 0x36, 0x52, 0x67, 0x8d, 0x0c, 0x39, 0xd8, 0xd8,
+	0x62, 0x78, 0xa2, 0x1c, 0xa8, 0xf7, 0x41, 0x9d, 0xdb, 0x8e, 0x79, 0x62, 0x1d, 0xfb, 0xf6, 0xc4,
+	0x7c, 0x4a, 0xfc, 0xc0, 0x76, 0x9d, 0x66, 0x9d, 0x4e, 0xac, 0x31, 0xb7, 0x9d, 0x7d, 0x5a, 0xf1,
+	0x98, 0xc1, 0xd5, 0x6f, 0xc0, 0xd6, 0xc4, 0x75, 0x42, 0x5c, 0xe2, 0xa9, 0x7d, 0x4a, 0x82, 0x30,
+	0x68, 0x6e, 0xd1, 0xe5, 0xaa, 0x73, 0xf0, 0x1e, 0x83, 0xaa, 0x6f, 0x42, 0x65, 0x4e, 0xfc, 0xf3,
+	0x19, 0x31, 0x7d, 0xd7, 0x0d, 0x9b, 0x0d, 0xca, 0x77, 0xc0, 0x40, 0x86, 0xeb, 0x86, 0xea, 0x1e,
+	0xd4, 0x7d, 0x82, 0x5f, 0xd8, 0xae, 0x63, 0x86, 0x36, 0xf1, 0x9b, 0xd7, 0xee, 0x2a, 0xf7, 0xea,
+	0x0f, 0xee, 0xb0, 0x01, 0x47, 0xbc, 0xbb, 0x63, 0x08, 0xac, 0xb1, 0x4d, 0x7c, 0xa3, 0xe6, 0xcb,
+	0x45, 0x64, 0x61, 0xf2, 0x3c, 0x24, 0xbe, 0x63, 0xcd, 0xcc, 0x85, 0x6f, 0x07, 0x4d, 0x95, 0x12,
+	0xba, 0x2a, 0x80, 0x47, 0xbe, 0x8d, 0x4c, 0xba, 0x15, 0xd8, 0xa7, 0x8e, 0x15, 0x2e, 0x7c, 0x62,
+	0x52, 0xe2, 0x35, 0xaf, 0x53, 0xe2, 0x5c, 0x67, 0x7d, 0x8d, 0x44, 0x65, 0xcf, 0x76, 0xce, 0x8d,
+	0x7a, 0x84, 0x4b, 0x29, 0x8f, 0x53, 0x0e, 0xed, 0x39, 0x09, 0x42, 0x6b, 0xee, 0x99, 0xa1, 0x7b,
+	0x4e, 0x9c, 0xe6, 0x0d, 0x3a, 0x9b, 0x7a, 0x04, 0x1e, 0x23, 0x54, 0x7d, 0x17, 0x62, 0x08, 0xe3,
+	0xb3, 0x9b, 0x94, 0xcf, 0x6a, 0x12, 0x54, 0x0f, 0x35, 0x0d, 0x6a, 0x89, 0x29, 0xa9, 0x45, 0xc8,
+	0x3e, 0x1a, 0x8c, 0x1b, 0x5f, 0x53, 0x4b, 0x90, 0x6b, 0x0f, 0x7a, 0x7b, 0x0d, 0x45, 0xfb, 0xab,
+	0x0a, 0x94, 0xc4, 0x12, 0xa9, 0x75, 0xc8, 0xb8, 0x01, 0xdd, 0xb9, 0x65, 0x23, 0xe3, 0x06, 0xea,
+	0x0f, 0xa1, 0x6a, 0xf9, 0x93, 0x33, 0x3b, 0x24, 0x13, 0x1c, 0x25, 0xdd, 0xb5, 0xf5, 0x07, 0xaf,
+	0x25, 0x17, 0x7a, 0x47, 0x97, 0x50, 0x8c, 0xc4, 0x07, 0xda, 0x21, 0x54, 0xe5, 0x5a, 0xf5, 0x75,
+	0x68, 0xea, 0x46, 0xfb, 0x51, 0x77, 0xdc, 0x69, 0x8f, 0x8f, 0x8c, 0x8e, 0x79, 0xd4, 0x1f, 0x0d,
+	0x3b, 0xed, 0xee, 0x7e, 0xb7, 0xb3, 0xd7, 0xf8, 0x9a, 0x5a, 0x86, 0xbc, 0x7e, 0xb8, 0xf7, 0xd1,
+	0xc3, 0x86, 0x42, 0x7f, 0x1a, 0x87, 0x1f, 0x3d, 0x6c, 0x64, 0xf0, 0xe7, 0xe8, 0xc3, 0x8f, 0xbf,
+	0xfd, 0x79, 0x23, 0xab, 0xfd, 0x8e, 0x02, 0x8d, 0x34, 0x93, 0xaa, 0x2a, 0xe4, 0x1c, 0x6b, 0x4e,
+	0xf8, 0xb0, 0xe9, 0x6f, 0xb5, 0x09, 0x45, 0xc1, 0x5f, 0x4c, 0xd2, 0x88, 0xa2, 0xfa, 0x7d, 0x28,
+	0xcd, 0x2c, 0xe7, 0x74, 0x61, 0x9d, 0x92, 0x66, 0x96, 0x4e, 0xe7, 0xcd, 0xd5, 0xcc, 0xbf, 0xd3,
+	0xe3, 0x68, 0x46, 0xf4, 0x01, 0x36, 0xeb, 0x2f, 0x1c, 0x24, 0x72, 0x33, 0xc7, 0x9a, 0xe5, 0x45,
+	0xed, 0x63, 0x28, 0x09, 0x7c, 0xb5, 0x06, 0xe5, 0xa3, 0xfe, 0x5e, 0x67, 0xbf, 0xdb, 0xa7, 0xb3,
+	0x02, 0x28, 0x1c, 0x0c, 0x7a, 0x7a, 0xff, 0xa0, 0xa1, 0x20, 0xdd, 0xfb, 0x83, 0xbd, 0x4e, 0x23,
+	0x83, 0xbf, 0x3e, 0xd5, 0x1f, 0xeb, 0x8d, 0x9c, 0xf6, 0xbb, 0x0a, 0x6c, 0x45, 0x3c, 0xf8, 0x19,
+	0xb9, 0x18, 0x91, 0x70, 0x59, 0x5e, 0x2a, 0x2b, 0xe4, 0xe5, 0x9b, 0x50, 0x39, 0xa6, 0x1f, 0x99,
+	0xe7, 0xe4, 0x22, 0x68, 0x66, 0x28, 0x3f, 0xc2, 0xb1, 0x68, 0x27, 0x40, 0x29, 0x75, 0x66, 0x05,
+	0xe6, 0xdc, 0xf5, 0xd9, 0x5c, 0x4b, 0x46, 0xf1, 0xcc, 0x0a, 0x0e, 0x5d, 0x9f, 0xa8, 0x2d, 0x28,
+	0x1d, 0xbb, 0xee, 0xf9, 0xdc, 0xf2, 0xcf, 0xf9, 0x54, 0xa2, 0x32, 0x76, 0xce, 0xdb, 0x3d, 0xb3,
+	0x82, 0x33, 0x22, 0xc4, 0x64, 0x95, 0x01, 0x1f, 0x51, 0x18, 0xdb, 0x9e, 0xb3, 0x19, 0x99, 0xd0,
+	0x5d, 0x85, 0x88, 0x54, 0x4c, 0xd2, 0xed, 0x29, 0xc0, 0x88, 0xaa, 0xfd, 0xfd, 0x02, 0xd4, 0x74,
+	0xcf, 0xdb, 0x8b, 0x46, 0xbe, 0x46, 0x45, 0xdc, 0x85, 0x8a, 0x98, 0x5d, 0xbc, 0x6c, 0x32, 0x48,
+	0x7d, 0x0d, 0xca, 0x7c, 0x5c, 0xf6, 0xb4, 0x99, 0xe5, 0x83, 0xa6, 0x80, 0xee, 0x54, 0x7d, 0x00,
+	0x37, 0x3d, 0xcb, 0xa7, 0xd2, 0x22, 0x26, 0xdc, 0x39, 0xb9, 0xe0, 0xb3, 0xbb, 0xce, 0x2a, 0xe3,
+	0x51, 0x7c, 0x46, 0x2e, 0xd4, 0x09, 0x6c, 0x13, 0xe7, 0xa9, 0xed, 0xbb, 0x0e, 0xd5, 0x24, 0x51,
+	0xe3, 0x6c, 0xc6, 0x95, 0x07, 0x1f, 0x44, 0x02, 0x22, 0xfe, 0x6e, 0xa7, 0x13, 0x7f, 0xb1, 0xcb,
+	0x3b, 0x0f, 0x3a, 0x4e, 0xe8, 0x5f, 0x18, 0x37, 0xc8, 0x8a, 0xaa, 0x84, 0xaa, 0x28, 0x6c, 0x52,
+	0x15, 0xc5, 0xb4, 0xaa, 0x50, 0x21, 0x17, 0x5a, 0xa7, 0x41, 0xb3, 0x44, 0x17, 0x96, 0xfe, 0x46,
+	0x3d, 0xe6, 0xf9, 0xf6, 0x53, 0x2b, 0x24, 0x66, 0x4c, 0x67, 0xae, 0x42, 0xae, 0xf1, 0x9a, 0x76,
+	0x54, 0xa1, 0x1e, 0xc0, 0x96, 0x40, 0x9f, 0x92, 0xd0, 0xb2, 0x67, 0x01, 0x55, 0x24, 0x95, 0x07,
+	0x6f, 0xb0, 0xa9, 0xc5, 0xf3, 0x1a, 0x32, 0xb4, 0x3d, 0x86, 0x65, 0xd4, 0xbd, 0x44, 0x59, 0xdd,
+	0x85, 0x6b, 0x27, 0x36, 0x99, 0x4d, 0xcd, 0x89, 0x3b, 0x9f, 0xdb, 0x21, 0x53, 0x9f, 0x15, 0x4a,
+	0xa5, 0x9b, 0xac, 0xa9, 0x7d, 0xac, 0x6e, 0x47, 0xb5, 0x46, 0xe3, 0x24, 0x09, 0x08, 0xd4, 0x8f,
+	0xa0, 0xe6, 0xf9, 0xf6, 0xc4, 0x76, 0x4e, 0xa9, 0x14, 0x16, 0xca, 0xe7, 0x1a, 0x17, 0x27, 0xac,
+	0x8a, 0x8a, 0xde, 0xaa, 0x17, 0x17, 0x50, 0xe5, 0xd4, 0x7d, 0xf7, 0xc2, 0x9a, 0x85, 0x17, 0x66,
+	0xe0, 0xcd, 0xec, 0x50, 0x28, 0x1c, 0x95, 0x7d, 0x68, 0xb0, 0xba, 0x11, 0x56, 0x19, 0x35, 0x5f,
+	0x2a, 0x05, 0x2b, 0xb4, 0x6d, 0xfd, 0x4a, 0xda, 0x76, 0x6b, 0xa5, 0xb6, 0x2d, 0x06, 0x0b, 0xcf,
+	0x73, 0x7d, 0xa6, 0x63, 0xa2, 0x81, 0x8f, 0x18, 0xb0, 0xeb, 0x9c, 0xb8, 0x86, 0xc0, 0x68, 0x1d,
+	0xc0, 0xed, 0xb5, 0x8c, 0xa2, 0x36, 0x20, 0x8b, 0x9c, 0xc9, 0xf6, 0x34, 0xfe, 0xc4, 0x2d, 0xf1,
+	0xd4, 0x9a, 0x2d, 0x08, 0x67, 0x7b, 0x56, 0xf8, 0x24, 0xf3, 0x73, 0x8a, 0xf6, 0x0f, 0x14, 0x50,
+	0xe3, 0x55, 0x1a, 0x39, 0x96, 0x17, 0x9c, 0xb9, 0x57, 0x14, 0x10, 0xd7, 0x21, 0x6f, 0x05, 0xa6,
+	0x7b, 0x42, 0x5b, 0xcd, 0x1a, 0x39, 0x2b, 0x18, 0x9c, 0x20, 0x30, 0x7c, 0x1e, 0xef, 0xa0, 0x5c,
+	0xf8, 0x9c, 0x99, 0x5e, 0x91, 0xea, 0xa0, 0x3b, 0x26, 0x6b, 0xc4, 0x00, 0xf5, 0x13, 0xa8, 0x5b,
+	0x9e, 0x27, 0x6d, 0xac, 0x66, 0xfe, 0xae, 0x12, 0x2b, 0xb5, 0xc4, 0xfe, 0x30, 0x6a, 0x96, 0x5c,
+	0xd4, 0xfe, 0xa5, 0x02, 0x15, 0x89, 0x42, 0x28, 0xb4, 0x38, 0x8d, 0xcc, 0x85, 0x3f, 0xe3, 0xc3,
+	0x06, 0x0e, 0x3a, 0xf2, 0x67, 0xb8, 0x91, 0x03, 0x32, 0x59, 0xf8, 0x76, 0x78, 0x61, 0xa2, 0xa6,
+	0x47, 0xe3, 0x86, 0x8a, 0x97, 0x0c, 0x95, 0x16, 0xd7, 0x45, 0x65, 0x9b, 0xd5, 0xa1, 0x8c, 0x51,
+	0x1f, 0x42, 0x29, 0x98, 0x59, 0x4c, 0xb7, 0x33, 0xa1, 0x7e, 0x7b, 0x69, 0x6d, 0x76, 0x46, 0x33,
+	0x8b, 0x32, 0x57, 0x31, 0x60, 0x3f, 0xb4, 0x8f, 0xa1, 0xc8, 0x61, 0x4c, 0x2e, 0xf7, 0x3b, 0x4c,
+	0x07, 0xed, 0xea, 0xa3, 0x6e, 0xbb, 0xa1, 0xa8, 0x55, 0x28, 0x8d, 0xc6, 0x7a, 0x7f, 0x4f, 0x37,
+	0xf6, 0x1a, 0x19, 0xb5, 0x02, 0xc5, 0xa1, 0xd1, 0x39, 0xec, 0x1e, 0x1d, 0x36, 0xb2, 0xda, 0x01,
+	0x54, 0x65, 0xb6, 0xc3, 0xf5, 0xf3, 0x2c, 0x3f, 0xbc, 0x10, 0x22, 0x8d, 0x16, 0xd4, 0xb7, 0xa0,
+	0x7a, 0x6c, 0x05, 0x76, 0x60, 0x7a, 0xae, 0x8d, 0xfb, 0x05, 0x67, 0x50, 0x33, 0x2a, 0x14, 0x36,
+	0xa4, 0x20, 0xed, 0xfb, 0x50, 0x33, 0x12, 0x1c, 0xfb, 0x4d, 0x28, 0x70, 0x26, 0x57, 0xd6, 0x32,
+	0x39, 0xc7, 0xd0, 0x2e, 0xa0, 0x22, 0xed, 0x9a, 0x95, 0x8a, 0x50, 0x85, 0xdc, 0xc2, 0xb1, 0x43,
+	0xce, 0x57, 0xf4, 0x37, 0x8a, 0x1d, 0xfc, 0x6b, 0xe2, 0x26, 0x63, 0x8a, 0x21, 0x67, 0x94, 0x11,
+	0x82, 0x8d, 0x11, 0x64, 0xad, 0xc9, 0xc2, 0xf7, 0x89, 0x33, 0xc1, 0x05, 0x98, 0x0a, 0x55, 0x57,
+	0x15, 0xc0, 0xb6, 0x3b, 0x25, 0xda, 0xf7, 0xa0, 0x3a, 0x94, 0xf7, 0xe8, 0x37, 0x20, 0xcf, 0xf6,
+	0xb4, 0xb2, 0x6e, 0x4f, 0xb3, 0x7a, 0xed, 0x00, 0xb6, 0x52, 0x92, 0x02, 0x89, 0x47, 0x65, 0x05,
+	0x1f, 0x38, 0x2b, 0xa0, 0x09, 0x1e, 0xcb, 0x1a, 0xbe, 0xf8, 0x12, 0x44, 0xfb, 0x0c, 0x1a, 0xfb,
+	0x69, 0x09, 0xf3, 0x3d, 0xa8, 0xc8, 0xf2, 0x49, 0xd9, 0x24, 0x9f, 0x64, 0x4c, 0xed, 0x9b, 0xa0,
+	0x3e, 0x26, 0xbe, 0x7d, 0x62, 0x4f, 0x2c, 0x94, 0x9b, 0x06, 0x09, 0x16, 0xb3, 0x90, 0xef, 0x4a,
+	0xbe, 0xb9, 0x4a, 0x06, 0x2b, 0x68, 0x43, 0x68, 0xae, 0x13, 0x9b, 0x68, 0x20, 0x70, 0xd1, 0xc5,
+	0x27, 0x23, 0x8a, 0xa8, 0x70, 0x39, 0x37, 0x0b, 0x4d, 0x1d, 0x95, 0xb5, 0xdf, 0xca, 0x40, 0x3d,
+	0xb1, 0x89, 0xd0, 0x90, 0xac, 0xc4, 0xdb, 0x8d, 0x9d, 0x86, 0x2a, 0x0f, 0x5a, 0x2b, 0xf6, 0x5b,
+	0xb0, 0xc3, 0x94, 0x8f, 0x8c, 0x9e, 0x50, 0xfc, 0xb9, 0xf5, 0x8a, 0x3f, 0x9f, 0x52, 0xfc, 0x57,
+	0xd5, 0xe9, 0x2d, 0x1b, 0xf2, 0xeb, 0x24, 0xd9, 0xb2, 0xac, 0xc8, 0x5c, 0x55, 0x56, 0x20, 0xb3,
+	0xd2, 0x4e, 0xb3, 0xb4, 0x53, 0xfa, 0x5b, 0xfb, 0xef, 0x0a, 0x80, 0xa4, 0xd0, 0xbe, 0xac, 0xed,
+	0xf0, 0x0d, 0xd8, 0x4a, 0xda, 0x05, 0x8c, 0xa6, 0x65, 0xa3, 0x3e, 0x95, 0x4d, 0x82, 0xa4, 0xba,
+	0xce, 0x6d, 0x52, 0xd7, 0xf9, 0xcb, 0x4f, 0x76, 0x85, 0x2b, 0xe9, 0x9a, 0xe2, 0xb2, 0xae, 0xd1,
+	0x76, 0x21, 0x3b, 0xb4, 0xd7, 0xcd, 0xf6, 0x5d, 0xa8, 0xa7, 0x6c, 0x1c, 0x36, 0xe1, 0x5a, 0x62,
+	0x2a, 0xda, 0x1f, 0x56, 0x20, 0xff, 0xc4, 0x0a, 0x27, 0x67, 0x57, 0x53, 0x16, 0x4d, 0x28, 0x3e,
+	0x43, 0x6c, 0xe2, 0xf3, 0xcd, 0x26, 0x8a, 0x38, 0x6f, 0xfe, 0x33, 0x56, 0x1b, 0x65, 0x0e, 0x59,
+	0x22, 0x4b, 0x2e, 0x45, 0x16, 0xed, 0x37, 0x14, 0xa8, 0x18, 0x24, 0x20, 0xfe, 0x53, 0xba, 0xb5,
+	0xae, 0x6c, 0xda, 0xfa, 0xf4, 0x1b, 0x32, 0x35, 0x8f, 0x2f, 0xc4, 0xee, 0x17, 0xa0, 0xdd, 0x8b,
+	0x04, 0x82, 0x15, 0xd2, 0x41, 0x65, 0x63, 0x04, 0x9d, 0x0a, 0x39, 0xf2, 0xdc, 0xb3, 0x7d, 0x12,
+	0x48, 0xa3, 0xe2, 0x10, 0x3d, 0xd4, 0xfe, 0xb4, 0x02, 0xb9, 0x9e, 0x3b, 0x39, 0xc7, 0xfd, 0xe0,
+	0x93, 0xc0, 0x5d, 0xf8, 0x13, 0x21, 0x38, 0xa3, 0xb2, 0xba, 0x0d, 0x85, 0x33, 0x77, 0x36, 0x8d,
+	0x28, 0xc2, 0x4b, 0x68, 0x88, 0xb2, 0x5f, 0x92, 0x21, 0xca, 0x00, 0x6c, 0xe8, 0xd6, 0xe4, 0x8b,
+	0x85, 0xed, 0xcb, 0xf4, 0x00, 0x01, 0x5a, 0x1a, 0x59, 0x3e, 0x3d, 0xb2, 0xdf, 0xcd, 0x40, 0x4d,
+	0x9f, 0x4c, 0x48, 0x10, 0x18, 0xe4, 0x8b, 0x05, 0x09, 0x42, 0x54, 0xce, 0x3e, 0xfb, 0x19, 0x71,
+	0x42, 0x0c, 0xb8, 0x9a, 0x6b, 0xe5, 0x0e, 0x40, 0x7c, 0x54, 0x10, 0x4b, 0x18, 0x9d, 0x14, 0xd4,
+	0x77, 0xa0, 0xf6, 0x93, 0x45, 0x10, 0x46, 0xf2, 0x8f, 0x73, 0x7e, 0x12, 0xa8, 0x3e, 0x80, 0x42,
+	0x10, 0x5a, 0xe1, 0x22, 0xa0, 0x83, 0xae, 0x47, 0xe2, 0x48, 0x1e, 0xec, 0xce, 0x88, 0x62, 0x18,
+	0x1c, 0x13, 0x3b, 0x9e, 0x92, 0x89, 0x3d, 0x65, 0xeb, 0xc8, 0xa4, 0x49, 0x99, 0x43, 0x76, 0xa9,
+	0x86, 0x14, 0x33, 0x91, 0x6c, 0xe0, 0x4a, 0x04, 0x63, 0xe4, 0x12, 0x2d, 0xc4, 0xfe, 0x14, 0x0e,
+	0xd1, 0x43, 0x6d, 0x07, 0x0a, 0xac, 0x4b, 0xaa, 0xa0, 0x3b, 0xfd, 0xbd, 0x6e, 0xff, 0xa0, 0xf1,
+	0x35, 0x2c, 0x1c, 0x18, 0x7a, 0x7f, 0xdc, 0xd9, 0x6b, 0x28, 0x78, 0x02, 0xdb, 0xeb, 0xf4, 0xf1,
+	0x8c, 0x99, 0xd1, 0xfe, 0xb2, 0x02, 0x30, 0x24, 0xfe, 0xdc, 0x0e, 0xe8, 0x71, 0xb0, 0x09, 0xc5,
+	0x53, 0xdf, 0x72, 0x42, 0x42, 0x38, 0x65, 0x45, 0xf1, 0x95, 0xd0, 0xf5, 0x0e, 0x00, 0x6b, 0x8e,
+	0xce, 0x3e, 0xc7, 0x66, 0xcf, 0x21, 0xbb, 0x89, 0xea, 0x98, 0x13, 0x38, 0x44, 0x0f, 0xb5, 0xff,
+	0xad, 0x40, 0x79, 0xe8, 0xbb, 0x73, 0xf7, 0xea, 0xfb, 0x26, 0x39, 0x9e, 0x4c, 0x7a, 0x3c, 0x3f,
+	0x80, 0x8a, 0x74, 0x46, 0x69, 0x66, 0x13, 0xc7, 0x79, 0xd1, 0x93, 0x7c, 0xc2, 0x31, 0x64, 0x7c,
+	0x64, 0x6d, 0x8f, 0x62, 0xc9, 0xf3, 0x01, 0x01, 0x62, 0xbb, 0x32, 0x42, 0x88, 0x66, 0x14, 0x21,
+	0xe8, 0xa1, 0xf6, 0x01, 0x54, 0xa4, 0xd6, 0xd1, 0x1f, 0xb1, 0xd7, 0x79, 0xcc, 0x96, 0x6b, 0x34,
+	0xd6, 0x0f, 0xba, 0xe2, 0x90, 0x3c, 0x34, 0x06, 0xb8, 0x58, 0xbf, 0x9d, 0x87, 0xa2, 0xe1, 0xce,
+	0x66, 0xee, 0x22, 0x7c, 0x25, 0xf3, 0x7f, 0x9f, 0x72, 0xf0, 0x29, 0x61, 0xc2, 0x3f, 0x52, 0x4a,
+	0xbc, 0x0b, 0xe4, 0xdd, 0x53, 0x62, 0x70, 0x14, 0x14, 0xb3, 0x41, 0x68, 0xf9, 0x38, 0x17, 0xfe,
+	0x51, 0x8e, 0xda, 0x6f, 0x35, 0x0e, 0x1d, 0x31, 0xb4, 0xfb, 0xa9, 0x5d, 0x71, 0x63, 0xa9, 0x4d,
+	0x79, 0x3f, 0xec, 0x40, 0x91, 0x09, 0xfa, 0xa0, 0x59, 0xa0, 0x43, 0x48, 0xa1, 0x1f, 0xd1, 0x4a,
+	0x43, 0x20, 0xc9, 0xc2, 0xf5, 0xf8, 0x82, 0x6e, 0x8f, 0x6a, 0x24, 0x5c, 0x19, 0x07, 0x6d, 0x70,
+	0x36, 0xb6, 0x02, 0xc8, 0xd3, 0x51, 0xae, 0x34, 0x0d, 0xdf, 0x00, 0xf0, 0x88, 0x3f, 0x21, 0x0e,
+	0x62, 0x70, 0xdb, 0x54, 0x82, 0xa8, 0xb7, 0xa0, 0xc8, 0x34, 0x94, 0x50, 0x95, 0x85, 0x39, 0xea,
+	0x26, 0x3a, 0x26, 0x41, 0x98, 0x58, 0xb4, 0x72, 0x88, 0x1e, 0xb6, 0xfe, 0x82, 0x02, 0x05, 0x36,
+	0x0d, 0x89, 0x36, 0xca, 0x15, 0x68, 0x73, 0x03, 0xf2, 0x41, 0x34, 0x96, 0xb2, 0xc1, 0x0a, 0x28,
+	0x84, 0x7d, 0x62, 0x05, 0xae, 0xc3, 0xb7, 0x17, 0x2f, 0x51, 0x2b, 0x96, 0x2b, 0xd2, 0x78, 0x6f,
+	0x71, 0x08, 0xa3, 0x8c, 0xa8, 0x8e, 0xf7, 0x16, 0x87, 0xe8, 0xa1, 0xa6, 0x27, 0xc4, 0x46, 0x4f,
+	0xef, 0x33, 0x5f, 0xcd, 0x16, 0x54, 0xba, 0x7d, 0x73, 0x68, 0x0c, 0x0e, 0x8c, 0xce, 0x68, 0xc4,
+	0x44, 0xc7, 0x23, 0xbd, 0x87, 0x62, 0x24, 0x83, 0x7e, 0x9d, 0xf6, 0xe0, 0x70, 0xd8, 0xeb, 0x60,
+	0x31, 0xab, 0xfd, 0x1a, 0x0a, 0xea, 0x20, 0x20, 0x61, 0xc7, 0x79, 0x4a, 0x66, 0xae, 0x47, 0xd0,
+	0xfc, 0x74, 0x8f, 0x7f, 0x42, 0x26, 0xa1, 0x19, 0x5e, 0x78, 0x84, 0xcf, 0x99, 0xfb, 0x56, 0x7f,
+	0xb4, 0x20, 0xfe, 0xc5, 0xce, 0x80, 0x56, 0x8f, 0x2f, 0x3c, 0x62, 0x80, 0x1b, 0xfd, 0x46, 0x85,
+	0x72, 0x4e, 0x2e, 0x4c, 0x3c, 0x35, 0x44, 0xd6, 0xe1, 0x39, 0xb9, 0x18, 0x62, 0x39, 0x3e, 0x1b,
+	0x32, 0xb3, 0x88, 0x15, 0x28, 0x77, 0x52, 0x2d, 0x85, 0x6e, 0x46, 0xc7, 0x21, 0x33, 0x21, 0xb3,
+	0x19, 0xb4, 0xcd, 0x80, 0xea, 0x5d, 0xa8, 0x72, 0x34, 0x76, 0xe8, 0xcb, 0xf3, 0xf3, 0x16, 0x85,
+	0x8d, 0x9f, 0x33, 0x7d, 0x45, 0x9e, 0xe3, 0x21, 0x49, 0x16, 0xd1, 0x20, 0x40, 0x6c, 0x53, 0x47,
+	0x08, 0x91, 0x88, 0x8e, 0x10, 0xf4, 0x50, 0x1b, 0xc0, 0x75, 0xf4, 0x6b, 0x92, 0x69, 0x92, 0x1a,
+	0x2d, 0x28, 0x11, 0xfe, 0x9b, 0xcb, 0xd6, 0xa8, 0x8c, 0x2a, 0x2d, 0xf2, 0x7d, 0x72, 0xe5, 0x1a,
+	0x03, 0xb4, 0x5f, 0x86, 0x7a, 0x3b, 0x61, 0x70, 0x22, 0x3e, 0xf2, 0x6c, 0xe0, 0x59, 0x91, 0x9a,
+	0x8e, 0x01, 0x9b, 0xc9, 0xb7, 0xc2, 0xa8, 0x14, 0x1f, 0x4c, 0xdc, 0x85, 0xc3, 0x18, 0x38, 0x47,
+	0x3f, 0x68, 0x63, 0x59, 0x23, 0xd0, 0x30, 0xc8, 0xa9, 0x1d, 0x84, 0xfe, 0x45, 0xfb, 0x8c, 0x4c,
+	0xce, 0x83, 0xc5, 0xfc, 0x92, 0xfe, 0xb7, 0xa1, 0xc0, 0x5c, 0xd4, 0xc2, 0x4e, 0x60, 0xa5, 0x64,
+	0x37, 0xd9, 0x54, 0x37, 0x77, 0xa0, 0xf8, 0x19, 0xb9, 0xe8, 0xd9, 0x01, 0x75, 0xf4, 0x50, 0x8b,
+	0x54, 0x61, 0x8e, 0x1e, 0xfc, 0xad, 0x0d, 0xa0, 0x1c, 0x79, 0x04, 0x5f, 0x85, 0xec, 0xd3, 0x1e,
+	0x42, 0x2d, 0x6a, 0x90, 0xf6, 0xfa, 0xb6, 0xd4, 0x6b, 0xe5, 0xc1, 0x16, 0x63, 0xd3, 0x08, 0x85,
+	0x0f, 0xe3, 0x1f, 0x29, 0xf8, 0xd9, 0xec, 0xfc, 0x80, 0x84, 0xfc, 0x50, 0xf4, 0x21, 0x14, 0x89,
+	0x13, 0xfa, 0x36, 0x11, 0x5f, 0xde, 0x16, 0x5f, 0x4a, 0x58, 0xfc, 0x50, 0x22, 0x30, 0x5b, 0x3f,
+	0x15, 0x07, 0x86, 0xc4, 0x52, 0x29, 0xcb, 0x9c, 0x7e, 0xe2, 0x2e, 0x1c, 0xa6, 0x6a, 0x4b, 0x06,
+	0x2b, 0xac, 0xe1, 0xff, 0x1b, 0x90, 0x27, 0xbe, 0xef, 0xfa, 0x9c, 0xed, 0x59, 0x21, 0x5a, 0xec,
+	0xbc, 0x74, 0x82, 0xf8, 0xf5, 0x9c, 0x98, 0xf9, 0x68, 0x31, 0x9f, 0x5b, 0xfe, 0x45, 0x8a, 0x52,
+	0x4a, 0x5a, 0x4b, 0x24, 0x83, 0x3f, 0x99, 0xa5, 0xe0, 0xcf, 0x1b, 0x00, 0x56, 0x10, 0xb8, 0x13,
+	0x1b, 0x65, 0x09, 0x77, 0xac, 0x4a, 0x10, 0x55, 0x83, 0xaa, 0xa4, 0x35, 0x59, 0x6c, 0xaa, 0x6c,
+	0x24, 0x60, 0x89, 0x63, 0x46, 0x7e, 0xd3, 0x31, 0xa3, 0x90, 0x3e, 0x66, 0xbc, 0x0b, 0xf5, 0x28,
+	0xe8, 0xc3, 0x38, 0xab, 0xc8, 0xd4, 0x92, 0x80, 0x52, 0xf6, 0x5a, 0x13, 0xee, 0x29, 0xbd, 0x8a,
+	0x70, 0x4f, 0xf9, 0x65, 0xc2, 0x3d, 0xb0, 0x26, 0xdc, 0x93, 0x8a, 0xe2, 0x54, 0xae, 0x10, 0xc5,
+	0xa9, 0xbe, 0x78, 0x14, 0x47, 0xfb, 0xf7, 0x0a, 0xd4, 0x12, 0x41, 0x98, 0x57, 0x62, 0x57, 0xbc,
+	0x0e, 0x65, 0x6f, 0x71, 0x3c, 0xb3, 0x83, 0x33, 0xee, 0x80, 0xaa, 0x1a, 0x31, 0x00, 0x8d, 0xdc,
+	0xa8, 0x10, 0x1f, 0x2b, 0x2b, 0x11, 0xac, 0x3b, 0x7d, 0xd1, 0xf0, 0xa4, 0xd4, 0xa2, 0xc4, 0x24,
+	0x51, 0x8b, 0x28, 0x94, 0x7f, 0x4d, 0x81, 0xfa, 0x28, 0x19, 0x5e, 0x7a, 0x0f, 0xf2, 0x33, 0xdb,
+	0x39, 0x17, 0xfb, 0x76, 0x65, 0x48, 0x8a, 0x61, 0xa0, 0xec, 0x7e, 0x4a, 0xfd, 0x21, 0xd1, 0x06,
+	0x88, 0xca, 0x38, 0xd6, 0xa7, 0x92, 0xaf, 0xc4, 0x64, 0xdb, 0x90, 0x29, 0xe7, 0x6b, 0x72, 0x4d,
+	0x07, 0x2b, 0xb4, 0xbf, 0xab, 0xc0, 0xcd, 0xf8, 0x8c, 0xff, 0xc4, 0x0e, 0xcf, 0xd8, 0x3a, 0x05,
+	0x2b, 0x5c, 0x05, 0xca, 0x95, 0x5d, 0x05, 0x1f, 0x40, 0x91, 0x91, 0x9f, 0x09, 0xfc, 0xe8, 0xa3,
+	0xc4, 0x46, 0x37, 0x04, 0xce, 0x97, 0x8c, 0x84, 0x68, 0xbf, 0xa7, 0xc0, 0x35, 0x9d, 0x6f, 0xec,
+	0xd8, 0x2d, 0xf4, 0xbd, 0xb4, 0x04, 0x14, 0x2c, 0x98, 0xc6, 0x4c, 0x4b, 0xc1, 0xdf, 0x54, 0x84,
+	0x18, 0xbc, 0x12, 0xd3, 0xdd, 0x47, 0x5f, 0x3f, 0x79, 0x6a, 0xbb, 0x8b, 0x20, 0x8e, 0x4d, 0x70,
+	0xe6, 0x6b, 0x88, 0x1a, 0xe1, 0x5a, 0x5e, 0x41, 0xcd, 0xec, 0x95, 0x9d, 0xb4, 0x5f, 0x87, 0x6a,
+	0xe7, 0xb9, 0x1d, 0x84, 0x01, 0x9f, 0xe1, 0x36, 0x14, 0x08, 0x2d, 0x73, 0xcf, 0x17, 0x2f, 0x69,
+	0xbf, 0x02, 0x80, 0x56, 0x13, 0x79, 0xe2, 0xdb, 0x21, 0xc1, 0x2d, 0x9b, 0x36, 0x77, 0xca, 0x2f,
+	0x6b, 0xd6, 0xbc, 0x06, 0x65, 0x3b, 0x30, 0xa7, 0x64, 0x46, 0x42, 0xe1, 0xba, 0x2a, 0xd9, 0xc1,
+	0x1e, 0x2d, 0x6b, 0x43, 0xa8, 0xee, 0xf9, 0x17, 0xc6, 0xc2, 0x89, 0x87, 0xe9, 0xd3, 0x5f, 0xdc,
+	0xbe, 0xe0, 0x25, 0xf5, 0x1e, 0x14, 0x9e, 0xe1, 0x08, 0x05, 0x6f, 0x34, 0x38, 0xa7, 0x47, 0x43,
+	0x37, 0x78, 0xbd, 0xa6, 0xc3, 0xd6, 0x88, 0x12, 0x61, 0xe0, 0x11, 0x9f, 0x9d, 0x72, 0x5b, 0x50,
+	0x3a, 0x59, 0x38, 0x2c, 0xae, 0xc2, 0x1d, 0x02, 0xa2, 0x8c, 0xea, 0xc5, 0xf2, 0x4f, 0x59, 0xb3,
+	0x55, 0x83, 0xfe, 0xd6, 0x7e, 0x08, 0x05, 0xd6, 0x84, 0xfa, 0x5d, 0x00, 0x57, 0x34, 0x93, 0x72,
+	0x3e, 0xa6, 0x3a, 0x31, 0x24, 0x44, 0xed, 0x1e, 0x54, 0x59, 0x35, 0x9f, 0x15, 0x06, 0x19, 0xe9,
+	0x2f, 0xd6, 0x46, 0xd5, 0x10, 0x45, 0xed, 0x7f, 0x28, 0x50, 0xa6, 0x93, 0x30, 0x88, 0x35, 0x7d,
+	0x49, 0xf2, 0xdf, 0x86, 0x92, 0x1d, 0x98, 0xbe, 0xe5, 0x9c, 0x46, 0x3b, 0xc2, 0x0e, 0x0c, 0x2c,
+	0xc6, 0x6a, 0x38, 0x27, 0xab, 0x61, 0xf4, 0xb8, 0x60, 0x35, 0x57, 0x3a, 0x79, 0x76, 0x5e, 0xa0,
+	0x20, 0xa6, 0x71, 0xa8, 0xc3, 0x36, 0x0a, 0x49, 0x31, 0xdf, 0x97, 0x04, 0xc1, 0xe1, 0x78, 0xd6,
+	0x29, 0x31, 0x03, 0xfb, 0xa7, 0x84, 0xea, 0xac, 0xbc, 0x51, 0x42, 0xc0, 0xc8, 0xfe, 0x69, 0x72,
+	0x17, 0x96, 0x52, 0xbb, 0xf0, 0x57, 0xa0, 0x31, 0xb2, 0xe7, 0x8b, 0x99, 0xbc, 0x07, 0xd7, 0x12,
+	0x49, 0x7d, 0x17, 0xf2, 0x3e, 0xb1, 0xa6, 0x62, 0xed, 0xb7, 0xa4, 0xb5, 0x47, 0xb2, 0x19, 0xac,
+	0x56, 0xe2, 0x91, 0xec, 0x25, 0x3c, 0x82, 0x0e, 0xac, 0x3d, 0x32, 0x77, 0xf7, 0xac, 0xd0, 0x0a,
+	0x08, 0xb5, 0xd6, 0x02, 0x42, 0xd8, 0x96, 0xcd, 0x1a, 0xf4, 0xb7, 0x7a, 0x37, 0xe9, 0xae, 0xe5,
+	0x8e, 0x7e, 0x09, 0x84, 0x03, 0x16, 0x02, 0x2b, 0x4b, 0x6b, 0x45, 0x11, 0xa7, 0x1e, 0x25, 0x6f,
+	0xb0, 0x13, 0x66, 0x54, 0xa6, 0x4e, 0x39, 0xd7, 0x3f, 0x47, 0xc7, 0x3a, 0x23, 0xb8, 0x28, 0x6a,
+	0x7f, 0x54, 0x41, 0x0f, 0x3c, 0x99, 0xb8, 0xce, 0xd4, 0xa6, 0xe4, 0xfd, 0x6a, 0x0e, 0x1f, 0x34,
+	0xeb, 0xc1, 0x23, 0x68, 0xf7, 0x98, 0x92, 0x19, 0x5d, 0x15, 0x40, 0x1a, 0xe2, 0xed, 0x42, 0x4d,
+	0x1e, 0x4a, 0xa0, 0xfe, 0x1c, 0x46, 0xfa, 0x24, 0x40, 0x32, 0x96, 0x21, 0xe3, 0x1a, 0x49, 0x44,
+	0xed, 0x47, 0x50, 0x36, 0xac, 0x90, 0xf4, 0xec, 0x39, 0x0b, 0x54, 0xcc, 0xad, 0xe7, 0x26, 0x5f,
+	0x27, 0x85, 0x12, 0xa0, 0x3c, 0xb7, 0x9e, 0xd3, 0xf5, 0xa1, 0x07, 0xf4, 0x67, 0xb6, 0x33, 0x75,
+	0x9f, 0x99, 0x01, 0x6d, 0x22, 0xe0, 0x71, 0xae, 0x1a, 0x83, 0x8e, 0x18, 0x50, 0xfb, 0xb7, 0x35,
+	0xa8, 0x47, 0x06, 0xbd, 0xeb, 0x9c, 0xd8, 0xa7, 0x28, 0x38, 0xac, 0xe9, 0xdc, 0x76, 0x04, 0xf3,
+	0xf0, 0x12, 0x5a, 0x3b, 0xb4, 0x33, 0xd3, 0xc7, 0x88, 0xe9, 0x0c, 0x07, 0xc1, 0xdd, 0xd7, 0x9c,
+	0x8d, 0xa2, 0xb1, 0x19, 0x75, 0x8a, 0x18, 0x8f, 0xf5, 0x07, 0x00, 0x9e, 0xb5, 0x08, 0x88, 0x39,
+	0xc7, 0x90, 0x09, 0xf3, 0xac, 0xf0, 0x20, 0x6b, 0xb2, 0xf3, 0x9d, 0x21, 0xa2, 0x1d, 0xba, 0x53,
+	0x62, 0x94, 0x3d, 0xf1, 0x53, 0xdd, 0x85, 0x3b, 0x88, 0x1b, 0x12, 0xc7, 0x72, 0x26, 0xc4, 0xb4,
+	0x66, 0x33, 0xf7, 0x19, 0x99, 0x9a, 0x42, 0xf2, 0x08, 0x23, 0xf2, 0x35, 0x09, 0x49, 0x67, 0x38,
+	0xfb, 0x02, 0x45, 0x1d, 0x40, 0x23, 0x08, 0x5d, 0x1f, 0xf7, 0x18, 0x41, 0x2b, 0x0e, 0xa3, 0x10,
+	0xcc, 0x27, 0xf1, 0xce, 0xca, 0x81, 0x8c, 0x18, 0x72, 0x87, 0xe3, 0x1a, 0x5b, 0x41, 0x12, 0xa0,
+	0x3e, 0x84, 0xea, 0x17, 0xc8, 0x39, 0x8c, 0x12, 0x01, 0xdd, 0xd3, 0x51, 0x6c, 0x87, 0xf2, 0x14,
+	0x9d, 0x7b, 0x60, 0x54, 0xbe, 0x88, 0x0b, 0xea, 0x0f, 0x60, 0x8b, 0xe6, 0xae, 0x98, 0x91, 0x35,
+	0x49, 0x77, 0x7b, 0xe4, 0xea, 0xa0, 0x29, 0x2c, 0x91, 0xed, 0x69, 0xd4, 0xc3, 0x44, 0x59, 0xfd,
+	0x0e, 0x54, 0x82, 0x89, 0xe5, 0x98, 0x9e, 0x3b, 0xb3, 0x27, 0x17, 0x54, 0x18, 0xc4, 0xbb, 0x73,
+	0x62, 0x39, 0x43, 0x0a, 0x37, 0x20, 0x88, 0x7e, 0xab, 0x9f, 0xc0, 0x6d, 0x41, 0xb0, 0xe5, 0x7c,
+	0xa8, 0x32, 0x25, 0xdc, 0x2d, 0x8e, 0xa0, 0xa7, 0xd3, 0xa2, 0x7e, 0x1f, 0x5c, 0xa7, 0x61, 0x1d,
+	0x66, 0xcb, 0x78, 0xbe, 0x7b, 0x62, 0xe3, 0x1e, 0x05, 0xca, 0xb0, 0xf7, 0x57, 0xd2, 0xed, 0x71,
+	0x84, 0x3f, 0xe4, 0xe8, 0x4c, 0xcf, 0xab, 0x4f, 0x97, 0x2a, 0xd4, 0x0f, 0xa1, 0xca, 0x26, 0x62,
+	0xfa, 0x8b, 0x19, 0x11, 0x21, 0x73, 0x3e, 0x1d, 0x3e, 0x95, 0xc5, 0x8c, 0x18, 0x15, 0x2f, 0xfa,
+	0x8d, 0x61, 0xac, 0xda, 0x09, 0x61, 0x39, 0x44, 0x27, 0x33, 0xcc, 0x00, 0xa8, 0xde, 0x55, 0xe2,
+	0xed, 0xb3, 0xcf, 0xaa, 0xf6, 0xb1, 0xc6, 0xa8, 0x9e, 0x48, 0x25, 0x39, 0xed, 0xa5, 0x46, 0x8f,
+	0x9b, 0xa2, 0x98, 0xf2, 0x96, 0xd4, 0x37, 0x7b, 0x4b, 0xb6, 0x52, 0xde, 0x12, 0x75, 0x0c, 0x8d,
+	0xe8, 0xb4, 0x6b, 0xf2, 0x9d, 0xd3, 0xa0, 0x33, 0x79, 0x6f, 0x25, 0x85, 0xfa, 0x02, 0x59, 0xa7,
+	0xb8, 0x8c, 0x3c, 0x5b, 0x4e, 0x12, 0x8a, 0x2a, 0x28, 0xf4, 0xb1, 0x45, 0x7b, 0x4a, 0x33, 0xb2,
+	0xca, 0x46, 0x91, 0x96, 0xbb, 0x53, 0xf5, 0x97, 0xe0, 0xc6, 0x94, 0xa0, 0x64, 0xb0, 0xc2, 0xc4,
+	0x2e, 0x50, 0xe5, 0xbc, 0x8c, 0x54, 0xa7, 0x7b, 0xd1, 0x07, 0xd1, 0x96, 0x60, 0x1d, 0x5f, 0x9f,
+	0x2e, 0xd7, 0xa8, 0xa7, 0x70, 0xcb, 0x27, 0xde, 0x4c, 0x18, 0xb1, 0xa1, 0xbf, 0x08, 0x42, 0x7a,
+	0xf4, 0x08, 0x78, 0xc6, 0xd6, 0xb7, 0x56, 0x76, 0x62, 0xc4, 0xdf, 0x8c, 0xf1, 0x13, 0x3c, 0x9a,
+	0xf0, 0x6e, 0x6e, 0xfa, 0xab, 0xea, 0x5a, 0xbf, 0x08, 0xb7, 0xd6, 0x30, 0xcc, 0x8a, 0xe8, 0xd9,
+	0x07, 0x72, 0x1e, 0x40, 0xfd, 0xc1, 0x2d, 0x36, 0x86, 0xa5, 0xef, 0xa5, 0x04, 0x81, 0xd6, 0x7b,
+	0xb0, 0x95, 0x22, 0xf7, 0x3a, 0xf1, 0xd6, 0x3a, 0x83, 0x1b, 0xab, 0x56, 0x66, 0x65, 0x14, 0x4f,
+	0x1a, 0x47, 0x65, 0x8d, 0xfc, 0x48, 0xb5, 0x25, 0x0f, 0x6a, 0x1f, 0x63, 0xa4, 0xab, 0x97, 0xe3,
+	0x45, 0xb2, 0x1f, 0x5a, 0xdf, 0x06, 0x88, 0x49, 0x89, 0x07, 0xeb, 0x09, 0xf1, 0x79, 0x44, 0x82,
+	0x88, 0xd9, 0x25, 0x60, 0x2d, 0x1b, 0x5a, 0xeb, 0xd7, 0x68, 0x45, 0xdf, 0xdf, 0x4d, 0xce, 0xf4,
+	0xcd, 0x95, 0x33, 0x8d, 0x9b, 0x91, 0x53, 0x33, 0x7a, 0x50, 0x8e, 0x64, 0x39, 0xba, 0x11, 0x8d,
+	0xa3, 0x7e, 0x9f, 0x45, 0x1f, 0xae, 0x41, 0xed, 0x89, 0xd1, 0x1d, 0x77, 0x46, 0xe6, 0x50, 0x3f,
+	0x1a, 0xd1, 0x18, 0x44, 0x1d, 0x40, 0xef, 0xf5, 0x44, 0x39, 0x83, 0x9e, 0xc6, 0x43, 0xbd, 0xdb,
+	0x1f, 0x77, 0xfa, 0x7a, 0xbf, 0xdd, 0x69, 0x64, 0xb5, 0x4f, 0x60, 0x2b, 0x25, 0x90, 0x31, 0x17,
+	0x61, 0x68, 0x0c, 0xc6, 0x83, 0xc6, 0xd7, 0x54, 0x15, 0xea, 0xf4, 0xa7, 0xa9, 0xf7, 0xf7, 0xcc,
+	0x4f, 0x47, 0x83, 0x3e, 0xf3, 0x93, 0xd3, 0x5f, 0x19, 0xed, 0x37, 0xb2, 0xb0, 0xb5, 0x8b, 0xc3,
+	0x0b, 0x7d, 0xcb, 0xbb, 0x44, 0xc7, 0xfd, 0xe2, 0x6a, 0x81, 0x97, 0x91, 0x77, 0x56, 0xaa, 0xad,
+	0x17, 0x92, 0x78, 0xab, 0x74, 0x68, 0xf6, 0x6a, 0x3a, 0x34, 0xad, 0x6f, 0x72, 0x57, 0xd2, 0x37,
+	0x4b, 0xd2, 0x32, 0x7f, 0x35, 0x69, 0xf9, 0x55, 0xef, 0x4c, 0xed, 0x6f, 0x28, 0x50, 0x63, 0x04,
+	0x7c, 0x64, 0xa3, 0x6a, 0xbd, 0x58, 0xeb, 0x3b, 0x4b, 0x60, 0xa5, 0x4f, 0x8d, 0x67, 0xe2, 0xd0,
+	0x18, 0x65, 0xee, 0x28, 0xeb, 0x32, 0x77, 0x32, 0xe9, 0xcc, 0x9d, 0xfb, 0x50, 0x98, 0xd0, 0xb6,
+	0x9b, 0x59, 0x59, 0x05, 0x27, 0xd9, 0xdb, 0xe0, 0x38, 0xda, 0xcf, 0x32, 0x50, 0x95, 0xe9, 0x85,
+	0x51, 0x73, 0xf2, 0x94, 0x38, 0x61, 0x60, 0x4e, 0xed, 0xc0, 0x3a, 0x9e, 0x11, 0x91, 0x0a, 0x51,
+	0x67, 0xe0, 0x3d, 0x0e, 0x55, 0x1f, 0xc2, 0xf6, 0x4f, 0x02, 0xf4, 0x05, 0x70, 0xd6, 0x8d, 0xf1,
+	0x99, 0xf7, 0xe0, 0x06, 0xd6, 0x0a, 0xbe, 0x8e, 0xbe, 0xc2, 0x5c, 0x20, 0xea, 0x54, 0x33, 0xad,
+	0xc9, 0x2c, 0x10, 0x9e, 0x34, 0x06, 0xd2, 0x27, 0x33, 0xda, 0xff, 0x17, 0x0b, 0x37, 0xb4, 0xa4,
+	0xfe, 0xd9, 0x99, 0xa4, 0xce, 0xc0, 0x51, 0x4b, 0xef, 0x42, 0x5d, 0x28, 0x09, 0x0c, 0xd6, 0x84,
+	0x8c, 0x09, 0x4a, 0x46, 0x4d, 0x40, 0xd1, 0xae, 0x47, 0x8f, 0xc3, 0xed, 0xc0, 0x9e, 0x11, 0x67,
+	0x42, 0xa6, 0x26, 0x9d, 0x81, 0x19, 0xe9, 0x24, 0x16, 0x8f, 0x29, 0x1b, 0xb7, 0x04, 0x42, 0x07,
+	0xeb, 0x23, 0x11, 0xc7, 0x2c, 0x61, 0xfa, 0xc9, 0x4f, 0xdc, 0x05, 0xe6, 0xfb, 0x52, 0xa3, 0xa6,
+	0x64, 0x54, 0x29, 0xf0, 0x53, 0x06, 0xd3, 0xfe, 0x96, 0x02, 0x10, 0x1b, 0x29, 0x34, 0x2f, 0x69,
+	0x62, 0x39, 0x4e, 0x9c, 0x18, 0xd3, 0x4c, 0x1b, 0x32, 0xf4, 0xa7, 0x43, 0x7c, 0x23, 0xc2, 0xc4,
+	0x59, 0xfb, 0x84, 0x85, 0x8b, 0x4d, 0xcf, 0x0a, 0x02, 0x22, 0x0e, 0x14, 0x75, 0x01, 0x1e, 0x52,
+	0x68, 0x6b, 0x0f, 0x8a, 0xfc, 0x6b, 0x1a, 0x93, 0x61, 0x3f, 0x63, 0x06, 0x29, 0x73, 0x48, 0x77,
+	0x8a, 0x67, 0x0c, 0x7b, 0x4a, 0x9c, 0xd0, 0x0e, 0x45, 0x30, 0x3d, 0x2a, 0x6b, 0xff, 0x1f, 0xd4,
+	0x93, 0x26, 0xd9, 0xba, 0x8c, 0x5a, 0x11, 0x68, 0xe0, 0x19, 0xb5, 0xbc, 0xa8, 0x3d, 0x83, 0x2a,
+	0xfd, 0x7e, 0x68, 0x5d, 0x88, 0x74, 0x1e, 0xcf, 0xba, 0x88, 0x93, 0x16, 0x68, 0x41, 0x40, 0x85,
+	0xb7, 0x9f, 0x15, 0xa8, 0x90, 0x9a, 0x4b, 0xee, 0x71, 0x5e, 0xba, 0x5a, 0x0e, 0xd2, 0xaf, 0x2a,
+	0x50, 0x91, 0xa4, 0x02, 0x75, 0x21, 0x5a, 0xcf, 0xcd, 0xf8, 0x5c, 0x48, 0x4f, 0xa8, 0x73, 0xeb,
+	0x39, 0x3b, 0x33, 0x06, 0x78, 0xd2, 0x41, 0x84, 0xe3, 0x8b, 0x90, 0x93, 0x34, 0x67, 0x94, 0xe6,
+	0xd6, 0xf3, 0x5d, 0x2c, 0xab, 0x1f, 0xc2, 0xcd, 0x89, 0x3b, 0xf7, 0x7c, 0x42, 0x03, 0xc3, 0x66,
+	0x78, 0xe6, 0x93, 0x00, 0x83, 0xfa, 0x7c, 0x64, 0x37, 0xa4, 0xca, 0xb1, 0xa8, 0xd3, 0xf6, 0xa1,
+	0x62, 0xd0, 0x8c, 0xcb, 0x85, 0x13, 0x32, 0x4f, 0x9f, 0x38, 0x91, 0x84, 0x96, 0x1f, 0xf2, 0x23,
+	0x62, 0x85, 0x9f, 0x47, 0x10, 0x84, 0x74, 0x60, 0x07, 0x68, 0xb6, 0xa4, 0xac, 0xa0, 0xfd, 0x49,
+	0x05, 0xb6, 0x84, 0x9a, 0x14, 0x8d, 0x6d, 0x72, 0x44, 0xbc, 0x06, 0xe5, 0x89, 0x35, 0x9b, 0x11,
+	0x29, 0x30, 0x5d, 0x62, 0x80, 0x2e, 0x3d, 0x8c, 0xda, 0xce, 0x53, 0x77, 0xc2, 0x1d, 0x11, 0x6c,
+	0xfc, 0x32, 0x48, 0xfd, 0x3a, 0x6c, 0xcd, 0xac, 0x20, 0x34, 0x11, 0x76, 0x2e, 0x87, 0xf1, 0x6a,
+	0x08, 0xee, 0x32, 0xa8, 0x1e, 0x6a, 0xff, 0x5a, 0x81, 0xda, 0x7e, 0x6a, 0x07, 0x95, 0x63, 0x6b,
+	0x8c, 0xb1, 0xf4, 0xeb, 0x5c, 0xd0, 0xca, 0x78, 0x51, 0xc9, 0x88, 0xd1, 0x5b, 0xbf, 0xae, 0x40,
+	0x49, 0xc0, 0x37, 0xce, 0x2e, 0x35, 0x81, 0xcc, 0xf2, 0x04, 0x90, 0x1b, 0xe9, 0x74, 0xa3, 0xd3,
+	0x34, 0x2f, 0x5e, 0x79, 0x6a, 0x23, 0xa8, 0x1f, 0xda, 0xa7, 0xbe, 0x25, 0x86, 0xcc, 0x22, 0x6a,
+	0x93, 0x33, 0x32, 0xb7, 0x22, 0x5f, 0xb5, 0xc2, 0xe3, 0xbd, 0x14, 0x2a, 0x1c, 0xd5, 0xb2, 0xa7,
+	0x22, 0x93, 0xf2, 0x54, 0xfc, 0x96, 0x02, 0xf5, 0x5d, 0x6b, 0x72, 0x7e, 0x62, 0xcf, 0x66, 0x71,
+	0x0e, 0xd9, 0x8a, 0xe4, 0xb6, 0x44, 0x3c, 0x29, 0x93, 0x8e, 0x27, 0xc9, 0x5d, 0x64, 0x93, 0x5d,
+	0xe0, 0xde, 0x9c, 0xba, 0x8e, 0xf0, 0x8d, 0xd1, 0xdf, 0xb8, 0x5b, 0x84, 0xf5, 0x2e, 0x3b, 0x67,
+	0x44, 0x4a, 0x11, 0x8b, 0x37, 0xfd, 0xd9, 0x0c, 0x6c, 0x75, 0x9d, 0x90, 0x9c, 0x62, 0xf2, 0xa4,
+	0x41, 0x30, 0x7a, 0x77, 0x49, 0x58, 0x6b, 0xc3, 0x4c, 0xa3, 0x61, 0x64, 0x93, 0xc3, 0x98, 0x60,
+	0xc0, 0x2c, 0x1a, 0x06, 0xf3, 0x66, 0x54, 0x39, 0x90, 0x0e, 0x43, 0xfd, 0x21, 0xc0, 0x53, 0xdb,
+	0x9d, 0xf1, 0xa5, 0x65, 0x79, 0xd6, 0xdc, 0xe8, 0x4a, 0x8d, 0x6e, 0xe7, 0xb1, 0xc0, 0x33, 0xa4,
+	0x4f, 0x5a, 0x9f, 0x43, 0x39, 0xaa, 0xb8, 0x3c, 0x9c, 0x44, 0x49, 0x9f, 0x91, 0x49, 0xdf, 0x84,
+	0xe2, 0x9c, 0x04, 0x81, 0xc8, 0xff, 0x2f, 0x1b, 0xa2, 0xa8, 0xfd, 0x73, 0x05, 0x6e, 0x72, 0x77,
+	0x6a, 0x8a, 0x4e, 0xaf, 0x22, 0x46, 0xb0, 0x0d, 0x05, 0x2a, 0xcc, 0x45, 0xc4, 0x88, 0x97, 0x58,
+	0x02, 0xd2, 0xc4, 0xf5, 0xa7, 0x91, 0x72, 0x8b, 0xca, 0x74, 0x93, 0x58, 0xf6, 0x6c, 0xe1, 0xf3,
+	0x24, 0xfc, 0xb2, 0x11, 0x95, 0xd3, 0x01, 0x93, 0x42, 0x3a, 0x60, 0xa2, 0xcd, 0x69, 0xe2, 0xdc,
+	0xb4, 0xed, 0x7a, 0x36, 0xc1, 0xc4, 0xf1, 0xc2, 0x84, 0xfe, 0x4a, 0x3a, 0x26, 0x63, 0x8c, 0x9d,
+	0xb6, 0xeb, 0x5d, 0x18, 0x1c, 0xa9, 0xf5, 0x6d, 0xc8, 0x61, 0x19, 0x0d, 0xa1, 0x85, 0x6f, 0x0b,
+	0x43, 0x68, 0xe1, 0xdb, 0xeb, 0x82, 0x9d, 0xda, 0x3f, 0x56, 0x40, 0x1d, 0x60, 0xa4, 0x22, 0x38,
+	0xb3, 0xbd, 0xf6, 0x19, 0x6e, 0x47, 0xee, 0x4c, 0x74, 0x5c, 0x27, 0x62, 0x2f, 0x56, 0x48, 0xfb,
+	0x2e, 0x33, 0x9b, 0x7d, 0x97, 0xd9, 0xd4, 0xc2, 0x52, 0x27, 0x71, 0xb0, 0x90, 0x23, 0xff, 0x25,
+	0x06, 0xd8, 0xbd, 0x90, 0x2a, 0xa3, 0xb8, 0x3f, 0xaf, 0x5c, 0xca, 0xbd, 0x2a, 0xa4, 0x73, 0xaf,
+	0x7e, 0x4f, 0x81, 0x7a, 0x34, 0x87, 0xa1, 0xef, 0xba, 0x27, 0x5f, 0xc9, 0xf8, 0xa3, 0xb4, 0xbe,
+	0x9c, 0x9c, 0xd6, 0xb7, 0x21, 0x24, 0x98, 0x08, 0x97, 0x17, 0x52, 0xe1, 0x72, 0xec, 0xcb, 0xf3,
+	0xdd, 0xa7, 0xc4, 0x89, 0xc3, 0xf3, 0x25, 0x06, 0xd0, 0xc3, 0xd8, 0x68, 0x2c, 0xc5, 0x46, 0xa3,
+	0xf6, 0x9f, 0x14, 0xa8, 0x30, 0x4e, 0x3f, 0xa0, 0x59, 0x26, 0xaf, 0x82, 0xbf, 0xef, 0x43, 0x1e,
+	0x55, 0xa2, 0xf0, 0xa7, 0x6e, 0xcb, 0xf1, 0x18, 0xda, 0xcb, 0xce, 0x23, 0x77, 0x36, 0x35, 0x18,
+	0x52, 0x6b, 0x06, 0x39, 0x2c, 0xae, 0x34, 0x35, 0xe2, 0x8c, 0x8f, 0x4c, 0x22, 0xe3, 0x03, 0xe7,
+	0x39, 0xb3, 0x26, 0x6c, 0xd9, 0x99, 0x1f, 0xb2, 0xc4, 0x00, 0x6c, 0xd9, 0x79, 0x65, 0x24, 0xf1,
+	0x79, 0xa5, 0x1e, 0x6a, 0xff, 0x4e, 0x01, 0x38, 0xa0, 0x0e, 0xe0, 0xaf, 0x7c, 0x3b, 0xbf, 0x0f,
+	0xf9, 0x53, 0x7a, 0x38, 0xcd, 0xc9, 0xdb, 0x2c, 0xee, 0x9c, 0xfd, 0x64, 0x38, 0xad, 0x1e, 0xe4,
+	0xb0, 0xb8, 0x8e, 0x0a, 0xbc, 0x83, 0x4c, 0xa2, 0x83, 0x26, 0x14, 0xb9, 0x0c, 0x10, 0xf2, 0x8b,
+	0x17, 0xb5, 0x7f, 0x9a, 0x81, 0x2d, 0x74, 0x71, 0xdb, 0x0e, 0xcd, 0xc7, 0x7b, 0x65, 0x53, 0xbd,
+	0x2c, 0xde, 0x7d, 0x83, 0x79, 0xdc, 0x2f, 0x44, 0xbc, 0x80, 0x16, 0x62, 0x42, 0xe4, 0x2f, 0x27,
+	0x84, 0xfa, 0x31, 0x94, 0x8e, 0x67, 0xee, 0x84, 0x3a, 0xba, 0x0b, 0x72, 0x4c, 0x2d, 0x35, 0x9f,
+	0x9d, 0x5d, 0x86, 0x65, 0x44, 0xe8, 0xad, 0x01, 0x14, 0x39, 0x10, 0xc9, 0x88, 0xcd, 0x09, 0x32,
+	0xe2, 0x6f, 0x24, 0x57, 0xb0, 0xa0, 0xfb, 0x52, 0xd8, 0xad, 0xbc, 0xb8, 0x2e, 0xb1, 0x48, 0xfb,
+	0x05, 0xa4, 0x62, 0xe0, 0xb9, 0x4e, 0x40, 0x9e, 0x58, 0xbe, 0x83, 0x07, 0x71, 0x15, 0x72, 0xd4,
+	0x0a, 0xe5, 0x0d, 0xe3, 0xef, 0x84, 0x01, 0x93, 0x49, 0x19, 0x30, 0xeb, 0x75, 0xcc, 0x1f, 0x53,
+	0xa0, 0x21, 0x5a, 0x3f, 0x24, 0xa1, 0x35, 0xb5, 0x42, 0x2b, 0xe1, 0x08, 0x53, 0x92, 0x8e, 0xb0,
+	0xef, 0x40, 0xe9, 0x19, 0x1b, 0x84, 0x38, 0xa2, 0xdf, 0x14, 0x84, 0x49, 0x0c, 0xd1, 0x88, 0xd0,
+	0xd4, 0xf7, 0xa0, 0x21, 0x2e, 0x4e, 0x46, 0x6e, 0x60, 0x36, 0x0a, 0x71, 0xa1, 0x52, 0x1c, 0xc4,
+	0xb4, 0x9f, 0x29, 0xa0, 0xb6, 0x5d, 0x27, 0x58, 0xcc, 0x89, 0x4f, 0x73, 0x5d, 0xe8, 0x45, 0x05,
+	0x94, 0x6e, 0x13, 0x0e, 0x8d, 0x87, 0x04, 0x02, 0xd4, 0x9d, 0xc6, 0x02, 0x2c, 0xb3, 0x4e, 0x80,
+	0x65, 0x93, 0x02, 0x0c, 0x6f, 0x42, 0xe0, 0x22, 0x99, 0xce, 0x62, 0x7e, 0xcc, 0x05, 0x5f, 0xce,
+	0xa8, 0x50, 0x58, 0x9f, 0x82, 0x62, 0x41, 0x95, 0x97, 0x4e, 0xb7, 0x34, 0xcd, 0x97, 0x29, 0xc3,
+	0x58, 0x60, 0x83, 0x00, 0xe9, 0x21, 0x4a, 0xb2, 0x9a, 0xf0, 0xe9, 0xb6, 0xcf, 0x16, 0xaf, 0x28,
+	0x9e, 0xff, 0x36, 0x44, 0xd9, 0x14, 0xf4, 0x84, 0xc8, 0xa7, 0x53, 0x15, 0xc0, 0x3e, 0xdf, 0xa0,
+	0xee, 0xc9, 0x49, 0x40, 0x44, 0x06, 0x11, 0x2f, 0x51, 0xd3, 0xc8, 0x0a, 0x2d, 0x91, 0x83, 0x82,
+	0xbf, 0xb1, 0xbf, 0xd0, 0x0d, 0xad, 0x19, 0x0b, 0x7e, 0x15, 0x28, 0x7e, 0x99, 0x42, 0x68, 0xf4,
+	0xab, 0x01, 0x59, 0xe2, 0x9e, 0xf0, 0x13, 0x25, 0xfe, 0x94, 0xb4, 0x6c, 0x29, 0xa1, 0x65, 0xff,
+	0x76, 0x06, 0xaa, 0x06, 0xf1, 0x2c, 0xdb, 0x37, 0x28, 0x11, 0x36, 0xda, 0xd1, 0x9b, 0xad, 0xcc,
+	0x8d, 0x2a, 0x2a, 0xde, 0x1c, 0xb9, 0x84, 0x0c, 0xde, 0x86, 0xc2, 0x31, 0x39, 0xc1, 0x30, 0x3a,
+	0x9b, 0x1e, 0x2f, 0x21, 0x47, 0x58, 0x27, 0x21, 0xf1, 0xb9, 0x76, 0x62, 0x05, 0xb6, 0x7c, 0x38,
+	0x58, 0x39, 0x7d, 0x11, 0x04, 0x68, 0x17, 0x85, 0x84, 0x2a, 0x21, 0x88, 0x8c, 0x78, 0xa6, 0xaa,
+	0xb6, 0x62, 0x3c, 0x96, 0x3a, 0x2f, 0xb7, 0x66, 0x85, 0xcd, 0xb2, 0x60, 0x06, 0x06, 0xd2, 0xc3,
+	0xc4, 0x3e, 0x82, 0xc4, 0x3e, 0xd2, 0xfe, 0xb3, 0x02, 0x37, 0x23, 0xcd, 0x6e, 0x10, 0x2b, 0x40,
+	0xf5, 0x49, 0x8f, 0xab, 0x1a, 0xd4, 0x4e, 0x7c, 0x77, 0x6e, 0x46, 0xac, 0xcb, 0xa8, 0x58, 0x41,
+	0xe0, 0x80, 0xb3, 0xef, 0x1b, 0x50, 0x09, 0xdd, 0x18, 0x83, 0x93, 0x32, 0x74, 0x45, 0xfd, 0x8b,
+	0x1a, 0xec, 0xef, 0x41, 0xc3, 0xe7, 0x63, 0x48, 0xd9, 0xec, 0x5b, 0x31, 0x9c, 0xd9, 0xcb, 0xdf,
+	0x83, 0x5b, 0x0b, 0x47, 0x42, 0x5e, 0x72, 0x58, 0x6c, 0xcb, 0xd5, 0xb1, 0xbf, 0x42, 0x9b, 0x42,
+	0x5e, 0x9f, 0xd9, 0x16, 0x4d, 0xd7, 0xe4, 0x29, 0x3c, 0x52, 0xb6, 0x13, 0x83, 0xf0, 0x1c, 0x65,
+	0x29, 0xc3, 0x34, 0xb3, 0x39, 0xc3, 0x34, 0x9b, 0xce, 0xee, 0xff, 0x6f, 0x0a, 0xdc, 0x6c, 0xbb,
+	0x73, 0x6f, 0x66, 0xd3, 0x90, 0x54, 0x18, 0x92, 0x20, 0xb4, 0x5e, 0x59, 0xbe, 0x32, 0xde, 0x80,
+	0x44, 0xfb, 0x4a, 0x5c, 0x55, 0x43, 0xcb, 0x0a, 0xdb, 0x75, 0x27, 0x0b, 0x7a, 0x63, 0x93, 0x46,
+	0x24, 0x99, 0x11, 0x55, 0x15, 0x40, 0x9a, 0x2f, 0xd8, 0x82, 0x92, 0x45, 0xc7, 0xc2, 0xef, 0xaa,
+	0x95, 0x8d, 0xa8, 0x4c, 0x13, 0xf4, 0xe9, 0xef, 0x44, 0xc2, 0xa3, 0x00, 0xb1, 0x84, 0xc7, 0x08,
+	0x21, 0x4e, 0x78, 0x14, 0x20, 0x3d, 0xd4, 0xfe, 0x62, 0x86, 0x79, 0x79, 0xf8, 0x11, 0xef, 0x55,
+	0xcc, 0x34, 0xe9, 0xbf, 0xc9, 0xa6, 0xfd, 0x37, 0x0f, 0x68, 0x60, 0x67, 0x6a, 0x4f, 0x98, 0xb0,
+	0xa9, 0xcb, 0x7e, 0x24, 0x36, 0x8a, 0x9d, 0xc7, 0xac, 0xde, 0x10, 0x88, 0x7c, 0xbb, 0xb8, 0x3e,
+	0x27, 0x53, 0x3e, 0xda, 0x7c, 0xae, 0xcf, 0x88, 0x24, 0x0b, 0xd7, 0x98, 0x10, 0x02, 0x24, 0x2e,
+	0x59, 0xc4, 0xd2, 0xb7, 0xb8, 0x24, 0x7d, 0xef, 0x40, 0x91, 0x77, 0x8b, 0xce, 0xe8, 0x7d, 0xbd,
+	0xdb, 0x63, 0x77, 0xcb, 0x87, 0x3a, 0x26, 0xcf, 0x6a, 0xff, 0x22, 0x03, 0xb9, 0xd1, 0xb1, 0x3b,
+	0x7f, 0x25, 0x14, 0x7a, 0x0f, 0x0a, 0x98, 0x57, 0x66, 0x89, 0xb4, 0x75, 0x71, 0xfb, 0xf2, 0xd8,
+	0x9d, 0xef, 0xec, 0xd3, 0x0a, 0x83, 0x23, 0xe0, 0xea, 0x0b, 0x6e, 0x10, 0xc7, 0x03, 0x51, 0x5e,
+	0x66, 0x9f, 0xfc, 0x0a, 0xf6, 0xe1, 0xa7, 0x9e, 0x42, 0x7c, 0xea, 0x61, 0xb7, 0xd1, 0x3c, 0xd7,
+	0xa1, 0x89, 0x59, 0x45, 0x76, 0xd5, 0x3a, 0x86, 0x70, 0x9e, 0xb1, 0x26, 0x67, 0x8c, 0x96, 0xa5,
+	0x88, 0xa9, 0x28, 0x28, 0x62, 0x2a, 0x86, 0x10, 0x0b, 0x2f, 0x01, 0xd2, 0x43, 0xed, 0x2d, 0x28,
+	0xb0, 0x69, 0x20, 0x01, 0x47, 0xc3, 0xbd, 0xcf, 0x1b, 0x5f, 0xa3, 0x19, 0xc7, 0x3f, 0x6e, 0xf7,
+	0x06, 0xfd, 0xce, 0xde, 0xe7, 0x0d, 0x45, 0x7b, 0x1b, 0x6a, 0x38, 0xdd, 0xb6, 0xe8, 0x16, 0xf7,
+	0x87, 0x17, 0xdf, 0xa2, 0xa4, 0xbf, 0xb5, 0x7f, 0xa2, 0x40, 0x3d, 0xc2, 0x38, 0x42, 0xa3, 0x43,
+	0x7d, 0x98, 0x76, 0x3b, 0xb7, 0xc4, 0xe1, 0x4f, 0x46, 0x4b, 0xf9, 0x9d, 0x13, 0x39, 0x53, 0x99,
+	0x44, 0xce, 0x54, 0xcb, 0x7c, 0xa1, 0x3c, 0xa6, 0xcb, 0x37, 0x39, 0x9d, 0x44, 0x56, 0x9a, 0xc4,
+	0xef, 0x28, 0xd0, 0x4c, 0x85, 0x6a, 0x3b, 0xcf, 0x27, 0xc4, 0x7b, 0x65, 0x92, 0xa5, 0x09, 0x45,
+	0x1e, 0x21, 0x16, 0xa6, 0x0a, 0x2f, 0xae, 0xd5, 0x7c, 0xb8, 0x80, 0x1e, 0x3d, 0x56, 0xd1, 0x15,
+	0xe6, 0xdb, 0x49, 0x80, 0xf8, 0x0a, 0x0b, 0x84, 0xd8, 0x56, 0x11, 0x20, 0x3d, 0xd4, 0xfe, 0x61,
+	0x16, 0x20, 0x0e, 0xf9, 0xae, 0x34, 0xfa, 0x5f, 0x97, 0xdd, 0x6b, 0x2c, 0x17, 0x23, 0x06, 0xa4,
+	0xaf, 0xb9, 0x65, 0x97, 0xaf, 0xb9, 0x7d, 0x02, 0xe0, 0xf9, 0x64, 0x6a, 0x4f, 0xa4, 0x23, 0x48,
+	0x2b, 0x1d, 0x6c, 0xde, 0x19, 0x0a, 0x14, 0x43, 0xc2, 0x46, 0x07, 0x68, 0xe4, 0x76, 0xb6, 0x62,
+	0x41, 0x2e, 0x3c, 0x0f, 0x37, 0x44, 0xa5, 0x24, 0xe4, 0xa9, 0xb1, 0x89, 0x49, 0x9e, 0x89, 0xb4,
+	0xc5, 0x02, 0xd3, 0x64, 0x73, 0xdb, 0x91, 0x93, 0x16, 0x5b, 0x3f, 0xa3, 0xd7, 0x59, 0x78, 0x77,
+	0x6b, 0xfc, 0x62, 0x1f, 0x40, 0xc6, 0xf5, 0x78, 0x88, 0xe5, 0xce, 0xfa, 0x71, 0xef, 0x0c, 0x3c,
+	0x23, 0xe3, 0x7a, 0xc9, 0x1c, 0x32, 0x11, 0x38, 0xd4, 0x9e, 0x40, 0x66, 0xe0, 0xf1, 0xfb, 0xba,
+	0xa3, 0x4e, 0x7f, 0xcc, 0xde, 0x60, 0xd0, 0x77, 0xe9, 0x6f, 0x9a, 0xd2, 0xdf, 0xf9, 0xd1, 0x91,
+	0xde, 0x1b, 0x35, 0x32, 0x18, 0x95, 0xeb, 0x0f, 0xc6, 0x26, 0x2f, 0x67, 0x71, 0xc3, 0x1d, 0x76,
+	0xfb, 0x66, 0x7b, 0x70, 0xd4, 0x1f, 0x37, 0x72, 0xb4, 0xa8, 0x7f, 0xce, 0x8b, 0x79, 0xed, 0xbb,
+	0x50, 0x19, 0x4a, 0x61, 0xfa, 0xaf, 0x43, 0x9e, 0x05, 0xf5, 0x95, 0x35, 0x41, 0x7d, 0x56, 0xad,
+	0xfd, 0x18, 0xb6, 0x57, 0xaa, 0x48, 0xf6, 0xbe, 0x86, 0x4c, 0x69, 0xd6, 0xd0, 0x6b, 0xf1, 0xee,
+	0x5c, 0xfa, 0xc6, 0x48, 0x7c, 0xa0, 0xfd, 0x76, 0x16, 0x40, 0x77, 0x1c, 0x97, 0x95, 0x5f, 0x32,
+	0x25, 0x6c, 0x95, 0xb6, 0xc5, 0x4c, 0x53, 0xeb, 0x62, 0xe6, 0x5a, 0x53, 0x59, 0xd9, 0x56, 0x38,
+	0x4c, 0xe4, 0xe6, 0x5b, 0x6c, 0x08, 0x5c, 0xd9, 0x56, 0x8d, 0x18, 0x80, 0x0d, 0x44, 0x85, 0xf8,
+	0x4e, 0x64, 0x25, 0x82, 0x75, 0xa7, 0x18, 0xef, 0x88, 0x51, 0xe6, 0x81, 0x17, 0xdd, 0x89, 0xac,
+	0x47, 0xe0, 0x43, 0x84, 0x4a, 0x6d, 0xc9, 0xf7, 0x5d, 0x2a, 0x11, 0x4c, 0x76, 0x77, 0x94, 0xa5,
+	0x53, 0xc4, 0xb7, 0xa2, 0x6b, 0x28, 0x20, 0x07, 0xef, 0x62, 0xc2, 0xa5, 0x6f, 0xa2, 0xbc, 0x05,
+	0x55, 0x4c, 0xe3, 0xf1, 0x45, 0x47, 0x15, 0xd6, 0x51, 0x04, 0x63, 0xe2, 0x3a, 0xbe, 0x40, 0xf2,
+	0xb8, 0x3b, 0xea, 0xee, 0xf6, 0x3a, 0x8c, 0xd1, 0x1e, 0x75, 0xf7, 0xf6, 0x3a, 0xfd, 0x86, 0xa2,
+	0xfd, 0x09, 0x05, 0x2a, 0x71, 0x1f, 0x81, 0xfa, 0x00, 0x2a, 0x56, 0x5c, 0x4c, 0x72, 0x4d, 0x8c,
+	0x67, 0xc8, 0x48, 0xf4, 0x0a, 0xa2, 0x3d, 0x9d, 0x12, 0x87, 0xc7, 0x0b, 0x78, 0xe9, 0xcb, 0x26,
+	0xb4, 0xfe, 0x17, 0x05, 0xae, 0xf3, 0x2b, 0xcb, 0xcc, 0x33, 0xc3, 0x4f, 0x11, 0xaf, 0xc8, 0x4d,
+	0x20, 0xe5, 0xff, 0x65, 0x97, 0xf2, 0xff, 0x90, 0x39, 0xa9, 0x05, 0xcd, 0x96, 0x38, 0xc7, 0x99,
+	0x13, 0x41, 0x6c, 0x79, 0xa3, 0x53, 0x65, 0x5e, 0x3e, 0x55, 0xc6, 0xaf, 0x9c, 0x48, 0x17, 0x92,
+	0x21, 0x7e, 0x8b, 0xe4, 0x92, 0x57, 0x34, 0xb4, 0xff, 0x90, 0x81, 0xa2, 0xbe, 0x98, 0x5c, 0x5d,
+	0x73, 0x6c, 0x43, 0x21, 0x20, 0x18, 0x4c, 0x10, 0x0e, 0x4e, 0x56, 0x92, 0x2e, 0x33, 0x65, 0xe5,
+	0xcb, 0x4c, 0xbc, 0xed, 0x34, 0x0b, 0xbd, 0x06, 0x65, 0xd7, 0x23, 0x4e, 0xc2, 0x1f, 0xc5, 0x00,
+	0x7a, 0x48, 0x8f, 0xc3, 0xf6, 0xd4, 0x9c, 0x12, 0x6b, 0x3a, 0xb3, 0x1d, 0xc2, 0xdd, 0x94, 0x95,
+	0x63, 0x7b, 0xba, 0xc7, 0x41, 0x2c, 0x08, 0xf8, 0x94, 0x58, 0xb3, 0x18, 0x8b, 0x69, 0x94, 0x3a,
+	0x03, 0x47, 0x88, 0xdb, 0x50, 0x78, 0x66, 0x3b, 0x48, 0x36, 0x76, 0xbc, 0xe2, 0x25, 0x9e, 0x1d,
+	0x87, 0x2e, 0x01, 0x93, 0x87, 0xd8, 0x4a, 0xf4, 0xd8, 0x59, 0xe3, 0x50, 0x9d, 0x02, 0x51, 0x80,
+	0x2f, 0x1c, 0xeb, 0x99, 0x45, 0x8d, 0x3c, 0xae, 0xf8, 0xd8, 0xde, 0xd9, 0x8a, 0xe0, 0x06, 0x05,
+	0x6b, 0x6f, 0x44, 0x2c, 0x5f, 0x82, 0xdc, 0x60, 0xd8, 0xe9, 0x33, 0x7e, 0x6f, 0xf7, 0x06, 0x34,
+	0xc5, 0x41, 0xfb, 0xe3, 0x0a, 0x64, 0x77, 0x6d, 0x4a, 0xc0, 0x63, 0xe4, 0x52, 0x11, 0x01, 0xe4,
+	0xa5, 0xcb, 0x6e, 0xf4, 0x33, 0x4f, 0x38, 0xce, 0x2d, 0xf2, 0x32, 0x45, 0x65, 0x29, 0x50, 0x98,
+	0x4b, 0x04, 0x0a, 0x13, 0x6e, 0xbf, 0x7c, 0xca, 0xed, 0xf7, 0xbf, 0x14, 0x28, 0x72, 0xeb, 0xe1,
+	0x6a, 0x4b, 0x1f, 0xa7, 0x62, 0x8a, 0x38, 0x65, 0x54, 0x46, 0x31, 0x47, 0x9e, 0x4f, 0x66, 0x8b,
+	0xc0, 0x7e, 0x2a, 0xb6, 0x5c, 0x0c, 0x40, 0x26, 0xb4, 0x18, 0x23, 0xc4, 0x19, 0xfe, 0x65, 0x0e,
+	0xe9, 0xca, 0xc3, 0xcf, 0x27, 0x86, 0x9f, 0xbc, 0x01, 0x5a, 0x48, 0xdd, 0x00, 0x45, 0xde, 0x17,
+	0xfd, 0xc7, 0x37, 0xc5, 0x41, 0x80, 0xba, 0xec, 0x1d, 0xb2, 0x93, 0x13, 0x76, 0x68, 0x28, 0x71,
+	0x97, 0x0b, 0x96, 0xbb, 0x53, 0xed, 0xcf, 0x67, 0x21, 0x3f, 0xc0, 0xdf, 0x57, 0x9e, 0xba, 0x70,
+	0xf0, 0x88, 0xa9, 0x8b, 0xf2, 0x25, 0xd7, 0x1b, 0xbe, 0x19, 0xed, 0x0b, 0x76, 0x34, 0xe1, 0x89,
+	0x17, 0xb4, 0xef, 0xf4, 0xae, 0xf8, 0x00, 0x4a, 0xd6, 0x33, 0xcb, 0x0e, 0xe3, 0xd4, 0xc4, 0x6b,
+	0x32, 0x36, 0xea, 0xa1, 0x0b, 0x23, 0x42, 0x91, 0xc8, 0x56, 0x48, 0x90, 0x2d, 0xb1, 0x16, 0xc5,
+	0xf4, 0x5a, 0xa0, 0x3f, 0x92, 0xe6, 0x2f, 0x97, 0x58, 0x88, 0x95, 0x16, 0x52, 0x62, 0xa2, 0x9c,
+	0xbe, 0x56, 0x93, 0xcc, 0x80, 0x83, 0xf4, 0x7d, 0xc1, 0x9d, 0x15, 0xbc, 0x5f, 0x85, 0x92, 0xde,
+	0x6e, 0x77, 0x86, 0xec, 0x92, 0x71, 0x15, 0x4a, 0x46, 0xe7, 0xd3, 0x4e, 0x7b, 0x4c, 0xaf, 0x19,
+	0xbf, 0x03, 0x79, 0x3a, 0x19, 0x34, 0x21, 0x86, 0x47, 0xbb, 0xbd, 0xee, 0xe8, 0x51, 0xc7, 0x60,
+	0xdf, 0xb4, 0x07, 0xfd, 0xd1, 0xd1, 0x61, 0xc7, 0x68, 0x28, 0xda, 0x9f, 0xc9, 0x40, 0x85, 0xda,
+	0xde, 0x2f, 0x22, 0x86, 0x37, 0xad, 0x54, 0xca, 0x73, 0x97, 0x5d, 0xf2, 0xdc, 0xa1, 0x8e, 0xb7,
+	0x89, 0xb8, 0x35, 0x45, 0x7f, 0x47, 0x6f, 0x84, 0xe4, 0xa5, 0x37, 0x42, 0x5a, 0x50, 0xfa, 0x62,
+	0x61, 0xb1, 0x84, 0x01, 0x46, 0xfb, 0xa8, 0x9c, 0x7a, 0x3f, 0xa4, 0x78, 0xe9, 0xfb, 0x21, 0xa5,
+	0xe5, 0xd8, 0x7d, 0xfa, 0x68, 0x59, 0x5e, 0x3a, 0x5a, 0xfe, 0x66, 0x1e, 0x8a, 0x18, 0xad, 0xb5,
+	0xd9, 0xfd, 0x3a, 0x8f, 0xf8, 0xb6, 0x2b, 0xe8, 0xc1, 0x4b, 0x57, 0x7e, 0x55, 0x70, 0x03, 0xf3,
+	0xca, 0xc4, 0xcc, 0x6d, 0x26, 0x66, 0x7e, 0x89, 0x98, 0x4b, 0x33, 0x2d, 0xac, 0x98, 0xe9, 0x3d,
+	0x7a, 0xeb, 0x86, 0xb0, 0x43, 0x63, 0x94, 0x96, 0xc4, 0xa7, 0xb6, 0xd3, 0xb3, 0x1d, 0x62, 0x30,
+	0x04, 0xe4, 0x5b, 0xea, 0x12, 0xe4, 0x82, 0x9a, 0x15, 0x24, 0xb5, 0x53, 0x96, 0xd5, 0x8e, 0x68,
+	0x60, 0xd9, 0x72, 0x39, 0x25, 0x0e, 0xf1, 0x93, 0x8c, 0x5c, 0x89, 0x60, 0x4c, 0xa8, 0x78, 0x2c,
+	0x55, 0xc3, 0xf4, 0xc9, 0x09, 0xb5, 0x6d, 0xca, 0x06, 0x70, 0x90, 0x41, 0x4e, 0xa8, 0x2f, 0x82,
+	0x84, 0xe1, 0x8c, 0x1d, 0x74, 0xaa, 0x3c, 0xdc, 0xc4, 0x20, 0xcc, 0x23, 0x24, 0xaa, 0xad, 0xb0,
+	0x59, 0xe3, 0xd7, 0x7f, 0x19, 0x44, 0x0f, 0x13, 0xaf, 0x35, 0x9d, 0x59, 0x18, 0xb9, 0xac, 0xaf,
+	0x7a, 0xc8, 0x06, 0xab, 0xe2, 0xd7, 0x9a, 0x28, 0x62, 0xeb, 0x0f, 0xe2, 0xa3, 0x0c, 0xa8, 0xd3,
+	0x04, 0x97, 0x2a, 0x2b, 0xb8, 0xf4, 0x05, 0x5e, 0xb2, 0x91, 0x99, 0x38, 0x97, 0x62, 0xe2, 0x35,
+	0x12, 0x59, 0x7b, 0x73, 0xc5, 0x46, 0xc7, 0xdb, 0xe9, 0x9d, 0xf1, 0xb8, 0x47, 0xb5, 0xdc, 0x93,
+	0xf8, 0xe9, 0x1f, 0x1c, 0xf5, 0x9a, 0xa7, 0x7f, 0x6e, 0x43, 0x89, 0xfe, 0x88, 0xb9, 0xb2, 0x48,
+	0xcb, 0x09, 0x5d, 0x90, 0xc8, 0x79, 0xd1, 0xfe, 0x99, 0x12, 0xb5, 0xcc, 0x0e, 0xd7, 0x2f, 0xc5,
+	0xf6, 0x97, 0x4a, 0x82, 0xab, 0xa4, 0xd8, 0xac, 0xd5, 0x5b, 0x29, 0x1e, 0x2a, 0xa4, 0x79, 0x48,
+	0xfb, 0x4b, 0x19, 0x68, 0x08, 0x32, 0x85, 0x56, 0x48, 0x8f, 0x80, 0x09, 0xa2, 0x28, 0x4b, 0x44,
+	0xe1, 0x73, 0xcd, 0x24, 0xe6, 0x7a, 0x3f, 0x76, 0x5d, 0x64, 0x57, 0xb0, 0x51, 0xca, 0x65, 0xf1,
+	0x10, 0x0a, 0x74, 0xd3, 0x88, 0xa3, 0xef, 0xeb, 0x49, 0x9e, 0x13, 0x03, 0xd9, 0x19, 0x23, 0x92,
+	0xc1, 0x71, 0x13, 0xb6, 0x74, 0x7e, 0xbd, 0x2d, 0x5d, 0x48, 0xda, 0xd2, 0xad, 0x3d, 0xc8, 0xd3,
+	0x76, 0x96, 0x29, 0xa9, 0x6c, 0xa4, 0x64, 0x26, 0xb1, 0xea, 0xbf, 0x1f, 0x6e, 0xf1, 0xad, 0x7c,
+	0xc0, 0xf6, 0x68, 0x7c, 0xc7, 0x65, 0xc3, 0xfa, 0x0b, 0x4d, 0x26, 0xa7, 0x12, 0x89, 0x77, 0x66,
+	0xda, 0x22, 0x83, 0x2a, 0x38, 0xb7, 0x3d, 0x2f, 0x42, 0x62, 0x79, 0x32, 0x55, 0x0e, 0xa4, 0x48,
+	0xda, 0x9f, 0x52, 0xa0, 0x31, 0xa2, 0x3b, 0x97, 0xad, 0x1b, 0x55, 0x42, 0xff, 0xef, 0xd9, 0x4e,
+	0xfb, 0x25, 0x28, 0xf1, 0x3c, 0x44, 0xaa, 0xb1, 0x7c, 0xcb, 0x39, 0xe7, 0xc9, 0x38, 0xf4, 0x37,
+	0xf6, 0xc2, 0x33, 0x39, 0xe5, 0xe7, 0x61, 0x04, 0x88, 0xf9, 0x62, 0x22, 0x84, 0xf8, 0x79, 0x18,
+	0x01, 0xd2, 0x43, 0xed, 0x3f, 0x2a, 0x70, 0x5d, 0x74, 0x21, 0xbf, 0xbb, 0xf4, 0x71, 0xda, 0x55,
+	0xf6, 0x66, 0x22, 0x8d, 0x74, 0xba, 0xfc, 0xf0, 0xd2, 0x55, 0xfc, 0x65, 0xbf, 0xfc, 0x42, 0xfe,
+	0x32, 0x31, 0xe3, 0x8c, 0x34, 0xe3, 0x97, 0xb9, 0xdd, 0xf7, 0x57, 0x14, 0xa8, 0xeb, 0x93, 0xd0,
+	0x7e, 0x1a, 0x27, 0xb4, 0x7c, 0x00, 0xb9, 0x73, 0xdb, 0x99, 0xf2, 0x5b, 0x42, 0x3c, 0x0b, 0x35,
+	0x89, 0xb3, 0xf3, 0x99, 0xed, 0x4c, 0x0d, 0x8a, 0xc6, 0x2c, 0x73, 0x04, 0xc6, 0x26, 0x87, 0x28,
+	0xc7, 0x6e, 0xe6, 0xd4, 0x4b, 0x3c, 0xd1, 0xf3, 0x00, 0xef, 0x43, 0x0e, 0x9b, 0x42, 0x79, 0xfa,
+	0xb8, 0xdb, 0x79, 0xc2, 0x8c, 0xa0, 0xbd, 0xc1, 0x93, 0x7e, 0x6f, 0xa0, 0xa3, 0xe1, 0x54, 0x81,
+	0x62, 0xb7, 0x3f, 0x1a, 0xeb, 0xbd, 0x5e, 0x23, 0x83, 0xcf, 0xc5, 0x5d, 0x1f, 0xfb, 0xc4, 0xa1,
+	0x79, 0xa2, 0x57, 0x58, 0x97, 0x15, 0xb8, 0xe9, 0xfc, 0xd9, 0x5f, 0x7d, 0xb1, 0x5b, 0x97, 0x78,
+	0xbf, 0x9a, 0x13, 0x22, 0xb1, 0xbd, 0x6a, 0x02, 0xca, 0xf6, 0x97, 0xf4, 0x1a, 0x60, 0xf6, 0xb2,
+	0xd7, 0x00, 0xb5, 0xff, 0x9a, 0x81, 0x86, 0xb4, 0x3e, 0xee, 0x6c, 0xb6, 0xf0, 0x5e, 0x6e, 0x9f,
+	0xdd, 0xc1, 0x34, 0x2a, 0xf2, 0x2c, 0xf1, 0xb6, 0x40, 0x19, 0x21, 0x6c, 0x74, 0xf8, 0x44, 0x94,
+	0xfb, 0xcc, 0xa1, 0x7e, 0x1b, 0xf9, 0x95, 0x83, 0x9a, 0x80, 0x46, 0x42, 0xc2, 0x76, 0x82, 0xd0,
+	0x9a, 0xcd, 0xa4, 0x20, 0x54, 0xce, 0xa8, 0x72, 0x20, 0x43, 0xba, 0x0f, 0xea, 0x02, 0x6d, 0x54,
+	0x93, 0x59, 0x67, 0x1c, 0x93, 0x19, 0x85, 0x8d, 0x45, 0x6c, 0xbd, 0x32, 0xec, 0x8f, 0x20, 0x4f,
+	0x61, 0xdc, 0xdc, 0xb9, 0x9b, 0x7e, 0x67, 0x92, 0x4d, 0x7e, 0x07, 0x2f, 0x63, 0x33, 0xcb, 0x97,
+	0xa1, 0xb7, 0x06, 0x50, 0x8e, 0x60, 0x57, 0xd6, 0xff, 0xb2, 0x82, 0xcf, 0x26, 0x15, 0x3c, 0xbe,
+	0x9e, 0x53, 0x67, 0x9d, 0x0d, 0x7d, 0xf7, 0xd4, 0x27, 0x41, 0xb0, 0x96, 0xe2, 0xf8, 0x6a, 0x80,
+	0xbb, 0xf0, 0xc5, 0x86, 0xc3, 0xdf, 0x1b, 0x43, 0x7a, 0x6f, 0x43, 0xc4, 0x0c, 0xa6, 0x14, 0xdb,
+	0xab, 0x0a, 0xe0, 0x9e, 0xeb, 0xd0, 0x13, 0x21, 0x23, 0x1b, 0xc5, 0x60, 0x7a, 0xa5, 0x4c, 0x21,
+	0xb4, 0x5a, 0x84, 0x05, 0x0b, 0x52, 0x58, 0xf0, 0xeb, 0xb0, 0xe5, 0xa3, 0xbf, 0x64, 0x6a, 0x2e,
+	0x3c, 0xe9, 0x6e, 0x7f, 0xce, 0xa8, 0x31, 0xf0, 0x91, 0x17, 0xad, 0xae, 0x4f, 0x42, 0xcb, 0x8e,
+	0x83, 0x87, 0xfc, 0x68, 0x2f, 0xa0, 0x4c, 0xba, 0xff, 0xcf, 0x0c, 0xd4, 0x44, 0xa6, 0x37, 0xcd,
+	0x66, 0xde, 0x18, 0x2c, 0x8e, 0x3c, 0x67, 0x19, 0xc9, 0x73, 0x26, 0x0e, 0x4d, 0xae, 0x1c, 0x96,
+	0xe2, 0x90, 0x4b, 0x9f, 0x8d, 0x7c, 0xc8, 0x52, 0x86, 0x4f, 0xa3, 0x1c, 0x90, 0x56, 0x32, 0xfb,
+	0x9c, 0x8e, 0x09, 0x5f, 0x21, 0x70, 0x4e, 0x89, 0x21, 0x50, 0xa3, 0x67, 0xd4, 0x98, 0x2f, 0x30,
+	0xfd, 0x8c, 0x1a, 0x75, 0x05, 0xb2, 0x83, 0x6f, 0x14, 0xea, 0x2d, 0x26, 0x42, 0xbd, 0x68, 0x45,
+	0x16, 0x58, 0xa3, 0x2f, 0xe9, 0x0f, 0x6d, 0x42, 0x91, 0x5d, 0x44, 0x16, 0xee, 0x08, 0x51, 0xc4,
+	0x76, 0xe3, 0x17, 0xd1, 0xc4, 0xdd, 0x3c, 0x88, 0x9e, 0x44, 0x0b, 0xb4, 0xbf, 0xa3, 0xc0, 0xb5,
+	0x8e, 0x94, 0x18, 0xce, 0xe4, 0xcf, 0xa6, 0x94, 0x91, 0x95, 0xcf, 0x72, 0x26, 0xc9, 0x9f, 0xdb,
+	0x48, 0xfe, 0xfc, 0x06, 0xf2, 0x17, 0xae, 0x4c, 0x7e, 0xed, 0x67, 0xb9, 0xf8, 0xb6, 0x24, 0x7b,
+	0x61, 0xfb, 0x92, 0x2c, 0xd1, 0x6f, 0xe0, 0x93, 0xd7, 0xce, 0x84, 0x98, 0xe9, 0x6b, 0x08, 0x75,
+	0x0a, 0x1e, 0x47, 0xe3, 0x79, 0x03, 0x2a, 0x1c, 0xf1, 0xb9, 0x1c, 0xe3, 0xa4, 0x48, 0x38, 0x59,
+	0x0d, 0xaa, 0xa1, 0x6f, 0x39, 0x81, 0x15, 0xdd, 0x78, 0xa4, 0x06, 0x8b, 0x0c, 0xa3, 0x79, 0xfe,
+	0x18, 0xac, 0x4f, 0x4f, 0x9b, 0x86, 0xf0, 0xe3, 0xae, 0xde, 0x82, 0x6a, 0xe8, 0x4a, 0x48, 0xfc,
+	0x7d, 0x84, 0xd0, 0x8d, 0x51, 0xbe, 0x2f, 0x47, 0x5a, 0x8a, 0xc9, 0x94, 0x23, 0x79, 0xf6, 0xab,
+	0x32, 0x99, 0xd5, 0xef, 0xc6, 0xa4, 0x2d, 0xc9, 0x2e, 0xfb, 0xd4, 0xa7, 0x69, 0xd6, 0x96, 0x2d,
+	0x84, 0x72, 0xd2, 0xd0, 0x6c, 0x42, 0x29, 0x74, 0x39, 0x65, 0x58, 0xea, 0x42, 0x21, 0x74, 0x91,
+	0x2c, 0xad, 0x4f, 0xaf, 0x98, 0x34, 0x9d, 0x26, 0x5f, 0x66, 0x99, 0x7c, 0xad, 0x49, 0xb4, 0x33,
+	0x36, 0x26, 0xce, 0x4a, 0x8c, 0x9f, 0x49, 0x32, 0x7e, 0xba, 0x93, 0xec, 0x72, 0x27, 0xda, 0x0e,
+	0xd4, 0x69, 0x56, 0x7e, 0x7c, 0xd7, 0xee, 0xf5, 0x74, 0xd2, 0xb8, 0x1c, 0xd5, 0xd2, 0xfe, 0xa6,
+	0x02, 0x5b, 0x86, 0x3d, 0x39, 0xa3, 0x1f, 0xbd, 0xc4, 0x23, 0x33, 0x1b, 0xf3, 0x95, 0x1f, 0xc0,
+	0xcd, 0x13, 0x12, 0xd2, 0xe8, 0x2b, 0x53, 0x63, 0x81, 0xa4, 0x3a, 0xf3, 0xc6, 0x75, 0x5e, 0xc9,
+	0x34, 0x59, 0xc0, 0xc4, 0x2c, 0xa6, 0x8e, 0xd1, 0x08, 0xbc, 0x48, 0xcc, 0x15, 0x45, 0xed, 0x0f,
+	0x15, 0x21, 0x4f, 0x87, 0xfb, 0x15, 0xdd, 0xba, 0x8e, 0x53, 0x8b, 0x18, 0x81, 0x79, 0x09, 0x15,
+	0x8f, 0x4f, 0xc2, 0x85, 0xef, 0x98, 0x34, 0xd2, 0x15, 0x08, 0xc5, 0xc3, 0x80, 0x8f, 0x29, 0x4c,
+	0xdc, 0x72, 0x90, 0xb3, 0x4a, 0xf0, 0x96, 0x03, 0x9b, 0xd3, 0x86, 0x03, 0x8d, 0xf6, 0xd7, 0xf3,
+	0x00, 0xf1, 0x68, 0xf1, 0xca, 0x99, 0x3e, 0x1c, 0x9a, 0x7b, 0x9d, 0x51, 0xdb, 0xe8, 0x0e, 0xc7,
+	0x03, 0x74, 0x5f, 0xe1, 0x2d, 0xb6, 0xe1, 0xd0, 0xdc, 0x3d, 0xea, 0xef, 0xf5, 0x3a, 0xec, 0x56,
+	0x5b, 0x7b, 0xd0, 0xeb, 0x75, 0xda, 0xe3, 0x2e, 0x5e, 0x44, 0xc3, 0x07, 0xdd, 0x86, 0xdd, 0x7e,
+	0x23, 0x4b, 0x3f, 0x6e, 0xb7, 0x3b, 0xa3, 0x91, 0x69, 0x74, 0x7e, 0x74, 0xd4, 0x19, 0x61, 0x34,
+	0xad, 0x0e, 0x30, 0xec, 0x18, 0x87, 0xdd, 0xd1, 0x08, 0x91, 0xf3, 0xd4, 0x35, 0x66, 0x0c, 0x0e,
+	0x07, 0xf4, 0xdb, 0x02, 0x75, 0x25, 0x0f, 0xfa, 0xfb, 0xdd, 0x83, 0x46, 0x51, 0x6d, 0x40, 0xd5,
+	0xd0, 0xc7, 0x1d, 0x16, 0x79, 0xeb, 0x18, 0x8d, 0x92, 0x7a, 0x1b, 0x6e, 0x0e, 0x8d, 0xee, 0x63,
+	0x04, 0xb2, 0xde, 0x4d, 0xa3, 0xd3, 0x1e, 0x18, 0x7b, 0x8d, 0x32, 0x1a, 0x90, 0xfa, 0x11, 0x1b,
+	0x01, 0xe0, 0x08, 0x76, 0xbb, 0x7b, 0x8d, 0x0a, 0x42, 0x7b, 0xdd, 0x76, 0xa7, 0x3f, 0xea, 0x34,
+	0xaa, 0x78, 0x93, 0x6e, 0xb0, 0xbf, 0xdf, 0x31, 0x1a, 0x35, 0xfc, 0x79, 0x34, 0xd2, 0x0f, 0x3a,
+	0x8d, 0x3a, 0xb3, 0x3c, 0x1f, 0x0f, 0xba, 0xed, 0x4e, 0x63, 0x0b, 0x47, 0xc7, 0x0e, 0xf9, 0x87,
+	0x18, 0x26, 0x6c, 0x60, 0xa5, 0x31, 0xf8, 0xb1, 0xde, 0x1b, 0xff, 0xb8, 0x71, 0x0d, 0x2d, 0xd6,
+	0xfd, 0x8e, 0x8e, 0x4f, 0xd6, 0xef, 0x35, 0x54, 0xe6, 0xf8, 0x1b, 0x77, 0x1f, 0x77, 0xc7, 0x3f,
+	0x6e, 0x5c, 0xc7, 0x71, 0x1b, 0x83, 0x5e, 0xef, 0x68, 0xd8, 0xb8, 0xa1, 0x5e, 0x87, 0x2d, 0xf6,
+	0x3b, 0x7e, 0x43, 0xec, 0x26, 0x45, 0xe8, 0x0c, 0xf5, 0xae, 0xd1, 0xd8, 0xc6, 0xde, 0xf5, 0x5e,
+	0x57, 0x1f, 0x35, 0x6e, 0xa9, 0x2d, 0xd8, 0xa6, 0xcf, 0x89, 0x75, 0xf1, 0x02, 0xa0, 0xa9, 0x8f,
+	0xc7, 0x9d, 0xd1, 0x58, 0xa7, 0xb3, 0x68, 0xe2, 0xed, 0xc0, 0x51, 0x5b, 0xef, 0x9b, 0x46, 0x67,
+	0x74, 0xd4, 0x1b, 0x37, 0x6e, 0xd3, 0x9c, 0x80, 0xdd, 0xc1, 0x61, 0xa3, 0x85, 0x94, 0xc5, 0x5f,
+	0x26, 0x7e, 0x3b, 0xe8, 0xe3, 0x58, 0x5f, 0x53, 0xdf, 0x80, 0x96, 0x6e, 0x8c, 0xbb, 0xfb, 0x7a,
+	0x7b, 0x6c, 0xf2, 0x49, 0x9b, 0x9d, 0xcf, 0xd1, 0x35, 0x89, 0xcd, 0xbd, 0xce, 0xe6, 0xd2, 0xeb,
+	0x0d, 0x8e, 0xc6, 0x8d, 0x3b, 0x38, 0x84, 0x27, 0xfa, 0xb8, 0xfd, 0xa8, 0xf1, 0x06, 0x76, 0x83,
+	0x21, 0x52, 0xe3, 0x31, 0xeb, 0xf7, 0x4d, 0x6c, 0x7c, 0xff, 0xa8, 0x4f, 0x69, 0x69, 0xe2, 0x68,
+	0x46, 0x8d, 0xbb, 0xea, 0x2d, 0xb8, 0x3e, 0x78, 0xd2, 0xef, 0x18, 0xa3, 0x47, 0xdd, 0xa1, 0xd9,
+	0x7e, 0xa4, 0xf7, 0x7a, 0x9d, 0xfe, 0x41, 0xa7, 0xf1, 0x16, 0x4e, 0x36, 0xae, 0x18, 0x1a, 0x83,
+	0xc1, 0x7e, 0x43, 0xc3, 0x95, 0xe3, 0xeb, 0x73, 0xa0, 0x8f, 0x3b, 0xa3, 0xc6, 0xdb, 0xf8, 0xbd,
+	0x70, 0x79, 0x9a, 0xed, 0x47, 0x9d, 0xf6, 0x67, 0xc3, 0x41, 0xb7, 0x3f, 0x6e, 0xbc, 0x83, 0x73,
+	0xea, 0x0d, 0xda, 0x9f, 0x35, 0xde, 0xc5, 0xfb, 0x92, 0x9d, 0xc7, 0x9d, 0xfe, 0xd8, 0xfc, 0x74,
+	0x70, 0x64, 0xf4, 0xf5, 0x5e, 0xe3, 0xeb, 0x94, 0xd3, 0xfa, 0xfd, 0x01, 0xa7, 0xc8, 0x3d, 0x44,
+	0xe1, 0xcb, 0x61, 0x8e, 0x07, 0x63, 0xbd, 0xd7, 0x78, 0x4f, 0xfb, 0x23, 0x19, 0x7e, 0xff, 0x87,
+	0x0b, 0x8d, 0xb7, 0x20, 0x4f, 0xef, 0x05, 0xf2, 0x67, 0x64, 0x2a, 0xd2, 0x2e, 0x34, 0x58, 0xcd,
+	0x86, 0x33, 0x9a, 0xfa, 0x9d, 0xf8, 0x45, 0x09, 0xe6, 0x69, 0xb8, 0x25, 0x7f, 0x9f, 0x10, 0x38,
+	0x1c, 0x6f, 0xe3, 0x23, 0xfa, 0x2b, 0xde, 0xd2, 0xcd, 0xaf, 0x7c, 0x4b, 0xb7, 0xbd, 0xfe, 0x2d,
+	0xdd, 0xc4, 0xbd, 0xd8, 0xe8, 0x89, 0x94, 0x55, 0xaf, 0xe4, 0x16, 0x21, 0xdf, 0x99, 0x7b, 0xe1,
+	0x85, 0xa6, 0xc3, 0x35, 0xc9, 0xd6, 0xe6, 0x0f, 0x88, 0xde, 0x07, 0x35, 0x79, 0x78, 0x94, 0x32,
+	0xc1, 0x1a, 0x89, 0xb3, 0x22, 0x3e, 0x14, 0xf6, 0x1d, 0xa8, 0xf3, 0x98, 0x96, 0xf8, 0x1e, 0x33,
+	0x1b, 0x18, 0x44, 0xfa, 0x50, 0xc4, 0x3b, 0xf0, 0x93, 0xf7, 0xa1, 0x4a, 0x1d, 0xf8, 0xe2, 0x03,
+	0x0c, 0x7e, 0x61, 0x59, 0x42, 0x67, 0x71, 0x0a, 0x44, 0xfe, 0x6b, 0x78, 0x51, 0xc0, 0x23, 0xce,
+	0x0b, 0x76, 0xb2, 0x66, 0x16, 0x99, 0xd5, 0xb3, 0xa0, 0x61, 0x43, 0x7b, 0x1a, 0xbd, 0x0c, 0xc1,
+	0x8f, 0xa5, 0xc7, 0xf6, 0x94, 0x3f, 0x0b, 0xc1, 0x8c, 0x68, 0x1a, 0x60, 0x13, 0x38, 0xfc, 0x9e,
+	0x10, 0x83, 0x72, 0x34, 0xcd, 0x80, 0xad, 0x21, 0xc6, 0x93, 0x76, 0xed, 0xe9, 0x95, 0x47, 0x7a,
+	0xd9, 0xd3, 0xd5, 0x26, 0xe6, 0x00, 0x63, 0x27, 0x2f, 0xd2, 0xe8, 0x1a, 0x07, 0x12, 0xb2, 0x43,
+	0x60, 0xcd, 0x42, 0xc1, 0x0e, 0xf8, 0x5b, 0x3b, 0x86, 0x6b, 0x07, 0x44, 0x24, 0xce, 0x7c, 0x29,
+	0x2e, 0x48, 0x87, 0x9e, 0x32, 0xe9, 0xd0, 0x13, 0x3e, 0x8b, 0xd2, 0x38, 0xb4, 0xce, 0xc9, 0x95,
+	0x17, 0xfe, 0x05, 0x17, 0x70, 0xdd, 0xd5, 0xc0, 0x44, 0xec, 0x27, 0x97, 0x8a, 0xfd, 0x68, 0x67,
+	0x70, 0x9d, 0xdf, 0x9f, 0xbb, 0xfa, 0xb8, 0xd6, 0x51, 0x76, 0x63, 0xc4, 0x4f, 0xfb, 0x03, 0xb0,
+	0x3d, 0x22, 0xa1, 0xfc, 0x08, 0xfa, 0x97, 0x23, 0xf4, 0xf7, 0xd2, 0xff, 0x15, 0x21, 0x23, 0x5f,
+	0x5f, 0x4e, 0xb4, 0x9f, 0xf8, 0xb7, 0x08, 0xda, 0x63, 0x50, 0x47, 0x24, 0x14, 0x8e, 0xa9, 0x2f,
+	0xd7, 0xf9, 0x0a, 0x57, 0x93, 0x16, 0xc2, 0x4d, 0xe6, 0x01, 0x8a, 0xfd, 0x41, 0x5f, 0xa6, 0x69,
+	0xe1, 0x62, 0xca, 0x5c, 0xc9, 0xc5, 0xa4, 0x7d, 0x0e, 0x77, 0x0e, 0x48, 0xb8, 0xc2, 0x9d, 0x23,
+	0x7a, 0x8f, 0xef, 0x56, 0xe2, 0xf9, 0x5c, 0x5c, 0xef, 0xe4, 0x77, 0x2b, 0x1f, 0x21, 0x08, 0xe5,
+	0x65, 0xfc, 0x66, 0x4b, 0xcd, 0x60, 0x05, 0xed, 0x47, 0xa0, 0xea, 0xec, 0xf5, 0x65, 0x7c, 0xf0,
+	0x59, 0x34, 0xb7, 0xe9, 0xdd, 0xe7, 0x37, 0xa1, 0x12, 0x86, 0xb3, 0xd4, 0xab, 0x32, 0x10, 0x86,
+	0x91, 0x50, 0x78, 0x0f, 0x2a, 0x57, 0x6c, 0x4b, 0xfb, 0x29, 0xbc, 0x29, 0xdc, 0x23, 0xe9, 0x3c,
+	0x7b, 0x69, 0xeb, 0x6f, 0x4e, 0xb7, 0x4f, 0x67, 0xcf, 0x67, 0x36, 0x64, 0xcf, 0x4b, 0xc7, 0x47,
+	0xed, 0xe7, 0xe1, 0xf6, 0x97, 0xef, 0x15, 0x9d, 0xc3, 0x77, 0xda, 0xd4, 0xa5, 0xcc, 0x33, 0x46,
+	0xa2, 0x27, 0xf6, 0x44, 0x13, 0xc9, 0x8c, 0x0f, 0x65, 0x29, 0xe3, 0xe3, 0x1d, 0xe6, 0xb4, 0x5c,
+	0x4a, 0x1a, 0xa9, 0x5a, 0xd2, 0xbf, 0xbb, 0x51, 0x77, 0x00, 0x62, 0xac, 0xe4, 0x53, 0x01, 0x71,
+	0x8f, 0xe5, 0xe8, 0x13, 0xed, 0xa7, 0xa0, 0xb1, 0x61, 0xd1, 0xdb, 0xeb, 0xf4, 0x16, 0xb3, 0x35,
+	0x5b, 0x1a, 0xdb, 0x72, 0xdf, 0xca, 0xa5, 0x7d, 0x67, 0x2e, 0xed, 0xfb, 0xcf, 0x29, 0x70, 0x83,
+	0xa7, 0xea, 0x10, 0xfa, 0xda, 0xa9, 0x44, 0xcd, 0x97, 0xf0, 0x34, 0xc4, 0x19, 0x50, 0xf4, 0xea,
+	0x71, 0x9c, 0x84, 0x55, 0x8f, 0xc1, 0xe3, 0xab, 0xa5, 0x63, 0x69, 0x0e, 0xa8, 0x52, 0xd2, 0xd1,
+	0xab, 0x19, 0xdf, 0x06, 0x67, 0x98, 0xf6, 0xaf, 0x14, 0xb8, 0x7d, 0xc8, 0x13, 0xa3, 0xe2, 0x8e,
+	0xff, 0xef, 0xd3, 0x25, 0x91, 0x65, 0x96, 0x5b, 0xce, 0x32, 0xfb, 0x56, 0xea, 0x9d, 0xe6, 0xcb,
+	0x92, 0xc0, 0xbe, 0xf9, 0x09, 0x5c, 0x5b, 0x7a, 0xde, 0x21, 0xf1, 0x3f, 0x41, 0x68, 0xf6, 0xcb,
+	0x68, 0x6c, 0x74, 0xdb, 0x63, 0xe6, 0xc6, 0xee, 0xe1, 0x23, 0xe3, 0xfd, 0x71, 0x23, 0xf3, 0xe0,
+	0xdf, 0xd4, 0xa0, 0xa2, 0x7b, 0x9e, 0xf0, 0x07, 0xa8, 0x1f, 0x41, 0x45, 0x32, 0x79, 0x54, 0x9e,
+	0xbd, 0xbd, 0x6c, 0x05, 0xb5, 0x6a, 0x89, 0xa4, 0x22, 0xf5, 0x3e, 0x94, 0x84, 0xf5, 0xa1, 0xde,
+	0x8c, 0x1e, 0xdc, 0x94, 0xad, 0x91, 0x56, 0x99, 0x9f, 0x8c, 0xed, 0xa9, 0xba, 0x03, 0xe5, 0xc8,
+	0xae, 0x50, 0xb7, 0x85, 0x4b, 0x22, 0x69, 0x68, 0xc8, 0xf8, 0x1f, 0x42, 0xb5, 0x3d, 0x73, 0x03,
+	0x22, 0x7a, 0x4b, 0x66, 0x34, 0xad, 0x19, 0xd2, 0x77, 0x00, 0x0e, 0x48, 0xf8, 0x42, 0x9f, 0x3c,
+	0x04, 0x88, 0xcd, 0x11, 0x95, 0x13, 0x7e, 0xc9, 0x40, 0x11, 0x5f, 0x09, 0xbc, 0x6f, 0x43, 0x39,
+	0xb2, 0x2f, 0xc4, 0x6c, 0xd2, 0x06, 0x47, 0xab, 0x22, 0xa5, 0x8f, 0xa8, 0x1f, 0x41, 0x55, 0x56,
+	0xfe, 0x6a, 0xf4, 0xba, 0xc6, 0x92, 0x41, 0x90, 0xfc, 0x6e, 0x07, 0x2a, 0xf8, 0x88, 0xbd, 0x17,
+	0xb2, 0xa2, 0x9c, 0xc0, 0xb2, 0x0e, 0xdf, 0x20, 0xc8, 0xc1, 0x57, 0xc4, 0x7f, 0x1f, 0x4a, 0x07,
+	0xe4, 0xaa, 0xc8, 0x7b, 0xb0, 0x95, 0xb2, 0x2b, 0x54, 0x1e, 0xc6, 0x5c, 0x6d, 0x6e, 0xb4, 0x56,
+	0x85, 0x80, 0xd4, 0x7d, 0xb8, 0x75, 0x10, 0xa1, 0xef, 0xbb, 0xbe, 0x54, 0x75, 0x6b, 0xc9, 0x25,
+	0xcf, 0x1b, 0x5a, 0x61, 0x72, 0xa0, 0x7f, 0x43, 0x32, 0x32, 0x04, 0xe3, 0x2e, 0xdb, 0x1d, 0xad,
+	0x7a, 0x32, 0x4e, 0xa6, 0x7e, 0x17, 0x6a, 0x47, 0x4e, 0x20, 0x7d, 0xba, 0xb6, 0x5b, 0x3e, 0x7b,
+	0x7a, 0x7e, 0x51, 0xff, 0x7f, 0xd8, 0x3e, 0x88, 0x3f, 0x92, 0x23, 0x40, 0x32, 0x5a, 0xeb, 0xf6,
+	0xda, 0xa8, 0x9c, 0xda, 0x86, 0x3a, 0xb3, 0x2e, 0x84, 0xad, 0xa1, 0x46, 0xce, 0xb9, 0x15, 0x46,
+	0x4d, 0xeb, 0xc6, 0x2a, 0xc3, 0x44, 0xfd, 0x1c, 0xb6, 0x57, 0x5b, 0x23, 0xea, 0xdb, 0x11, 0xf7,
+	0xae, 0xb7, 0x55, 0xc4, 0xf0, 0x56, 0x7d, 0xff, 0x21, 0x54, 0x24, 0x6b, 0x44, 0x10, 0x74, 0xd9,
+	0x40, 0x69, 0x01, 0xdf, 0x0d, 0x88, 0xf5, 0x01, 0x32, 0xdc, 0x8c, 0x58, 0x01, 0xfb, 0xe8, 0x5a,
+	0x5c, 0xb5, 0x92, 0x88, 0xf7, 0xa0, 0x88, 0xbb, 0x6b, 0x0d, 0xaa, 0xdc, 0xf0, 0x2f, 0x40, 0x73,
+	0x9d, 0x75, 0xa2, 0xbe, 0x2b, 0xc8, 0xb6, 0xd1, 0x7a, 0x69, 0x35, 0xc5, 0x26, 0x5b, 0x6a, 0xc0,
+	0x80, 0x9b, 0x07, 0x24, 0x5c, 0x51, 0xf1, 0xe6, 0xba, 0x4f, 0x2e, 0x6f, 0xf3, 0x73, 0xd8, 0x5e,
+	0x6d, 0x93, 0x88, 0x85, 0xd9, 0x68, 0xb1, 0x88, 0x85, 0x59, 0x95, 0x02, 0x7b, 0x0c, 0xaf, 0x6d,
+	0x30, 0x2b, 0xd4, 0x7b, 0x72, 0xf3, 0x9b, 0x2c, 0x8f, 0x4d, 0x7d, 0xfc, 0x00, 0x6a, 0x09, 0xeb,
+	0x41, 0x6d, 0x25, 0x94, 0x50, 0xc2, 0xa4, 0x68, 0x2d, 0x65, 0x06, 0xab, 0x3f, 0x80, 0x3a, 0x8a,
+	0x5e, 0x27, 0x4e, 0x0f, 0x6e, 0xa6, 0x71, 0x22, 0x16, 0xbc, 0xb6, 0x54, 0xa3, 0x1e, 0x80, 0xba,
+	0xac, 0xa8, 0xc5, 0x62, 0xac, 0x55, 0xe1, 0xcb, 0xe3, 0x38, 0x2e, 0xd0, 0x7f, 0xff, 0xfa, 0xe1,
+	0xff, 0x19, 0x00, 0xc3, 0xd0, 0xa3, 0xc9, 0x0b, 0x76, 0x00, 0x00,
 }
@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Large artifacts are read in chunks, so a client fetching a bundle's content is not limited by
+// the size of a single response. getArtifactChunk returns at most MAX_ARTIFACT_CHUNK_BYTES of
+// one artifact or chaincode deployment spec, named as in a BundleIntegrityReport, from a byte
+// offset; the client asks for the next offset until a chunk has eof set, then checks the
+// reassembled bytes against the chunk's digest. The chunks are cut from the AppBundle as
+// stored, so the usual read access checks apply to every chunk.
+
+const MAX_ARTIFACT_CHUNK_BYTES = 1024 * 1024
+
+func (ac *assetContext) getArtifactChunk() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	artifact_name := ""
+	var offset, length uint64 = 0, MAX_ARTIFACT_CHUNK_BYTES
+
+	switch len(args) {
+	case 6:
+		value, err := strconv.ParseUint(string(args[5]), 10, 64)
+		if err != nil || value == 0 || value > MAX_ARTIFACT_CHUNK_BYTES {
+			return nil, fmt.Errorf("Error in getArtifactChunk, length must be between 1 and %d", MAX_ARTIFACT_CHUNK_BYTES)
+		}
+		length = value
+		fallthrough
+	case 5:
+		value, err := strconv.ParseUint(string(args[4]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getArtifactChunk, invalid offset %s", args[4])
+		}
+		offset = value
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		artifact_name = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getArtifactChunk")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getArtifactChunk: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getArtifactChunk: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getArtifactChunk: %s", err)
+	}
+
+	contents, names := bundleContents(appBundle)
+	index := -1
+	for i, name := range names {
+		if name == artifact_name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("Error in getArtifactChunk: AppBundle %s has no %s", app_bundle_key_part, artifact_name)
+	}
+	if appBundle.RetentionTier == AppBundle_COLD {
+		return nil, fmt.Errorf("Error in getArtifactChunk: AppBundle %s is COLD, %s is kept at %s", app_bundle_key_part, artifact_name, appBundle.ExternalUris[index])
+	}
+	content := contents[index]
+	total_size := uint64(len(content))
+	if offset > total_size {
+		return nil, fmt.Errorf("Error in getArtifactChunk: offset %d is beyond the %d bytes of %s", offset, total_size, artifact_name)
+	}
+	end := offset + length
+	if end > total_size {
+		end = total_size
+	}
+
+	artifactChunk := &ArtifactChunk{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		ArtifactName: artifact_name,
+		Offset:       offset,
+		Data:         content[offset:end],
+		TotalSize:    total_size,
+		Eof:          end == total_size,
+	}
+	if index < len(appBundle.ContentDigests) {
+		artifactChunk.Digest = appBundle.ContentDigests[index]
+	}
+	artifactChunkBytes, err := proto.Marshal(artifactChunk)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ArtifactChunk in getArtifactChunk: %s", err)
+	}
+	return artifactChunkBytes, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Each artifact of an AppBundle may carry the SPDX identifier of its license. Once the admins
+// set allowed_artifact_licenses in the RegistryConfig, every AppBundle created must carry only
+// allowed licenses; an artifact without an identifier counts as NOASSERTION, which admins may
+// allow like any other. A bundle needing a disallowed license is let through by an
+// ArtifactLicenseException, which an admin approves for its descriptor and bundle key, and
+// that license, before the bundle is created.
+
+// NO_LICENSE_ASSERTION is the SPDX identifier of an artifact whose license is not stated.
+const NO_LICENSE_ASSERTION = "NOASSERTION"
+
+// validLicenseId reports whether license is made of the characters of SPDX identifiers and
+// LicenseRef- identifiers.
+func validLicenseId(license string) bool {
+	if len(license) == 0 {
+		return false
+	}
+	for _, r := range license {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune(".-+", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkArtifactLicenses returns an error if the new AppBundle at key_part carries a license the
+// registry does not allow and has no ArtifactLicenseException for. The policy is not applied
+// in LENIENT namespaces.
+func (ac *assetContext) checkArtifactLicenses(key_part string, appBundle *AppBundle) error {
+	if len(appBundle.ArtifactLicenses) != 0 && len(appBundle.ArtifactLicenses) != len(appBundle.Artifacts) {
+		return fmt.Errorf("AppBundle has %d artifact_licenses for %d artifacts", len(appBundle.ArtifactLicenses), len(appBundle.Artifacts))
+	}
+	for i, license := range appBundle.ArtifactLicenses {
+		if !validLicenseId(license) {
+			return fmt.Errorf("artifact_licenses[%d] %q is not an SPDX license identifier", i, license)
+		}
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	if len(registryConfig.AllowedArtifactLicenses) == 0 {
+		return nil
+	}
+	if lenient, err := ac.isLenient(); err != nil || lenient {
+		return err
+	}
+	allowed := make(map[string]bool)
+	for _, license := range registryConfig.AllowedArtifactLicenses {
+		allowed[license] = true
+	}
+	for i := range appBundle.Artifacts {
+		license := NO_LICENSE_ASSERTION
+		if len(appBundle.ArtifactLicenses) != 0 {
+			license = appBundle.ArtifactLicenses[i]
+		}
+		if allowed[license] {
+			continue
+		}
+		excepted, err := ac.keyExists(COMPOSITE_KEY_ARTIFACT_LICENSE_EXCEPTION_OBJECTTYPE, []string{appBundle.DescriptorId, key_part, license})
+		if err != nil {
+			return err
+		}
+		if !excepted {
+			return fmt.Errorf("artifact %d has license %s, which is not allowed without an admin-approved exception", i, license)
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) setAllowedArtifactLicenses() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	var licenses []string
+	for _, arg := range args[1:] {
+		license := string(arg)
+		if !validLicenseId(license) {
+			return nil, fmt.Errorf("Error in setAllowedArtifactLicenses: %q is not an SPDX license identifier", license)
+		}
+		licenses = append(licenses, license)
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setAllowedArtifactLicenses: %s", err)
+	}
+	registryConfig.AllowedArtifactLicenses = licenses
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) approveArtifactLicenseException() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	license := ""
+	reason := ""
+
+	switch len(args) {
+	case 5:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		license = string(args[3])
+		reason = string(args[4])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to approveArtifactLicenseException")
+	}
+	if !validLicenseId(license) {
+		return nil, fmt.Errorf("Error in approveArtifactLicenseException: %q is not an SPDX license identifier", license)
+	}
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("Error in approveArtifactLicenseException: a reason is required")
+	}
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in approveArtifactLicenseException: %s", err)
+	}
+
+	approved_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in approveArtifactLicenseException: %s", err)
+	}
+	artifactLicenseException := &ArtifactLicenseException{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		License:      license,
+		Reason:       reason,
+		ApprovedBy:   ac.creator,
+		ApprovedAt:   approved_at,
+	}
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part, license}
+	return ac.putAsset(COMPOSITE_KEY_ARTIFACT_LICENSE_EXCEPTION_OBJECTTYPE, key_parts, artifactLicenseException)
+}
@@ -7,7 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	sc "github.com/hyperledger/fabric/protos/peer"
@@ -17,13 +20,63 @@ import (
 
 var COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE string = Query_APP_BUNDLE.String()
 var COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE = Query_APP_DESCRIPTOR.String()
+var COMPOSITE_KEY_COLLECTION_OBJECTTYPE = Query_COLLECTION.String()
+var COMPOSITE_KEY_PIN_OBJECTTYPE = Query_PIN.String()
+var COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE = Query_ACCESS_REQUEST.String()
+var COMPOSITE_KEY_PERMISSION_OBJECTTYPE = Query_PERMISSION.String()
+var COMPOSITE_KEY_PROMOTION_OBJECTTYPE = Query_PROMOTION.String()
+var COMPOSITE_KEY_CONFIG_OBJECTTYPE = Query_CONFIG.String()
+var COMPOSITE_KEY_RATE_COUNTER_OBJECTTYPE = Query_RATE_COUNTER.String()
+var COMPOSITE_KEY_PRIVATE_BUNDLE_RECORD_OBJECTTYPE = Query_PRIVATE_BUNDLE_RECORD.String()
+var COMPOSITE_KEY_AUCTION_OBJECTTYPE = Query_AUCTION.String()
+var COMPOSITE_KEY_BID_OBJECTTYPE = Query_BID.String()
+var COMPOSITE_KEY_LICENSE_OBJECTTYPE = Query_LICENSE.String()
+var COMPOSITE_KEY_OFFER_OBJECTTYPE = Query_OFFER.String()
+var COMPOSITE_KEY_USAGE_OBJECTTYPE = Query_USAGE.String()
+var COMPOSITE_KEY_INVOICE_OBJECTTYPE = Query_INVOICE.String()
+var COMPOSITE_KEY_SETTLEMENT_OBJECTTYPE = Query_SETTLEMENT.String()
+var COMPOSITE_KEY_ROYALTY_OBJECTTYPE = Query_ROYALTY.String()
+var COMPOSITE_KEY_FEATURED_OBJECTTYPE = Query_FEATURED.String()
+var COMPOSITE_KEY_ACTIVITY_OBJECTTYPE = Query_ACTIVITY.String()
+var COMPOSITE_KEY_ROLLUP_OBJECTTYPE = Query_ROLLUP.String()
+var COMPOSITE_KEY_ROLLUP_PROGRESS_OBJECTTYPE = Query_ROLLUP_PROGRESS.String()
+var COMPOSITE_KEY_REPAIR_OBJECTTYPE = Query_REPAIR.String()
+var COMPOSITE_KEY_ALIAS_OBJECTTYPE = Query_ALIAS.String()
+var COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE = Query_COMPLIANCE_ATTESTATION.String()
+var COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE = Query_SCAN_RESULT.String()
+var COMPOSITE_KEY_SBOM_OBJECTTYPE = Query_SBOM.String()
+var COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE = Query_SBOM_COMPONENT.String()
+var COMPOSITE_KEY_ARTIFACT_LICENSE_EXCEPTION_OBJECTTYPE = Query_ARTIFACT_LICENSE_EXCEPTION.String()
+var COMPOSITE_KEY_ROLLOUT_OBJECTTYPE = Query_ROLLOUT.String()
+var COMPOSITE_KEY_WATCH_OBJECTTYPE = Query_WATCH.String()
+var COMPOSITE_KEY_RESERVATION_OBJECTTYPE = Query_RESERVATION.String()
+var COMPOSITE_KEY_FUNCTION_STATS_OBJECTTYPE = Query_FUNCTION_STATS.String()
+var COMPOSITE_KEY_OWNERSHIP_CHALLENGE_OBJECTTYPE = Query_OWNERSHIP_CHALLENGE.String()
+var COMPOSITE_KEY_OWNERSHIP_PROOF_OBJECTTYPE = Query_OWNERSHIP_PROOF.String()
+var COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE = Query_BUNDLE_GATES.String()
+var COMPOSITE_KEY_CONSUMER_CHECKPOINT_OBJECTTYPE = Query_CONSUMER_CHECKPOINT.String()
+var COMPOSITE_KEY_LOCK_OBJECTTYPE = Query_LOCK.String()
+var COMPOSITE_KEY_EVENT_JOURNAL_OBJECTTYPE = Query_EVENT_JOURNAL.String()
+var COMPOSITE_KEY_ANNOTATION_OBJECTTYPE = Query_ANNOTATION.String()
+var COMPOSITE_KEY_ROYALTY_TOTAL_OBJECTTYPE = Query_ROYALTY_TOTAL.String()
 
 // AssetRegistry defines the smart contract structure.
 type AssetRegistry struct{}
 
-// Init is called when the chaincode is instantiatied, for now, a no-op
+// Init is called when the chaincode is instantiatied or upgraded, with the optional argument
+//   ["init", <BootstrapConfig>]
+// On first instantiation it creates the RegistryConfig from the BootstrapConfig, by default
+// making the instantiating identity the registry admin; on upgrade the BootstrapConfig updates
+// it. It then runs a bounded batch of any pending data migrations; see migration.go.
 func (s *AssetRegistry) Init(stub shim.ChaincodeStubInterface) sc.Response {
 	_ = &pb.SignedChaincodeDeploymentSpec{}
+	system := newSystemStub(stub)
+	if err := bootstrapRegistryConfig(system); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := migrateOnInit(system); err != nil {
+		return shim.Error(err.Error())
+	}
 	return shim.Success(nil)
 }
 
@@ -31,10 +84,154 @@ func (s *AssetRegistry) Init(stub shim.ChaincodeStubInterface) sc.Response {
 // Possible arguments are:
 //   ["createAppDescriptor",   <app_key>, <app_descriptor>]                 // Creates a new asset
 //   ["createAppBundle",   <app_bundle_key>,  <app_bundle>]                 // Creates a new asset
-//   ["associateDescriptorWithBundle", <app_key>, <app_bundle_key>]                 // Associates an AppBundle with an AppDescriptor
-//   ["getAppDescriptors", <query>]  // Queries the AppDescriptors
-//   ["getAppBundleKeySetForDescriptor", <app_descriptor_key>]
+//   ["associateDescriptorWithBundle", <app_key>, <app_bundle_key>, <range_check>]  // Associates an AppBundle with an AppDescriptor, see associateDescriptorWithBundle
+//   ["getAppDescriptors", <bookmark>]  // Queries the AppDescriptors, starting after the optional <bookmark>
+//   ["getAppDescriptor", <app_descriptor_key>]                             // Returns a single AppDescriptor, with its private details if authorized
+//   ["getAppDescriptorsByKeys", <key_list>]                                // Returns found/not-found per AppDescriptor key
+//   ["getAppBundlesByKeys", <bundle_key_list>]                             // Returns found/not-found per AppBundle key
+//   ["descriptorExists", <app_descriptor_key>]                             // Returns an ExistsResult
+//   ["bundleExists", <app_descriptor_key>, <app_bundle_key>]               // Returns an ExistsResult
+//   ["getAppBundleKeySetForDescriptor", <app_descriptor_key>, <bookmark>]
 //   ["getAppBundleForDescriptor",<app_descriptor_key>, <app_bundle_key>]
+//   ["getChildDescriptors", <app_descriptor_key>, <bookmark>]              // Queries the AppDescriptors whose parent is <app_descriptor_key>
+//   ["createCollection", <collection_key>, <collection>]                   // Creates a new Collection
+//   ["addDescriptorToCollection", <collection_key>, <app_descriptor_key>]  // Adds an AppDescriptor to a Collection
+//   ["getCollection", <collection_key>]
+//   ["pinDescriptor", <app_descriptor_key>]                                // Bookmarks an AppDescriptor for the caller
+//   ["unpinDescriptor", <app_descriptor_key>]
+//   ["getMyPinnedDescriptors", <bookmark>]                                 // Queries the AppDescriptors pinned by the caller
+//   ["requestAccess", <app_descriptor_key>, <app_bundle_key>, <justification>]   // Requests read access to a restricted AppBundle
+//   ["grantAccess", <app_descriptor_key>, <app_bundle_key>, <requester>]         // Owner approves a pending AccessRequest
+//   ["denyAccess", <app_descriptor_key>, <app_bundle_key>, <requester>]          // Owner rejects a pending AccessRequest
+//   ["promoteBundle", <app_descriptor_key>, <app_bundle_key>, <environment>]     // Promotes an AppBundle to DEV, STAGING or PROD
+//   ["exportAssetForChannel", <object_type>, <key_part>...]                // Returns an AssetEnvelope for the owner to sign
+//   ["importAssetFromChannel", <signed_asset_envelope>]                    // Mirrors an asset exported from another channel
+//   ["setReplicationTrustRoots", <mspid>, <pem_certificate>...]            // Admin only, the CAs exporters of <mspid> must chain to, none to remove
+//   ["computeRegistryChecksum", <namespace>]                               // Digest of all keys of an object type, or of the whole registry if empty
+//   ["validateOnly", <function>, <arg>...]                                 // Runs <function> without writing, returns a DryRunResult
+//   ["executeScript", <script>]                                            // Applies a Script of operations atomically
+//   ["ifMatch", <preconditions>, <function>, <arg>...]                     // Runs <function> only if all Preconditions hold
+//   ["setWriteRateLimit", <max_writes>, <window_seconds>]                  // Admin only, limits writes per identity
+//   ["pauseRegistry", <scope>]                                             // Admin only, halts WRITES (default) or ALL operations
+//   ["pauseRegistry", "MAINTENANCE", <key_list>]                           // Admin only, allows admins the listed and migration functions
+//   ["resumeRegistry"]                                                     // Admin only
+//   ["continueMigration"]                                                  // Admin only, runs the next batch of pending migrations
+//   ["getMigrationState"]
+//   ["backfill", <field>, <namespace>, <bookmark>]                         // Admin only, populates <field> on the next batch of assets
+//   ["setStorageEncoding", <encoding>]                                     // Admin only, writes assets as PROTO, PROTO_AND_JSON or JSON
+//   ["setQueryLimits", <max_results>, <max_bytes>, [compression_threshold]]   // Admin only, caps the results of any query, zero for the default
+//   ["queryAssetsByOwner", <namespace>, <owner_id>, <page_size>, <bookmark>]     // Pages through a namespace's assets owned by <owner_id>
+//   ["queryAccessRequestsByStatus", <status>, <page_size>, <bookmark>]           // Pages through the PENDING, GRANTED or DENIED AccessRequests the caller may read
+//   ["queryDescriptorsByTag", <tag>, <page_size>, <bookmark>]                    // Pages through the AppDescriptors tagged <tag>
+//   ["createPrivateAppBundle", <collection>, <app_bundle_key>]             // Stores the transient AppBundle in <collection>, returning its PrivateBundleRecord
+//   ["getPrivateAppBundles", <collection>, <query>]                        // Queries the AppBundles in <collection> by Query or CouchDB selector
+//   ["createConfidentialAppBundle", <app_bundle_key>]                      // Stores the transient AppBundle in the caller org's implicit collection
+//   ["getConfidentialAppBundle", <app_descriptor_key>, <app_bundle_key>]   // Reads it on a peer of the owner org, verifying the public record
+//   ["getPrivateBundleRecord", <app_descriptor_key>, <app_bundle_key>]
+//   ["setDescriptorPrivateDetails", <app_descriptor_key>]                  // Owner stores the transient DescriptorPrivateDetails in the private_collection
+//   ["setFieldCommitments", <app_descriptor_key>, <field_commitments>]     // Owner replaces the AppDescriptor's FieldCommitments
+//   ["verifyFieldCommitment", <app_descriptor_key>, <field>, <salt>, <value>]    // Checks a disclosed value against its FieldCommitment
+//   ["openAuction", <auction_key>, <app_descriptor_key>, <bid_seconds>, <reveal_seconds>]   // Owner auctions an exclusive License
+//   ["placeBid", <auction_key>, <commitment>]                              // Places or replaces the caller's sealed Bid
+//   ["revealBid", <auction_key>, <amount>, <salt>]                         // Reveals the caller's Bid after bidding closes
+//   ["closeAuction", <auction_key>]                                        // Awards the License to the highest revealed Bid
+//   ["getAuction", <auction_key>]
+//   ["getLicense", <app_descriptor_key>, [<licensee_id>]]                  // Defaults to the caller's License
+//   ["makeOffer", <offer_key>, <app_descriptor_key>, <amount>, <exclusive>]  // Consumer proposes License terms
+//   ["counterOffer", <offer_key>, <amount>, <exclusive>]                   // Awaited party counters, passing the turn
+//   ["acceptOffer", <offer_key>]                                           // Awaited party accepts, granting the License
+//   ["rejectOffer", <offer_key>]
+//   ["getOffer", <offer_key>]
+//   ["setPricingTiers", <app_descriptor_key>, <pricing_tiers>]            // Owner replaces the AppDescriptor's PricingTiers
+//   ["getPricingForDescriptor", <app_descriptor_key>]                      // Returns the AppDescriptor's PricingTiers
+//   ["recordUsage", <app_descriptor_key>, <tier>, <quantity>]              // Licensee reports metered use, priced by <tier>
+//   ["generateInvoices", <period>]                                         // Admin only, invoices the usage of a past YYYY-MM period
+//   ["markInvoiceSettled", <period>, <app_descriptor_key>, <consumer_id>, <currency_code>, <payment_ref>]   // Publisher or admin records payment
+//   ["getInvoice", <period>, <app_descriptor_key>, <consumer_id>, <currency_code>]
+//   ["setTokenChaincode", <name>, <channel>]                               // Admin only, verifies settlements with a token chaincode, empty to disable
+//   ["setRoyaltySplits", <app_descriptor_key>, <royalty_splits>]           // Owner divides future invoices among other parties
+//   ["getRoyaltyStatement", <party_id>, <period>, [bookmark]]              // A page of the party's shares of the period's settled Invoices, with the period's totals
+//   ["setFeatured", <app_descriptor_key>, <rank>]                          // Admin only, features an AppDescriptor on the storefront
+//   ["unsetFeatured", <app_descriptor_key>]                                // Admin only
+//   ["getFeaturedDescriptors"]                                             // The featured AppDescriptors in rank order
+//   ["reportActivity", <app_descriptor_key>, [<kind>]]                     // Reports a VIEW (default), DOWNLOAD or INSTALL
+//   ["getTrendingDescriptors", <window_hours>, [<limit>]]                  // Ranks AppDescriptors by activity reported in the window
+//   ["rollupActivity", <period>]                                           // Admin only, summarizes and prunes the next batch of a past period's records
+//   ["getActivityRollup", <period>, <app_descriptor_key>]
+//   ["getApiDescriptor"]                                                   // JSON description of the functions and their messages, for REST gateways
+//   ["checkIntegrity", <namespace>, <bookmark>]                            // Admin only, reports broken references in the next batch of assets
+//   ["repairDescriptorBundlePointer", <app_descriptor_key>, <app_bundle_key>, <reason>]   // Admin only, repoints or clears a broken bundle_id
+//   ["rebuildIndexEntry", <namespace>, <reason>, <key_part>...]            // Admin only, recomputes an asset's indexed fields and rewrites it
+//   ["getRepairRecord", <tx_id>]
+//   ["renameDescriptor", <old_app_descriptor_key>, <new_app_descriptor_key>]   // Owner re-keys an AppDescriptor and its AppBundles, leaving an Alias
+//   ["reassignOwnership", <from_owner_id>, <to_identity>, <bookmark>]      // Admin only, transfers the next batch of a departing member's assets
+//   ["attachComplianceAttestation", <app_descriptor_key>, <bundle_key>, <type>, <document_hash>, <attestor>]   // Attestor org attests an AppBundle
+//   ["getAttestationsForBundle", <app_descriptor_key>, <bundle_key>]      // Returns ComplianceAttestations
+//   ["registerScanner", <scanner_id>, <identity>]                          // Admin only, an empty identity deregisters the scanner
+//   ["setRequiredScanPasses", <required_passes>]                           // Admin only, 0 disables the scan gate
+//   ["recordScanResult", <app_descriptor_key>, <bundle_key>, <scanner_id>, <verdict>, <report_hash>]   // Registered scanner records PASS or FAIL
+//   ["attachSbom", <app_descriptor_key>, <bundle_key>, <Sbom>]             // Bundle owner attaches or replaces the Sbom
+//   ["getSbomForBundle", <app_descriptor_key>, <bundle_key>]
+//   ["findBundlesUsingComponent", <purl>]                                  // Returns ComponentUsage, any version unless the purl has one
+//   ["setAllowedArtifactLicenses", <license>...]                           // Admin only, no licenses allows any
+//   ["approveArtifactLicenseException", <app_descriptor_key>, <bundle_key>, <license>, <reason>]   // Admin only, before the AppBundle is created
+//   ["putPolicyRule", <PolicyRule>]                                        // Admin only, creates or replaces the rule of that name
+//   ["deletePolicyRule", <name>]                                           // Admin only
+//   ["getPolicyRules"]                                                     // Returns PolicyRules
+//   ["setValidationProfile", <namespace>, <profile>]                       // Admin only, APP_DESCRIPTOR or APP_BUNDLE to STANDARD, STRICT or LENIENT
+//   ["setFeatureFlag", <flag>, <value>]                                    // Admin only, a bool field of FeatureFlags, from the next transaction
+//   ["getFeatureFlags"]
+//   ["getConfig"]                                                          // Returns the RegistryConfig
+//   ["updateConfig", <expected_version>, <RegistryConfig>]                 // Admin only, fails unless the RegistryConfig is at expected_version
+//   ["getConfigHistory"]                                                   // Returns ConfigHistory
+//   ["getBundlesForDescriptorByLanguage", <app_descriptor_key>, <language>, <runtime>, <bookmark>] // GOLANG, NODE or JAVA, an empty runtime matches any
+//   ["getBundlesForDescriptorByPlatform", <app_descriptor_key>, <os>, <architecture>, <bookmark>] // AMD64, ARM64 or S390X, an empty os matches any
+//   ["getCompatibleBundles", <app_descriptor_key>, <fabric_version>, <bookmark>] // The AppBundles whose min_fabric_version is at most fabric_version
+//   ["createRollout", <app_descriptor_key>, <bundle_key>, <Rollout>]       // Descriptor owner only, the stages of a new plan
+//   ["advanceRollout", <app_descriptor_key>, <bundle_key>, <reason>]       // Descriptor owner only, starts the next stage or completes the rollout
+//   ["haltRollout", <app_descriptor_key>, <bundle_key>, <reason>]          // Descriptor owner only, advanceRollout resumes
+//   ["getRollout", <app_descriptor_key>, <bundle_key>]
+//   ["watchDescriptor", <app_descriptor_key>]                              // Adds the caller to the watcher_ids of the RegistryEvents changing it
+//   ["unwatchDescriptor", <app_descriptor_key>]
+//   ["verifyBundleIntegrity", <app_descriptor_key>, <bundle_key>]          // Re-hashes the content, returns a BundleIntegrityReport
+//   ["listQueryFunctions"]                                                 // Returns QueryFunctions, the functions gateways may evaluate
+//   ["exportAssetJSON", <object_type>, <key_part>...]                      // Returns the asset as canonical JSON for audit evidence
+//   ["appointNamespaceAdmin", <namespace>, <identity>]                     // Admin only, delegates the namespace's admin functions, see namespaceadmin.go
+//   ["revokeNamespaceAdmin", <namespace>, <identity>]                      // Admin only
+//   ["reserveDescriptorKey", <app_descriptor_key>, <ttl_seconds>]          // Only the caller may create the AppDescriptor until the Reservation expires
+//   ["getArtifactChunk", <app_descriptor_key>, <bundle_key>, <artifact_name>, <offset>, <length>]   // Returns an ArtifactChunk of at most <length> bytes, 1 MiB by default
+//   ["traced", <trace_id>, <function>, <arg>...]                           // Runs <function> under the trace ID, echoed in the response message
+//   ["getFunctionStats", <function>]                                       // Returns FunctionStats of all write functions, or of <function>
+//   ["demoteToCold", <app_descriptor_key>, <app_bundle_key>, <cold_copies>]   // Admin only, strips the content of an AppBundle copied off-chain
+//   ["issueOwnershipChallenge", <namespace>, <key_part>...]                // Returns an OwnershipChallenge for the owner of the asset to sign
+//   ["proveOwnership", <nonce>, <nonce_signature>]                         // Records and returns an OwnershipProof, see ownershipproof.go
+//   ["getOwnershipProof", <nonce>]                                         // Returns the OwnershipProof made for the challenge <nonce>
+//   ["associateDescriptorsWithBundles", <bundle_key_list>, <range_check>]  // Makes every association or none, see association.go
+//   ["placeBundleHold", <app_descriptor_key>, <app_bundle_key>, <name>, <reason>]   // Admin only, blocks associating the AppBundle until released
+//   ["releaseBundleHold", <app_descriptor_key>, <app_bundle_key>, <name>]  // Admin only
+//   ["getBundleGates", <app_descriptor_key>, <app_bundle_key>]             // Returns a GateReport of the association gates, see gates.go
+//   ["recordConsumerCheckpoint", <consumer_id>, <block>, <tx>]             // Records the replay position of an event consumer, see checkpoint.go
+//   ["getConsumerCheckpoint", <consumer_id>]                               // Returns the ConsumerCheckpoint of the consumer
+//   ["silenceEventNamespace", <namespace>, <silenced>]                     // Admin only, leaves the namespace's changes out of RegistryEvents
+//   ["acquireLock", <resource>, <ttl_seconds>]                             // Locks <resource> for the caller, or renews its Lock, see lock.go
+//   ["releaseLock", <resource>]                                            // The holder or an admin removes the Lock
+//   ["getLock", <resource>]                                                // Returns the active Lock of <resource>
+//   ["getDescriptorWithBundles", <app_descriptor_key>, [bookmark]]         // Returns the descriptor with summaries of its bundles
+//   ["mineOnly", <function>, <args>...]                                    // Runs the query <function> listing only the caller's assets
+//   ["loadDemoDataset", <seed>]                                            // Admin only, creates the fixtures demo dataset of <seed>
+//   ["simulateScript", <script>]                                           // Returns the reads, writes and results the Script would make
+//   ["republishBundle", <app_descriptor_key>, <app_bundle_key>, <to_app_descriptor_key>, <to_app_bundle_key>]   // Copies a bundle, carrying its signature chain forward
+//   ["getSignatureChain", <app_descriptor_key>, <app_bundle_key>]          // Returns and verifies the publications of a bundle
+//   ["setDescriptorSupport", <app_descriptor_key>, <support_info>]         // Owner sets where consumers report issues
+//   ["getReadiness", <app_descriptor_key>, [app_bundle_key]]               // Returns a ReadinessReport of what blocks an AppBundle from going live
+//   ["emitDigest", <namespace>, <since_timestamp>, [since_tx_id]]          // Admin only, emits a RegistryDigest of the changes journaled after the cursor
+//   ["getDescriptorAsOf", <app_descriptor_key>, <timestamp>]               // Returns a DescriptorSnapshot of the descriptor as stored at the time
+//   ["compressed", <function>, <args>...]                                  // Runs query <function>, gzip-compressing a payload above the compression threshold
+//   ["attachTimestampToken", <app_descriptor_key>, <app_bundle_key>, <timestamp_token>]   // Owner attaches an RFC 3161 token over the Merkle root
+//   ["annotateAsset", <object_type>, <key_part>..., <annotation_type>, <payload_hash>]    // A non-owner annotates an asset, see annotation.go
+//   ["getAnnotations", <object_type>, <key_part>..., <bookmark>]                          // Returns a page of Annotations, the HIDDEN ones to the owner only
+//   ["moderateAnnotation", <object_type>, <key_part>..., <annotation_type>, <annotator_id>, <status>]   // Owner sets an Annotation VISIBLE or HIDDEN
+//   ["getCollectionHash", <namespace>, <key_part>...]                                     // Returns the CollectionHash of the assets under the key parts
 func (s *AssetRegistry) Invoke(stub shim.ChaincodeStubInterface) sc.Response {
 	fmt.Printf("Constructing assetContext...\n")
 	ac, err := newAssetContext(stub)
@@ -46,47 +243,36 @@ func (s *AssetRegistry) Invoke(stub shim.ChaincodeStubInterface) sc.Response {
 	return ac.execute()
 }
 
-// parseArgs returns the function name, the key of the asset to operate on, an optional
-// additional arg for the function, or an error if there are too few, or too many args.
-func parseArgs(args [][]byte) (function string, key string, arg []byte, err error) {
-	switch len(args) {
-	case 3:
-		arg = args[2]
-		fallthrough
-	case 2:
-		key = string(args[1])
-		function = string(args[0])
-	case 1:
-		err = fmt.Errorf("Invoke called with only one argument")
-	case 0:
-		err = fmt.Errorf("Invoke called with no arguments")
-	default:
-		err = fmt.Errorf("Invoke called with too many arguments")
-	}
-	return
-}
-
 type assetContext struct {
 	stub        shim.ChaincodeStubInterface
 	creator     []byte // Guaranteed to be set
+	identity    string // The normalized creator, safe to use as a composite key part
+	mspId       string // The MSP ID of the creator, the organization submitting the transaction
 	function    string // The name of the operation being invoked
+	events      *eventStub // Records the state changes for the RegistryEvent
+	system      *systemStub // Stores the registry's own records under the SYSTEM prefix
+	featureFlags *FeatureFlags // Read by execute, nil outside Invoke
+	traceId     string // The client's trace ID, set by traced
+	warnings    *responseWarnings // Returned in the response message, nil outside Invoke
+	mineOnly    bool // Listing queries return only the caller's assets, set by mineOnly
+}
+
+// normalizeIdentity returns a stable, composite-key-safe representation of a serialized identity.
+func normalizeIdentity(creator []byte) string {
+	digest := sha256.Sum256(creator)
+	return hex.EncodeToString(digest[:])
 }
 
 func newAssetContext(stub shim.ChaincodeStubInterface) (*assetContext, error) {
 	var args = stub.GetArgs()
 	var err error = nil
 	var function = ""
+	// Each function validates its own number of arguments
 	switch len(args) {
-	case 3:
-		fallthrough
-	case 2:
-		fallthrough
-	case 1:
-		function = string(args[0])
 	case 0:
 		err = fmt.Errorf("Invoke called with no arguments")
 	default:
-		err = fmt.Errorf("Invoke called with too many arguments")
+		function = string(args[0])
 	}
 	if err != nil {
 		return nil, err
@@ -96,42 +282,91 @@ func newAssetContext(stub shim.ChaincodeStubInterface) (*assetContext, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Could not get creator: %s", err)
 	}
+	mspId, err := mspIdFromIdentity(creator)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get MSP ID of creator: %s", err)
+	}
 
+	// The events see the keys handlers write, not their storage encoding
+	system := newSystemStub(stub)
+	events := newEventStub(newEncodingStub(system))
 	return &assetContext{
-		stub:        stub,
+		stub:        events,
 		creator:     creator,
+		identity:    normalizeIdentity(creator),
+		mspId:       mspId,
 		function:    function,
+		events:      events,
+		system:      system,
+		warnings:    &responseWarnings{},
 	}, nil
 }
 
 func (ac *assetContext) execute() sc.Response {
-	// Route to the appropriate handler function to interact with the ledger appropriately
-	var err error
-	var result []byte
+	if err := ac.loadFeatureFlags(); err != nil {
+		return shim.Error(err.Error())
+	}
+	result, err := ac.dispatch()
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := ac.journalChanges(); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := ac.emitRegistryEvent(); err != nil {
+		return shim.Error(err.Error())
+	}
 
+	response := shim.Success(result)
+	if response.Message, err = ac.responseMessage(); err != nil {
+		return shim.Error(err.Error())
+	}
+	return response
+}
+
+// dispatch routes to the handler function for ac.function and returns its result.
+func (ac *assetContext) dispatch() ([]byte, error) {
+	// Route to the appropriate handler function to interact with the ledger appropriately
 	fmt.Printf("inside execute... function = %s\n", ac.function)
-	switch ac.function {
-	case "createAppDescriptor":
-		result, err = ac.createAppDescriptor()
-	case "createAppBundle":
-		result, err = ac.createAppBundle()
-	case "associateDescriptorWithBundle":
-		result, err = ac.associateDescriptorWithBundle()
-	case "getAppDescriptors":
-		result, err = ac.getAppDescriptors()
-	case "getAppBundleKeySetForDescriptor":
-		result, err = ac.getAppBundleKeySetForDescriptor()
-	case "getAppBundleForDescriptor":
-		result, err = ac.getAppBundleForDescriptor()
-	default:
-		return shim.Error("Invalid invocation function")
+	h, ok := handlers[ac.function]
+	if !ok {
+		return nil, fmt.Errorf("Invalid invocation function")
+	}
+	if err := ac.warnIfDeprecated(); err != nil {
+		return nil, err
 	}
 
-	if err != nil {
-		return shim.Error(err.Error())
+	if h.admin {
+		if err := ac.requireAdmin(); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := ac.checkNotPaused(h); err != nil {
+			return nil, err
+		}
+		if h.write {
+			if err := ac.enforceWriteRateLimit(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := ac.validatePayloads(); err != nil {
+		return nil, err
 	}
 
-	return shim.Success(result)
+	if h.isQuery() {
+		query := *ac
+		query.stub = &readOnlyStub{ChaincodeStubInterface: ac.stub, function: ac.function}
+		return h.fn(&query)
+	}
+	result, err := h.fn(ac)
+	if err != nil || !h.write {
+		return result, err
+	}
+	if err := ac.countInvocation(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 func (ac *assetContext) getDescriptor(key_part string) (*AppDescriptor, error){
@@ -152,6 +387,73 @@ func (ac *assetContext) getDescriptor(key_part string) (*AppDescriptor, error){
 	return appDescriptor, nil
 }
 
+// getAsset reads the asset stored under the composite key for objectType and key_parts into asset.
+// It returns false if no asset is stored under that key.
+func (ac *assetContext) getAsset(objectType string, key_parts []string, asset proto.Message) (bool, error) {
+	compositeKey, err := ac.stub.CreateCompositeKey(objectType, key_parts)
+	if err != nil {
+		return false, fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, key_parts, err)
+	}
+
+	assetBytesFromStore, err := ac.stub.GetState(compositeKey)
+	if err != nil {
+		return false, fmt.Errorf("Error in GetState using composite key (%v): %s", compositeKey, err)
+	}
+	if assetBytesFromStore == nil {
+		return false, nil
+	}
+
+	if err := proto.Unmarshal(assetBytesFromStore, asset); err != nil {
+		return false, fmt.Errorf("Cannot unmarshal %s for key_parts (%v), err = %s", objectType, key_parts, err)
+	}
+	return true, nil
+}
+
+// marshalDeterministic marshals message with its map entries in key order. proto.Marshal
+// writes maps in Go's random iteration order, so an asset with two or more map entries would
+// get different bytes, and different write sets, on each endorser. Every proto written to state
+// or returned by a write transaction is marshaled with it.
+func marshalDeterministic(message proto.Message) ([]byte, error) {
+	buffer := proto.NewBuffer(nil)
+	buffer.SetDeterministic(true)
+	if err := buffer.Marshal(message); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// putAsset marshals asset and stores it under the composite key for objectType and key_parts,
+// returning the stored bytes.
+func (ac *assetContext) putAsset(objectType string, key_parts []string, asset proto.Message) ([]byte, error) {
+	compositeKey, err := ac.stub.CreateCompositeKey(objectType, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, key_parts, err)
+	}
+
+	if stamped, ok := asset.(mspStamped); ok {
+		stamped.setUpdatedMspId(ac.mspId)
+	}
+	assetBytesToStore, err := marshalDeterministic(asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling proto: %s", err)
+	}
+
+	if err := ac.stub.PutState(compositeKey, assetBytesToStore); err != nil {
+		return nil, fmt.Errorf("Could not put state for key %s: %s", compositeKey, err)
+	}
+	return assetBytesToStore, nil
+}
+
+// txTimestamp returns the transaction timestamp in seconds since the epoch. It is the same on
+// every endorser, so it is safe to record in state.
+func (ac *assetContext) txTimestamp() (int64, error) {
+	timestamp, err := ac.stub.GetTxTimestamp()
+	if err != nil {
+		return 0, fmt.Errorf("Could not get transaction timestamp: %s", err)
+	}
+	return timestamp.Seconds, nil
+}
+
 func (ac *assetContext) createAppDescriptor() ([]byte, error) {
 	var args = ac.stub.GetArgs()
 	key_part := ""
@@ -184,13 +486,17 @@ func (ac *assetContext) createAppDescriptor() ([]byte, error) {
 	if len(appDescriptor.BundleId) != 0 {
 		return nil, fmt.Errorf("AppDscriptor's bundle_id field must be empty during creation")
 	}
+	ac.warnIfRawCreatorOwner(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, appDescriptor.Owner)
+	if err := ac.prepareAppDescriptor(key_part, appDescriptor); err != nil {
+		return nil, err
+	}
 
-	// Set the owner if not set
-	if len(appDescriptor.Owner) == 0 {
-		appDescriptor.Owner = ac.creator
+	// Store the private details, if any, in the descriptor's private collection
+	if err := ac.storeDescriptorPrivateDetails(key_part, appDescriptor); err != nil {
+		return nil, fmt.Errorf("Error in createAppDescriptor: %s", err)
 	}
 
-	appDescriptorBytesToStore, err := proto.Marshal(appDescriptor)
+	appDescriptorBytesToStore, err := marshalDeterministic(appDescriptor)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshaling proto: %s", err)
 	}
@@ -204,39 +510,149 @@ func (ac *assetContext) createAppDescriptor() ([]byte, error) {
 }
 
 
-func (ac *assetContext) createAppBundle() ([]byte, error) {
-	var args = ac.stub.GetArgs()
-	key_part := ""
+// prepareAppDescriptor validates a new AppDescriptor to be stored at key_part, claiming its
+// Reservation and setting the fields the registry maintains.
+func (ac *assetContext) prepareAppDescriptor(key_part string, appDescriptor *AppDescriptor) error {
+	aliased, err := ac.keyExists(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{key_part})
+	if err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if aliased {
+		return fmt.Errorf("Cannot create an AppDescriptor whose key_part is the Alias of a renamed AppDescriptor")
+	}
+	if err := ac.claimReservation(key_part); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
 
-	var appBundleBytesFromArgs = []byte{}
-	switch len(args) {
-	case 3:
-		key_part = string(args[1])
-		appBundleBytesFromArgs = args[2]
-	default:
-		return nil, fmt.Errorf("Wrong number of arguments to createAppBundle")
+	// Make sure the parent, if specified, exists
+	if len(appDescriptor.ParentDescriptorKey) != 0 {
+		if appDescriptor.ParentDescriptorKey == key_part {
+			return fmt.Errorf("AppDescriptor cannot be its own parent")
+		}
+		if _, err := ac.getDescriptor(appDescriptor.ParentDescriptorKey); err != nil {
+			return fmt.Errorf("Could not get parent descriptor with parent_descriptor_key = %s:  %s", appDescriptor.ParentDescriptorKey, err.Error())
+		}
 	}
 
-	// First get the AppBundle from the args
+	// Set the owner if not set
+	if len(appDescriptor.Owner) == 0 {
+		appDescriptor.Owner = ac.creator
+	}
+	appDescriptor.OwnerId = normalizeIdentity(appDescriptor.Owner)
+	if appDescriptor.CreatedAt, err = ac.txTimestamp(); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	appDescriptor.CreatedMspId = ac.mspId
+	appDescriptor.UpdatedMspId = ac.mspId
+
+	if err := validateFieldCommitments(appDescriptor.FieldCommitments); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := validatePricingTiers(appDescriptor.PricingTiers); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := validateRoyaltySplits(appDescriptor.RoyaltySplits, appDescriptor.Owner); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := validateSupportInfo(appDescriptor.Support); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := ac.evaluatePolicyRules(appDescriptor, key_part, ""); err != nil {
+		return fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	return nil
+}
+
+// newAppBundle unmarshals and validates an AppBundle to be created, setting its owner if not set.
+func (ac *assetContext) newAppBundle(key_part string, appBundleBytes []byte) (*AppBundle, error) {
 	appBundle := &AppBundle{}
-	if err := proto.Unmarshal(appBundleBytesFromArgs, appBundle); err != nil {
+	if err := proto.Unmarshal(appBundleBytes, appBundle); err != nil {
 		return nil, fmt.Errorf("Cannot unmarshal AppBundle, err = %s", err.Error())
 	}
+	return ac.checkNewAppBundle(key_part, appBundle)
+}
+
+// checkNewAppBundle validates an AppBundle to be created, setting its owner if not set.
+func (ac *assetContext) checkNewAppBundle(key_part string, appBundle *AppBundle) (*AppBundle, error) {
+	if len(appBundle.SignatureChain) != 0 {
+		return nil, fmt.Errorf("Error in %s: the signature_chain of an AppBundle is set by republishBundle", ac.function)
+	}
+	return ac.prepareAppBundle(key_part, appBundle)
+}
 
+// prepareAppBundle validates a new AppBundle, setting the fields the registry maintains.
+func (ac *assetContext) prepareAppBundle(key_part string, appBundle *AppBundle) (*AppBundle, error) {
 	if len(appBundle.Artifacts) == 0 && len(appBundle.ChaincodeDeploymentSpecs) == 0 {
 		return nil, fmt.Errorf("Must specify at least 1 artifact or chaincode deployment spec in an AppBundle")
 	}
+	if appBundle.RetentionTier != AppBundle_HOT || len(appBundle.ExternalUris) != 0 {
+		return nil, fmt.Errorf("AppBundles are created HOT, see demoteToCold")
+	}
 
 	// Set the owner if not set
+	ac.warnIfRawCreatorOwner(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, appBundle.Owner)
 	if len(appBundle.Owner) == 0 {
 		appBundle.Owner = ac.creator
 	}
+	appBundle.OwnerId = normalizeIdentity(appBundle.Owner)
+	created_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	appBundle.CreatedAt = created_at
+	appBundle.CreatedMspId = ac.mspId
+	appBundle.UpdatedMspId = ac.mspId
 
 	// Make sure the descriptor exists
-	_, err := ac.getDescriptor(appBundle.DescriptorId)
+	_, err = ac.getDescriptor(appBundle.DescriptorId)
 	if err != nil {
 		return nil, fmt.Errorf("Could not get descriptor for AppBundle with descriptor_id = %s:  %s", appBundle.DescriptorId, err.Error())
 	}
+	if err := recordContentDigests(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := anchorTimestampToken(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := validateMinFabricVersion(appBundle.MinFabricVersion); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := validateTargetPlatforms(appBundle.TargetPlatforms); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := ac.describeChaincodePackages(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := ac.checkArtifactLicenses(key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := ac.evaluatePolicyRules(appBundle, appBundle.DescriptorId, key_part); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	if err := ac.checkStrictBundle(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in %s: %s", ac.function, err)
+	}
+	return appBundle, nil
+}
+
+func (ac *assetContext) createAppBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	key_part := ""
+
+	var appBundleBytesFromArgs = []byte{}
+	switch len(args) {
+	case 3:
+		key_part = string(args[1])
+		appBundleBytesFromArgs = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to createAppBundle")
+	}
+
+	// First get the AppBundle from the args
+	appBundle, err := ac.newAppBundle(key_part, appBundleBytesFromArgs)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get the composite key_part
 	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{appBundle.DescriptorId, key_part})
@@ -249,7 +665,7 @@ func (ac *assetContext) createAppBundle() ([]byte, error) {
 		return nil, fmt.Errorf("Cannot create an AppBundle whose key_part already exists: %s", compositeKey)
 	}
 
-	appBundleBytes, err := proto.Marshal(appBundle)
+	appBundleBytes, err := marshalDeterministic(appBundle)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshaling proto: %s", err)
 	}
@@ -263,12 +679,26 @@ func (ac *assetContext) createAppBundle() ([]byte, error) {
 }
 
 
+// associateDescriptorWithBundle sets the bundle_id of an AppDescriptor. By default the AppBundle
+// is looked up by its key, so the read set holds that key alone and validation only detects a
+// concurrent change to it. With the optional <range_check> set to true the bundle is instead
+// found by a range read over all of the descriptor's AppBundles; the peer re-executes range
+// reads at validation, so the transaction is invalidated as a phantom read if any bundle of the
+// descriptor is created or deleted by an earlier transaction in the same block. The range read
+// costs a scan of the descriptor's bundles.
 func (ac *assetContext) associateDescriptorWithBundle() ([]byte, error) {
 	var args = ac.stub.GetArgs()
 	app_descriptor_key_part := ""
 	app_bundle_key_part := ""
+	range_check := false
 
 	switch len(args) {
+	case 4:
+		var err error
+		if range_check, err = strconv.ParseBool(string(args[3])); err != nil {
+			return nil, fmt.Errorf("Error in associateDescriptorWithBundle, invalid range_check %s: %s", args[3], err)
+		}
+		fallthrough
 	case 3:
 		app_descriptor_key_part = string(args[1])
 		app_bundle_key_part = string(args[2])
@@ -284,14 +714,27 @@ func (ac *assetContext) associateDescriptorWithBundle() ([]byte, error) {
 	}
 
 	// Verify AppBundle exists
-	_, err = ac.getAppBundleForDescriptorByKey(app_descriptor_key_part, app_bundle_key_part)
+	if range_check {
+		err = ac.verifyBundleByRange(app_descriptor_key_part, app_bundle_key_part)
+	} else {
+		_, err = ac.getAppBundleForDescriptorByKey(app_descriptor_key_part, app_bundle_key_part)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Error in associateDescriptorWithBundle: %s", err.Error())
 	}
 
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in associateDescriptorWithBundle: %s", err.Error())
+	}
+	if err := ac.evaluateAssociationGates(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in associateDescriptorWithBundle: %s", err.Error())
+	}
+
 	// Now set the bundle_id field on
 	appDescriptor.BundleId = app_bundle_key_part
-	appDescriptorBytesToStore, err := proto.Marshal(appDescriptor)
+	appDescriptor.UpdatedMspId = ac.mspId
+	appDescriptorBytesToStore, err := marshalDeterministic(appDescriptor)
 	if err != nil {
 		return nil, fmt.Errorf("Error in associateDescriptorWithBundle, error marshaling proto: %s", err)
 	}
@@ -327,44 +770,165 @@ func (ac *assetContext) getAppBundleForDescriptorByKey(app_descriptor_key string
 	return appBundleBytesFromStore, nil
 }
 
+// verifyBundleByRange checks that an AppBundle exists by reading every AppBundle of the
+// descriptor. The iteration is not stopped at the bundle, so the range in the read set covers
+// the whole descriptor prefix.
+func (ac *assetContext) verifyBundleByRange(app_descriptor_key string, app_bundle_key string) error {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key})
+	if err != nil {
+		return fmt.Errorf("Error reading the AppBundles of AppDescriptor %s: %s", app_descriptor_key, err)
+	}
+	defer stateQueryIterator.Close()
+
+	found := false
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return fmt.Errorf("Error reading the AppBundles of AppDescriptor %s: %s", app_descriptor_key, err)
+		}
+		_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return fmt.Errorf("Error splitting composite key %s: %s", kv.Key, err)
+		}
+		found = found || key_parts[len(key_parts)-1] == app_bundle_key
+	}
+	if !found {
+		return fmt.Errorf("AppBundle %s not found among the AppBundles of AppDescriptor %s", app_bundle_key, app_descriptor_key)
+	}
+	return nil
+}
+
 
+// query returns the assets of query.object_type whose composite keys start with query.key_parts,
+// keyed by their last key part, starting after query.bookmark. Unless query.return_values is
+// set the results hold only the keys: the iterator still carries the values, but they are
+// neither copied into the result nor returned to callers that only need the key set. The
+// results stop at the registry's QueryLimits, setting has_more. Mine-only listings skip the
+// assets that are not the caller's, see mineonly.go. Every result carries its content hash, and
+// results holding the whole collection its hash, see collectionhash.go.
 func (ac *assetContext) query(query *Query) (*QueryResult, error) {
 	fmt.Printf("Entering query function\n")
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in query using Query = (%v): %s", query, err)
+	}
+	mine_only, err := ac.listsMineOnly()
+	if err != nil {
+		return nil, fmt.Errorf("Error in query using Query = (%v): %s", query, err)
+	}
 	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(query.ObjectType.String(), query.KeyParts)
 	if err != nil {
 		return nil, fmt.Errorf("Error in query using object_type = %s and query %v: %s", query.ObjectType.String(), query, err)
 	}
 	defer stateQueryIterator.Close()
 
-	var queryResult = &QueryResult{Query: query, Results: make(map[string][]byte)}
+	var queryResult = &QueryResult{Query: query}
+	var result_bytes uint64
+	hasher := newCollectionHasher()
 	for stateQueryIterator.HasNext() {
 		queryResultFromIterator, err := stateQueryIterator.Next()
 		if (err != nil) {
 			return nil, fmt.Errorf("Error in query using Query = (%v): %s", query, err)
 		}
+		if queryResultFromIterator.Key <= query.Bookmark {
+			continue
+		}
 		_, key_parts, err := ac.stub.SplitCompositeKey(queryResultFromIterator.Key)
-		last_key_part := key_parts[len(key_parts)-1]
 		if err != nil {
 			return nil, fmt.Errorf("Error in query, could not split returned composite key using Query = (%v): %s", query, err)
 		}
-		queryResult.Results[last_key_part] = queryResultFromIterator.Value
+		if mine_only {
+			mine, err := ac.isStoredMine(query.ObjectType.String(), key_parts, queryResultFromIterator.Value)
+			if err != nil {
+				return nil, fmt.Errorf("Error in query using Query = (%v): %s", query, err)
+			}
+			if !mine {
+				continue
+			}
+		}
+		size := uint64(len(queryResultFromIterator.Key))
+		if query.ReturnValues {
+			size += uint64(len(queryResultFromIterator.Value))
+		}
+		if uint32(len(queryResult.Results)) == queryLimits.MaxResults || result_bytes+size > queryLimits.MaxBytes {
+			if len(queryResult.Results) == 0 {
+				return nil, fmt.Errorf("Error in query using Query = (%v): key %s exceeds max_bytes", query, queryResultFromIterator.Key)
+			}
+			queryResult.HasMore = true
+			break
+		}
+		result_bytes += size
+		queryResult.Bookmark = queryResultFromIterator.Key
+		last_key_part := key_parts[len(key_parts)-1]
+		entry := &QueryResult_Entry{Key: last_key_part, Hash: contentHash(queryResultFromIterator.Value)}
+		if query.ReturnValues {
+			entry.Value = queryResultFromIterator.Value
+		}
+		hasher.add(queryResultFromIterator.Key, entry.Hash)
+		queryResult.Results = append(queryResult.Results, entry)
+	}
+	if len(query.Bookmark) == 0 && !queryResult.HasMore {
+		queryResult.CollectionHash = hasher.sum()
 	}
 	return queryResult, nil
 }
 
+// scanBatch calls fn with each key/value of objectType whose key starts with key_parts, after the
+// composite key bookmark, in key order, stopping after batchSize keys. It returns the last key
+// processed and whether the scan reached the end. Unlike the pagination APIs it can be used in
+// update transactions.
+func (ac *assetContext) scanBatch(objectType string, key_parts []string, bookmark string, batchSize int, fn func(compositeKey string, value []byte) error) (string, bool, error) {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(objectType, key_parts)
+	if err != nil {
+		return "", false, fmt.Errorf("Error scanning object_type %s: %s", objectType, err)
+	}
+	defer stateQueryIterator.Close()
+
+	processed := 0
+	for stateQueryIterator.HasNext() {
+		if processed == batchSize {
+			return bookmark, false, nil
+		}
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return "", false, fmt.Errorf("Error scanning object_type %s: %s", objectType, err)
+		}
+		if kv.Key <= bookmark {
+			continue
+		}
+		if err := fn(kv.Key, kv.Value); err != nil {
+			return "", false, err
+		}
+		bookmark = kv.Key
+		processed++
+	}
+	return bookmark, true, nil
+}
+
 func (ac *assetContext) getAppDescriptors() ([]byte, error) {
-	var query *Query = &Query{ObjectType:Query_APP_DESCRIPTOR}
+	var args = ac.stub.GetArgs()
+	bookmark := ""
+
+	switch len(args) {
+	case 2:
+		bookmark = string(args[1])
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getAppDescriptors")
+	}
+
+	var query *Query = &Query{ObjectType:Query_APP_DESCRIPTOR, ReturnValues: true, Bookmark: bookmark}
 	var query_results, err = ac.query(query)
 	if err != nil {
 		return nil, fmt.Errorf("Error in getAppDescriptors: %s", err)
 	}
-	var appDescriptors = &AppDescriptors{Descriptors:make(map[string]*AppDescriptor)}
-	for k, v := range query_results.Results {
+	var appDescriptors = &AppDescriptors{HasMore: query_results.HasMore, Bookmark: query_results.Bookmark, CollectionHash: query_results.CollectionHash}
+	for _, entry := range query_results.Results {
 		var appDescriptor = &AppDescriptor{}
-		if err := proto.Unmarshal(v, appDescriptor); err != nil {
-			return nil, fmt.Errorf("Error unmarshalling AppDescriptor in getAppDescriptors for key '%s': %s", k, err)
+		if err := proto.Unmarshal(entry.Value, appDescriptor); err != nil {
+			return nil, fmt.Errorf("Error unmarshalling AppDescriptor in getAppDescriptors for key '%s': %s", entry.Key, err)
 		}
-		appDescriptors.Descriptors[k] = appDescriptor
+		appDescriptors.Descriptors = append(appDescriptors.Descriptors, &AppDescriptors_Entry{Key: entry.Key, AppDescriptor: appDescriptor, Hash: entry.Hash})
 	}
 	var appDescriptorsBytes, err_marshalling = proto.Marshal(appDescriptors)
 	if err_marshalling != nil {
@@ -373,32 +937,126 @@ func (ac *assetContext) getAppDescriptors() ([]byte, error) {
 	return appDescriptorsBytes, nil
 }
 
+func (ac *assetContext) getAppDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getAppDescriptor")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAppDescriptor: %s", err)
+	}
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAppDescriptor: %s", err)
+	}
+	if err := ac.mergeDescriptorPrivateDetails(app_descriptor_key_part, appDescriptor); err != nil {
+		return nil, fmt.Errorf("Error in getAppDescriptor: %s", err)
+	}
+	appDescriptorBytes, err := proto.Marshal(appDescriptor)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling AppDescriptor in getAppDescriptor: %s", err)
+	}
+	return appDescriptorBytes, nil
+}
+
+func (ac *assetContext) getChildDescriptors() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	bookmark := ""
+
+	switch len(args) {
+	case 3:
+		bookmark = string(args[2])
+		fallthrough
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getChildDescriptors")
+	}
+
+	app_descriptor_key_part, err_resolve := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err_resolve != nil {
+		return nil, fmt.Errorf("Error in getChildDescriptors: %s", err_resolve)
+	}
+	// First make sure the parent descriptor exists
+	_, err_get_descriptor := ac.getDescriptor(app_descriptor_key_part)
+	if err_get_descriptor != nil {
+		return nil, fmt.Errorf("Error trying to get app_descriptor (%s) inside getChildDescriptors: %s", app_descriptor_key_part, err_get_descriptor.Error())
+	}
+
+	var query *Query = &Query{ObjectType: Query_APP_DESCRIPTOR, ReturnValues: true, Bookmark: bookmark}
+	var query_results, err = ac.query(query)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getChildDescriptors: %s", err)
+	}
+	var appDescriptors = &AppDescriptors{HasMore: query_results.HasMore, Bookmark: query_results.Bookmark}
+	for _, entry := range query_results.Results {
+		var appDescriptor = &AppDescriptor{}
+		if err := proto.Unmarshal(entry.Value, appDescriptor); err != nil {
+			return nil, fmt.Errorf("Error unmarshalling AppDescriptor in getChildDescriptors for key '%s': %s", entry.Key, err)
+		}
+		// Children of a renamed parent may still hold its old key
+		parent_key_part := appDescriptor.ParentDescriptorKey
+		if len(parent_key_part) != 0 && parent_key_part != app_descriptor_key_part {
+			if parent_key_part, err = ac.resolveDescriptorKey(parent_key_part); err != nil {
+				return nil, fmt.Errorf("Error in getChildDescriptors: %s", err)
+			}
+		}
+		if parent_key_part == app_descriptor_key_part {
+			appDescriptors.Descriptors = append(appDescriptors.Descriptors, &AppDescriptors_Entry{Key: entry.Key, AppDescriptor: appDescriptor, Hash: entry.Hash})
+		}
+	}
+	var appDescriptorsBytes, err_marshalling = proto.Marshal(appDescriptors)
+	if err_marshalling != nil {
+		return nil, fmt.Errorf("Error marshalling AppDescriptors in getChildDescriptors: %s", err_marshalling.Error())
+	}
+	return appDescriptorsBytes, nil
+}
+
 
 func (ac *assetContext) getAppBundleKeySetForDescriptor() ([]byte, error) {
 	var args = ac.stub.GetArgs()
 	app_descriptor_key_part := ""
 
+	bookmark := ""
+
 	switch len(args) {
+	case 3:
+		bookmark = string(args[2])
+		fallthrough
 	case 2:
 		app_descriptor_key_part = string(args[1])
 	default:
 		return nil, fmt.Errorf("Wrong number of arguments to getAppBundleKeySetForDescriptor")
 	}
 
+	app_descriptor_key_part, err_resolve := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err_resolve != nil {
+		return nil, fmt.Errorf("Error in getAppBundleKeySetForDescriptor: %s", err_resolve)
+	}
 	// First make sure descriptor exists
 	_, err_get_descriptor := ac.getDescriptor(app_descriptor_key_part)
 	if err_get_descriptor != nil {
 		return nil, fmt.Errorf("Error trying to get app_descriptor (%s) inside getAppBundleKeySetForDescriptor: %s", app_descriptor_key_part, err_get_descriptor.Error())
 	}
 
-	var query *Query = &Query{ObjectType:Query_APP_BUNDLE, KeyParts: []string{app_descriptor_key_part}}
+	var query *Query = &Query{ObjectType:Query_APP_BUNDLE, KeyParts: []string{app_descriptor_key_part}, Bookmark: bookmark}
 	var query_results, err = ac.query(query)
 	if err != nil {
 		return nil, fmt.Errorf("Error in getAppBundleKeySetForDescriptor: %s", err.Error())
 	}
-	var appBundleKeySet = &AppBundleKeySet{DescriptorId: app_descriptor_key_part}
-	for k, _ := range query_results.Results {
-		appBundleKeySet.BundleKeys = append(appBundleKeySet.BundleKeys, k)
+	var appBundleKeySet = &AppBundleKeySet{DescriptorId: app_descriptor_key_part, HasMore: query_results.HasMore, Bookmark: query_results.Bookmark, CollectionHash: query_results.CollectionHash}
+	for _, entry := range query_results.Results {
+		appBundleKeySet.BundleKeys = append(appBundleKeySet.BundleKeys, entry.Key)
+		appBundleKeySet.BundleHashes = append(appBundleKeySet.BundleHashes, entry.Hash)
 	}
 	var appBundleKeySetBytes, err_marshalling = proto.Marshal(appBundleKeySet)
 	if err_marshalling != nil {
@@ -423,8 +1081,12 @@ func (ac *assetContext) getAppBundleForDescriptor() ([]byte, error) {
 		return nil, fmt.Errorf("Wrong number of arguments to getAppBundleForDescriptor")
 	}
 
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAppBundleForDescriptor: %s", err)
+	}
 	// Verify AppDescriptor exists
-	_, err := ac.getDescriptor(app_descriptor_key_part)
+	_, err = ac.getDescriptor(app_descriptor_key_part)
 	if err != nil {
 		return nil, fmt.Errorf("Error in getAppBundleForDescriptor: %s", err.Error())
 	}
@@ -434,6 +1096,15 @@ func (ac *assetContext) getAppBundleForDescriptor() ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Error in getAppBundleForDescriptor: %s", err.Error())
 	}
+
+	// Restricted bundles may only be read by their owner or permitted identities
+	appBundle := &AppBundle{}
+	if err := proto.Unmarshal(appBundleBytesFromStore, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getAppBundleForDescriptor, cannot unmarshal AppBundle: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getAppBundleForDescriptor: %s", err)
+	}
 	return appBundleBytesFromStore, nil
 }
 
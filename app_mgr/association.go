@@ -0,0 +1,94 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A coordinated release of several apps re-points each of their AppDescriptors to a new
+// AppBundle. associateDescriptorsWithBundles makes all of those associations in one
+// transaction: each is made, and checked, exactly as associateDescriptorWithBundle would make
+// it, but their writes are only sent to the peer once every association has succeeded, so
+// either every descriptor moves to its new bundle or none does. The middleware of the
+// associations is that of associateDescriptorsWithBundles, e.g. one write against the caller's
+// rate limit.
+
+func (ac *assetContext) associateDescriptorsWithBundles() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var bundleKeyListBytes = []byte{}
+	range_check := false
+
+	switch len(args) {
+	case 3:
+		var err error
+		if range_check, err = strconv.ParseBool(string(args[2])); err != nil {
+			return nil, fmt.Errorf("Error in associateDescriptorsWithBundles, invalid range_check %s: %s", args[2], err)
+		}
+		fallthrough
+	case 2:
+		bundleKeyListBytes = args[1]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to associateDescriptorsWithBundles")
+	}
+
+	bundleKeyList := &BundleKeyList{}
+	if err := proto.Unmarshal(bundleKeyListBytes, bundleKeyList); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal BundleKeyList, err = %s", err)
+	}
+	if len(bundleKeyList.Keys) == 0 {
+		return nil, fmt.Errorf("Error in associateDescriptorsWithBundles: no associations given")
+	}
+	if err := ac.checkQueryKeyCount(len(bundleKeyList.Keys)); err != nil {
+		return nil, fmt.Errorf("Error in associateDescriptorsWithBundles: %s", err)
+	}
+
+	overlay := newOverlayStub(ac.stub, nil)
+	associationResult := &AssociationResult{}
+	associated := make(map[string]bool)
+	for i, bundleKey := range bundleKeyList.Keys {
+		if associated[bundleKey.DescriptorId] {
+			return nil, fmt.Errorf("Error in associateDescriptorsWithBundles: AppDescriptor %s is associated more than once", bundleKey.DescriptorId)
+		}
+		associated[bundleKey.DescriptorId] = true
+
+		appDescriptor, err := ac.getDescriptor(bundleKey.DescriptorId)
+		if err != nil {
+			return nil, fmt.Errorf("Error in associateDescriptorsWithBundles, keys[%d]: %s", i, err)
+		}
+		overlay.args = [][]byte{[]byte("associateDescriptorWithBundle"), []byte(bundleKey.DescriptorId), []byte(bundleKey.BundleKey), []byte(strconv.FormatBool(range_check))}
+		association := *ac
+		association.stub = overlay
+		association.function = "associateDescriptorWithBundle"
+		appDescriptorBytes, err := association.associateDescriptorWithBundle()
+		if err != nil {
+			return nil, fmt.Errorf("Error in associateDescriptorsWithBundles, keys[%d]: %s", i, err)
+		}
+		associatedDescriptor := &AppDescriptor{}
+		if err := proto.Unmarshal(appDescriptorBytes, associatedDescriptor); err != nil {
+			return nil, fmt.Errorf("Error in associateDescriptorsWithBundles, cannot unmarshal AppDescriptor %s: %s", bundleKey.DescriptorId, err)
+		}
+		associationResult.Entries = append(associationResult.Entries, &AssociationResult_Entry{
+			DescriptorId:     bundleKey.DescriptorId,
+			PreviousBundleId: appDescriptor.BundleId,
+			AppDescriptor:    associatedDescriptor,
+		})
+	}
+
+	if err := overlay.flush(); err != nil {
+		return nil, fmt.Errorf("Error in associateDescriptorsWithBundles: %s", err)
+	}
+
+	associationResultBytes, err := proto.Marshal(associationResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling AssociationResult in associateDescriptorsWithBundles: %s", err)
+	}
+	return associationResultBytes, nil
+}
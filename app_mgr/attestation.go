@@ -0,0 +1,122 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Auditors attach ComplianceAttestations, such as SOC2 or ISO 27001 reports, to the AppBundle
+// they cover; the document itself stays off-chain and only its SHA-256 digest is recorded. The
+// attestor is an MSP ID, which must be that of the submitting certificate, so an attestation
+// always names the organization that actually signed it. Attestations are keyed by descriptor,
+// bundle, type and attestor, so re-attesting, e.g. with a renewed report, replaces the previous
+// attestation of that type by that attestor.
+
+func (ac *assetContext) attachComplianceAttestation() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	attestation_type := ""
+	var document_hash []byte
+	attestor := ""
+
+	switch len(args) {
+	case 6:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		attestation_type = string(args[3])
+		document_hash = args[4]
+		attestor = string(args[5])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to attachComplianceAttestation")
+	}
+	if len(attestation_type) == 0 {
+		return nil, fmt.Errorf("Error in attachComplianceAttestation: the attestation type must be non-empty")
+	}
+	if len(document_hash) != sha256.Size {
+		return nil, fmt.Errorf("Error in attachComplianceAttestation: document_hash must be a SHA-256 digest of %d bytes, got %d", sha256.Size, len(document_hash))
+	}
+	if attestor != ac.mspId {
+		return nil, fmt.Errorf("Error in attachComplianceAttestation: attestor %s does not match the MSP ID %s of the submitting certificate", attestor, ac.mspId)
+	}
+
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachComplianceAttestation: %s", err)
+	}
+	if bytes.Equal(appBundle.Owner, ac.creator) {
+		return nil, fmt.Errorf("The owner of AppBundle %s may not attest it", app_bundle_key_part)
+	}
+
+	attested_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachComplianceAttestation: %s", err)
+	}
+	complianceAttestation := &ComplianceAttestation{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		Type:         attestation_type,
+		DocumentHash: document_hash,
+		Attestor:     attestor,
+		AttestedBy:   ac.creator,
+		AttestedAt:   attested_at,
+	}
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part, attestation_type, attestor}
+	return ac.putAsset(COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE, key_parts, complianceAttestation)
+}
+
+func (ac *assetContext) getAttestationsForBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getAttestationsForBundle")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAttestationsForBundle: %s", err)
+	}
+	if _, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part); err != nil {
+		return nil, fmt.Errorf("Error in getAttestationsForBundle: %s", err)
+	}
+
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAttestationsForBundle: %s", err)
+	}
+	defer stateQueryIterator.Close()
+
+	complianceAttestations := &ComplianceAttestations{}
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getAttestationsForBundle: %s", err)
+		}
+		complianceAttestation := &ComplianceAttestation{}
+		if err := proto.Unmarshal(kv.Value, complianceAttestation); err != nil {
+			return nil, fmt.Errorf("Error in getAttestationsForBundle, cannot unmarshal ComplianceAttestation: %s", err)
+		}
+		complianceAttestations.Attestations = append(complianceAttestations.Attestations, complianceAttestation)
+	}
+
+	complianceAttestationsBytes, err := proto.Marshal(complianceAttestations)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ComplianceAttestations in getAttestationsForBundle: %s", err)
+	}
+	return complianceAttestationsBytes, nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Auctions sell an exclusive License for an AppDescriptor. Bidding is sealed by commit-reveal:
+// until the bid deadline each bidder places a commitment to their amount, computed like a
+// FieldCommitment over the decimal amount with a secret salt, so no bid can be seen and
+// outbid. Between the bid and reveal deadlines bidders reveal their amount and salt. Once the
+// reveal deadline has passed anyone may close the auction, awarding the License to the
+// highest revealed bid; unrevealed bids are ignored. A descriptor has one open Auction at a
+// time, and an Auction whose License can no longer be granted closes without a winner. Bids
+// are keyed by auction and the normalized bidder identity; the winning License is granted
+// through grantLicense.
+
+func (ac *assetContext) getAuctionAsset(auction_key_part string) (*Auction, error) {
+	auction := &Auction{}
+	found, err := ac.getAsset(COMPOSITE_KEY_AUCTION_OBJECTTYPE, []string{auction_key_part}, auction)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Auction not found for key_part %s", auction_key_part)
+	}
+	return auction, nil
+}
+
+func (ac *assetContext) OpenAuction(request *OpenAuctionRequest) (*Auction, error) {
+	auction_key_part := request.AuctionKey
+	app_descriptor_key_part := request.AppDescriptorKey
+	bid_seconds := request.BidSeconds
+	reveal_seconds := request.RevealSeconds
+	if bid_seconds <= 0 {
+		return nil, fmt.Errorf("Error in openAuction, invalid bid_seconds %d", bid_seconds)
+	}
+	if reveal_seconds <= 0 {
+		return nil, fmt.Errorf("Error in openAuction, invalid reveal_seconds %d", reveal_seconds)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in openAuction: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may auction it", app_descriptor_key_part)
+	}
+	// An exclusive License can only be sold while nobody holds a License
+	if err := ac.checkLicenseGrantable(app_descriptor_key_part, true); err != nil {
+		return nil, fmt.Errorf("Error in openAuction: %s", err)
+	}
+	if err := ac.checkNoOpenDeals(app_descriptor_key_part, COMPOSITE_KEY_AUCTION_OBJECTTYPE); err != nil {
+		return nil, fmt.Errorf("Error in openAuction: %s", err)
+	}
+	exists, err := ac.keyExists(COMPOSITE_KEY_AUCTION_OBJECTTYPE, []string{auction_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in openAuction: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Cannot open an Auction whose key_part already exists")
+	}
+
+	opened_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in openAuction: %s", err)
+	}
+	auction := &Auction{
+		DescriptorId:   app_descriptor_key_part,
+		Seller:         ac.creator,
+		Status:         Auction_OPEN,
+		OpenedAt:       opened_at,
+		BidDeadline:    opened_at + bid_seconds,
+		RevealDeadline: opened_at + bid_seconds + reveal_seconds,
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_AUCTION_OBJECTTYPE, []string{auction_key_part}, auction); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+func (ac *assetContext) PlaceBid(request *PlaceBidRequest) (*Bid, error) {
+	auction_key_part := request.AuctionKey
+	commitment := request.Commitment
+	if err := validateFieldCommitments([]*FieldCommitment{{Field: "amount", Commitment: commitment}}); err != nil {
+		return nil, fmt.Errorf("Error in placeBid: %s", err)
+	}
+
+	auction, err := ac.getAuctionAsset(auction_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in placeBid: %s", err)
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in placeBid: %s", err)
+	}
+	if auction.Status != Auction_OPEN || now >= auction.BidDeadline {
+		return nil, fmt.Errorf("Error in placeBid, bidding on Auction %s has closed", auction_key_part)
+	}
+	if bytes.Equal(auction.Seller, ac.creator) {
+		return nil, fmt.Errorf("Error in placeBid, the seller may not bid")
+	}
+
+	bid := &Bid{Bidder: ac.creator, Commitment: commitment, PlacedAt: now}
+	if _, err := ac.putAsset(COMPOSITE_KEY_BID_OBJECTTYPE, []string{auction_key_part, ac.identity}, bid); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+func (ac *assetContext) RevealBid(request *RevealBidRequest) (*Bid, error) {
+	auction_key_part := request.AuctionKey
+	amount := request.Amount
+	salt := request.Salt
+
+	auction, err := ac.getAuctionAsset(auction_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in revealBid: %s", err)
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in revealBid: %s", err)
+	}
+	if now < auction.BidDeadline || now >= auction.RevealDeadline || auction.Status != Auction_OPEN {
+		return nil, fmt.Errorf("Error in revealBid, Auction %s is not accepting reveals", auction_key_part)
+	}
+
+	bid := &Bid{}
+	found, err := ac.getAsset(COMPOSITE_KEY_BID_OBJECTTYPE, []string{auction_key_part, ac.identity}, bid)
+	if err != nil {
+		return nil, fmt.Errorf("Error in revealBid: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in revealBid, the caller has no Bid on Auction %s", auction_key_part)
+	}
+	// The amount is committed to in its canonical decimal form
+	if !bytes.Equal(bid.Commitment, computeFieldCommitment(salt, []byte(strconv.FormatUint(amount, 10)))) {
+		return nil, fmt.Errorf("Error in revealBid, amount and salt do not match the Bid commitment")
+	}
+	bid.Revealed = true
+	bid.Amount = amount
+	if _, err := ac.putAsset(COMPOSITE_KEY_BID_OBJECTTYPE, []string{auction_key_part, ac.identity}, bid); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+func (ac *assetContext) CloseAuction(request *AuctionRequest) (*Auction, error) {
+	auction_key_part := request.AuctionKey
+
+	auction, err := ac.getAuctionAsset(auction_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in closeAuction: %s", err)
+	}
+	if auction.Status != Auction_OPEN {
+		return nil, fmt.Errorf("Error in closeAuction, Auction %s is already closed", auction_key_part)
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in closeAuction: %s", err)
+	}
+	if now < auction.RevealDeadline {
+		return nil, fmt.Errorf("Error in closeAuction, bids on Auction %s may be revealed until %d", auction_key_part, auction.RevealDeadline)
+	}
+
+	// Bids are iterated in key order, so the earliest key wins a tie on every endorser
+	var winner *Bid
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_BID_OBJECTTYPE, []string{auction_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in closeAuction: %s", err)
+	}
+	defer stateQueryIterator.Close()
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in closeAuction: %s", err)
+		}
+		bid := &Bid{}
+		if err := proto.Unmarshal(kv.Value, bid); err != nil {
+			return nil, fmt.Errorf("Error in closeAuction, cannot unmarshal Bid %s: %s", kv.Key, err)
+		}
+		if bid.Revealed && (winner == nil || bid.Amount > winner.Amount) {
+			winner = bid
+		}
+	}
+
+	// An Auction opened before its descriptor was renamed holds an Alias
+	if auction.DescriptorId, err = ac.resolveDescriptorKey(auction.DescriptorId); err != nil {
+		return nil, fmt.Errorf("Error in closeAuction: %s", err)
+	}
+	auction.Status = Auction_CLOSED
+	// A License granted since the Auction opened, e.g. through an Offer, leaves nothing to sell
+	if winner != nil {
+		if err := ac.checkLicenseGrantable(auction.DescriptorId, true); err != nil {
+			auction.UnawardedReason = err.Error()
+			winner = nil
+		}
+	}
+	if winner != nil {
+		auction.Winner = winner.Bidder
+		auction.WinningAmount = winner.Amount
+		license := &License{
+			DescriptorId: auction.DescriptorId,
+			Licensee:     winner.Bidder,
+			Exclusive:    true,
+			AuctionId:    auction_key_part,
+			Amount:       winner.Amount,
+			GrantedAt:    now,
+		}
+		if err := ac.grantLicense(license); err != nil {
+			return nil, fmt.Errorf("Error in closeAuction: %s", err)
+		}
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_AUCTION_OBJECTTYPE, []string{auction_key_part}, auction); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+func (ac *assetContext) GetAuction(request *AuctionRequest) (*Auction, error) {
+	auction, err := ac.getAuctionAsset(request.AuctionKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getAuction: %s", err)
+	}
+	return auction, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Backfills populate a field added to an asset type after assets of that type were stored.
+// Unlike migrations, which run in order from Init, an admin runs each backfill explicitly for
+// one namespace (object type), a batch at a time, passing back the returned bookmark until
+// the BackfillResult is done. Assets that already have the field set are left unchanged.
+
+// backfillAssetTypes returns an empty asset for each namespace that can be backfilled.
+var backfillAssetTypes = map[string]func() proto.Message{
+	COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE: func() proto.Message { return &AppDescriptor{} },
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:     func() proto.Message { return &AppBundle{} },
+	COMPOSITE_KEY_COLLECTION_OBJECTTYPE:     func() proto.Message { return &Collection{} },
+}
+
+// backfillFields maps each field name to the function populating it on an asset, which reports
+// whether the asset changed.
+var backfillFields = map[string]func(ac *assetContext, asset proto.Message) (bool, error){
+	"owner_id":    backfillOwnerId,
+	"created_at":  backfillCreatedAt,
+	"merkle_root": backfillMerkleRoot,
+}
+
+// backfillOwnerId sets owner_id from the owner.
+func backfillOwnerId(ac *assetContext, asset proto.Message) (bool, error) {
+	switch a := asset.(type) {
+	case *AppDescriptor:
+		if len(a.OwnerId) == 0 {
+			a.OwnerId = normalizeIdentity(a.Owner)
+			return true, nil
+		}
+	case *AppBundle:
+		if len(a.OwnerId) == 0 {
+			a.OwnerId = normalizeIdentity(a.Owner)
+			return true, nil
+		}
+	case *Collection:
+		if len(a.OwnerId) == 0 {
+			a.OwnerId = normalizeIdentity(a.Owner)
+			return true, nil
+		}
+	default:
+		return false, fmt.Errorf("%T has no owner_id field", asset)
+	}
+	return false, nil
+}
+
+// backfillCreatedAt sets created_at to the timestamp of the backfill transaction, the earliest
+// time the asset is known to have existed.
+func backfillCreatedAt(ac *assetContext, asset proto.Message) (bool, error) {
+	created_at, err := ac.txTimestamp()
+	if err != nil {
+		return false, err
+	}
+	switch a := asset.(type) {
+	case *AppDescriptor:
+		if a.CreatedAt == 0 {
+			a.CreatedAt = created_at
+			return true, nil
+		}
+	case *AppBundle:
+		if a.CreatedAt == 0 {
+			a.CreatedAt = created_at
+			return true, nil
+		}
+	case *Collection:
+		if a.CreatedAt == 0 {
+			a.CreatedAt = created_at
+			return true, nil
+		}
+	default:
+		return false, fmt.Errorf("%T has no created_at field", asset)
+	}
+	return false, nil
+}
+
+func (ac *assetContext) backfill() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	field := ""
+	namespace := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 4:
+		bookmark = string(args[3])
+		fallthrough
+	case 3:
+		field = string(args[1])
+		namespace = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to backfill")
+	}
+
+	fill, ok := backfillFields[field]
+	if !ok {
+		return nil, fmt.Errorf("Error in backfill: unknown field %s", field)
+	}
+	newAsset, ok := backfillAssetTypes[namespace]
+	if !ok {
+		return nil, fmt.Errorf("Error in backfill: namespace %s cannot be backfilled", namespace)
+	}
+
+	if _, err := ac.holdLock(backfillLockResource(field, namespace), FLOW_LOCK_SECONDS); err != nil {
+		return nil, fmt.Errorf("Error in backfill: %s", err)
+	}
+	backfillResult := &BackfillResult{Field: field, Namespace: namespace}
+	bookmark, done, err := ac.scanBatch(namespace, []string{}, bookmark, MIGRATION_BATCH_SIZE, func(compositeKey string, value []byte) error {
+		asset := newAsset()
+		if err := proto.Unmarshal(value, asset); err != nil {
+			return fmt.Errorf("Cannot unmarshal %s %s: %s", namespace, compositeKey, err)
+		}
+		changed, err := fill(ac, asset)
+		if err != nil || !changed {
+			return err
+		}
+		assetBytes, err := marshalDeterministic(asset)
+		if err != nil {
+			return fmt.Errorf("Error marshaling proto: %s", err)
+		}
+		if err := ac.stub.PutState(compositeKey, assetBytes); err != nil {
+			return fmt.Errorf("Could not put state for key %s: %s", compositeKey, err)
+		}
+		backfillResult.UpdatedCount++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in backfill: %s", err)
+	}
+	backfillResult.Bookmark = bookmark
+	backfillResult.Done = done
+	if done {
+		if err := ac.dropLock(backfillLockResource(field, namespace)); err != nil {
+			return nil, fmt.Errorf("Error in backfill: %s", err)
+		}
+	}
+
+	backfillResultBytes, err := proto.Marshal(backfillResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling BackfillResult in backfill: %s", err)
+	}
+	return backfillResultBytes, nil
+}
@@ -0,0 +1,376 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// Billing closes the loop from pricing to payment. Licensees report metered use with
+// recordUsage, priced by the PricingTier in effect at the time. After a calendar month has
+// ended an admin runs generateInvoices for it, producing one Invoice per descriptor, consumer
+// and currency. The publisher, or an admin, then records payment with markInvoiceSettled;
+// when a token chaincode is configured the payment is first verified against it. Settling
+// credits each party its royalty share; see royalty.go.
+//
+// Usage is keyed by period, descriptor, consumer and transaction ID, so generateInvoices reads
+// a period with a single range query. Invoices are keyed by period, descriptor, consumer and
+// currency.
+
+// PERIOD_LAYOUT formats billing periods, which are calendar months in UTC.
+const PERIOD_LAYOUT = "2006-01"
+
+func billingPeriod(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(PERIOD_LAYOUT)
+}
+
+// addUint64 returns a+b, failing instead of wrapping around.
+func addUint64(a, b uint64) (uint64, error) {
+	if a+b < a {
+		return 0, fmt.Errorf("amount overflows")
+	}
+	return a + b, nil
+}
+
+// mulUint64 returns a*b, failing instead of wrapping around.
+func mulUint64(a, b uint64) (uint64, error) {
+	if a != 0 && (a*b)/a != b {
+		return 0, fmt.Errorf("amount overflows")
+	}
+	return a * b, nil
+}
+
+func (ac *assetContext) recordUsage() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	tier := ""
+	var quantity uint64
+	var err error
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		tier = string(args[2])
+		if quantity, err = strconv.ParseUint(string(args[3]), 10, 64); err != nil || quantity == 0 {
+			return nil, fmt.Errorf("Error in recordUsage, invalid quantity %s", args[3])
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to recordUsage")
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordUsage: %s", err)
+	}
+	licensed, err := ac.keyExists(COMPOSITE_KEY_LICENSE_OBJECTTYPE, []string{app_descriptor_key_part, ac.identity})
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordUsage: %s", err)
+	}
+	if !licensed {
+		return nil, fmt.Errorf("Error in recordUsage, the caller holds no License for AppDescriptor %s", app_descriptor_key_part)
+	}
+	var pricingTier *PricingTier
+	for _, candidate := range appDescriptor.PricingTiers {
+		if candidate.Name == tier {
+			pricingTier = candidate
+		}
+	}
+	if pricingTier == nil {
+		return nil, fmt.Errorf("Error in recordUsage, AppDescriptor %s has no PricingTier %s", app_descriptor_key_part, tier)
+	}
+	if _, err := mulUint64(quantity, pricingTier.UnitPrice); err != nil {
+		return nil, fmt.Errorf("Error in recordUsage: %s", err)
+	}
+
+	recorded_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordUsage: %s", err)
+	}
+	usageRecord := &UsageRecord{
+		DescriptorId: app_descriptor_key_part,
+		Consumer:     ac.creator,
+		ConsumerId:   ac.identity,
+		Tier:         pricingTier.Name,
+		Unit:         pricingTier.Unit,
+		Quantity:     quantity,
+		UnitPrice:    pricingTier.UnitPrice,
+		CurrencyCode: pricingTier.CurrencyCode,
+		RecordedAt:   recorded_at,
+	}
+	return ac.putAsset(COMPOSITE_KEY_USAGE_OBJECTTYPE, []string{billingPeriod(recorded_at), app_descriptor_key_part, ac.identity, ac.stub.GetTxID()}, usageRecord)
+}
+
+// addUsageToInvoice adds usageRecord to the Line for its tier and unit price.
+func addUsageToInvoice(invoice *Invoice, usageRecord *UsageRecord) error {
+	amount, err := mulUint64(usageRecord.Quantity, usageRecord.UnitPrice)
+	if err != nil {
+		return err
+	}
+	var line *Invoice_Line
+	for _, candidate := range invoice.Lines {
+		if candidate.Tier == usageRecord.Tier && candidate.UnitPrice == usageRecord.UnitPrice {
+			line = candidate
+		}
+	}
+	if line == nil {
+		line = &Invoice_Line{Tier: usageRecord.Tier, Unit: usageRecord.Unit, UnitPrice: usageRecord.UnitPrice}
+		invoice.Lines = append(invoice.Lines, line)
+	}
+	if line.Quantity, err = addUint64(line.Quantity, usageRecord.Quantity); err != nil {
+		return err
+	}
+	if line.Amount, err = addUint64(line.Amount, amount); err != nil {
+		return err
+	}
+	invoice.Total, err = addUint64(invoice.Total, amount)
+	return err
+}
+
+func (ac *assetContext) generateInvoices() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	period := ""
+
+	switch len(args) {
+	case 2:
+		period = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to generateInvoices")
+	}
+	if _, err := time.Parse(PERIOD_LAYOUT, period); err != nil {
+		return nil, fmt.Errorf("Error in generateInvoices, period must be YYYY-MM: %s", err)
+	}
+	generated_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+	}
+	// Usage may still be recorded for the current month
+	if period >= billingPeriod(generated_at) {
+		return nil, fmt.Errorf("Error in generateInvoices, period %s has not ended", period)
+	}
+
+	invoices := make(map[string]*Invoice)
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_USAGE_OBJECTTYPE, []string{period})
+	if err != nil {
+		return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+	}
+	defer stateQueryIterator.Close()
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+		}
+		usageRecord := &UsageRecord{}
+		if err := proto.Unmarshal(kv.Value, usageRecord); err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices, cannot unmarshal UsageRecord %s: %s", kv.Key, err)
+		}
+		invoice_key_parts := []string{period, usageRecord.DescriptorId, usageRecord.ConsumerId, usageRecord.CurrencyCode}
+		invoice_key := strings.Join(invoice_key_parts, "\x00")
+		invoice, ok := invoices[invoice_key]
+		if !ok {
+			invoice = &Invoice{
+				Period:       period,
+				DescriptorId: usageRecord.DescriptorId,
+				Consumer:     usageRecord.Consumer,
+				ConsumerId:   usageRecord.ConsumerId,
+				CurrencyCode: usageRecord.CurrencyCode,
+				Status:       Invoice_OPEN,
+				GeneratedAt:  generated_at,
+			}
+			invoices[invoice_key] = invoice
+		}
+		if err := addUsageToInvoice(invoice, usageRecord); err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices, invoice for %s: %s", usageRecord.DescriptorId, err)
+		}
+	}
+
+	var invoice_keys []string
+	for invoice_key := range invoices {
+		invoice_keys = append(invoice_keys, invoice_key)
+	}
+	sort.Strings(invoice_keys)
+
+	result := &InvoiceGenerationResult{Period: period}
+	for _, invoice_key := range invoice_keys {
+		invoice := invoices[invoice_key]
+		invoice_key_parts := strings.Split(invoice_key, "\x00")
+		// Rerunning a period leaves the invoices of the earlier run, and their settlement, alone
+		exists, err := ac.keyExists(COMPOSITE_KEY_INVOICE_OBJECTTYPE, invoice_key_parts)
+		if err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+		}
+		if exists {
+			result.SkippedCount++
+			continue
+		}
+		appDescriptor, err := ac.getDescriptor(invoice.DescriptorId)
+		if err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+		}
+		invoice.Publisher = appDescriptor.Owner
+		invoice.RoyaltyShares = computeRoyaltyShares(invoice.Total, appDescriptor.Owner, appDescriptor.RoyaltySplits)
+		if _, err := ac.putAsset(COMPOSITE_KEY_INVOICE_OBJECTTYPE, invoice_key_parts, invoice); err != nil {
+			return nil, fmt.Errorf("Error in generateInvoices: %s", err)
+		}
+		result.CreatedCount++
+	}
+
+	resultBytes, err := proto.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling InvoiceGenerationResult in generateInvoices: %s", err)
+	}
+	return resultBytes, nil
+}
+
+// verifyTokenPayment checks with the token chaincode that payment_ref pays invoice in full.
+func (ac *assetContext) verifyTokenPayment(tokenChaincode *TokenChaincode, invoice *Invoice, payment_ref string) error {
+	response := ac.stub.InvokeChaincode(tokenChaincode.Name, [][]byte{[]byte("getPayment"), []byte(payment_ref)}, tokenChaincode.Channel)
+	if response.Status >= shim.ERRORTHRESHOLD {
+		return fmt.Errorf("token chaincode %s: %s", tokenChaincode.Name, response.Message)
+	}
+	tokenPayment := &TokenPayment{}
+	if err := proto.Unmarshal(response.Payload, tokenPayment); err != nil {
+		return fmt.Errorf("cannot unmarshal TokenPayment from %s: %s", tokenChaincode.Name, err)
+	}
+	if !bytes.Equal(tokenPayment.Payer, invoice.Consumer) || !bytes.Equal(tokenPayment.Payee, invoice.Publisher) {
+		return fmt.Errorf("payment %s is not from the consumer to the publisher", payment_ref)
+	}
+	if tokenPayment.CurrencyCode != invoice.CurrencyCode || tokenPayment.Amount < invoice.Total {
+		return fmt.Errorf("payment %s of %d %s does not cover %d %s", payment_ref, tokenPayment.Amount, tokenPayment.CurrencyCode, invoice.Total, invoice.CurrencyCode)
+	}
+	return nil
+}
+
+func (ac *assetContext) markInvoiceSettled() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var invoice_key_parts []string
+	payment_ref := ""
+
+	switch len(args) {
+	case 6:
+		invoice_key_parts = []string{string(args[1]), string(args[2]), string(args[3]), string(args[4])}
+		payment_ref = string(args[5])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to markInvoiceSettled")
+	}
+	if len(payment_ref) == 0 {
+		return nil, fmt.Errorf("Error in markInvoiceSettled, payment_ref must not be empty")
+	}
+
+	invoice := &Invoice{}
+	found, err := ac.getAsset(COMPOSITE_KEY_INVOICE_OBJECTTYPE, invoice_key_parts, invoice)
+	if err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in markInvoiceSettled, Invoice not found")
+	}
+	if invoice.Status == Invoice_SETTLED {
+		return nil, fmt.Errorf("Error in markInvoiceSettled, Invoice is already settled by payment %s", invoice.PaymentRef)
+	}
+	if !bytes.Equal(invoice.Publisher, ac.creator) {
+		if err := ac.requireAdmin(); err != nil {
+			return nil, fmt.Errorf("Only the publisher or an admin may settle an Invoice")
+		}
+	}
+	used, err := ac.keyExists(COMPOSITE_KEY_SETTLEMENT_OBJECTTYPE, []string{payment_ref})
+	if err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	if used {
+		return nil, fmt.Errorf("Error in markInvoiceSettled, payment %s already settled an Invoice", payment_ref)
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	if registryConfig.TokenChaincode != nil && len(registryConfig.TokenChaincode.Name) != 0 {
+		if err := ac.verifyTokenPayment(registryConfig.TokenChaincode, invoice, payment_ref); err != nil {
+			return nil, fmt.Errorf("Error in markInvoiceSettled, %s", err)
+		}
+	}
+
+	invoice.Status = Invoice_SETTLED
+	invoice.PaymentRef = payment_ref
+	invoice.SettledBy = ac.creator
+	if invoice.SettledAt, err = ac.txTimestamp(); err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	settlementRecord := &SettlementRecord{
+		Period:       invoice_key_parts[0],
+		DescriptorId: invoice_key_parts[1],
+		ConsumerId:   invoice_key_parts[2],
+		CurrencyCode: invoice_key_parts[3],
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_SETTLEMENT_OBJECTTYPE, []string{payment_ref}, settlementRecord); err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	if err := ac.creditRoyalties(invoice); err != nil {
+		return nil, fmt.Errorf("Error in markInvoiceSettled: %s", err)
+	}
+	return ac.putAsset(COMPOSITE_KEY_INVOICE_OBJECTTYPE, invoice_key_parts, invoice)
+}
+
+func (ac *assetContext) getInvoice() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var invoice_key_parts []string
+
+	switch len(args) {
+	case 5:
+		invoice_key_parts = []string{string(args[1]), string(args[2]), string(args[3]), string(args[4])}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getInvoice")
+	}
+
+	invoice := &Invoice{}
+	found, err := ac.getAsset(COMPOSITE_KEY_INVOICE_OBJECTTYPE, invoice_key_parts, invoice)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getInvoice: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getInvoice, Invoice not found")
+	}
+	invoiceBytes, err := proto.Marshal(invoice)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling Invoice in getInvoice: %s", err)
+	}
+	return invoiceBytes, nil
+}
+
+func (ac *assetContext) setTokenChaincode() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	tokenChaincode := &TokenChaincode{}
+
+	switch len(args) {
+	case 3:
+		tokenChaincode.Channel = string(args[2])
+		fallthrough
+	case 2:
+		tokenChaincode.Name = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setTokenChaincode")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setTokenChaincode: %s", err)
+	}
+	if len(tokenChaincode.Name) == 0 {
+		registryConfig.TokenChaincode = nil
+	} else {
+		registryConfig.TokenChaincode = tokenChaincode
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
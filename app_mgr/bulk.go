@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Bulk reads return one BulkGetResult entry per requested key, so clients can fetch a list of
+// assets in a single evaluation instead of one round trip per key. The existence checks let
+// clients validate references without downloading payloads.
+
+func (ac *assetContext) getAppDescriptorsByKeys() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var keyListBytes = []byte{}
+
+	switch len(args) {
+	case 2:
+		keyListBytes = args[1]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getAppDescriptorsByKeys")
+	}
+
+	keyList := &KeyList{}
+	if err := proto.Unmarshal(keyListBytes, keyList); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal KeyList, err = %s", err)
+	}
+
+	if err := ac.checkQueryKeyCount(len(keyList.Keys)); err != nil {
+		return nil, fmt.Errorf("Error in getAppDescriptorsByKeys: %s", err)
+	}
+
+	bulkGetResult := &BulkGetResult{}
+	for _, app_descriptor_key_part := range keyList.Keys {
+		var key_parts = []string{app_descriptor_key_part}
+		compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, key_parts)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getAppDescriptorsByKeys, could not create composite key for (%s): %s", app_descriptor_key_part, err)
+		}
+		appDescriptorBytesFromStore, err := ac.stub.GetState(compositeKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getAppDescriptorsByKeys, GetState failed for (%s): %s", app_descriptor_key_part, err)
+		}
+		entry := &BulkGetResult_Entry{
+			KeyParts: key_parts,
+			Found:    appDescriptorBytesFromStore != nil,
+			Value:    appDescriptorBytesFromStore,
+		}
+		if entry.Found {
+			entry.Hash = contentHash(entry.Value)
+		}
+		bulkGetResult.Entries = append(bulkGetResult.Entries, entry)
+	}
+
+	bulkGetResultBytes, err := proto.Marshal(bulkGetResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling BulkGetResult in getAppDescriptorsByKeys: %s", err)
+	}
+	return bulkGetResultBytes, nil
+}
+
+func (ac *assetContext) getAppBundlesByKeys() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var bundleKeyListBytes = []byte{}
+
+	switch len(args) {
+	case 2:
+		bundleKeyListBytes = args[1]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getAppBundlesByKeys")
+	}
+
+	bundleKeyList := &BundleKeyList{}
+	if err := proto.Unmarshal(bundleKeyListBytes, bundleKeyList); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal BundleKeyList, err = %s", err)
+	}
+
+	if err := ac.checkQueryKeyCount(len(bundleKeyList.Keys)); err != nil {
+		return nil, fmt.Errorf("Error in getAppBundlesByKeys: %s", err)
+	}
+
+	bulkGetResult := &BulkGetResult{}
+	for _, bundleKey := range bundleKeyList.Keys {
+		var key_parts = []string{bundleKey.DescriptorId, bundleKey.BundleKey}
+		entry := &BulkGetResult_Entry{KeyParts: key_parts}
+		appBundle := &AppBundle{}
+		found, err := ac.getAsset(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts, appBundle)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getAppBundlesByKeys: %s", err)
+		}
+		if found {
+			entry.Found = true
+			if err := ac.checkBundleReadAccess(bundleKey.DescriptorId, bundleKey.BundleKey, appBundle); err != nil {
+				entry.Error = err.Error()
+			} else if entry.Value, err = proto.Marshal(appBundle); err != nil {
+				return nil, fmt.Errorf("Error marshalling AppBundle in getAppBundlesByKeys: %s", err)
+			} else {
+				entry.Hash = contentHash(entry.Value)
+			}
+		}
+		bulkGetResult.Entries = append(bulkGetResult.Entries, entry)
+	}
+
+	bulkGetResultBytes, err := proto.Marshal(bulkGetResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling BulkGetResult in getAppBundlesByKeys: %s", err)
+	}
+	return bulkGetResultBytes, nil
+}
+
+// keyExists reports whether any value is stored under the composite key for objectType and
+// key_parts.
+func (ac *assetContext) keyExists(objectType string, key_parts []string) (bool, error) {
+	compositeKey, err := ac.stub.CreateCompositeKey(objectType, key_parts)
+	if err != nil {
+		return false, fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, key_parts, err)
+	}
+	valueBytes, err := ac.stub.GetState(compositeKey)
+	if err != nil {
+		return false, fmt.Errorf("Error in GetState using composite key (%v): %s", compositeKey, err)
+	}
+	return valueBytes != nil, nil
+}
+
+func marshalExistsResult(exists bool) ([]byte, error) {
+	existsResultBytes, err := proto.Marshal(&ExistsResult{Exists: exists})
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ExistsResult: %s", err)
+	}
+	return existsResultBytes, nil
+}
+
+func (ac *assetContext) descriptorExists() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to descriptorExists")
+	}
+
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in descriptorExists: %s", err)
+	}
+	return marshalExistsResult(exists)
+}
+
+func (ac *assetContext) bundleExists() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to bundleExists")
+	}
+
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in bundleExists: %s", err)
+	}
+	return marshalExistsResult(exists)
+}
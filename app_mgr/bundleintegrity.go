@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// An AppBundle records the SHA-256 digest of each artifact and chaincode deployment spec when
+// it is created, and the Merkle root over those digests, so verifyBundleIntegrity can later
+// re-hash the stored content and detect corruption, e.g. after a state restore or migration.
+// checkIntegrity only checks references between assets; this checks the content itself.
+// Bundles stored before digests were recorded can be backfilled with the merkle_root field,
+// which records the content as stored, so only later corruption is detected for them.
+//
+// The Merkle tree hashes each leaf as SHA-256(0x00 || digest) and each node as
+// SHA-256(0x01 || left || right), an odd last node being carried up unchanged, so that a leaf
+// can never be taken for a node.
+
+// bundleContents returns the artifacts followed by the chaincode deployment specs of appBundle,
+// with their names for reports.
+func bundleContents(appBundle *AppBundle) ([][]byte, []string) {
+	var contents [][]byte
+	var names []string
+	for i, artifact := range appBundle.Artifacts {
+		contents = append(contents, artifact)
+		names = append(names, fmt.Sprintf("artifacts[%d]", i))
+	}
+	for i, chaincodeDeploymentSpec := range appBundle.ChaincodeDeploymentSpecs {
+		contents = append(contents, chaincodeDeploymentSpec)
+		names = append(names, fmt.Sprintf("chaincode_deployment_specs[%d]", i))
+	}
+	return contents, names
+}
+
+func contentDigests(contents [][]byte) [][]byte {
+	var digests [][]byte
+	for _, content := range contents {
+		digest := sha256.Sum256(content)
+		digests = append(digests, digest[:])
+	}
+	return digests
+}
+
+// merkleRoot returns the root of the Merkle tree over digests.
+func merkleRoot(digests [][]byte) []byte {
+	if len(digests) == 0 {
+		return nil
+	}
+	var level [][]byte
+	for _, digest := range digests {
+		leaf := sha256.Sum256(append([]byte{0x00}, digest...))
+		level = append(level, leaf[:])
+	}
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i+1 < len(level); i += 2 {
+			node := sha256.Sum256(append(append([]byte{0x01}, level[i]...), level[i+1]...))
+			next = append(next, node[:])
+		}
+		if len(level)%2 == 1 {
+			next = append(next, level[len(level)-1])
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// recordContentDigests sets the content digests and Merkle root of an AppBundle to be created.
+// Digests passed by the owner must match the content, so corruption in transit is detected.
+func recordContentDigests(appBundle *AppBundle) error {
+	contents, names := bundleContents(appBundle)
+	digests := contentDigests(contents)
+	if len(appBundle.ContentDigests) != 0 {
+		if len(appBundle.ContentDigests) != len(digests) {
+			return fmt.Errorf("the AppBundle has %d content_digests for %d artifacts and deployment specs", len(appBundle.ContentDigests), len(digests))
+		}
+		for i, digest := range digests {
+			if !bytes.Equal(appBundle.ContentDigests[i], digest) {
+				return fmt.Errorf("content_digests[%d] does not match %s", i, names[i])
+			}
+		}
+	}
+	root := merkleRoot(digests)
+	if len(appBundle.MerkleRoot) != 0 && !bytes.Equal(appBundle.MerkleRoot, root) {
+		return fmt.Errorf("merkle_root does not match the content")
+	}
+	appBundle.ContentDigests = digests
+	appBundle.MerkleRoot = root
+	return nil
+}
+
+// backfillMerkleRoot records the content digests and Merkle root of an AppBundle.
+func backfillMerkleRoot(ac *assetContext, asset proto.Message) (bool, error) {
+	appBundle, ok := asset.(*AppBundle)
+	if !ok {
+		return false, fmt.Errorf("%T has no merkle_root field", asset)
+	}
+	if len(appBundle.MerkleRoot) != 0 {
+		return false, nil
+	}
+	return true, recordContentDigests(appBundle)
+}
+
+func (ac *assetContext) verifyBundleIntegrity() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to verifyBundleIntegrity")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in verifyBundleIntegrity: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in verifyBundleIntegrity: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in verifyBundleIntegrity: %s", err)
+	}
+
+	contents, names := bundleContents(appBundle)
+	digests := contentDigests(contents)
+	if appBundle.RetentionTier == AppBundle_COLD {
+		// The content is kept off-chain, only its recorded digests are left to check
+		digests = appBundle.ContentDigests
+	}
+	report := &BundleIntegrityReport{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		Recorded:     len(appBundle.MerkleRoot) != 0,
+		MerkleRoot:   merkleRoot(digests),
+	}
+	if report.Recorded {
+		if len(appBundle.ContentDigests) != len(digests) {
+			report.Failures = append(report.Failures, fmt.Sprintf("%d content digests are recorded for %d artifacts and deployment specs", len(appBundle.ContentDigests), len(digests)))
+		}
+		for i, digest := range digests {
+			if i < len(appBundle.ContentDigests) && !bytes.Equal(appBundle.ContentDigests[i], digest) {
+				report.Failures = append(report.Failures, fmt.Sprintf("%s: digest mismatch", names[i]))
+			}
+		}
+		if !bytes.Equal(merkleRoot(appBundle.ContentDigests), appBundle.MerkleRoot) {
+			report.Failures = append(report.Failures, "merkle_root does not match the recorded content digests")
+		}
+		if len(appBundle.TimestampToken) != 0 {
+			if _, err := verifyTimestampToken(appBundle.TimestampToken, appBundle.MerkleRoot); err != nil {
+				report.Failures = append(report.Failures, err.Error())
+			}
+		}
+		report.Passed = len(report.Failures) == 0
+	}
+
+	reportBytes, err := proto.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling BundleIntegrityReport in verifyBundleIntegrity: %s", err)
+	}
+	return reportBytes, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// An AppBundle's chaincode deployment specs may package Go, Node.js or Java chaincode.
+// newAppBundle checks that each spec is a ChaincodeDeploymentSpec of one of those languages and
+// records its name, version and language in a ChaincodePackage, beside the runtime the owner
+// declares, so consumers can find the bundles their peers can run with
+// getBundlesForDescriptorByLanguage without unpacking the specs. Specs are not checked in
+// LENIENT namespaces.
+
+// describeChaincodePackages fills in the ChaincodePackage of each chaincode deployment spec of
+// appBundle. The owner may pass the ChaincodePackages to declare their runtimes.
+func (ac *assetContext) describeChaincodePackages(appBundle *AppBundle) error {
+	if lenient, err := ac.isLenient(); err != nil || lenient {
+		return err
+	}
+	if len(appBundle.ChaincodePackages) == 0 {
+		appBundle.ChaincodePackages = make([]*ChaincodePackage, len(appBundle.ChaincodeDeploymentSpecs))
+	}
+	if len(appBundle.ChaincodePackages) != len(appBundle.ChaincodeDeploymentSpecs) {
+		return fmt.Errorf("the AppBundle has %d chaincode_packages for %d chaincode_deployment_specs", len(appBundle.ChaincodePackages), len(appBundle.ChaincodeDeploymentSpecs))
+	}
+	for i, chaincodeDeploymentSpecBytes := range appBundle.ChaincodeDeploymentSpecs {
+		chaincodeDeploymentSpec := &pb.ChaincodeDeploymentSpec{}
+		if err := proto.Unmarshal(chaincodeDeploymentSpecBytes, chaincodeDeploymentSpec); err != nil {
+			return fmt.Errorf("chaincode_deployment_specs[%d] is not a ChaincodeDeploymentSpec: %s", i, err)
+		}
+		chaincodeSpec := chaincodeDeploymentSpec.ChaincodeSpec
+		if chaincodeSpec.GetChaincodeId().GetName() == "" {
+			return fmt.Errorf("chaincode_deployment_specs[%d] has no chaincode name", i)
+		}
+		language, ok := ChaincodePackage_Language_name[int32(chaincodeSpec.Type)]
+		if !ok || chaincodeSpec.Type == pb.ChaincodeSpec_UNDEFINED {
+			return fmt.Errorf("chaincode_deployment_specs[%d] has unsupported type %s", i, chaincodeSpec.Type)
+		}
+		if len(chaincodeDeploymentSpec.CodePackage) == 0 {
+			return fmt.Errorf("chaincode_deployment_specs[%d] has no code package", i)
+		}
+
+		chaincodePackage := appBundle.ChaincodePackages[i]
+		if chaincodePackage == nil {
+			chaincodePackage = &ChaincodePackage{}
+			appBundle.ChaincodePackages[i] = chaincodePackage
+		}
+		if chaincodePackage.Language != ChaincodePackage_UNDEFINED && chaincodePackage.Language != ChaincodePackage_Language(chaincodeSpec.Type) {
+			return fmt.Errorf("chaincode_packages[%d] declares language %s, but the deployment spec is %s", i, chaincodePackage.Language, language)
+		}
+		chaincodePackage.Name = chaincodeSpec.ChaincodeId.Name
+		chaincodePackage.Version = chaincodeSpec.ChaincodeId.Version
+		chaincodePackage.Language = ChaincodePackage_Language(chaincodeSpec.Type)
+	}
+	return nil
+}
+
+// filterAppBundleKeySet returns the keys of the AppBundles of an AppDescriptor that match,
+// paging through the AppBundles as getAppBundleKeySetForDescriptor does. A page may hold fewer
+// keys than the query limits allow, or none, while has_more is set.
+func (ac *assetContext) filterAppBundleKeySet(app_descriptor_key_part string, bookmark string, match func(*AppBundle) bool) (*AppBundleKeySet, error) {
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, err
+	}
+
+	query_results, err := ac.query(&Query{ObjectType: Query_APP_BUNDLE, KeyParts: []string{app_descriptor_key_part}, ReturnValues: true, Bookmark: bookmark})
+	if err != nil {
+		return nil, err
+	}
+	appBundleKeySet := &AppBundleKeySet{DescriptorId: app_descriptor_key_part, HasMore: query_results.HasMore, Bookmark: query_results.Bookmark}
+	for _, entry := range query_results.Results {
+		appBundle := &AppBundle{}
+		if err := proto.Unmarshal(entry.Value, appBundle); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal AppBundle %s: %s", entry.Key, err)
+		}
+		if match(appBundle) {
+			appBundleKeySet.BundleKeys = append(appBundleKeySet.BundleKeys, entry.Key)
+		}
+	}
+	return appBundleKeySet, nil
+}
+
+// getBundlesForDescriptorByLanguage returns the keys of the AppBundles of an AppDescriptor with a
+// chaincode package of the language, and of the runtime unless it is empty.
+func (ac *assetContext) getBundlesForDescriptorByLanguage() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	language_name := ""
+	runtime := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 5:
+		bookmark = string(args[4])
+		fallthrough
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		language_name = string(args[2])
+		runtime = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getBundlesForDescriptorByLanguage")
+	}
+	language_value, ok := ChaincodePackage_Language_value[language_name]
+	if !ok || ChaincodePackage_Language(language_value) == ChaincodePackage_UNDEFINED {
+		return nil, fmt.Errorf("Error in getBundlesForDescriptorByLanguage, unknown language '%s'", language_name)
+	}
+
+	appBundleKeySet, err := ac.filterAppBundleKeySet(app_descriptor_key_part, bookmark, func(appBundle *AppBundle) bool {
+		for _, chaincodePackage := range appBundle.ChaincodePackages {
+			if chaincodePackage.Language == ChaincodePackage_Language(language_value) && (len(runtime) == 0 || chaincodePackage.Runtime == runtime) {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getBundlesForDescriptorByLanguage: %s", err)
+	}
+	appBundleKeySetBytes, err := proto.Marshal(appBundleKeySet)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling AppBundleKeySet in getBundlesForDescriptorByLanguage: %s", err)
+	}
+	return appBundleKeySetBytes, nil
+}
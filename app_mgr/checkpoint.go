@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Off-chain consumers of RegistryEvents, such as the gateway's notification services, keep
+// their replay position on the ledger they consume: after processing the events of a block a
+// consumer records a ConsumerCheckpoint, and on restart it resumes its block events after the
+// checkpoint it reads back, skipping the transactions it has already processed. A checkpoint
+// belongs to the identity that first records it, and it never moves back to an earlier block,
+// so a stale instance of a consumer cannot rewind the others. The checkpoint write itself emits
+// a RegistryEvent like any other, which consumers can ignore by its CONSUMER_CHECKPOINT key.
+
+// MAX_CONSUMER_ID_LENGTH bounds the IDs of consumers, e.g. "gateway-notifier".
+const MAX_CONSUMER_ID_LENGTH = 64
+
+func (ac *assetContext) RecordConsumerCheckpoint(request *RecordConsumerCheckpointRequest) (*ConsumerCheckpoint, error) {
+	consumer_id := request.ConsumerId
+	block_number := request.BlockNumber
+	tx_id := request.TxId
+
+	if len(consumer_id) == 0 || len(consumer_id) > MAX_CONSUMER_ID_LENGTH {
+		return nil, fmt.Errorf("Error in recordConsumerCheckpoint, the consumer_id must be between 1 and %d bytes", MAX_CONSUMER_ID_LENGTH)
+	}
+	if len(tx_id) == 0 {
+		return nil, fmt.Errorf("Error in recordConsumerCheckpoint: a tx is required")
+	}
+	consumerCheckpoint := &ConsumerCheckpoint{}
+	found, err := ac.getAsset(COMPOSITE_KEY_CONSUMER_CHECKPOINT_OBJECTTYPE, []string{consumer_id}, consumerCheckpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordConsumerCheckpoint: %s", err)
+	}
+	if found {
+		if !bytes.Equal(consumerCheckpoint.Owner, ac.creator) {
+			return nil, fmt.Errorf("Error in recordConsumerCheckpoint: the checkpoint of consumer %s belongs to %s", consumer_id, consumerCheckpoint.OwnerId)
+		}
+		if block_number < consumerCheckpoint.BlockNumber {
+			return nil, fmt.Errorf("Error in recordConsumerCheckpoint: consumer %s is already checkpointed at block %d", consumer_id, consumerCheckpoint.BlockNumber)
+		}
+	} else {
+		consumerCheckpoint = &ConsumerCheckpoint{ConsumerId: consumer_id, Owner: ac.creator, OwnerId: ac.identity}
+	}
+
+	recorded_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordConsumerCheckpoint: %s", err)
+	}
+	consumerCheckpoint.BlockNumber = block_number
+	consumerCheckpoint.TxId = tx_id
+	consumerCheckpoint.RecordedAt = recorded_at
+	if _, err := ac.putAsset(COMPOSITE_KEY_CONSUMER_CHECKPOINT_OBJECTTYPE, []string{consumer_id}, consumerCheckpoint); err != nil {
+		return nil, fmt.Errorf("Error in recordConsumerCheckpoint: %s", err)
+	}
+	return consumerCheckpoint, nil
+}
+
+func (ac *assetContext) GetConsumerCheckpoint(request *ConsumerCheckpointRequest) (*ConsumerCheckpoint, error) {
+	consumer_id := request.ConsumerId
+
+	consumerCheckpoint := &ConsumerCheckpoint{}
+	found, err := ac.getAsset(COMPOSITE_KEY_CONSUMER_CHECKPOINT_OBJECTTYPE, []string{consumer_id}, consumerCheckpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConsumerCheckpoint: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getConsumerCheckpoint: consumer %s has no checkpoint", consumer_id)
+	}
+	return consumerCheckpoint, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// computeRegistryChecksum hashes every key/value pair of a namespace (an object type such as
+// APP_DESCRIPTOR) in key order, so auditors can compare the registry state held by two peers
+// or by a backup. An empty namespace hashes all object types in enum order.
+func (ac *assetContext) computeRegistryChecksum() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+
+	switch len(args) {
+	case 2:
+		namespace = string(args[1])
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to computeRegistryChecksum")
+	}
+
+	var objectTypes []Query_ObjectType
+	if len(namespace) == 0 {
+		objectTypes = allObjectTypes()
+	} else {
+		object_type_value, ok := Query_ObjectType_value[namespace]
+		if !ok {
+			return nil, fmt.Errorf("Error in computeRegistryChecksum, unknown namespace '%s'", namespace)
+		}
+		if err := checkNotSystemObjectType(namespace); err != nil {
+			return nil, fmt.Errorf("Error in computeRegistryChecksum: %s", err)
+		}
+		objectTypes = []Query_ObjectType{Query_ObjectType(object_type_value)}
+	}
+
+	digest := sha256.New()
+	var key_count uint64
+	for _, objectType := range objectTypes {
+		count, err := ac.hashNamespace(digest, objectType)
+		if err != nil {
+			return nil, fmt.Errorf("Error in computeRegistryChecksum: %s", err)
+		}
+		key_count += count
+	}
+
+	registryChecksum := &RegistryChecksum{Namespace: namespace, Digest: digest.Sum(nil), KeyCount: key_count}
+	registryChecksumBytes, err := proto.Marshal(registryChecksum)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RegistryChecksum in computeRegistryChecksum: %s", err)
+	}
+	return registryChecksumBytes, nil
+}
+
+// allObjectTypes returns every Query_ObjectType in ascending enum order.
+func allObjectTypes() []Query_ObjectType {
+	var values []int
+	for value := range Query_ObjectType_name {
+		values = append(values, int(value))
+	}
+	sort.Ints(values)
+	var objectTypes []Query_ObjectType
+	for _, value := range values {
+		objectTypes = append(objectTypes, Query_ObjectType(value))
+	}
+	return objectTypes
+}
+
+// hashNamespace writes each length-prefixed key and value of objectType into digest, in the
+// key order returned by the state iterator, and returns the number of keys hashed.
+func (ac *assetContext) hashNamespace(digest hash.Hash, objectType Query_ObjectType) (uint64, error) {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(objectType.String(), []string{})
+	if err != nil {
+		return 0, fmt.Errorf("Error iterating namespace %s: %s", objectType, err)
+	}
+	defer stateQueryIterator.Close()
+
+	var key_count uint64
+	var length = make([]byte, 8)
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return 0, fmt.Errorf("Error iterating namespace %s: %s", objectType, err)
+		}
+		binary.BigEndian.PutUint64(length, uint64(len(kv.Key)))
+		digest.Write(length)
+		digest.Write([]byte(kv.Key))
+		binary.BigEndian.PutUint64(length, uint64(len(kv.Value)))
+		digest.Write(length)
+		digest.Write(kv.Value)
+		key_count++
+	}
+	return key_count, nil
+}
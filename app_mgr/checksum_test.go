@@ -0,0 +1,41 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+func TestRegistryChecksumNamespaces(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "checksum"})
+	tests := []struct {
+		namespace string
+		err       string
+	}{
+		{"", ""},
+		{"APP_DESCRIPTOR", ""},
+		{"APP_BUNDLE", ""},
+		{"NOPE", "unknown namespace"},
+		{"CONFIG", "reserved to the registry"},
+		{"RATE_COUNTER", "reserved to the registry"},
+		{"ALIAS", "reserved to the registry"},
+	}
+	for _, test := range tests {
+		response := s.invoke(t, "computeRegistryChecksum", test.namespace)
+		if len(test.err) == 0 {
+			if response.Status != shim.OK {
+				t.Errorf("namespace '%s': %s", test.namespace, response.Message)
+			}
+		} else if response.Status == shim.OK || !strings.Contains(response.Message, test.err) {
+			t.Errorf("namespace '%s': got %q, want an error containing %q", test.namespace, response.Message, test.err)
+		}
+	}
+}
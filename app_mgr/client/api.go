@@ -0,0 +1,67 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ApiDescriptor is the description of the chaincode returned by getApiDescriptor.
+type ApiDescriptor struct {
+	Functions   map[string]*ApiFunction `json:"functions"`
+	Definitions map[string]*JSONSchema  `json:"definitions"`
+}
+
+// ApiFunction describes a chaincode function. Query functions have no side effects and may be
+// evaluated; the others must be submitted. Arguments lists every argument if Complete is set,
+// otherwise only the message arguments.
+type ApiFunction struct {
+	Write     bool           `json:"write,omitempty"`
+	Admin     bool           `json:"admin,omitempty"`
+	Wrapper   bool           `json:"wrapper,omitempty"`
+	Migration bool           `json:"migration,omitempty"`
+	Query     bool           `json:"query,omitempty"`
+	Complete  bool           `json:"complete,omitempty"`
+	Arguments []*ApiArgument `json:"arguments,omitempty"`
+	Response  *JSONSchema    `json:"response,omitempty"`
+}
+
+// ApiArgument describes the positional argument at Index, where 0 is the function name.
+// Encoding is one of "string", "bytes", "decimal", "bool", "enum" or "proto".
+type ApiArgument struct {
+	Index    int         `json:"index"`
+	Name     string      `json:"name,omitempty"`
+	Encoding string      `json:"encoding"`
+	Schema   *JSONSchema `json:"schema"`
+	Required []string    `json:"required,omitempty"`
+}
+
+// JSONSchema describes a value in the encoding/json form of the message types.
+type JSONSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	EnumNames            []string               `json:"x-enumNames,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+// GetApiDescriptor returns the description of the chaincode's functions and messages.
+func (c *Client) GetApiDescriptor() (*ApiDescriptor, error) {
+	payload, err := c.Evaluate("getApiDescriptor")
+	if err != nil {
+		return nil, err
+	}
+	apiDescriptor := &ApiDescriptor{}
+	if err := json.Unmarshal(payload, apiDescriptor); err != nil {
+		return nil, &Error{Function: "getApiDescriptor", Err: fmt.Errorf("cannot unmarshal ApiDescriptor: %s", err)}
+	}
+	return apiDescriptor, nil
+}
@@ -0,0 +1,700 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package client wraps the app_mgr chaincode functions in typed Go calls, so integrators pass
+// and receive messages instead of hand-crafting byte-slice args. It does not depend on a
+// particular Fabric SDK: a Client invokes the chaincode through an Invoker, which can be
+// backed by fabric-sdk-go, the Fabric Gateway (see NewGatewayInvoker) or a test double.
+//
+// The messages in app.pb.go are generated from ../app.proto with go_package set to client;
+// their proto names match the chaincode's, so the wire format is identical. Regenerate it
+// whenever app.proto changes.
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Invoker submits or evaluates a chaincode function, returning the response payload or an
+// error carrying the chaincode's error message.
+type Invoker interface {
+	// Submit endorses and commits a transaction. transient may be nil.
+	Submit(function string, args [][]byte, transient map[string][]byte) ([]byte, error)
+	// Evaluate queries a peer without committing.
+	Evaluate(function string, args [][]byte) ([]byte, error)
+}
+
+// ErrorCode classifies the error messages returned by the chaincode.
+type ErrorCode int
+
+const (
+	Unknown ErrorCode = iota
+	NotFound
+	AlreadyExists
+	PermissionDenied
+	InvalidArgument
+	// Paused means the registry is paused or in maintenance.
+	Paused
+)
+
+var errorCodeNames = []string{"Unknown", "NotFound", "AlreadyExists", "PermissionDenied", "InvalidArgument", "Paused"}
+
+func (c ErrorCode) String() string {
+	if int(c) < len(errorCodeNames) {
+		return errorCodeNames[c]
+	}
+	return "ErrorCode(" + strconv.Itoa(int(c)) + ")"
+}
+
+// errorCodePatterns maps phrases the chaincode uses in its error messages to an ErrorCode,
+// checked in order.
+var errorCodePatterns = []struct {
+	phrase string
+	code   ErrorCode
+}{
+	{"Invalid argument", InvalidArgument},
+	{"paused", Paused},
+	{"maintenance", Paused},
+	{"already exists", AlreadyExists},
+	{"not found", NotFound},
+	{"Only ", PermissionDenied},
+	{"only the", PermissionDenied},
+	{"not authorized", PermissionDenied},
+	{"Wrong number of arguments", InvalidArgument},
+	{"invalid", InvalidArgument},
+	{"Cannot unmarshal", InvalidArgument},
+}
+
+// Error is returned for a failed chaincode call.
+type Error struct {
+	Function string
+	Code     ErrorCode
+	// The error returned by the Invoker.
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s failed (%s): %s", e.Function, e.Code, e.Err)
+}
+
+// Code returns the ErrorCode of err if it is an *Error, otherwise Unknown.
+func Code(err error) ErrorCode {
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	return Unknown
+}
+
+func classify(message string) ErrorCode {
+	for _, pattern := range errorCodePatterns {
+		if strings.Contains(message, pattern.phrase) {
+			return pattern.code
+		}
+	}
+	return Unknown
+}
+
+// Client calls the app_mgr chaincode through an Invoker.
+type Client struct {
+	invoker Invoker
+}
+
+// New returns a Client invoking the chaincode through invoker.
+func New(invoker Invoker) *Client {
+	return &Client{invoker: invoker}
+}
+
+// tracedInvoker runs every invocation through the chaincode's traced function.
+type tracedInvoker struct {
+	invoker Invoker
+	traceId string
+}
+
+func (t *tracedInvoker) tracedArgs(function string, args [][]byte) [][]byte {
+	return append([][]byte{[]byte(t.traceId), []byte(function)}, args...)
+}
+
+func (t *tracedInvoker) Submit(function string, args [][]byte, transient map[string][]byte) ([]byte, error) {
+	return t.invoker.Submit("traced", t.tracedArgs(function, args), transient)
+}
+
+func (t *tracedInvoker) Evaluate(function string, args [][]byte) ([]byte, error) {
+	return t.invoker.Evaluate("traced", t.tracedArgs(function, args))
+}
+
+// WithTraceId returns a Client whose invocations carry traceId, which the chaincode records in
+// their RegistryEvents and audit records.
+func (c *Client) WithTraceId(traceId string) *Client {
+	return &Client{invoker: &tracedInvoker{invoker: c.invoker, traceId: traceId}}
+}
+
+// compressingInvoker runs every evaluation through the chaincode's compressed function, which
+// only compresses query functions; submissions are passed through.
+type compressingInvoker struct {
+	invoker Invoker
+}
+
+func (c *compressingInvoker) Submit(function string, args [][]byte, transient map[string][]byte) ([]byte, error) {
+	return c.invoker.Submit(function, args, transient)
+}
+
+func (c *compressingInvoker) Evaluate(function string, args [][]byte) ([]byte, error) {
+	payload, err := c.invoker.Evaluate("compressed", append([][]byte{[]byte(function)}, args...))
+	if err != nil {
+		return nil, err
+	}
+	return DecompressPayload(payload)
+}
+
+// WithCompression returns a Client whose query functions have their large payloads compressed
+// by the chaincode, and decompressed again before they are returned. Apply WithTraceId after
+// it, since compressed does not wrap the traced function.
+func (c *Client) WithCompression() *Client {
+	return &Client{invoker: &compressingInvoker{invoker: c.invoker}}
+}
+
+// gzipMagic begins every gzip stream, and never a marshaled message.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// DecompressPayload returns payload decompressed if it is gzip-compressed, as the chaincode's
+// compressed function returns large payloads, and payload itself otherwise.
+func DecompressPayload(payload []byte) ([]byte, error) {
+	if !bytes.HasPrefix(payload, gzipMagic) {
+		return payload, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decompress payload: %s", err)
+	}
+	defer reader.Close()
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decompress payload: %s", err)
+	}
+	return decompressed, nil
+}
+
+// ParseResponseMessage returns the trace ID, any ResponseWarnings and the content encoding of
+// the payload carried by the message of a successful chaincode response, for Invokers whose
+// SDK exposes it.
+func ParseResponseMessage(message string) (*ResponseMetadata, error) {
+	if !strings.HasPrefix(message, "{") {
+		return &ResponseMetadata{TraceId: message}, nil
+	}
+	responseMetadata := &ResponseMetadata{}
+	if err := json.Unmarshal([]byte(message), responseMetadata); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal ResponseMetadata: %s", err)
+	}
+	return responseMetadata, nil
+}
+
+// args converts each of values, a string, []byte or proto.Message, to a chaincode argument.
+func args(values ...interface{}) ([][]byte, error) {
+	var result [][]byte
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			result = append(result, []byte(v))
+		case []byte:
+			result = append(result, v)
+		case proto.Message:
+			b, err := proto.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, b)
+		default:
+			return nil, fmt.Errorf("unsupported argument type %T", value)
+		}
+	}
+	return result, nil
+}
+
+// call submits function, or evaluates it if submit is false, and unmarshals the payload
+// into response unless response is nil.
+func (c *Client) call(submit bool, transient map[string][]byte, response proto.Message, function string, values ...interface{}) error {
+	chaincodeArgs, err := args(values...)
+	if err != nil {
+		return &Error{Function: function, Code: InvalidArgument, Err: err}
+	}
+	var payload []byte
+	if submit {
+		payload, err = c.invoker.Submit(function, chaincodeArgs, transient)
+	} else {
+		payload, err = c.invoker.Evaluate(function, chaincodeArgs)
+	}
+	if err != nil {
+		return &Error{Function: function, Code: classify(err.Error()), Err: err}
+	}
+	if response != nil {
+		if err := proto.Unmarshal(payload, response); err != nil {
+			return &Error{Function: function, Err: fmt.Errorf("cannot unmarshal %T: %s", response, err)}
+		}
+	}
+	return nil
+}
+
+// Submit calls any chaincode function in a transaction, for functions without a typed wrapper.
+func (c *Client) Submit(function string, args ...[]byte) ([]byte, error) {
+	payload, err := c.invoker.Submit(function, args, nil)
+	if err != nil {
+		return nil, &Error{Function: function, Code: classify(err.Error()), Err: err}
+	}
+	return payload, nil
+}
+
+// Evaluate queries any chaincode function, for functions without a typed wrapper.
+func (c *Client) Evaluate(function string, args ...[]byte) ([]byte, error) {
+	payload, err := c.invoker.Evaluate(function, args)
+	if err != nil {
+		return nil, &Error{Function: function, Code: classify(err.Error()), Err: err}
+	}
+	return payload, nil
+}
+
+// CreateAppDescriptor creates an AppDescriptor; privateDetails may be nil.
+func (c *Client) CreateAppDescriptor(key string, appDescriptor *AppDescriptor, privateDetails *DescriptorPrivateDetails) (*AppDescriptor, error) {
+	var transient map[string][]byte
+	if privateDetails != nil {
+		b, err := proto.Marshal(privateDetails)
+		if err != nil {
+			return nil, &Error{Function: "createAppDescriptor", Code: InvalidArgument, Err: err}
+		}
+		transient = map[string][]byte{"private_details": b}
+	}
+	created := &AppDescriptor{}
+	if err := c.call(true, transient, created, "createAppDescriptor", key, appDescriptor); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetAppDescriptor returns an AppDescriptor, with its private details if the caller may see them.
+func (c *Client) GetAppDescriptor(key string) (*AppDescriptor, error) {
+	appDescriptor := &AppDescriptor{}
+	if err := c.call(false, nil, appDescriptor, "getAppDescriptor", key); err != nil {
+		return nil, err
+	}
+	return appDescriptor, nil
+}
+
+// GetAppDescriptors returns a page of AppDescriptors after bookmark, empty for the first page.
+func (c *Client) GetAppDescriptors(bookmark string) (*AppDescriptors, error) {
+	values := []interface{}{}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	appDescriptors := &AppDescriptors{}
+	if err := c.call(false, nil, appDescriptors, "getAppDescriptors", values...); err != nil {
+		return nil, err
+	}
+	return appDescriptors, nil
+}
+
+// GetChildDescriptors returns a page of the AppDescriptors whose parent is key.
+func (c *Client) GetChildDescriptors(key string, bookmark string) (*AppDescriptors, error) {
+	values := []interface{}{key}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	appDescriptors := &AppDescriptors{}
+	if err := c.call(false, nil, appDescriptors, "getChildDescriptors", values...); err != nil {
+		return nil, err
+	}
+	return appDescriptors, nil
+}
+
+// DescriptorExists reports whether an AppDescriptor exists.
+func (c *Client) DescriptorExists(key string) (bool, error) {
+	existsResult := &ExistsResult{}
+	if err := c.call(false, nil, existsResult, "descriptorExists", key); err != nil {
+		return false, err
+	}
+	return existsResult.Exists, nil
+}
+
+// CreateAppBundle creates an AppBundle.
+func (c *Client) CreateAppBundle(key string, appBundle *AppBundle) (*AppBundle, error) {
+	created := &AppBundle{}
+	if err := c.call(true, nil, created, "createAppBundle", key, appBundle); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// AssociateDescriptorWithBundle associates an AppBundle with an AppDescriptor.
+func (c *Client) AssociateDescriptorWithBundle(descriptorKey string, bundleKey string) (*AppDescriptor, error) {
+	appDescriptor := &AppDescriptor{}
+	if err := c.call(true, nil, appDescriptor, "associateDescriptorWithBundle", descriptorKey, bundleKey); err != nil {
+		return nil, err
+	}
+	return appDescriptor, nil
+}
+
+// AssociateDescriptorWithBundleRangeChecked associates an AppBundle with an AppDescriptor,
+// finding the bundle by a range read so the transaction fails validation if a bundle of the
+// descriptor is created or deleted concurrently.
+func (c *Client) AssociateDescriptorWithBundleRangeChecked(descriptorKey string, bundleKey string) (*AppDescriptor, error) {
+	appDescriptor := &AppDescriptor{}
+	if err := c.call(true, nil, appDescriptor, "associateDescriptorWithBundle", descriptorKey, bundleKey, "true"); err != nil {
+		return nil, err
+	}
+	return appDescriptor, nil
+}
+
+// GetAppBundleForDescriptor returns one of an AppDescriptor's AppBundles.
+func (c *Client) GetAppBundleForDescriptor(descriptorKey string, bundleKey string) (*AppBundle, error) {
+	appBundle := &AppBundle{}
+	if err := c.call(false, nil, appBundle, "getAppBundleForDescriptor", descriptorKey, bundleKey); err != nil {
+		return nil, err
+	}
+	return appBundle, nil
+}
+
+// GetAppBundleKeySetForDescriptor returns a page of the keys of an AppDescriptor's AppBundles.
+func (c *Client) GetAppBundleKeySetForDescriptor(descriptorKey string, bookmark string) (*AppBundleKeySet, error) {
+	values := []interface{}{descriptorKey}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	appBundleKeySet := &AppBundleKeySet{}
+	if err := c.call(false, nil, appBundleKeySet, "getAppBundleKeySetForDescriptor", values...); err != nil {
+		return nil, err
+	}
+	return appBundleKeySet, nil
+}
+
+// CreateCollection creates a Collection.
+func (c *Client) CreateCollection(key string, collection *Collection) (*Collection, error) {
+	created := &Collection{}
+	if err := c.call(true, nil, created, "createCollection", key, collection); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetCollection returns a Collection.
+func (c *Client) GetCollection(key string) (*Collection, error) {
+	collection := &Collection{}
+	if err := c.call(false, nil, collection, "getCollection", key); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// ExportAssetForChannel returns an AssetEnvelope for the asset owner to sign and import into
+// another channel.
+func (c *Client) ExportAssetForChannel(objectType Query_ObjectType, keyParts ...string) (*AssetEnvelope, error) {
+	values := []interface{}{objectType.String()}
+	for _, keyPart := range keyParts {
+		values = append(values, keyPart)
+	}
+	assetEnvelope := &AssetEnvelope{}
+	if err := c.call(false, nil, assetEnvelope, "exportAssetForChannel", values...); err != nil {
+		return nil, err
+	}
+	return assetEnvelope, nil
+}
+
+// GetCollectionHash returns the CollectionHash of the assets in namespace under keyParts, to
+// compare with the collection_hash of a listing read earlier.
+func (c *Client) GetCollectionHash(namespace Query_ObjectType, keyParts ...string) (*CollectionHash, error) {
+	values := []interface{}{namespace.String()}
+	for _, keyPart := range keyParts {
+		values = append(values, keyPart)
+	}
+	collectionHash := &CollectionHash{}
+	if err := c.call(false, nil, collectionHash, "getCollectionHash", values...); err != nil {
+		return nil, err
+	}
+	return collectionHash, nil
+}
+
+// QueryAssetsByOwner returns a page of the assets in namespace owned by ownerId.
+func (c *Client) QueryAssetsByOwner(namespace Query_ObjectType, ownerId string, pageSize int32, bookmark string) (*RichQueryResult, error) {
+	values := []interface{}{namespace.String(), ownerId, strconv.FormatInt(int64(pageSize), 10)}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	richQueryResult := &RichQueryResult{}
+	if err := c.call(false, nil, richQueryResult, "queryAssetsByOwner", values...); err != nil {
+		return nil, err
+	}
+	return richQueryResult, nil
+}
+
+// PinDescriptor bookmarks an AppDescriptor for the caller.
+func (c *Client) PinDescriptor(key string) error {
+	return c.call(true, nil, nil, "pinDescriptor", key)
+}
+
+// GetPricingForDescriptor returns an AppDescriptor's PricingTiers.
+func (c *Client) GetPricingForDescriptor(key string) (*PricingTiers, error) {
+	pricingTiers := &PricingTiers{}
+	if err := c.call(false, nil, pricingTiers, "getPricingForDescriptor", key); err != nil {
+		return nil, err
+	}
+	return pricingTiers, nil
+}
+
+// GetLicense returns the License of licenseeId for an AppDescriptor, or the caller's if empty.
+func (c *Client) GetLicense(descriptorKey string, licenseeId string) (*License, error) {
+	values := []interface{}{descriptorKey}
+	if len(licenseeId) != 0 {
+		values = append(values, licenseeId)
+	}
+	license := &License{}
+	if err := c.call(false, nil, license, "getLicense", values...); err != nil {
+		return nil, err
+	}
+	return license, nil
+}
+
+// MakeOffer proposes License terms for an AppDescriptor to its publisher.
+func (c *Client) MakeOffer(offerKey string, descriptorKey string, amount uint64, exclusive bool) (*Offer, error) {
+	offer := &Offer{}
+	if err := c.call(true, nil, offer, "makeOffer", offerKey, descriptorKey, strconv.FormatUint(amount, 10), strconv.FormatBool(exclusive)); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// AcceptOffer accepts an Offer awaiting the caller, granting the License.
+func (c *Client) AcceptOffer(offerKey string) (*Offer, error) {
+	offer := &Offer{}
+	if err := c.call(true, nil, offer, "acceptOffer", offerKey); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// CounterOffer replaces the terms of an Offer awaiting the caller, passing the turn back.
+func (c *Client) CounterOffer(offerKey string, amount uint64, exclusive bool) (*Offer, error) {
+	offer := &Offer{}
+	if err := c.call(true, nil, offer, "counterOffer", offerKey, strconv.FormatUint(amount, 10), strconv.FormatBool(exclusive)); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// RejectOffer rejects an Offer awaiting the caller.
+func (c *Client) RejectOffer(offerKey string) (*Offer, error) {
+	offer := &Offer{}
+	if err := c.call(true, nil, offer, "rejectOffer", offerKey); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// GetOffer returns an Offer.
+func (c *Client) GetOffer(offerKey string) (*Offer, error) {
+	offer := &Offer{}
+	if err := c.call(false, nil, offer, "getOffer", offerKey); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// OpenAuction auctions an exclusive License for an AppDescriptor, taking sealed bids for
+// bidSeconds and then reveals for revealSeconds.
+func (c *Client) OpenAuction(auctionKey string, descriptorKey string, bidSeconds int64, revealSeconds int64) (*Auction, error) {
+	auction := &Auction{}
+	if err := c.call(true, nil, auction, "openAuction", auctionKey, descriptorKey, strconv.FormatInt(bidSeconds, 10), strconv.FormatInt(revealSeconds, 10)); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// BidCommitment returns the commitment to a Bid of amount with a secret salt, which must be
+// revealed with the amount once bidding has closed.
+func BidCommitment(amount uint64, salt []byte) []byte {
+	var saltLength [4]byte
+	binary.BigEndian.PutUint32(saltLength[:], uint32(len(salt)))
+	h := sha256.New()
+	h.Write(saltLength[:])
+	h.Write(salt)
+	h.Write([]byte(strconv.FormatUint(amount, 10)))
+	return h.Sum(nil)
+}
+
+// PlaceBid places or replaces the caller's sealed Bid, a BidCommitment to its amount.
+func (c *Client) PlaceBid(auctionKey string, commitment []byte) (*Bid, error) {
+	bid := &Bid{}
+	if err := c.call(true, nil, bid, "placeBid", auctionKey, commitment); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+// RevealBid reveals the amount and salt of the caller's Bid once bidding has closed.
+func (c *Client) RevealBid(auctionKey string, amount uint64, salt []byte) (*Bid, error) {
+	bid := &Bid{}
+	if err := c.call(true, nil, bid, "revealBid", auctionKey, strconv.FormatUint(amount, 10), salt); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+// CloseAuction awards the License to the highest revealed Bid.
+func (c *Client) CloseAuction(auctionKey string) (*Auction, error) {
+	auction := &Auction{}
+	if err := c.call(true, nil, auction, "closeAuction", auctionKey); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// GetAuction returns an Auction.
+func (c *Client) GetAuction(auctionKey string) (*Auction, error) {
+	auction := &Auction{}
+	if err := c.call(false, nil, auction, "getAuction", auctionKey); err != nil {
+		return nil, err
+	}
+	return auction, nil
+}
+
+// GetFeaturedDescriptors returns the storefront's featured AppDescriptors in rank order.
+func (c *Client) GetFeaturedDescriptors() (*FeaturedDescriptors, error) {
+	featuredDescriptors := &FeaturedDescriptors{}
+	if err := c.call(false, nil, featuredDescriptors, "getFeaturedDescriptors"); err != nil {
+		return nil, err
+	}
+	return featuredDescriptors, nil
+}
+
+// GetTrendingDescriptors ranks AppDescriptors by the activity reported in the last windowHours.
+func (c *Client) GetTrendingDescriptors(windowHours uint32, limit uint32) (*TrendingDescriptors, error) {
+	trendingDescriptors := &TrendingDescriptors{}
+	if err := c.call(false, nil, trendingDescriptors, "getTrendingDescriptors", strconv.FormatUint(uint64(windowHours), 10), strconv.FormatUint(uint64(limit), 10)); err != nil {
+		return nil, err
+	}
+	return trendingDescriptors, nil
+}
+
+// RequestAccess requests read access to a restricted AppBundle.
+func (c *Client) RequestAccess(descriptorKey string, bundleKey string, justification string) (*AccessRequest, error) {
+	accessRequest := &AccessRequest{}
+	if err := c.call(true, nil, accessRequest, "requestAccess", descriptorKey, bundleKey, justification); err != nil {
+		return nil, err
+	}
+	return accessRequest, nil
+}
+
+// GrantAccess approves the pending AccessRequest of requesterId for one of the caller's AppBundles.
+func (c *Client) GrantAccess(descriptorKey string, bundleKey string, requesterId string) (*AccessRequest, error) {
+	accessRequest := &AccessRequest{}
+	if err := c.call(true, nil, accessRequest, "grantAccess", descriptorKey, bundleKey, requesterId); err != nil {
+		return nil, err
+	}
+	return accessRequest, nil
+}
+
+// DenyAccess rejects the pending AccessRequest of requesterId for one of the caller's AppBundles.
+func (c *Client) DenyAccess(descriptorKey string, bundleKey string, requesterId string) (*AccessRequest, error) {
+	accessRequest := &AccessRequest{}
+	if err := c.call(true, nil, accessRequest, "denyAccess", descriptorKey, bundleKey, requesterId); err != nil {
+		return nil, err
+	}
+	return accessRequest, nil
+}
+
+// QueryAccessRequestsByStatus returns a page of the AccessRequests with status that the caller
+// may read.
+func (c *Client) QueryAccessRequestsByStatus(status AccessRequest_Status, pageSize int32, bookmark string) (*RichQueryResult, error) {
+	values := []interface{}{status.String(), strconv.FormatInt(int64(pageSize), 10)}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	richQueryResult := &RichQueryResult{}
+	if err := c.call(false, nil, richQueryResult, "queryAccessRequestsByStatus", values...); err != nil {
+		return nil, err
+	}
+	return richQueryResult, nil
+}
+
+// RecordUsage reports the caller's metered use of an AppDescriptor, priced by tier.
+func (c *Client) RecordUsage(descriptorKey string, tier string, quantity uint64) (*UsageRecord, error) {
+	usageRecord := &UsageRecord{}
+	if err := c.call(true, nil, usageRecord, "recordUsage", descriptorKey, tier, strconv.FormatUint(quantity, 10)); err != nil {
+		return nil, err
+	}
+	return usageRecord, nil
+}
+
+// GenerateInvoices invoices the usage recorded in a past YYYY-MM period. Admin only.
+func (c *Client) GenerateInvoices(period string) (*InvoiceGenerationResult, error) {
+	invoiceGenerationResult := &InvoiceGenerationResult{}
+	if err := c.call(true, nil, invoiceGenerationResult, "generateInvoices", period); err != nil {
+		return nil, err
+	}
+	return invoiceGenerationResult, nil
+}
+
+// MarkInvoiceSettled records the payment of an Invoice, crediting its RoyaltyEntries.
+func (c *Client) MarkInvoiceSettled(period string, descriptorKey string, consumerId string, currencyCode string, paymentRef string) (*Invoice, error) {
+	invoice := &Invoice{}
+	if err := c.call(true, nil, invoice, "markInvoiceSettled", period, descriptorKey, consumerId, currencyCode, paymentRef); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// GetInvoice returns the Invoice of consumerId for an AppDescriptor in a period and currency.
+func (c *Client) GetInvoice(period string, descriptorKey string, consumerId string, currencyCode string) (*Invoice, error) {
+	invoice := &Invoice{}
+	if err := c.call(false, nil, invoice, "getInvoice", period, descriptorKey, consumerId, currencyCode); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// SetTokenChaincode verifies settlements with the token chaincode name on channel, or on the
+// registry's channel if empty; an empty name disables verification. Admin only.
+func (c *Client) SetTokenChaincode(name string, channel string) (*RegistryConfig, error) {
+	values := []interface{}{name}
+	if len(channel) != 0 {
+		values = append(values, channel)
+	}
+	registryConfig := &RegistryConfig{}
+	if err := c.call(true, nil, registryConfig, "setTokenChaincode", values...); err != nil {
+		return nil, err
+	}
+	return registryConfig, nil
+}
+
+// SetRoyaltySplits divides the future Invoices of one of the caller's AppDescriptors among
+// other parties.
+func (c *Client) SetRoyaltySplits(descriptorKey string, royaltySplits *RoyaltySplits) (*AppDescriptor, error) {
+	appDescriptor := &AppDescriptor{}
+	if err := c.call(true, nil, appDescriptor, "setRoyaltySplits", descriptorKey, royaltySplits); err != nil {
+		return nil, err
+	}
+	return appDescriptor, nil
+}
+
+// GetRoyaltyStatement returns a page of a party's RoyaltyEntries for a YYYY-MM period after
+// bookmark, empty for the first page, with the totals of the whole period.
+func (c *Client) GetRoyaltyStatement(partyId string, period string, bookmark string) (*RoyaltyStatement, error) {
+	values := []interface{}{partyId, period}
+	if len(bookmark) != 0 {
+		values = append(values, bookmark)
+	}
+	royaltyStatement := &RoyaltyStatement{}
+	if err := c.call(false, nil, royaltyStatement, "getRoyaltyStatement", values...); err != nil {
+		return nil, err
+	}
+	return royaltyStatement, nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"fmt"
+)
+
+// GatewayContract is the part of a Fabric Gateway contract used by NewGatewayInvoker; the
+// *Contract of github.com/hyperledger/fabric-gateway/pkg/client satisfies it.
+type GatewayContract interface {
+	SubmitTransaction(name string, args ...string) ([]byte, error)
+	EvaluateTransaction(name string, args ...string) ([]byte, error)
+}
+
+type gatewayInvoker struct {
+	contract GatewayContract
+}
+
+// NewGatewayInvoker returns an Invoker backed by a Fabric Gateway contract. Gateway string
+// arguments carry arbitrary bytes, so marshaled protos pass through unchanged. Functions that
+// take a transient map need an Invoker built on the Gateway's proposal API instead.
+func NewGatewayInvoker(contract GatewayContract) Invoker {
+	return &gatewayInvoker{contract: contract}
+}
+
+func stringArgs(args [][]byte) []string {
+	result := make([]string, len(args))
+	for i, arg := range args {
+		result[i] = string(arg)
+	}
+	return result
+}
+
+func (g *gatewayInvoker) Submit(function string, args [][]byte, transient map[string][]byte) ([]byte, error) {
+	if len(transient) != 0 {
+		return nil, fmt.Errorf("transient data is not supported by SubmitTransaction")
+	}
+	return g.contract.SubmitTransaction(function, stringArgs(args)...)
+}
+
+func (g *gatewayInvoker) Evaluate(function string, args [][]byte) ([]byte, error) {
+	return g.contract.EvaluateTransaction(function, stringArgs(args)...)
+}
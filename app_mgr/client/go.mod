@@ -0,0 +1,7 @@
+// The client is a module of its own, apart from the chaincode, which is built from GOPATH, so
+// that applications and the tools in ../../tools can require it.
+module github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client
+
+go 1.20
+
+require github.com/golang/protobuf v1.3.2
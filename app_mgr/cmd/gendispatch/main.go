@@ -0,0 +1,399 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// gendispatch generates dispatch.go, the handlers of the functions declared by the AppRegistry
+// service in app.proto. Each generated handler decodes the positional arguments into the rpc's
+// request message, calls the assetContext method named after the rpc, and marshals its
+// response, so those methods never touch GetArgs. The request and response messages of each
+// function are also recorded in serviceRequests and serviceResponses, from which dispatch
+// derives its argument schema and getApiDescriptor its description. A repeated field, such as
+// the key_parts of an asset, takes the arguments between the fields before and after it, and
+// the fields in TRANSIENT_FIELDS are read from the transient map instead.
+//
+// The service is read from the file descriptor embedded in the client package, which is
+// generated from the same app.proto, so regenerate the client package first. Run from app_mgr:
+//
+//	go run ./cmd/gendispatch > dispatch.go
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	_ "github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+)
+
+const SERVICE_NAME = "AppRegistry"
+
+// OPTIONAL_FIELDS names, as <request message>.<field>, the trailing request fields a caller may
+// omit, leaving them unset. Every other field must be passed, and a handler rejects any other
+// number of arguments.
+var OPTIONAL_FIELDS = map[string]bool{
+	"GetLicenseRequest.licensee_id":       true,
+	"ReportActivityRequest.kind":          true,
+	"GetTrendingDescriptorsRequest.limit": true,
+}
+
+// TRANSIENT_FIELDS names, as <request message>.<field>, the request fields passed in the
+// transient map under the field name rather than as arguments, so they are not recorded in the
+// transaction. A transient field must be passed.
+var TRANSIENT_FIELDS = map[string]bool{
+	"CreatePrivateAppBundleRequest.app_bundle":      true,
+	"CreateConfidentialAppBundleRequest.app_bundle": true,
+}
+
+func fileDescriptor(filename string) (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(filename)
+	if gz == nil {
+		return nil, fmt.Errorf("%s is not registered", filename)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(b, fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// camelCase converts a proto name to its protoc-gen-go Go name.
+func camelCase(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, c := range name {
+		switch {
+		case c == '_':
+			upper = true
+		case upper && c >= 'a' && c <= 'z':
+			b.WriteRune(c - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteRune(c)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+// goType returns the Go name of a fully qualified message or enum type, e.g. Featured or
+// ActivityReport_Kind for .main.ActivityReport.Kind.
+func goType(fd *descriptor.FileDescriptorProto, typeName string) string {
+	typeName = strings.TrimPrefix(typeName, "."+fd.GetPackage()+".")
+	return strings.Replace(typeName, ".", "_", -1)
+}
+
+func lowerFirst(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+type generator struct {
+	fd          *descriptor.FileDescriptorProto
+	out         bytes.Buffer
+	usesStrconv bool
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.out, format, args...)
+	g.out.WriteByte('\n')
+}
+
+func (g *generator) message(typeName string) (*descriptor.DescriptorProto, error) {
+	name := strings.TrimPrefix(typeName, "."+g.fd.GetPackage()+".")
+	for _, message := range g.fd.MessageType {
+		if message.GetName() == name {
+			return message, nil
+		}
+	}
+	return nil, fmt.Errorf("request message %s must be a top-level message of %s", typeName, g.fd.GetName())
+}
+
+// decodeField emits the decoding of arg, an expression of the argument, into field of the
+// request.
+func (g *generator) decodeField(function string, field *descriptor.FieldDescriptorProto, arg string) error {
+	target := "request." + camelCase(field.GetName())
+	parsed := func(parse string, conversion string) {
+		g.usesStrconv = true
+		g.p("if value, err := %s; err != nil {", parse)
+		g.p("return nil, fmt.Errorf(\"Error in %s, invalid %s %%s\", %s)", function, field.GetName(), arg)
+		g.p("} else {")
+		g.p("%s = %s", target, conversion)
+		g.p("}")
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		g.p("%s = string(%s)", target, arg)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		g.p("%s = %s", target, arg)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		parsed(fmt.Sprintf("strconv.ParseBool(string(%s))", arg), "value")
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		parsed(fmt.Sprintf("strconv.ParseInt(string(%s), 10, 64)", arg), "value")
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		parsed(fmt.Sprintf("strconv.ParseUint(string(%s), 10, 64)", arg), "value")
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		parsed(fmt.Sprintf("strconv.ParseInt(string(%s), 10, 32)", arg), "int32(value)")
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		parsed(fmt.Sprintf("strconv.ParseUint(string(%s), 10, 32)", arg), "uint32(value)")
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		enum := goType(g.fd, field.GetTypeName())
+		g.p("if value, ok := %s_value[string(%s)]; !ok {", enum, arg)
+		g.p("return nil, fmt.Errorf(\"Error in %s, unknown %s '%%s'\", %s)", function, field.GetName(), arg)
+		g.p("} else {")
+		g.p("%s = %s(value)", target, enum)
+		g.p("}")
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		g.p("%s = &%s{}", target, goType(g.fd, field.GetTypeName()))
+		g.p("if err := proto.Unmarshal(%s, %s); err != nil {", arg, target)
+		g.p("return nil, fmt.Errorf(\"Cannot unmarshal %s, err = %%s\", err)", goType(g.fd, field.GetTypeName()))
+		g.p("}")
+	default:
+		return fmt.Errorf("%s: unsupported field type %s", field.GetName(), field.GetType())
+	}
+	return nil
+}
+
+// decodeRepeated emits the decoding of the arguments args[from:to] into field of the request.
+func (g *generator) decodeRepeated(field *descriptor.FieldDescriptorProto, from string, to string) error {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+		return fmt.Errorf("%s: only repeated string fields may take several arguments", field.GetName())
+	}
+	target := "request." + camelCase(field.GetName())
+	g.p("for _, arg := range args[%s:%s] {", from, to)
+	g.p("%s = append(%s, string(arg))", target, target)
+	g.p("}")
+	return nil
+}
+
+func (g *generator) method(method *descriptor.MethodDescriptorProto) error {
+	function := lowerFirst(method.GetName())
+	request, err := g.message(method.GetInputType())
+	if err != nil {
+		return err
+	}
+	requestType := goType(g.fd, method.GetInputType())
+	responseType := goType(g.fd, method.GetOutputType())
+
+	// fields are passed as arguments, in field number order, and transient in the transient map
+	var fields, transient []*descriptor.FieldDescriptorProto
+	for _, field := range request.Field {
+		if TRANSIENT_FIELDS[request.GetName()+"."+field.GetName()] {
+			transient = append(transient, field)
+		} else {
+			fields = append(fields, field)
+		}
+	}
+	// repeated is the index of the repeated field, which takes the arguments between the
+	// fields before and after it
+	repeated := -1
+	for i, field := range fields {
+		if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			continue
+		}
+		if repeated >= 0 {
+			return fmt.Errorf("%s: only one repeated field may take several arguments", method.GetName())
+		}
+		repeated = i
+	}
+	variadic := repeated >= 0 && repeated == len(fields)-1
+	// required counts the fields that must be passed, all but the variadic and optional ones
+	required := len(fields)
+	if variadic {
+		required--
+	}
+	for required > 0 && OPTIONAL_FIELDS[request.GetName()+"."+fields[required-1].GetName()] {
+		required--
+	}
+	for _, field := range fields[:required] {
+		if OPTIONAL_FIELDS[request.GetName()+"."+field.GetName()] {
+			return fmt.Errorf("%s: optional field %s must be followed by optional fields only", method.GetName(), field.GetName())
+		}
+	}
+	if repeated >= 0 && !variadic {
+		if required < len(fields) {
+			return fmt.Errorf("%s: the fields after repeated field %s may not be optional", method.GetName(), fields[repeated].GetName())
+		}
+		// Message arguments are checked by validatePayloads, which needs their position
+		for _, field := range fields[repeated+1:] {
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				return fmt.Errorf("%s: message field %s may not follow repeated field %s", method.GetName(), field.GetName(), fields[repeated].GetName())
+			}
+		}
+	}
+	g.p("")
+	g.p("func (ac *assetContext) %s() ([]byte, error) {", function)
+	g.p("var args = ac.stub.GetArgs()")
+	switch {
+	case variadic:
+		g.p("if len(args) < %d {", required+1)
+	case repeated >= 0:
+		// The repeated field takes at least one argument
+		g.p("if len(args) < %d {", len(fields)+1)
+	case required < len(fields):
+		g.p("if len(args) < %d || len(args) > %d {", required+1, len(fields)+1)
+	default:
+		g.p("if len(args) != %d {", len(fields)+1)
+	}
+	g.p("return nil, fmt.Errorf(\"Wrong number of arguments to %s\")", function)
+	g.p("}")
+	g.p("request := &%s{}", requestType)
+	for i, field := range fields {
+		switch {
+		case i == repeated && variadic:
+			err = g.decodeRepeated(field, fmt.Sprint(i+1), "")
+		case i == repeated:
+			err = g.decodeRepeated(field, fmt.Sprint(i+1), fmt.Sprintf("len(args)-%d", len(fields)-1-i))
+		case repeated >= 0 && i > repeated:
+			err = g.decodeField(function, field, fmt.Sprintf("args[len(args)-%d]", len(fields)-i))
+		case i >= required:
+			g.p("if len(args) > %d {", i+1)
+			err = g.decodeField(function, field, fmt.Sprintf("args[%d]", i+1))
+			g.p("}")
+		default:
+			err = g.decodeField(function, field, fmt.Sprintf("args[%d]", i+1))
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %s", method.GetName(), err)
+		}
+	}
+	if len(transient) > 0 {
+		g.p("transientMap, err := ac.stub.GetTransient()")
+		g.p("if err != nil {")
+		g.p("return nil, fmt.Errorf(\"Error in %s, could not get transient map: %%s\", err)", function)
+		g.p("}")
+	}
+	for _, field := range transient {
+		if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			return fmt.Errorf("%s: transient field %s may not be repeated", method.GetName(), field.GetName())
+		}
+		g.p("if _, ok := transientMap[%q]; !ok {", field.GetName())
+		g.p("return nil, fmt.Errorf(\"Error in %s, %s must be passed in the transient map\")", function, field.GetName())
+		g.p("}")
+		if err := g.decodeField(function, field, fmt.Sprintf("transientMap[%q]", field.GetName())); err != nil {
+			return fmt.Errorf("%s: %s", method.GetName(), err)
+		}
+	}
+	g.p("response, err := ac.%s(request)", method.GetName())
+	g.p("if err != nil {")
+	g.p("return nil, err")
+	g.p("}")
+	g.p("responseBytes, err := marshalDeterministic(response)")
+	g.p("if err != nil {")
+	g.p("return nil, fmt.Errorf(\"Error marshalling %s in %s: %%s\", err)", responseType, function)
+	g.p("}")
+	g.p("return responseBytes, nil")
+	g.p("}")
+	return nil
+}
+
+func (g *generator) generate() error {
+	var service *descriptor.ServiceDescriptorProto
+	for _, s := range g.fd.Service {
+		if s.GetName() == SERVICE_NAME {
+			service = s
+		}
+	}
+	if service == nil {
+		return fmt.Errorf("%s declares no %s service", g.fd.GetName(), SERVICE_NAME)
+	}
+
+	for _, method := range service.Method {
+		if err := g.method(method); err != nil {
+			return err
+		}
+	}
+	handlers := g.out.String()
+	g.out.Reset()
+
+	g.p("// Code generated by gendispatch from the %s service in %s. DO NOT EDIT.", SERVICE_NAME, g.fd.GetName())
+	g.p("")
+	g.p("package main")
+	g.p("")
+	g.p("import (")
+	g.p("\"fmt\"")
+	if g.usesStrconv {
+		g.p("\"strconv\"")
+	}
+	g.p("")
+	g.p("\"github.com/golang/protobuf/proto\"")
+	g.p(")")
+	g.p("")
+	g.p("// appRegistryServer is implemented by assetContext, one method per rpc of the %s service.", SERVICE_NAME)
+	g.p("type appRegistryServer interface {")
+	for _, method := range service.Method {
+		g.p("%s(request *%s) (*%s, error)", method.GetName(), goType(g.fd, method.GetInputType()), goType(g.fd, method.GetOutputType()))
+	}
+	g.p("}")
+	g.p("")
+	g.p("var _ appRegistryServer = (*assetContext)(nil)")
+	g.p("")
+	g.p("// serviceRequests maps each function generated from the %s service to its request message.", SERVICE_NAME)
+	g.p("var serviceRequests = map[string]func() proto.Message{")
+	for _, method := range service.Method {
+		g.p("%q: func() proto.Message { return &%s{} },", lowerFirst(method.GetName()), goType(g.fd, method.GetInputType()))
+	}
+	g.p("}")
+	g.p("")
+	g.p("// serviceResponses maps each function generated from the %s service to its response message.", SERVICE_NAME)
+	g.p("var serviceResponses = map[string]func() proto.Message{")
+	for _, method := range service.Method {
+		g.p("%q: func() proto.Message { return &%s{} },", lowerFirst(method.GetName()), goType(g.fd, method.GetOutputType()))
+	}
+	g.p("}")
+	g.p("")
+	g.p("// serviceTransientFields maps each function generated from the %s service that reads request", SERVICE_NAME)
+	g.p("// fields from the transient map to those fields.")
+	g.p("var serviceTransientFields = map[string][]string{")
+	for _, method := range service.Method {
+		request, err := g.message(method.GetInputType())
+		if err != nil {
+			return err
+		}
+		var transient []string
+		for _, field := range request.Field {
+			if TRANSIENT_FIELDS[request.GetName()+"."+field.GetName()] {
+				transient = append(transient, fmt.Sprintf("%q", field.GetName()))
+			}
+		}
+		if len(transient) > 0 {
+			g.p("%q: {%s},", lowerFirst(method.GetName()), strings.Join(transient, ", "))
+		}
+	}
+	g.p("}")
+	g.out.WriteString(handlers)
+	return nil
+}
+
+func main() {
+	fd, err := fileDescriptor("app.proto")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gendispatch: %s\n", err)
+		os.Exit(1)
+	}
+	g := &generator{fd: fd}
+	if err := g.generate(); err != nil {
+		fmt.Fprintf(os.Stderr, "gendispatch: %s\n", err)
+		os.Exit(1)
+	}
+	src, err := format.Source(g.out.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gendispatch: generated invalid Go: %s\n", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(src)
+}
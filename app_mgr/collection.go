@@ -0,0 +1,132 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Collections are curated, ordered sets of AppDescriptors (e.g. "Summit 2019 demos")
+// that a UI can render as featured groupings.
+
+func (ac *assetContext) createCollection() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	collection_key_part := ""
+
+	var collectionBytesFromArgs = []byte{}
+	switch len(args) {
+	case 3:
+		collection_key_part = string(args[1])
+		collectionBytesFromArgs = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to createCollection")
+	}
+
+	collection := &Collection{}
+	if err := proto.Unmarshal(collectionBytesFromArgs, collection); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal Collection, err = %s", err.Error())
+	}
+
+	found, err := ac.getAsset(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, []string{collection_key_part}, &Collection{})
+	if err != nil {
+		return nil, fmt.Errorf("Error in createCollection: %s", err)
+	}
+	if found {
+		return nil, fmt.Errorf("Cannot create a Collection whose key_part already exists")
+	}
+
+	// Make sure every referenced descriptor exists
+	for _, app_descriptor_key_part := range collection.DescriptorKeys {
+		if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+			return nil, fmt.Errorf("Error in createCollection: %s", err)
+		}
+	}
+
+	// Set the owner if not set
+	ac.warnIfRawCreatorOwner(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, collection.Owner)
+	if len(collection.Owner) == 0 {
+		collection.Owner = ac.creator
+	}
+	collection.OwnerId = normalizeIdentity(collection.Owner)
+	if collection.CreatedAt, err = ac.txTimestamp(); err != nil {
+		return nil, fmt.Errorf("Error in createCollection: %s", err)
+	}
+	collection.CreatedMspId = ac.mspId
+
+	return ac.putAsset(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, []string{collection_key_part}, collection)
+}
+
+func (ac *assetContext) addDescriptorToCollection() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	collection_key_part := ""
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 3:
+		collection_key_part = string(args[1])
+		app_descriptor_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to addDescriptorToCollection")
+	}
+
+	collection := &Collection{}
+	found, err := ac.getAsset(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, []string{collection_key_part}, collection)
+	if err != nil {
+		return nil, fmt.Errorf("Error in addDescriptorToCollection: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Collection not found for key_part %s", collection_key_part)
+	}
+
+	// Only the curator of the collection may change it
+	if !bytes.Equal(collection.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of Collection %s may add descriptors to it", collection_key_part)
+	}
+
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in addDescriptorToCollection: %s", err)
+	}
+
+	for _, existing_key_part := range collection.DescriptorKeys {
+		if existing_key_part == app_descriptor_key_part {
+			return nil, fmt.Errorf("AppDescriptor %s is already in Collection %s", app_descriptor_key_part, collection_key_part)
+		}
+	}
+	collection.DescriptorKeys = append(collection.DescriptorKeys, app_descriptor_key_part)
+
+	return ac.putAsset(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, []string{collection_key_part}, collection)
+}
+
+func (ac *assetContext) getCollection() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	collection_key_part := ""
+
+	switch len(args) {
+	case 2:
+		collection_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getCollection")
+	}
+
+	collection := &Collection{}
+	found, err := ac.getAsset(COMPOSITE_KEY_COLLECTION_OBJECTTYPE, []string{collection_key_part}, collection)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getCollection: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Collection not found for key_part %s", collection_key_part)
+	}
+
+	collectionBytes, err := proto.Marshal(collection)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling Collection in getCollection: %s", err)
+	}
+	return collectionBytes, nil
+}
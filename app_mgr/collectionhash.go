@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Listings carry caching hints so clients and gateways can skip fetches that would return what
+// they already hold. Each listed asset comes with its content hash, which a client compares to
+// decide whether to refetch the asset, and a listing holding a whole collection comes with the
+// collection's hash. A client holding a paged listing asks getCollectionHash whether anything
+// under it changed since it read hash H, instead of paging through it again. Both hashes are
+// computed over the assets a mine-only listing returns to the caller, so they match the
+// listings the caller receives. See CollectionHash for how they are computed.
+
+// contentHash returns the content hash of a stored value.
+func contentHash(value []byte) []byte {
+	digest := sha256.Sum256(value)
+	return digest[:]
+}
+
+// collectionHasher accumulates the hash of a collection, one asset at a time in key order.
+type collectionHasher struct {
+	digest    hash.Hash
+	key_count uint64
+}
+
+func newCollectionHasher() *collectionHasher {
+	return &collectionHasher{digest: sha256.New()}
+}
+
+// add hashes the asset stored under compositeKey, given its content hash.
+func (h *collectionHasher) add(compositeKey string, content_hash []byte) {
+	var length = make([]byte, 8)
+	binary.BigEndian.PutUint64(length, uint64(len(compositeKey)))
+	h.digest.Write(length)
+	h.digest.Write([]byte(compositeKey))
+	h.digest.Write(content_hash)
+	h.key_count++
+}
+
+func (h *collectionHasher) sum() []byte {
+	return h.digest.Sum(nil)
+}
+
+func (ac *assetContext) getCollectionHash() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("Wrong number of arguments to getCollectionHash")
+	}
+	namespace := string(args[1])
+	var key_parts []string
+	for _, arg := range args[2:] {
+		key_parts = append(key_parts, string(arg))
+	}
+	if _, ok := Query_ObjectType_value[namespace]; !ok {
+		return nil, fmt.Errorf("Error in getCollectionHash, unknown namespace '%s'", namespace)
+	}
+	if err := checkNotSystemObjectType(namespace); err != nil {
+		return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+	}
+	mine_only, err := ac.listsMineOnly()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+	}
+
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(namespace, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+	}
+	defer stateQueryIterator.Close()
+
+	hasher := newCollectionHasher()
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+		}
+		if mine_only {
+			_, stored_key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+			if err != nil {
+				return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+			}
+			mine, err := ac.isStoredMine(namespace, stored_key_parts, kv.Value)
+			if err != nil {
+				return nil, fmt.Errorf("Error in getCollectionHash: %s", err)
+			}
+			if !mine {
+				continue
+			}
+		}
+		hasher.add(kv.Key, contentHash(kv.Value))
+	}
+
+	collectionHash := &CollectionHash{Namespace: namespace, KeyParts: key_parts, Hash: hasher.sum(), KeyCount: hasher.key_count}
+	collectionHashBytes, err := proto.Marshal(collectionHash)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling CollectionHash in getCollectionHash: %s", err)
+	}
+	return collectionHashBytes, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Field commitments let a public record vouch for a sensitive value kept in private data or
+// off-chain. The owner publishes a salted SHA-256 commitment to the value; whoever is later
+// given the value and its salt can check them against the record with verifyFieldCommitment,
+// while the random salt keeps a low-entropy value (a price, an email) from being guessed.
+// Salts are chosen by the client, since chaincode cannot generate randomness deterministically.
+
+// MIN_COMMITMENT_SALT_LENGTH is the minimum salt length in bytes.
+const MIN_COMMITMENT_SALT_LENGTH = 16
+
+// computeFieldCommitment returns the commitment to value with salt. The salt length is
+// included so that no other salt/value split yields the same digest.
+func computeFieldCommitment(salt []byte, value []byte) []byte {
+	var salt_length [4]byte
+	binary.BigEndian.PutUint32(salt_length[:], uint32(len(salt)))
+	h := sha256.New()
+	h.Write(salt_length[:])
+	h.Write(salt)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+// validateFieldCommitments checks that each field is named once and has a SHA-256 commitment.
+func validateFieldCommitments(fieldCommitments []*FieldCommitment) error {
+	fields := make(map[string]bool)
+	for _, fieldCommitment := range fieldCommitments {
+		if len(fieldCommitment.Field) == 0 {
+			return fmt.Errorf("FieldCommitment must name a field")
+		}
+		if fields[fieldCommitment.Field] {
+			return fmt.Errorf("Duplicate FieldCommitment for field %s", fieldCommitment.Field)
+		}
+		fields[fieldCommitment.Field] = true
+		if len(fieldCommitment.Commitment) != sha256.Size {
+			return fmt.Errorf("FieldCommitment for field %s must be a SHA-256 digest", fieldCommitment.Field)
+		}
+	}
+	return nil
+}
+
+// findFieldCommitment returns the commitment for field, or nil.
+func findFieldCommitment(fieldCommitments []*FieldCommitment, field string) []byte {
+	for _, fieldCommitment := range fieldCommitments {
+		if fieldCommitment.Field == field {
+			return fieldCommitment.Commitment
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) setFieldCommitments() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	var fieldCommitmentsBytes = []byte{}
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		fieldCommitmentsBytes = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setFieldCommitments")
+	}
+
+	fieldCommitments := &FieldCommitments{}
+	if err := proto.Unmarshal(fieldCommitmentsBytes, fieldCommitments); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal FieldCommitments, err = %s", err)
+	}
+	if err := validateFieldCommitments(fieldCommitments.Commitments); err != nil {
+		return nil, fmt.Errorf("Error in setFieldCommitments: %s", err)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setFieldCommitments: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may set its field commitments", app_descriptor_key_part)
+	}
+	appDescriptor.FieldCommitments = fieldCommitments.Commitments
+	return ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor)
+}
+
+func (ac *assetContext) verifyFieldCommitment() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	field := ""
+	var salt, value []byte
+
+	switch len(args) {
+	case 5:
+		app_descriptor_key_part = string(args[1])
+		field = string(args[2])
+		salt = args[3]
+		value = args[4]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to verifyFieldCommitment")
+	}
+	if len(salt) < MIN_COMMITMENT_SALT_LENGTH {
+		return nil, fmt.Errorf("Error in verifyFieldCommitment, the salt must be at least %d bytes", MIN_COMMITMENT_SALT_LENGTH)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in verifyFieldCommitment: %s", err)
+	}
+	commitment := findFieldCommitment(appDescriptor.FieldCommitments, field)
+	if commitment == nil {
+		return nil, fmt.Errorf("Error in verifyFieldCommitment, AppDescriptor %s has no commitment for field %s", app_descriptor_key_part, field)
+	}
+
+	verificationResult := &VerificationResult{Valid: bytes.Equal(commitment, computeFieldCommitment(salt, value))}
+	verificationResultBytes, err := proto.Marshal(verificationResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling VerificationResult in verifyFieldCommitment: %s", err)
+	}
+	return verificationResultBytes, nil
+}
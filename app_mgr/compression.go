@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+)
+
+// Large catalog responses can approach the gRPC message size limit even within the query
+// limits. A client that can decompress runs such an invocation through compressed, and a
+// payload larger than the compression_threshold of the QueryLimits is then gzip-compressed,
+// with content_encoding "gzip" in the ResponseMetadata of the response message. Smaller
+// payloads are returned as they are, so clients check the message rather than assume. The
+// gzip header carries no name or time, so every endorser compresses a payload to the same
+// bytes. A marshaled message never starts with the gzip magic number, whose first byte would
+// be the tag of a field of the invalid wire type 7, so clients that cannot read the response
+// message may also tell a compressed payload by its first bytes.
+
+const CONTENT_ENCODING_GZIP = "gzip"
+
+// compressed runs the query function named by its second argument with the remaining
+// arguments, compressing its payload if it exceeds the compression threshold. Only query
+// functions, whose payloads grow with the catalog, may be compressed; writes return small
+// payloads and are not run through it.
+func (ac *assetContext) compressed() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("Wrong number of arguments to compressed")
+	}
+	function := string(args[1])
+	h, ok := handlers[function]
+	if !ok {
+		return nil, fmt.Errorf("Error in compressed, unknown function %s", function)
+	}
+	if !h.isQuery() {
+		return nil, fmt.Errorf("Error in compressed, %s is not a query function", function)
+	}
+
+	inner := *ac
+	inner.stub = newOverlayStub(ac.stub, args[1:])
+	inner.function = function
+	result, err := inner.dispatch()
+	if err != nil {
+		return nil, err
+	}
+
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in compressed: %s", err)
+	}
+	// Outside Invoke there is no response message to flag the encoding in
+	if ac.warnings == nil || len(ac.warnings.contentEncoding) != 0 || uint64(len(result)) <= queryLimits.CompressionThreshold {
+		return result, nil
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(result); err != nil {
+		return nil, fmt.Errorf("Error in compressed: %s", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("Error in compressed: %s", err)
+	}
+	ac.warnings.contentEncoding = CONTENT_ENCODING_GZIP
+	return compressed.Bytes(), nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Confidential AppBundles are kept in the implicit private data collection of the creator's
+// org, which Fabric 2.x peers provide for every org without any collection configuration.
+// A public PrivateBundleRecord, keyed like the bundle, names the collection and holds the
+// SHA-256 digest of the bundle, so anyone can discover the bundle and check a copy disclosed
+// to them, while only peers of the owner org hold the bundle itself.
+
+// implicitCollectionName returns the name of the implicit private data collection of an org.
+func implicitCollectionName(mspid string) string {
+	return "_implicit_org_" + mspid
+}
+
+func (ac *assetContext) CreateConfidentialAppBundle(request *CreateConfidentialAppBundleRequest) (*PrivateBundleRecord, error) {
+	key_part := request.AppBundleKey
+
+	appBundle, err := ac.checkNewAppBundle(key_part, request.AppBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key must be free both publicly and among the confidential bundles
+	var key_parts = []string{appBundle.DescriptorId, key_part}
+	for _, objectType := range []string{COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, COMPOSITE_KEY_PRIVATE_BUNDLE_RECORD_OBJECTTYPE} {
+		exists, err := ac.keyExists(objectType, key_parts)
+		if err != nil {
+			return nil, fmt.Errorf("Error in createConfidentialAppBundle: %s", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("Cannot create an AppBundle whose key_part already exists: %v", key_parts)
+		}
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts, err)
+	}
+	appBundleBytes, err := marshalDeterministic(appBundle)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling proto: %s", err)
+	}
+	collection := implicitCollectionName(ac.mspId)
+	if err := ac.stub.PutPrivateData(collection, compositeKey, appBundleBytes); err != nil {
+		return nil, fmt.Errorf("Could not put private data for key %s in collection %s: %s", compositeKey, collection, err)
+	}
+
+	digest := sha256.Sum256(appBundleBytes)
+	privateBundleRecord := &PrivateBundleRecord{
+		DescriptorId: appBundle.DescriptorId,
+		BundleKey:    key_part,
+		Collection:   collection,
+		OwnerMspid:   ac.mspId,
+		Owner:        appBundle.Owner,
+		BundleHash:   digest[:],
+		CreatedAt:    appBundle.CreatedAt,
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_PRIVATE_BUNDLE_RECORD_OBJECTTYPE, key_parts, privateBundleRecord); err != nil {
+		return nil, err
+	}
+	return privateBundleRecord, nil
+}
+
+func (ac *assetContext) getPrivateBundleRecordAsset(app_descriptor_key_part string, app_bundle_key_part string) (*PrivateBundleRecord, error) {
+	privateBundleRecord := &PrivateBundleRecord{}
+	found, err := ac.getAsset(COMPOSITE_KEY_PRIVATE_BUNDLE_RECORD_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, privateBundleRecord)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("No confidential AppBundle %s for AppDescriptor %s", app_bundle_key_part, app_descriptor_key_part)
+	}
+	return privateBundleRecord, nil
+}
+
+func (ac *assetContext) getPrivateBundleRecord() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getPrivateBundleRecord")
+	}
+
+	privateBundleRecord, err := ac.getPrivateBundleRecordAsset(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getPrivateBundleRecord: %s", err)
+	}
+	privateBundleRecordBytes, err := proto.Marshal(privateBundleRecord)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling PrivateBundleRecord in getPrivateBundleRecord: %s", err)
+	}
+	return privateBundleRecordBytes, nil
+}
+
+func (ac *assetContext) getConfidentialAppBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getConfidentialAppBundle")
+	}
+
+	privateBundleRecord, err := ac.getPrivateBundleRecordAsset(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle: %s", err)
+	}
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle: %s", err)
+	}
+	appBundleBytes, err := ac.stub.GetPrivateData(privateBundleRecord.Collection, compositeKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle, could not get private data: %s", err)
+	}
+	if appBundleBytes == nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle, the AppBundle is only available on peers of MSP %s", privateBundleRecord.OwnerMspid)
+	}
+	digest := sha256.Sum256(appBundleBytes)
+	if !bytes.Equal(digest[:], privateBundleRecord.BundleHash) {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle, the AppBundle does not match the hash in its PrivateBundleRecord")
+	}
+
+	appBundle := &AppBundle{}
+	if err := proto.Unmarshal(appBundleBytes, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle, cannot unmarshal AppBundle: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getConfidentialAppBundle: %s", err)
+	}
+	return appBundleBytes, nil
+}
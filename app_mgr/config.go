@@ -0,0 +1,349 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// The RegistryConfig is a single asset holding the registry admins and every setting they
+// manage: quotas, pause mode, storage encoding, policies and feature flags. Each change goes
+// through putRegistryConfig, which bumps its version and records who made it, so the history
+// of the key returned by getConfigHistory is an audit trail of the config. updateConfig
+// replaces the whole config, and fails unless the caller read the version it replaces.
+var REGISTRY_CONFIG_KEY_PARTS = []string{"registry"}
+
+// bootstrapRegistryConfig creates the RegistryConfig from the BootstrapConfig passed to Init,
+// if any, or with the Init caller as its only admin. If the RegistryConfig already exists
+// (e.g. on upgrade), the settings of the BootstrapConfig replace its own.
+func bootstrapRegistryConfig(stub shim.ChaincodeStubInterface) error {
+	var args = stub.GetArgs()
+	bootstrapConfig := &BootstrapConfig{}
+
+	switch len(args) {
+	case 0, 1:
+	case 2:
+		if err := proto.Unmarshal(args[1], bootstrapConfig); err != nil {
+			return fmt.Errorf("Error in Init, cannot unmarshal BootstrapConfig: %s", err)
+		}
+	default:
+		return fmt.Errorf("Wrong number of arguments to Init")
+	}
+
+	creator, err := stub.GetCreator()
+	if err != nil {
+		return fmt.Errorf("Could not get creator: %s", err)
+	}
+	ac := &assetContext{stub: stub, creator: creator, identity: normalizeIdentity(creator), function: "Init"}
+
+	registryConfig := &RegistryConfig{}
+	found, err := ac.getAsset(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS, registryConfig)
+	if err != nil {
+		return fmt.Errorf("Error in bootstrapRegistryConfig: %s", err)
+	}
+	if found && len(args) < 2 {
+		return nil
+	}
+	if !found {
+		registryConfig.Admins = [][]byte{creator}
+	}
+	if len(bootstrapConfig.Admins) > 0 {
+		registryConfig.Admins = bootstrapConfig.Admins
+	}
+	if bootstrapConfig.ValidationProfiles != nil {
+		registryConfig.ValidationProfiles = bootstrapConfig.ValidationProfiles
+	}
+	if bootstrapConfig.WriteRateLimit != nil {
+		registryConfig.WriteRateLimit = bootstrapConfig.WriteRateLimit
+	}
+	if bootstrapConfig.QueryLimits != nil {
+		registryConfig.QueryLimits = bootstrapConfig.QueryLimits
+	}
+	if bootstrapConfig.FeatureFlags != nil {
+		registryConfig.FeatureFlags = bootstrapConfig.FeatureFlags
+	}
+	if err := validateRegistryConfig(registryConfig); err != nil {
+		return fmt.Errorf("Error in Init, invalid BootstrapConfig: %s", err)
+	}
+	if _, err := ac.putRegistryConfig(registryConfig); err != nil {
+		return fmt.Errorf("Error in bootstrapRegistryConfig: %s", err)
+	}
+	return nil
+}
+
+// getRegistryConfig returns the RegistryConfig, or an empty one if the chaincode was never
+// initialized.
+func (ac *assetContext) getRegistryConfig() (*RegistryConfig, error) {
+	registryConfig := &RegistryConfig{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS, registryConfig); err != nil {
+		return nil, fmt.Errorf("Could not get RegistryConfig: %s", err)
+	}
+	return registryConfig, nil
+}
+
+// putRegistryConfig stores registryConfig as the next version of the RegistryConfig.
+func (ac *assetContext) putRegistryConfig(registryConfig *RegistryConfig) ([]byte, error) {
+	updated_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	registryConfig.Version++
+	registryConfig.UpdatedBy = ac.creator
+	registryConfig.UpdatedAt = updated_at
+	registryConfig.TraceId = ac.traceId
+	return ac.putAsset(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS, registryConfig)
+}
+
+// validateRegistryConfig checks the settings of a RegistryConfig passed to updateConfig, as
+// the functions setting them one at a time would.
+func validateRegistryConfig(registryConfig *RegistryConfig) error {
+	if len(registryConfig.Admins) == 0 {
+		return fmt.Errorf("admins must not be empty")
+	}
+	if rateLimit := registryConfig.WriteRateLimit; rateLimit.GetMaxWrites() > 0 && rateLimit.GetWindowSeconds() <= 0 {
+		return fmt.Errorf("write_rate_limit.window_seconds must be positive")
+	}
+	if scanPolicy := registryConfig.ScanPolicy; scanPolicy.GetRequiredPasses() > uint32(len(scanPolicy.GetScanners())) {
+		return fmt.Errorf("scan_policy.required_passes is %d, but only %d scanners are registered", scanPolicy.GetRequiredPasses(), len(scanPolicy.GetScanners()))
+	}
+	for i, license := range registryConfig.AllowedArtifactLicenses {
+		if !validLicenseId(license) {
+			return fmt.Errorf("allowed_artifact_licenses[%d] %q is not an SPDX license identifier", i, license)
+		}
+	}
+	var profiled []string
+	for namespace := range registryConfig.ValidationProfiles {
+		profiled = append(profiled, namespace)
+	}
+	sort.Strings(profiled)
+	for _, namespace := range profiled {
+		if namespace != COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE && namespace != COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE {
+			return fmt.Errorf("validation_profiles: namespace %s has no ValidationProfile", namespace)
+		}
+	}
+	names := make(map[string]bool)
+	for _, policyRule := range registryConfig.PolicyRules {
+		if err := validatePolicyRule(policyRule); err != nil {
+			return fmt.Errorf("policy_rules: PolicyRule %s: %s", policyRule.Name, err)
+		}
+		if names[policyRule.Name] {
+			return fmt.Errorf("policy_rules: PolicyRule %s is listed more than once", policyRule.Name)
+		}
+		names[policyRule.Name] = true
+	}
+	if err := validateSilencedEventNamespaces(registryConfig.FeatureFlags.GetSilencedEventNamespaces()); err != nil {
+		return err
+	}
+	if err := validateDeprecatedFunctions(registryConfig.DeprecatedFunctions); err != nil {
+		return err
+	}
+	if err := validateNamespaceAdmins(registryConfig.NamespaceAdmins); err != nil {
+		return err
+	}
+	return validateReplicationTrustRoots(registryConfig.ReplicationTrustRoots)
+}
+
+// isAdmin reports whether the caller is a registry admin.
+func (ac *assetContext) isAdmin() (bool, error) {
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return false, err
+	}
+	for _, admin := range registryConfig.Admins {
+		if bytes.Equal(admin, ac.creator) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// requireAdmin returns an error unless the caller is a registry admin, or a namespace admin of
+// the namespace the invoked function administers.
+func (ac *assetContext) requireAdmin() error {
+	admin, err := ac.isAdmin()
+	if err != nil {
+		return err
+	}
+	if !admin {
+		if admin, err = ac.isNamespaceAdmin(); err != nil {
+			return err
+		}
+	}
+	if !admin {
+		return fmt.Errorf("Only registry admins may call %s", ac.function)
+	}
+	return nil
+}
+
+func (ac *assetContext) setWriteRateLimit() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var max_writes, window_seconds uint64
+	var err error
+
+	switch len(args) {
+	case 3:
+		if max_writes, err = strconv.ParseUint(string(args[1]), 10, 32); err != nil {
+			return nil, fmt.Errorf("Error in setWriteRateLimit, invalid max_writes: %s", err)
+		}
+		if window_seconds, err = strconv.ParseUint(string(args[2]), 10, 63); err != nil {
+			return nil, fmt.Errorf("Error in setWriteRateLimit, invalid window_seconds: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setWriteRateLimit")
+	}
+	if max_writes > 0 && window_seconds == 0 {
+		return nil, fmt.Errorf("Error in setWriteRateLimit, window_seconds must be positive")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setWriteRateLimit: %s", err)
+	}
+	registryConfig.WriteRateLimit = &RateLimit{MaxWrites: uint32(max_writes), WindowSeconds: int64(window_seconds)}
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) getConfig() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getConfig")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfig: %s", err)
+	}
+	registryConfigBytes, err := marshalDeterministic(registryConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RegistryConfig in getConfig: %s", err)
+	}
+	return registryConfigBytes, nil
+}
+
+// updateConfig replaces the RegistryConfig, if its version is still expected_version. The
+// version and updated_by/updated_at of the passed RegistryConfig are ignored.
+func (ac *assetContext) updateConfig() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var expected_version uint64
+	registryConfig := &RegistryConfig{}
+
+	switch len(args) {
+	case 3:
+		var err error
+		if expected_version, err = strconv.ParseUint(string(args[1]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Error in updateConfig, invalid expected_version: %s", err)
+		}
+		if err := proto.Unmarshal(args[2], registryConfig); err != nil {
+			return nil, fmt.Errorf("Error in updateConfig, cannot unmarshal RegistryConfig: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to updateConfig")
+	}
+	if err := validateRegistryConfig(registryConfig); err != nil {
+		return nil, fmt.Errorf("Error in updateConfig, invalid RegistryConfig: %s", err)
+	}
+
+	current, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in updateConfig: %s", err)
+	}
+	if current.Version != expected_version {
+		return nil, fmt.Errorf("Error in updateConfig, the RegistryConfig is at version %d, not %d", current.Version, expected_version)
+	}
+	sort.Slice(registryConfig.PolicyRules, func(i, j int) bool { return registryConfig.PolicyRules[i].Name < registryConfig.PolicyRules[j].Name })
+	registryConfig.Version = current.Version
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) getConfigHistory() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getConfigHistory")
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfigHistory: %s", err)
+	}
+	// A registry deployed before the SYSTEM prefix began its history under the legacy key,
+	// which the migration deleted when it moved the RegistryConfig
+	var keyModifications []*queryresult.KeyModification
+	legacyKey, ok, err := ac.system.legacyKey(compositeKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getConfigHistory: %s", err)
+	}
+	if ok {
+		if keyModifications, err = ac.appendConfigHistory(keyModifications, legacyKey, true); err != nil {
+			return nil, fmt.Errorf("Error in getConfigHistory: %s", err)
+		}
+	}
+	if keyModifications, err = ac.appendConfigHistory(keyModifications, compositeKey, false); err != nil {
+		return nil, fmt.Errorf("Error in getConfigHistory: %s", err)
+	}
+	// Peers differ in the order they return the history of a key in, so sort it most recent first
+	sort.SliceStable(keyModifications, func(i, j int) bool { return modifiedAfter(keyModifications[i], keyModifications[j]) })
+
+	configHistory := &ConfigHistory{}
+	for _, keyModification := range keyModifications {
+		entry := &ConfigHistory_Entry{TxId: keyModification.TxId, Timestamp: keyModification.Timestamp.GetSeconds()}
+		if !keyModification.IsDelete {
+			entry.Config = &RegistryConfig{}
+			if err := proto.Unmarshal(keyModification.Value, entry.Config); err != nil {
+				return nil, fmt.Errorf("Error in getConfigHistory, cannot unmarshal RegistryConfig of transaction %s: %s", keyModification.TxId, err)
+			}
+		}
+		configHistory.Entries = append(configHistory.Entries, entry)
+	}
+	configHistoryBytes, err := marshalDeterministic(configHistory)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ConfigHistory in getConfigHistory: %s", err)
+	}
+	return configHistoryBytes, nil
+}
+
+// appendConfigHistory appends the modifications of the RegistryConfig stored at compositeKey
+// to keyModifications, skipping deletes if skipDeletes is set.
+func (ac *assetContext) appendConfigHistory(keyModifications []*queryresult.KeyModification, compositeKey string, skipDeletes bool) ([]*queryresult.KeyModification, error) {
+	historyIterator, err := ac.stub.GetHistoryForKey(compositeKey)
+	if err != nil {
+		return nil, err
+	}
+	defer historyIterator.Close()
+
+	for historyIterator.HasNext() {
+		keyModification, err := historyIterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		if keyModification.IsDelete && skipDeletes {
+			continue
+		}
+		keyModifications = append(keyModifications, keyModification)
+	}
+	return keyModifications, nil
+}
+
+// modifiedAfter reports whether a was committed with a later transaction timestamp than b.
+func modifiedAfter(a *queryresult.KeyModification, b *queryresult.KeyModification) bool {
+	if a.Timestamp.GetSeconds() != b.Timestamp.GetSeconds() {
+		return a.Timestamp.GetSeconds() > b.Timestamp.GetSeconds()
+	}
+	return a.Timestamp.GetNanos() > b.Timestamp.GetNanos()
+}
@@ -0,0 +1,117 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/fixtures"
+	"github.com/hyperledger/fabric/protos/msp"
+)
+
+// Summit rehearsals start from the demo dataset of a seed, see the fixtures package: an admin
+// runs loadDemoDataset on a fresh channel and gets the same descriptors, bundles and licenses
+// every time. The descriptors and bundles are created through their usual handlers, owned by
+// the admin, and the Licenses are granted to the identities of fixtures.Licensees in
+// fixtures.LicenseeMspId. A descriptor that runs on a fixtures Worker is created with a
+// RoyaltySplit paying the Worker's operator, an identity of fixtures.WorkerOperators in
+// fixtures.WorkerOperatorMspId. The dataset is loaded in one transaction and all or nothing, so
+// loading it where any of its keys already exists fails without writing anything.
+
+// demoIdentity returns the serialized identity of the fixtures identity name in mspid.
+func demoIdentity(mspid string, name string) ([]byte, error) {
+	return proto.Marshal(&msp.SerializedIdentity{Mspid: mspid, IdBytes: []byte(name)})
+}
+
+// loadStep runs the handler of function with args over overlay.
+func (ac *assetContext) loadStep(overlay *overlayStub, function string, args ...[]byte) error {
+	overlay.args = append([][]byte{[]byte(function)}, args...)
+	step := *ac
+	step.stub = overlay
+	step.function = function
+	if _, err := handlers[function].fn(&step); err != nil {
+		return fmt.Errorf("%s %s failed: %s", function, args[0], err)
+	}
+	return nil
+}
+
+func (ac *assetContext) loadDemoDataset() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var seed int64
+
+	switch len(args) {
+	case 2:
+		var err error
+		if seed, err = strconv.ParseInt(string(args[1]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset, invalid seed %s: %s", args[1], err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to loadDemoDataset")
+	}
+
+	dataset := fixtures.Generate(seed)
+	overlay := newOverlayStub(ac.stub, nil)
+	for _, descriptor := range dataset.Descriptors {
+		appDescriptor := &AppDescriptor{Description: descriptor.Description, Tags: descriptor.Tags, ParentDescriptorKey: descriptor.ParentKey}
+		if worker, runs := dataset.Worker(descriptor.WorkerKey); runs {
+			operator, err := demoIdentity(fixtures.WorkerOperatorMspId, worker.Operator)
+			if err != nil {
+				return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+			}
+			appDescriptor.RoyaltySplits = []*RoyaltySplit{{Party: operator, BasisPoints: worker.BasisPoints}}
+		}
+		appDescriptorBytes, err := proto.Marshal(appDescriptor)
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling AppDescriptor in loadDemoDataset: %s", err)
+		}
+		if err := ac.loadStep(overlay, "createAppDescriptor", []byte(descriptor.Key), appDescriptorBytes); err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+		}
+	}
+	for _, bundle := range dataset.Bundles {
+		appBundleBytes, err := proto.Marshal(&AppBundle{DescriptorId: bundle.DescriptorKey, Artifacts: bundle.Artifacts, Restricted: bundle.Restricted})
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling AppBundle in loadDemoDataset: %s", err)
+		}
+		if err := ac.loadStep(overlay, "createAppBundle", []byte(bundle.Key), appBundleBytes); err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+		}
+		if !bundle.Associated {
+			continue
+		}
+		if err := ac.loadStep(overlay, "associateDescriptorWithBundle", []byte(bundle.DescriptorKey), []byte(bundle.Key)); err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+		}
+	}
+
+	granted_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+	}
+	grant := *ac
+	grant.stub = overlay
+	for _, license := range dataset.Licenses {
+		licensee, err := demoIdentity(fixtures.LicenseeMspId, license.Licensee)
+		if err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+		}
+		if err := grant.grantLicense(&License{DescriptorId: license.DescriptorKey, Licensee: licensee, Exclusive: license.Exclusive, Amount: license.Amount, GrantedAt: granted_at}); err != nil {
+			return nil, fmt.Errorf("Error in loadDemoDataset, License of %s for %s: %s", license.DescriptorKey, license.Licensee, err)
+		}
+	}
+
+	if err := overlay.flush(); err != nil {
+		return nil, fmt.Errorf("Error in loadDemoDataset: %s", err)
+	}
+	demoDatasetBytes, err := proto.Marshal(&DemoDataset{Seed: seed, Workers: uint32(len(dataset.Workers)), Descriptors: uint32(len(dataset.Descriptors)), Bundles: uint32(len(dataset.Bundles)), Licenses: uint32(len(dataset.Licenses))})
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling DemoDataset in loadDemoDataset: %s", err)
+	}
+	return demoDatasetBytes, nil
+}
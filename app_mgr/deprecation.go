@@ -0,0 +1,109 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Before an old path is removed, callers still using it get ResponseWarnings telling them how
+// to migrate, so SDKs can surface the nudge or fail their own tests on it. Admins deprecate a
+// function by adding it to the deprecated_functions of the RegistryConfig with its advice, and
+// the registry itself warns of the legacy encodings it still accepts, such as a payload setting
+// the owner of a new asset to the caller's raw creator bytes. Fabric responses carry no field
+// for warnings, so a successful response with warnings has the JSON of a ResponseMetadata as
+// its message, in place of the bare trace ID; trace IDs cannot start with "{", so clients tell
+// the two apart by the first character. Warnings are not returned by failed invocations.
+
+const (
+	WARNING_DEPRECATED_FUNCTION = "DEPRECATED_FUNCTION"
+	WARNING_RAW_CREATOR_OWNER   = "RAW_CREATOR_OWNER"
+)
+
+// responseWarnings collects the warnings of an invocation, shared by the contexts of the
+// functions run by wrappers, along with the encoding of its payload.
+type responseWarnings struct {
+	deprecatedFunctions map[string]string // Read from the RegistryConfig by the first dispatch
+	warnings            []*ResponseWarning
+	contentEncoding     string // Set by compressed
+}
+
+// warn adds a warning for the current function, once per invocation.
+func (ac *assetContext) warn(code string, message string) {
+	if ac.warnings == nil {
+		return
+	}
+	for _, warning := range ac.warnings.warnings {
+		if warning.Code == code && warning.Function == ac.function && warning.Message == message {
+			return
+		}
+	}
+	ac.warnings.warnings = append(ac.warnings.warnings, &ResponseWarning{Code: code, Function: ac.function, Message: message})
+}
+
+// warnIfDeprecated warns if the current function is deprecated.
+func (ac *assetContext) warnIfDeprecated() error {
+	if ac.warnings == nil {
+		return nil
+	}
+	if ac.warnings.deprecatedFunctions == nil {
+		registryConfig, err := ac.getRegistryConfig()
+		if err != nil {
+			return err
+		}
+		ac.warnings.deprecatedFunctions = registryConfig.DeprecatedFunctions
+		if ac.warnings.deprecatedFunctions == nil {
+			ac.warnings.deprecatedFunctions = make(map[string]string)
+		}
+	}
+	if advice, ok := ac.warnings.deprecatedFunctions[ac.function]; ok {
+		ac.warn(WARNING_DEPRECATED_FUNCTION, fmt.Sprintf("%s is deprecated: %s", ac.function, advice))
+	}
+	return nil
+}
+
+// warnIfRawCreatorOwner warns if the payload of a new asset of objectType sets its owner to the
+// caller, which is the owner an asset gets when none is set.
+func (ac *assetContext) warnIfRawCreatorOwner(objectType string, owner []byte) {
+	if len(owner) > 0 && bytes.Equal(owner, ac.creator) {
+		ac.warn(WARNING_RAW_CREATOR_OWNER, fmt.Sprintf("setting the owner of a new %s to the creator is deprecated, leave owner unset", objectType))
+	}
+}
+
+// responseMessage returns the message of a successful response.
+func (ac *assetContext) responseMessage() (string, error) {
+	if ac.warnings == nil || (len(ac.warnings.warnings) == 0 && len(ac.warnings.contentEncoding) == 0) {
+		return ac.traceId, nil
+	}
+	responseMetadataBytes, err := json.Marshal(&ResponseMetadata{TraceId: ac.traceId, Warnings: ac.warnings.warnings, ContentEncoding: ac.warnings.contentEncoding})
+	if err != nil {
+		return "", fmt.Errorf("Error marshaling ResponseMetadata: %s", err)
+	}
+	return string(responseMetadataBytes), nil
+}
+
+// validateDeprecatedFunctions checks the deprecated_functions of a RegistryConfig.
+func validateDeprecatedFunctions(deprecatedFunctions map[string]string) error {
+	var functions []string
+	for function := range deprecatedFunctions {
+		functions = append(functions, function)
+	}
+	sort.Strings(functions)
+	for _, function := range functions {
+		advice := deprecatedFunctions[function]
+		if _, ok := handlers[function]; !ok {
+			return fmt.Errorf("deprecated_functions: %s is not a function", function)
+		}
+		if len(advice) == 0 {
+			return fmt.Errorf("deprecated_functions: %s has no advice", function)
+		}
+	}
+	return nil
+}
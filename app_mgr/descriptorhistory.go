@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// Auditors reconstructing an incident need the registry as it was then, e.g. which AppBundle
+// was associated with an AppDescriptor on the day. getDescriptorAsOf replays the history of
+// the descriptor's key and returns the AppDescriptor as written by the last transaction at or
+// before the requested time. The key is not resolved through renames: before a rename the
+// descriptor was stored under its old key, whose history is the one to ask. Snapshots hold
+// the public state only, since private data keeps no history.
+
+// getDescriptorAsOf returns a DescriptorSnapshot of the AppDescriptor stored under a key at a
+// time in seconds since the epoch.
+func (ac *assetContext) getDescriptorAsOf() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	var as_of int64
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		var err error
+		if as_of, err = strconv.ParseInt(string(args[2]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Error in getDescriptorAsOf, invalid timestamp %s: %s", args[2], err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getDescriptorAsOf")
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorAsOf: %s", err)
+	}
+	historyIterator, err := ac.stub.GetHistoryForKey(compositeKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorAsOf, cannot read the history of AppDescriptor %s: %s", app_descriptor_key_part, err)
+	}
+	defer historyIterator.Close()
+
+	var latest *queryresult.KeyModification
+	for historyIterator.HasNext() {
+		keyModification, err := historyIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getDescriptorAsOf: %s", err)
+		}
+		if keyModification.Timestamp.GetSeconds() > as_of {
+			continue
+		}
+		if latest == nil || modifiedAfter(keyModification, latest) {
+			latest = keyModification
+		}
+	}
+	if latest == nil || latest.IsDelete {
+		return nil, fmt.Errorf("Error in getDescriptorAsOf, AppDescriptor %s did not exist at %d", app_descriptor_key_part, as_of)
+	}
+	decoded, err := decodeStored(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, latest.Value)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorAsOf, transaction %s: %s", latest.TxId, err)
+	}
+	appDescriptor := &AppDescriptor{}
+	if err := proto.Unmarshal(decoded, appDescriptor); err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorAsOf, cannot unmarshal AppDescriptor of transaction %s: %s", latest.TxId, err)
+	}
+	snapshot := &DescriptorSnapshot{DescriptorId: app_descriptor_key_part, AsOf: as_of, TxId: latest.TxId, Timestamp: latest.Timestamp.GetSeconds(), AppDescriptor: appDescriptor}
+
+	snapshotBytes, err := proto.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling DescriptorSnapshot in getDescriptorAsOf: %s", err)
+	}
+	return snapshotBytes, nil
+}
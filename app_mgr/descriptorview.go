@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Descriptor pages in UIs show an AppDescriptor with a row per AppBundle, which otherwise takes
+// getAppDescriptor, getAppBundleKeySetForDescriptor and a read of every bundle. The
+// getDescriptorWithBundles query returns the same in one call from one prefix scan of the
+// descriptor's bundles, with each bundle summarized rather than returned with its artifacts.
+// Restricted bundles the caller may not read are still listed, so the page shows they exist,
+// but with no more than their key and where they are used.
+
+// summarizeBundle returns the BundleSummary of the AppBundle app_bundle_key_part of appDescriptor.
+func (ac *assetContext) summarizeBundle(app_descriptor_key_part string, app_bundle_key_part string, appDescriptor *AppDescriptor, appBundle *AppBundle) *BundleSummary {
+	bundleSummary := &BundleSummary{
+		BundleKey:  app_bundle_key_part,
+		Restricted: appBundle.Restricted,
+		Associated: appDescriptor.BundleId == app_bundle_key_part,
+	}
+	for environment, bundle_id := range appDescriptor.EnvironmentBundleIds {
+		if bundle_id == app_bundle_key_part {
+			bundleSummary.Environments = append(bundleSummary.Environments, environment)
+		}
+	}
+	sort.Strings(bundleSummary.Environments)
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return bundleSummary
+	}
+	bundleSummary.OwnerId = appBundle.OwnerId
+	bundleSummary.CreatedAt = appBundle.CreatedAt
+	bundleSummary.ArtifactCount = uint32(len(appBundle.Artifacts))
+	bundleSummary.ChaincodePackages = appBundle.ChaincodePackages
+	bundleSummary.TargetPlatforms = appBundle.TargetPlatforms
+	bundleSummary.MinFabricVersion = appBundle.MinFabricVersion
+	bundleSummary.MerkleRoot = appBundle.MerkleRoot
+	bundleSummary.RetentionTier = appBundle.RetentionTier
+	return bundleSummary
+}
+
+func (ac *assetContext) getDescriptorWithBundles() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	bookmark := ""
+
+	switch len(args) {
+	case 3:
+		bookmark = string(args[2])
+		fallthrough
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getDescriptorWithBundles")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorWithBundles: %s", err)
+	}
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorWithBundles: %s", err)
+	}
+	if err := ac.mergeDescriptorPrivateDetails(app_descriptor_key_part, appDescriptor); err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorWithBundles: %s", err)
+	}
+
+	query_results, err := ac.query(&Query{ObjectType: Query_APP_BUNDLE, KeyParts: []string{app_descriptor_key_part}, Bookmark: bookmark, ReturnValues: true})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getDescriptorWithBundles: %s", err)
+	}
+	descriptorWithBundles := &DescriptorWithBundles{AppDescriptor: appDescriptor, HasMore: query_results.HasMore, Bookmark: query_results.Bookmark}
+	for _, entry := range query_results.Results {
+		appBundle := &AppBundle{}
+		if err := proto.Unmarshal(entry.Value, appBundle); err != nil {
+			return nil, fmt.Errorf("Error unmarshalling AppBundle %s in getDescriptorWithBundles: %s", entry.Key, err)
+		}
+		descriptorWithBundles.Bundles = append(descriptorWithBundles.Bundles, ac.summarizeBundle(app_descriptor_key_part, entry.Key, appDescriptor, appBundle))
+	}
+
+	descriptorWithBundlesBytes, err := proto.Marshal(descriptorWithBundles)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling DescriptorWithBundles in getDescriptorWithBundles: %s", err)
+	}
+	return descriptorWithBundlesBytes, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// The generated handlers take one argument per request field and reject any other number of
+// arguments, except that the fields in OPTIONAL_FIELDS of cmd/gendispatch may be omitted, a
+// repeated field takes the arguments between those of the fields around it, and transient
+// fields are not arguments.
+func TestGeneratedHandlerArity(t *testing.T) {
+	s := newTestRegistry(t)
+	tests := []struct {
+		function string
+		args     []interface{}
+		accepted bool
+	}{
+		{"makeOffer", []interface{}{}, false},
+		{"makeOffer", []interface{}{"o"}, false},
+		{"makeOffer", []interface{}{"o", "d"}, false},
+		{"makeOffer", []interface{}{"o", "d", "10"}, false},
+		{"makeOffer", []interface{}{"o", "d", "10", "false"}, true},
+		{"makeOffer", []interface{}{"o", "d", "10", "false", "extra"}, false},
+		{"counterOffer", []interface{}{"o", "10"}, false},
+		{"acceptOffer", []interface{}{}, false},
+		{"acceptOffer", []interface{}{"o"}, true},
+		{"openAuction", []interface{}{"a", "d", "60"}, false},
+		{"getFeaturedDescriptors", []interface{}{}, true},
+		{"getFeaturedDescriptors", []interface{}{"extra"}, false},
+		{"getLicense", []interface{}{}, false},
+		{"getLicense", []interface{}{"d"}, true},
+		{"getLicense", []interface{}{"d", "licensee"}, true},
+		{"getLicense", []interface{}{"d", "licensee", "extra"}, false},
+		{"reportActivity", []interface{}{"d"}, true},
+		{"reportActivity", []interface{}{"d", "INSTALL", "extra"}, false},
+		{"getTrendingDescriptors", []interface{}{}, false},
+		{"getTrendingDescriptors", []interface{}{"1"}, true},
+		{"getTrendingDescriptors", []interface{}{"1", "5"}, true},
+		{"acquireLock", []interface{}{"r"}, false},
+		{"acquireLock", []interface{}{"r", "60"}, true},
+		{"annotateAsset", []interface{}{"APP_DESCRIPTOR", "t", "hash"}, false},
+		{"annotateAsset", []interface{}{"APP_DESCRIPTOR", "d", "t", "hash"}, true},
+		{"annotateAsset", []interface{}{"APP_BUNDLE", "d", "b", "t", "hash"}, true},
+		{"getAnnotations", []interface{}{"APP_DESCRIPTOR", "d"}, false},
+		{"getAnnotations", []interface{}{"APP_DESCRIPTOR", "d", ""}, true},
+		{"moderateAnnotation", []interface{}{"APP_DESCRIPTOR", "t", "annotator", "HIDDEN"}, false},
+		{"moderateAnnotation", []interface{}{"APP_DESCRIPTOR", "d", "t", "annotator", "HIDDEN"}, true},
+		{"createConfidentialAppBundle", []interface{}{}, false},
+		{"createConfidentialAppBundle", []interface{}{"b"}, true},
+		{"createConfidentialAppBundle", []interface{}{"b", "bundle"}, false},
+	}
+	for _, test := range tests {
+		response := s.invoke(t, test.function, test.args...)
+		// The accepted calls may still fail, e.g. for a missing AppDescriptor
+		rejected := response.Status != shim.OK && strings.Contains(response.Message, "Wrong number of arguments to "+test.function)
+		if rejected == test.accepted {
+			t.Errorf("%s with %d arguments: got %q, accepted %v", test.function, len(test.args), response.Message, test.accepted)
+		}
+	}
+}
+
+// A repeated request field carries the key_parts of an asset ahead of the trailing fields.
+func TestGeneratedKeyParts(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "d"})
+	s.mustInvoke(t, "createAppBundle", "b", &AppBundle{DescriptorId: "d", Artifacts: [][]byte{[]byte("artifact")}})
+	owner := s.Creator
+	s.Creator = testIdentity(t, "annotator")
+	payload_hash := sha256.Sum256([]byte("validated"))
+	s.mustInvoke(t, "annotateAsset", "APP_BUNDLE", "d", "b", "validated", payload_hash[:])
+
+	annotations := &Annotations{}
+	if err := proto.Unmarshal(s.mustInvoke(t, "getAnnotations", "APP_BUNDLE", "d", "b", ""), annotations); err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations.Annotations) != 1 || strings.Join(annotations.Annotations[0].KeyParts, "/") != "d/b" || annotations.Annotations[0].Type != "validated" {
+		t.Fatalf("the AppBundle has annotations %v, want one validated annotation of d/b", annotations.Annotations)
+	}
+	annotator_id := annotations.Annotations[0].AnnotatorId
+
+	s.Creator = owner
+	annotation := &Annotation{}
+	if err := proto.Unmarshal(s.mustInvoke(t, "moderateAnnotation", "APP_BUNDLE", "d", "b", "validated", annotator_id, "HIDDEN"), annotation); err != nil {
+		t.Fatal(err)
+	}
+	if annotation.Status != Annotation_HIDDEN {
+		t.Errorf("the moderated annotation is %s, want HIDDEN", annotation.Status)
+	}
+	if message := s.mustFail(t, "moderateAnnotation", "APP_BUNDLE", "d", "b", "validated", annotator_id, "GONE"); !strings.Contains(message, "unknown status") {
+		t.Errorf("moderating to an unknown status failed with %q", message)
+	}
+}
+
+// Transient request fields are read from the transient map, never from the arguments.
+func TestGeneratedTransientField(t *testing.T) {
+	s := newTestRegistry(t)
+	if message := s.mustFail(t, "createConfidentialAppBundle", "b"); !strings.Contains(message, "app_bundle must be passed in the transient map") {
+		t.Errorf("createConfidentialAppBundle without a transient AppBundle failed with %q", message)
+	}
+}
@@ -0,0 +1,334 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Assets, public or in private data collections, can be stored as JSON documents so that
+// CouchDB rich queries and Fauxton work on them. The RegistryConfig storage_encoding selects
+// whether asset writes store the proto bytes (the default), JSON documents, or the proto
+// bytes with a JSON copy under a companion <object_type>_JSON composite key. encodingStub
+// applies the encoding below the handlers: they keep reading and writing proto bytes, and reads
+// accept either encoding, so changing the encoding does not require migrating existing assets.
+// The JSON is the proto3 JSON mapping, with the proto field names as keys; see encodeDocument.
+// Documents written before, in the encoding/json form of the generated structs, with enums and
+// 64-bit integers as numbers, are still read.
+
+// JSON_DOCUMENT_OBJECTTYPE_SUFFIX is appended to the object type of an asset's companion
+// JSON document key in PROTO_AND_JSON mode.
+const JSON_DOCUMENT_OBJECTTYPE_SUFFIX = "_JSON"
+
+// jsonDocumentTypes returns an empty asset for each object type that may be stored as JSON.
+// Registry bookkeeping (config, rate counters) is always stored as proto bytes.
+var jsonDocumentTypes = map[string]func() proto.Message{
+	COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE: func() proto.Message { return &AppDescriptor{} },
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:     func() proto.Message { return &AppBundle{} },
+	COMPOSITE_KEY_COLLECTION_OBJECTTYPE:     func() proto.Message { return &Collection{} },
+	COMPOSITE_KEY_PIN_OBJECTTYPE:            func() proto.Message { return &Pin{} },
+	COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE: func() proto.Message { return &AccessRequest{} },
+	COMPOSITE_KEY_PERMISSION_OBJECTTYPE:     func() proto.Message { return &Permission{} },
+	COMPOSITE_KEY_PROMOTION_OBJECTTYPE:      func() proto.Message { return &Promotion{} },
+}
+
+// JSON_DOCUMENT_TYPE_FIELD holds the object type of a JSON document, since CouchDB selectors
+// cannot match on keys.
+const JSON_DOCUMENT_TYPE_FIELD = "doc_type"
+
+// jsonIndexedFields lists the fields of each object type covered by the CouchDB indexes in
+// META-INF/statedb/couchdb/indexes, with the JSON of the zero value the JSON mapping omits.
+var jsonIndexedFields = map[string]map[string]string{
+	COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE: {"owner_id": `""`},
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:     {"owner_id": `""`},
+	COMPOSITE_KEY_COLLECTION_OBJECTTYPE:     {"owner_id": `""`},
+	COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE: {"status": `"PENDING"`},
+}
+
+// documentMarshaler writes the proto3 JSON mapping, keyed by the proto field names the CouchDB
+// indexes name.
+var documentMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+// documentUnmarshaler skips the doc_type field, which is not a field of the asset.
+var documentUnmarshaler = &jsonpb.Unmarshaler{AllowUnknownFields: true}
+
+type encodingStub struct {
+	shim.ChaincodeStubInterface
+	encoding *RegistryConfig_StorageEncoding // Read from the committed RegistryConfig on first write
+}
+
+func newEncodingStub(stub shim.ChaincodeStubInterface) *encodingStub {
+	return &encodingStub{ChaincodeStubInterface: stub}
+}
+
+// storageEncoding returns the encoding of the committed RegistryConfig, so a change made by
+// setStorageEncoding applies from the next transaction.
+func (es *encodingStub) storageEncoding() (RegistryConfig_StorageEncoding, error) {
+	if es.encoding == nil {
+		compositeKey, err := es.CreateCompositeKey(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS)
+		if err != nil {
+			return RegistryConfig_PROTO, err
+		}
+		registryConfigBytes, err := es.ChaincodeStubInterface.GetState(compositeKey)
+		if err != nil {
+			return RegistryConfig_PROTO, fmt.Errorf("Could not get RegistryConfig: %s", err)
+		}
+		registryConfig := &RegistryConfig{}
+		if err := proto.Unmarshal(registryConfigBytes, registryConfig); err != nil {
+			return RegistryConfig_PROTO, fmt.Errorf("Cannot unmarshal RegistryConfig: %s", err)
+		}
+		es.encoding = &registryConfig.StorageEncoding
+	}
+	return *es.encoding, nil
+}
+
+// documentType returns the object type and key parts of key if it holds an asset that may be
+// stored as JSON.
+func (es *encodingStub) documentType(key string) (string, []string, bool) {
+	objectType, key_parts, err := es.SplitCompositeKey(key)
+	if err != nil {
+		return "", nil, false
+	}
+	_, ok := jsonDocumentTypes[objectType]
+	return objectType, key_parts, ok
+}
+
+func (es *encodingStub) companionKey(objectType string, key_parts []string) (string, error) {
+	return es.CreateCompositeKey(objectType+JSON_DOCUMENT_OBJECTTYPE_SUFFIX, key_parts)
+}
+
+// encodeDocument returns the JSON document for asset. It adds a doc_type field holding the
+// object type, and the fields in jsonIndexedFields even when empty, so that CouchDB selectors
+// on them can be served by the indexes. Decoding ignores doc_type.
+func encodeDocument(objectType string, asset proto.Message) ([]byte, error) {
+	assetJSON, err := documentMarshaler.MarshalToString(asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling %s JSON document: %s", objectType, err)
+	}
+	document := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(assetJSON), &document); err != nil {
+		return nil, fmt.Errorf("Error marshaling %s JSON document: %s", objectType, err)
+	}
+	for field, zero := range jsonIndexedFields[objectType] {
+		if _, ok := document[field]; !ok {
+			document[field] = json.RawMessage(zero)
+		}
+	}
+	document[JSON_DOCUMENT_TYPE_FIELD], _ = json.Marshal(objectType)
+	// Map keys are sorted, so the document is the same on every endorser
+	return json.Marshal(document)
+}
+
+// decodeStored returns the proto bytes of a stored asset value. A marshaled asset never
+// starts with '{', which would be the tag of a group field 15, so that marks a JSON document.
+// The proto bytes are marshaled deterministically, so every read of a document, on every
+// endorser, gets the same bytes and the same content hash.
+func decodeStored(objectType string, value []byte) ([]byte, error) {
+	if len(value) == 0 || value[0] != '{' {
+		return value, nil
+	}
+	newAsset, ok := jsonDocumentTypes[objectType]
+	if !ok {
+		// Other object types are never stored as JSON documents, whatever their value
+		return value, nil
+	}
+	asset := newAsset()
+	if err := documentUnmarshaler.Unmarshal(bytes.NewReader(value), asset); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal %s JSON document: %s", objectType, err)
+	}
+	return marshalDeterministic(asset)
+}
+
+func (es *encodingStub) GetState(key string) ([]byte, error) {
+	value, err := es.ChaincodeStubInterface.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	objectType, _, ok := es.documentType(key)
+	if !ok {
+		return value, nil
+	}
+	return decodeStored(objectType, value)
+}
+
+func (es *encodingStub) PutState(key string, value []byte) error {
+	return es.put(key, value, es.ChaincodeStubInterface.PutState, es.ChaincodeStubInterface.DelState)
+}
+
+func (es *encodingStub) DelState(key string) error {
+	return es.del(key, es.ChaincodeStubInterface.DelState)
+}
+
+// put writes value, proto bytes, under key in the storage encoding using the put and del
+// functions of the public state or of a private data collection.
+func (es *encodingStub) put(key string, value []byte, put func(string, []byte) error, del func(string) error) error {
+	objectType, key_parts, ok := es.documentType(key)
+	if !ok {
+		return put(key, value)
+	}
+	encoding, err := es.storageEncoding()
+	if err != nil {
+		return err
+	}
+	companionKey, err := es.companionKey(objectType, key_parts)
+	if err != nil {
+		return err
+	}
+
+	var documentBytes []byte
+	if encoding != RegistryConfig_PROTO {
+		asset := jsonDocumentTypes[objectType]()
+		if err := proto.Unmarshal(value, asset); err != nil {
+			return fmt.Errorf("Cannot unmarshal %s for key %s: %s", objectType, key, err)
+		}
+		if documentBytes, err = encodeDocument(objectType, asset); err != nil {
+			return err
+		}
+	}
+
+	switch encoding {
+	case RegistryConfig_JSON:
+		if err := put(key, documentBytes); err != nil {
+			return err
+		}
+		return del(companionKey)
+	case RegistryConfig_PROTO_AND_JSON:
+		if err := put(key, value); err != nil {
+			return err
+		}
+		return put(companionKey, documentBytes)
+	default:
+		if err := put(key, value); err != nil {
+			return err
+		}
+		// Drop any copy written while the encoding was PROTO_AND_JSON
+		return del(companionKey)
+	}
+}
+
+func (es *encodingStub) del(key string, del func(string) error) error {
+	if err := del(key); err != nil {
+		return err
+	}
+	objectType, key_parts, ok := es.documentType(key)
+	if !ok {
+		return nil
+	}
+	companionKey, err := es.companionKey(objectType, key_parts)
+	if err != nil {
+		return err
+	}
+	return del(companionKey)
+}
+
+func (es *encodingStub) GetStateByPartialCompositeKey(objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	stateQueryIterator, err := es.ChaincodeStubInterface.GetStateByPartialCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := jsonDocumentTypes[objectType]; !ok {
+		return stateQueryIterator, nil
+	}
+	return &encodingIterator{StateQueryIteratorInterface: stateQueryIterator, objectType: objectType}, nil
+}
+
+func (es *encodingStub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	stateQueryIterator, queryResponseMetadata, err := es.ChaincodeStubInterface.GetStateByPartialCompositeKeyWithPagination(objectType, keys, pageSize, bookmark)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := jsonDocumentTypes[objectType]; !ok {
+		return stateQueryIterator, queryResponseMetadata, nil
+	}
+	return &encodingIterator{StateQueryIteratorInterface: stateQueryIterator, objectType: objectType}, queryResponseMetadata, nil
+}
+
+func (es *encodingStub) GetPrivateData(collection string, key string) ([]byte, error) {
+	value, err := es.ChaincodeStubInterface.GetPrivateData(collection, key)
+	if err != nil {
+		return nil, err
+	}
+	objectType, _, ok := es.documentType(key)
+	if !ok {
+		return value, nil
+	}
+	return decodeStored(objectType, value)
+}
+
+func (es *encodingStub) PutPrivateData(collection string, key string, value []byte) error {
+	put := func(key string, value []byte) error {
+		return es.ChaincodeStubInterface.PutPrivateData(collection, key, value)
+	}
+	del := func(key string) error {
+		return es.ChaincodeStubInterface.DelPrivateData(collection, key)
+	}
+	return es.put(key, value, put, del)
+}
+
+func (es *encodingStub) DelPrivateData(collection string, key string) error {
+	return es.del(key, func(key string) error { return es.ChaincodeStubInterface.DelPrivateData(collection, key) })
+}
+
+func (es *encodingStub) GetPrivateDataByPartialCompositeKey(collection string, objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	stateQueryIterator, err := es.ChaincodeStubInterface.GetPrivateDataByPartialCompositeKey(collection, objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := jsonDocumentTypes[objectType]; !ok {
+		return stateQueryIterator, nil
+	}
+	return &encodingIterator{StateQueryIteratorInterface: stateQueryIterator, objectType: objectType}, nil
+}
+
+// encodingIterator returns the proto bytes of assets stored as JSON documents.
+type encodingIterator struct {
+	shim.StateQueryIteratorInterface
+	objectType string
+}
+
+func (it *encodingIterator) Next() (*queryresult.KV, error) {
+	kv, err := it.StateQueryIteratorInterface.Next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeStored(it.objectType, kv.Value)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding key %s: %s", kv.Key, err)
+	}
+	return &queryresult.KV{Namespace: kv.Namespace, Key: kv.Key, Value: value}, nil
+}
+
+func (ac *assetContext) setStorageEncoding() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var storageEncoding RegistryConfig_StorageEncoding
+
+	switch len(args) {
+	case 2:
+		value, ok := RegistryConfig_StorageEncoding_value[string(args[1])]
+		if !ok {
+			return nil, fmt.Errorf("Error in setStorageEncoding, invalid encoding %s", args[1])
+		}
+		storageEncoding = RegistryConfig_StorageEncoding(value)
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setStorageEncoding")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setStorageEncoding: %s", err)
+	}
+	registryConfig.StorageEncoding = storageEncoding
+	return ac.putRegistryConfig(registryConfig)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestDecodeStored(t *testing.T) {
+	descriptor := &AppDescriptor{
+		Description:          "catalog",
+		CreatedAt:            1556668800,
+		Tags:                 []string{"tcf", "worker"},
+		EnvironmentBundleIds: map[string]string{"DEV": "b2", "STAGING": "b1", "PROD": "b1"},
+	}
+	protoBytes, err := marshalDeterministic(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	document, err := encodeDocument(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		objectType string
+		value      []byte
+		want       proto.Message
+	}{
+		{"proto bytes are returned as stored", COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, protoBytes, descriptor},
+		{"document written by encodeDocument", COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, document, descriptor},
+		{
+			"encoding/json document with numbers for 64-bit integers and enums",
+			COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE,
+			[]byte(`{"descriptor_id":"d","status":2,"requested_at":5,"doc_type":"ACCESS_REQUEST"}`),
+			&AccessRequest{DescriptorId: "d", Status: AccessRequest_DENIED, RequestedAt: 5},
+		},
+		{
+			"proto3 JSON document with strings for 64-bit integers and enums",
+			COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE,
+			[]byte(`{"descriptor_id":"d","status":"GRANTED","requested_at":"5","doc_type":"ACCESS_REQUEST"}`),
+			&AccessRequest{DescriptorId: "d", Status: AccessRequest_GRANTED, RequestedAt: 5},
+		},
+	}
+	for _, test := range tests {
+		want, err := marshalDeterministic(test.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Maps are decoded into Go maps, so each decode may iterate them in another order
+		for i := 0; i < 20; i++ {
+			got, err := decodeStored(test.objectType, test.value)
+			if err != nil {
+				t.Fatalf("%s: %s", test.name, err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("%s: decoded to %x, want %x", test.name, got, want)
+			}
+		}
+	}
+
+	if _, err := decodeStored(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []byte(`{"description":`)); err == nil {
+		t.Fatal("a truncated document decoded")
+	}
+	value := []byte("{not a document")
+	if got, err := decodeStored(COMPOSITE_KEY_EVENT_JOURNAL_OBJECTTYPE, value); err != nil || !bytes.Equal(got, value) {
+		t.Errorf("a value of an object type never stored as JSON decoded to %q, %v", got, err)
+	}
+}
+
+// A descriptor stored as JSON is re-marshaled on every read, and its content hash, which
+// listings and ifMatch compare, must not depend on the order its map entries are visited in.
+func TestJSONDocumentHashIsStable(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "setStorageEncoding", "JSON")
+	s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "environments"})
+	s.mustInvoke(t, "createAppBundle", "b", &AppBundle{DescriptorId: "d", Artifacts: [][]byte{[]byte("artifact")}})
+	s.mustInvoke(t, "promoteBundle", "d", "b", "DEV")
+	s.mustInvoke(t, "promoteBundle", "d", "b", "STAGING")
+
+	var hash []byte
+	var collectionHash []byte
+	for i := 0; i < 20; i++ {
+		appDescriptors := &AppDescriptors{}
+		if err := proto.Unmarshal(s.mustInvoke(t, "getAppDescriptors"), appDescriptors); err != nil {
+			t.Fatal(err)
+		}
+		if len(appDescriptors.Descriptors) != 1 || len(appDescriptors.Descriptors[0].AppDescriptor.EnvironmentBundleIds) != 2 {
+			t.Fatalf("got %v, want the descriptor with two environments", appDescriptors)
+		}
+		collection := &CollectionHash{}
+		if err := proto.Unmarshal(s.mustInvoke(t, "getCollectionHash", COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE), collection); err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			hash = appDescriptors.Descriptors[0].Hash
+			collectionHash = collection.Hash
+			continue
+		}
+		if !bytes.Equal(appDescriptors.Descriptors[0].Hash, hash) {
+			t.Fatalf("read %d hashed the descriptor to %x, the first read to %x", i, appDescriptors.Descriptors[0].Hash, hash)
+		}
+		if !bytes.Equal(collection.Hash, collectionHash) {
+			t.Fatalf("read %d hashed the collection to %x, the first read to %x", i, collection.Hash, collectionHash)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Low-frequency consumers, such as a weekly compliance report, need what changed in a
+// namespace rather than every RegistryEvent. With the event_journal FeatureFlag set, each
+// transaction writes an EventJournalEntry of its changes to the journal of every namespace it
+// changes, keyed by its timestamp and tx_id. An admin then calls emitDigest with the cursor
+// the consumer last saw, a timestamp and optionally the tx_id of the last transaction seen at
+// it, and the transaction emits a single RegistryDigest of the journaled changes after it,
+// named REGISTRY_DIGEST.<namespace>. The name does not begin with REGISTRY_EVENT_PREFIX, so
+// listeners filtering RegistryEvents by namespace, the gateway's among them, do not mistake a
+// RegistryDigest for a RegistryEvent. A digest summarizes at most MAX_DIGEST_TRANSACTIONS
+// transactions; its to_timestamp and to_tx_id are where the next one continues. Changes in
+// silenced namespaces and to the registry's own records are not journaled.
+//
+// Nothing prunes the journal, so a digest reads only the keys from its cursor on. Fabric
+// refuses key range queries over composite keys, so the journal is kept under simple keys,
+// SYSTEM.EVENT_JOURNAL.<namespace>.<timestamp>.<tx_id>, written below the eventStub and
+// encodingStub, which handle composite keys only. Journal entries are therefore not listed
+// in the RegistryEvent of their transaction.
+//
+// Journaling reads nothing and writes only keys unique to the transaction, so concurrent
+// writes to a namespace do not conflict on its journal. The journal is in transaction
+// timestamp order, which the submitting clients set: a transaction committed after a digest
+// may sort before its cursor, so consumers that must see every change start their cursor a
+// margin behind.
+
+// REGISTRY_DIGEST_EVENT_PREFIX begins the name of every RegistryDigest event.
+const REGISTRY_DIGEST_EVENT_PREFIX = "REGISTRY_DIGEST"
+
+// MAX_DIGEST_TRANSACTIONS bounds the transactions a RegistryDigest summarizes.
+const MAX_DIGEST_TRANSACTIONS = 1000
+
+// journalKey returns the key of the EventJournalEntry of transaction tx_id in the journal of
+// namespace, the timestamp zero-padded so the journal is in timestamp order, then in tx_id
+// order.
+func journalKey(namespace string, timestamp int64, tx_id string) string {
+	return fmt.Sprintf("%s.%s.%s.%020d.%s", COMPOSITE_KEY_SYSTEM_PREFIX, COMPOSITE_KEY_EVENT_JOURNAL_OBJECTTYPE, namespace, timestamp, tx_id)
+}
+
+// journalChanges records the changes of the transaction in the journals of their namespaces.
+func (ac *assetContext) journalChanges() error {
+	if ac.events == nil || len(ac.events.order) == 0 || !ac.featureFlags.GetEventJournal() {
+		return nil
+	}
+	silenced := make(map[string]bool)
+	for _, namespace := range ac.featureFlags.GetSilencedEventNamespaces() {
+		silenced[namespace] = true
+	}
+	changes := make(map[string][]*RegistryEvent_Change)
+	var namespaces []string
+	for _, key := range ac.events.order {
+		objectType, key_parts, err := ac.stub.SplitCompositeKey(key)
+		if err != nil {
+			return fmt.Errorf("Error splitting written key %s: %s", key, err)
+		}
+		if systemObjectTypes[objectType] || silenced[objectType] {
+			continue
+		}
+		if _, ok := changes[objectType]; !ok {
+			namespaces = append(namespaces, objectType)
+		}
+		changes[objectType] = append(changes[objectType], &RegistryEvent_Change{ObjectType: objectType, KeyParts: key_parts, Deleted: ac.events.deleted[key]})
+	}
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	timestamp, err := ac.txTimestamp()
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		eventJournalEntry := &EventJournalEntry{
+			Function:  ac.function,
+			TxId:      ac.stub.GetTxID(),
+			CreatorId: ac.identity,
+			Timestamp: timestamp,
+			Changes:   changes[namespace],
+		}
+		eventJournalEntryBytes, err := marshalDeterministic(eventJournalEntry)
+		if err != nil {
+			return fmt.Errorf("Error marshalling EventJournalEntry: %s", err)
+		}
+		if err := ac.system.PutState(journalKey(namespace, timestamp, eventJournalEntry.TxId), eventJournalEntryBytes); err != nil {
+			return fmt.Errorf("Could not journal the changes to %s: %s", namespace, err)
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) emitDigest() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	since_timestamp_arg := ""
+	since_tx_id := ""
+
+	switch len(args) {
+	case 4:
+		since_tx_id = string(args[3])
+		fallthrough
+	case 3:
+		namespace = string(args[1])
+		since_timestamp_arg = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to emitDigest")
+	}
+	since_timestamp, err := strconv.ParseInt(since_timestamp_arg, 10, 64)
+	if err != nil || since_timestamp < 0 {
+		return nil, fmt.Errorf("Error in emitDigest, invalid timestamp %s", since_timestamp_arg)
+	}
+	if _, ok := Query_ObjectType_value[namespace]; !ok {
+		return nil, fmt.Errorf("Error in emitDigest, %s is not a namespace", namespace)
+	}
+	if err := checkNotSystemObjectType(namespace); err != nil {
+		return nil, fmt.Errorf("Error in emitDigest: %s", err)
+	}
+	for _, silenced := range ac.featureFlags.GetSilencedEventNamespaces() {
+		if silenced == namespace {
+			return nil, fmt.Errorf("Error in emitDigest, namespace %s is silenced and not journaled", namespace)
+		}
+	}
+	// The journal keys after the cursor: those after since_tx_id at since_timestamp, or if
+	// since_tx_id is empty those after every tx_id at since_timestamp, which sort before
+	// U+10FFFF. The range starts at the cursor's own key, which is skipped.
+	startKey := journalKey(namespace, since_timestamp, since_tx_id)
+	if len(since_tx_id) == 0 {
+		startKey = journalKey(namespace, since_timestamp, "\U0010FFFF")
+	}
+	endKey := journalKey(namespace, math.MaxInt64, "\U0010FFFF")
+
+	stateQueryIterator, err := ac.stub.GetStateByRange(startKey, endKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in emitDigest: %s", err)
+	}
+	defer stateQueryIterator.Close()
+
+	registryDigest := &RegistryDigest{
+		Namespace:      namespace,
+		SinceTimestamp: since_timestamp,
+		SinceTxId:      since_tx_id,
+		ToTimestamp:    since_timestamp,
+		ToTxId:         since_tx_id,
+	}
+	functions := make(map[string]*RegistryDigest_Function)
+	changes := make(map[string]*RegistryDigest_Change)
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in emitDigest: %s", err)
+		}
+		if kv.Key == startKey {
+			continue
+		}
+		if registryDigest.Transactions == MAX_DIGEST_TRANSACTIONS {
+			registryDigest.HasMore = true
+			break
+		}
+		eventJournalEntry := &EventJournalEntry{}
+		if err := proto.Unmarshal(kv.Value, eventJournalEntry); err != nil {
+			return nil, fmt.Errorf("Error in emitDigest, cannot unmarshal EventJournalEntry %s: %s", kv.Key, err)
+		}
+
+		if registryDigest.Transactions == 0 {
+			registryDigest.FromTimestamp = eventJournalEntry.Timestamp
+		}
+		registryDigest.Transactions++
+		registryDigest.ToTimestamp = eventJournalEntry.Timestamp
+		registryDigest.ToTxId = eventJournalEntry.TxId
+		if _, ok := functions[eventJournalEntry.Function]; !ok {
+			functions[eventJournalEntry.Function] = &RegistryDigest_Function{Function: eventJournalEntry.Function}
+		}
+		functions[eventJournalEntry.Function].Transactions++
+		for _, journaled := range eventJournalEntry.Changes {
+			key := strings.Join(journaled.KeyParts, "\x00")
+			change, ok := changes[key]
+			if !ok {
+				change = &RegistryDigest_Change{KeyParts: journaled.KeyParts}
+				changes[key] = change
+			}
+			change.Deleted = journaled.Deleted
+			change.Transactions++
+		}
+	}
+
+	for _, function := range functions {
+		registryDigest.Functions = append(registryDigest.Functions, function)
+	}
+	sort.Slice(registryDigest.Functions, func(i, j int) bool {
+		return registryDigest.Functions[i].Function < registryDigest.Functions[j].Function
+	})
+	var keys []string
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		registryDigest.Changes = append(registryDigest.Changes, changes[key])
+	}
+
+	registryDigestBytes, err := proto.Marshal(registryDigest)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RegistryDigest in emitDigest: %s", err)
+	}
+	if err := ac.stub.SetEvent(strings.Join([]string{REGISTRY_DIGEST_EVENT_PREFIX, namespace}, "."), registryDigestBytes); err != nil {
+		return nil, fmt.Errorf("Error in emitDigest: %s", err)
+	}
+	return registryDigestBytes, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// mustEmitDigest emits the RegistryDigest of namespace after the cursor of args.
+func (s *testRegistry) mustEmitDigest(t *testing.T, namespace string, args ...interface{}) *RegistryDigest {
+	registryDigest := &RegistryDigest{}
+	if err := proto.Unmarshal(s.mustInvoke(t, "emitDigest", append([]interface{}{namespace}, args...)...), registryDigest); err != nil {
+		t.Fatal(err)
+	}
+	return registryDigest
+}
+
+// A digest continues from the cursor of the one before it, and silenced namespaces, which are
+// not journaled, have no digest.
+func TestEmitDigest(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "setFeatureFlag", "event_journal", "true")
+	for _, key := range []string{"a", "b", "c"} {
+		s.mustInvoke(t, "createAppDescriptor", key, &AppDescriptor{Description: key})
+	}
+
+	first := s.mustEmitDigest(t, "APP_DESCRIPTOR", "0")
+	if first.Transactions != 3 || first.HasMore {
+		t.Fatalf("the first digest summarizes %d transactions, has_more %v, want 3", first.Transactions, first.HasMore)
+	}
+	s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "d"})
+
+	next := s.mustEmitDigest(t, "APP_DESCRIPTOR", fmt.Sprint(first.ToTimestamp), first.ToTxId)
+	if next.Transactions != 1 || len(next.Changes) != 1 || strings.Join(next.Changes[0].KeyParts, "") != "d" {
+		t.Fatalf("the next digest summarizes %d transactions changing %v, want only the creation of d", next.Transactions, next.Changes)
+	}
+	if next.SinceTimestamp != first.ToTimestamp || next.SinceTxId != first.ToTxId {
+		t.Errorf("the next digest continues from %d %s, want %d %s", next.SinceTimestamp, next.SinceTxId, first.ToTimestamp, first.ToTxId)
+	}
+	if last := s.mustEmitDigest(t, "APP_DESCRIPTOR", fmt.Sprint(next.ToTimestamp)); last.Transactions != 0 {
+		t.Errorf("the digest after second %d summarizes %d transactions, want none", next.ToTimestamp, last.Transactions)
+	}
+
+	s.mustInvoke(t, "silenceEventNamespace", "APP_BUNDLE")
+	if message := s.mustFail(t, "emitDigest", "APP_BUNDLE", "0"); !strings.Contains(message, "silenced") {
+		t.Errorf("emitDigest of a silenced namespace failed with %q", message)
+	}
+}
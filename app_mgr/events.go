@@ -0,0 +1,218 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// Every transaction that changes public state emits a single RegistryEvent, so off-chain
+// services such as the gateway can follow the registry without polling. Fabric keeps only
+// the last event set by a transaction, so the changes are collected by an eventStub under
+// the handlers and emitted once the handler has succeeded. Writes buffered by an overlay
+// reach the eventStub only when the overlay is flushed, so validateOnly emits nothing.
+// Changes to an AppDescriptor or its AppBundles list the identities watching the descriptor,
+// so a notification service can tell them without tracking subscriptions itself.
+//
+// The event is named REGISTRY.<namespaces>.<function>, where <namespaces> are the object types
+// of the assets changed, joined by "+" in name order, e.g.
+// REGISTRY.APP_DESCRIPTOR.createAppDescriptor, so listeners can subscribe to the namespaces
+// they follow with an event name filter such as ^REGISTRY\.([^.]*\+)?APP_BUNDLE[+.]. The
+// registry's own records, e.g. rate counters, are still listed as changes but do not name the
+// event, which is REGISTRY.SYSTEM.<function> when only they changed. Admins may silence noisy
+// namespaces with silenceEventNamespace: their changes are left out of the events, and a
+// transaction changing nothing else emits none.
+//
+// A handler may set an event of its own instead, as emitDigest does; the eventStub holds it
+// and it is emitted in place of the RegistryEvent of the transaction.
+
+// REGISTRY_EVENT_PREFIX begins the name of every RegistryEvent.
+const REGISTRY_EVENT_PREFIX = "REGISTRY"
+
+// eventStub records the keys written through it, and the event set through it.
+type eventStub struct {
+	shim.ChaincodeStubInterface
+	deleted map[string]bool
+	order   []string // Written keys, in first-write order
+	event   *handlerEvent
+}
+
+// handlerEvent is an event set by a handler.
+type handlerEvent struct {
+	name    string
+	payload []byte
+}
+
+func newEventStub(stub shim.ChaincodeStubInterface) *eventStub {
+	return &eventStub{ChaincodeStubInterface: stub, deleted: make(map[string]bool)}
+}
+
+func (es *eventStub) record(key string, deleted bool) {
+	if _, written := es.deleted[key]; !written {
+		es.order = append(es.order, key)
+	}
+	es.deleted[key] = deleted
+}
+
+func (es *eventStub) PutState(key string, value []byte) error {
+	if err := es.ChaincodeStubInterface.PutState(key, value); err != nil {
+		return err
+	}
+	es.record(key, false)
+	return nil
+}
+
+func (es *eventStub) DelState(key string) error {
+	if err := es.ChaincodeStubInterface.DelState(key); err != nil {
+		return err
+	}
+	es.record(key, true)
+	return nil
+}
+
+func (es *eventStub) SetEvent(name string, payload []byte) error {
+	es.event = &handlerEvent{name: name, payload: payload}
+	return nil
+}
+
+// registryEventName returns the name of the RegistryEvent of function making changes.
+func registryEventName(function string, changes []*RegistryEvent_Change) string {
+	named := make(map[string]bool)
+	var namespaces []string
+	for _, change := range changes {
+		if !systemObjectTypes[change.ObjectType] && !named[change.ObjectType] {
+			named[change.ObjectType] = true
+			namespaces = append(namespaces, change.ObjectType)
+		}
+	}
+	if len(namespaces) == 0 {
+		namespaces = []string{COMPOSITE_KEY_SYSTEM_PREFIX}
+	}
+	sort.Strings(namespaces)
+	return strings.Join([]string{REGISTRY_EVENT_PREFIX, strings.Join(namespaces, "+"), function}, ".")
+}
+
+// emitRegistryEvent sets the RegistryEvent of the transaction, if it changed any state outside
+// the silenced namespaces and events are not disabled by the FeatureFlags, unless the handler
+// set an event of its own.
+func (ac *assetContext) emitRegistryEvent() error {
+	if ac.events != nil && ac.events.event != nil {
+		return ac.events.ChaincodeStubInterface.SetEvent(ac.events.event.name, ac.events.event.payload)
+	}
+	if ac.events == nil || len(ac.events.order) == 0 || ac.featureFlags.GetEventsDisabled() {
+		return nil
+	}
+	timestamp, err := ac.txTimestamp()
+	if err != nil {
+		return err
+	}
+	registryEvent := &RegistryEvent{
+		Function:     ac.function,
+		TxId:         ac.stub.GetTxID(),
+		CreatorId:    ac.identity,
+		CreatorMspId: ac.mspId,
+		Timestamp:    timestamp,
+		TraceId:      ac.traceId,
+	}
+	silenced := make(map[string]bool)
+	for _, namespace := range ac.featureFlags.GetSilencedEventNamespaces() {
+		silenced[namespace] = true
+	}
+	watcher_ids := make(map[string][]string)
+	for _, key := range ac.events.order {
+		objectType, key_parts, err := ac.stub.SplitCompositeKey(key)
+		if err != nil {
+			return fmt.Errorf("Error splitting written key %s: %s", key, err)
+		}
+		if silenced[objectType] {
+			continue
+		}
+		change := &RegistryEvent_Change{ObjectType: objectType, KeyParts: key_parts, Deleted: ac.events.deleted[key]}
+		if objectType == COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE || objectType == COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE {
+			watchers, ok := watcher_ids[key_parts[0]]
+			if !ok {
+				if watchers, err = ac.descriptorWatcherIds(key_parts[0]); err != nil {
+					return err
+				}
+				watcher_ids[key_parts[0]] = watchers
+			}
+			change.WatcherIds = watchers
+		}
+		registryEvent.Changes = append(registryEvent.Changes, change)
+	}
+	if len(registryEvent.Changes) == 0 {
+		return nil
+	}
+	registryEventBytes, err := proto.Marshal(registryEvent)
+	if err != nil {
+		return fmt.Errorf("Error marshalling RegistryEvent: %s", err)
+	}
+	return ac.events.ChaincodeStubInterface.SetEvent(registryEventName(ac.function, registryEvent.Changes), registryEventBytes)
+}
+
+// validateSilencedEventNamespaces checks the silenced_event_namespaces of the FeatureFlags.
+func validateSilencedEventNamespaces(namespaces []string) error {
+	for i, namespace := range namespaces {
+		if _, ok := Query_ObjectType_value[namespace]; !ok {
+			return fmt.Errorf("feature_flags.silenced_event_namespaces: %s is not a namespace", namespace)
+		}
+		if i > 0 && namespaces[i-1] >= namespace {
+			return fmt.Errorf("feature_flags.silenced_event_namespaces must be in name order, without duplicates")
+		}
+	}
+	return nil
+}
+
+// silenceEventNamespace leaves the changes in a namespace out of RegistryEvents, from the next
+// transaction, or includes them again.
+func (ac *assetContext) silenceEventNamespace() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	silence := true
+
+	switch len(args) {
+	case 3:
+		var err error
+		if silence, err = strconv.ParseBool(string(args[2])); err != nil {
+			return nil, fmt.Errorf("Error in silenceEventNamespace, invalid silenced %s: %s", args[2], err)
+		}
+		fallthrough
+	case 2:
+		namespace = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to silenceEventNamespace")
+	}
+	if _, ok := Query_ObjectType_value[namespace]; !ok {
+		return nil, fmt.Errorf("Error in silenceEventNamespace, %s is not a namespace", namespace)
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in silenceEventNamespace: %s", err)
+	}
+	if registryConfig.FeatureFlags == nil {
+		registryConfig.FeatureFlags = &FeatureFlags{}
+	}
+	var namespaces []string
+	for _, silenced := range registryConfig.FeatureFlags.SilencedEventNamespaces {
+		if silenced != namespace {
+			namespaces = append(namespaces, silenced)
+		}
+	}
+	if silence {
+		namespaces = append(namespaces, namespace)
+		sort.Strings(namespaces)
+	}
+	registryConfig.FeatureFlags.SilencedEventNamespaces = namespaces
+	return ac.putRegistryConfig(registryConfig)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// An AppBundle may require a minimum Fabric release, so deployment tooling can ask
+// getCompatibleBundles for the bundles that run on its network's version rather than finding
+// out at install time. Versions are release numbers, MAJOR.MINOR with an optional PATCH
+// defaulting to 0; pre-release suffixes are not accepted.
+
+// parseFabricVersion returns the major, minor and patch numbers of version.
+func parseFabricVersion(version string) ([3]uint64, error) {
+	var numbers [3]uint64
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return numbers, fmt.Errorf("version %q is not MAJOR.MINOR or MAJOR.MINOR.PATCH", version)
+	}
+	for i, part := range parts {
+		if len(part) == 0 || len(part) > 1 && part[0] == '0' || strings.IndexFunc(part, func(c rune) bool { return c < '0' || c > '9' }) >= 0 {
+			return numbers, fmt.Errorf("version %q has an invalid number %q", version, part)
+		}
+		number, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return numbers, fmt.Errorf("version %q has an invalid number %q: %s", version, part, err)
+		}
+		numbers[i] = number
+	}
+	return numbers, nil
+}
+
+// validateMinFabricVersion checks the min_fabric_version of an AppBundle to be created.
+func validateMinFabricVersion(min_fabric_version string) error {
+	if len(min_fabric_version) == 0 {
+		return nil
+	}
+	if _, err := parseFabricVersion(min_fabric_version); err != nil {
+		return fmt.Errorf("invalid min_fabric_version: %s", err)
+	}
+	return nil
+}
+
+// runsOnFabric reports whether a bundle requiring min_fabric_version runs on fabric_version.
+func runsOnFabric(min_fabric_version string, fabric_version [3]uint64) bool {
+	if len(min_fabric_version) == 0 {
+		return true
+	}
+	min_version, err := parseFabricVersion(min_fabric_version)
+	if err != nil {
+		// Stored before min_fabric_version was validated, so incompatible with every version
+		return false
+	}
+	for i := range min_version {
+		if min_version[i] != fabric_version[i] {
+			return min_version[i] < fabric_version[i]
+		}
+	}
+	return true
+}
+
+func (ac *assetContext) getCompatibleBundles() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	fabric_version_string := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 4:
+		bookmark = string(args[3])
+		fallthrough
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		fabric_version_string = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getCompatibleBundles")
+	}
+	fabric_version, err := parseFabricVersion(fabric_version_string)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getCompatibleBundles, invalid fabric_version: %s", err)
+	}
+
+	appBundleKeySet, err := ac.filterAppBundleKeySet(app_descriptor_key_part, bookmark, func(appBundle *AppBundle) bool {
+		return runsOnFabric(appBundle.MinFabricVersion, fabric_version)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getCompatibleBundles: %s", err)
+	}
+	appBundleKeySetBytes, err := proto.Marshal(appBundleKeySet)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling AppBundleKeySet in getCompatibleBundles: %s", err)
+	}
+	return appBundleKeySetBytes, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The storefront's featured AppDescriptors are curated by the registry admins, so the demo
+// UI's home page comes from the ledger. Each featured descriptor has a Featured flag keyed by
+// descriptor; getFeaturedDescriptors returns them in rank order.
+
+func (ac *assetContext) SetFeatured(request *SetFeaturedRequest) (*Featured, error) {
+	app_descriptor_key_part := request.AppDescriptorKey
+
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in setFeatured: %s", err)
+	}
+	featured_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setFeatured: %s", err)
+	}
+	featured := &Featured{Rank: request.Rank, FeaturedBy: ac.creator, FeaturedAt: featured_at}
+	if _, err := ac.putAsset(COMPOSITE_KEY_FEATURED_OBJECTTYPE, []string{app_descriptor_key_part}, featured); err != nil {
+		return nil, err
+	}
+	return featured, nil
+}
+
+func (ac *assetContext) UnsetFeatured(request *DescriptorRequest) (*Empty, error) {
+	app_descriptor_key_part := request.AppDescriptorKey
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_FEATURED_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unsetFeatured: %s", err)
+	}
+	exists, err := ac.keyExists(COMPOSITE_KEY_FEATURED_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unsetFeatured: %s", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("Error in unsetFeatured, AppDescriptor %s is not featured", app_descriptor_key_part)
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return nil, fmt.Errorf("Error in unsetFeatured: %s", err)
+	}
+	return &Empty{}, nil
+}
+
+func (ac *assetContext) GetFeaturedDescriptors(request *Empty) (*FeaturedDescriptors, error) {
+	featuredDescriptors := &FeaturedDescriptors{}
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_FEATURED_OBJECTTYPE, []string{})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getFeaturedDescriptors: %s", err)
+	}
+	defer stateQueryIterator.Close()
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getFeaturedDescriptors: %s", err)
+		}
+		_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getFeaturedDescriptors: %s", err)
+		}
+		featured := &Featured{}
+		if err := proto.Unmarshal(kv.Value, featured); err != nil {
+			return nil, fmt.Errorf("Error in getFeaturedDescriptors, cannot unmarshal Featured %s: %s", kv.Key, err)
+		}
+		featuredDescriptors.Entries = append(featuredDescriptors.Entries, &FeaturedDescriptors_Entry{DescriptorId: key_parts[0], Rank: featured.Rank})
+	}
+	sort.SliceStable(featuredDescriptors.Entries, func(i, j int) bool {
+		return featuredDescriptors.Entries[i].Rank < featuredDescriptors.Entries[j].Rank
+	})
+
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getFeaturedDescriptors: %s", err)
+	}
+	if uint64(len(featuredDescriptors.Entries)) > uint64(queryLimits.MaxResults) {
+		featuredDescriptors.Entries = featuredDescriptors.Entries[:queryLimits.MaxResults]
+		featuredDescriptors.HasMore = true
+	}
+	for _, entry := range featuredDescriptors.Entries {
+		if entry.AppDescriptor, err = ac.getDescriptor(entry.DescriptorId); err != nil {
+			return nil, fmt.Errorf("Error in getFeaturedDescriptors: %s", err)
+		}
+	}
+	return featuredDescriptors, nil
+}
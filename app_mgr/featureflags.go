@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// FeatureFlags let admins toggle behaviors of a deployment by transaction rather than by
+// redeploying the chaincode. execute reads the committed FeatureFlags before dispatching, so a
+// change made by setFeatureFlag applies from the next transaction, and the code consulting a
+// flag reads it from the assetContext. Every bool field of FeatureFlags is a flag that
+// setFeatureFlag can set by its name in app.proto. The FeatureFlags are part of the
+// RegistryConfig, so flag changes are versioned with the rest of the config.
+
+// loadFeatureFlags reads the FeatureFlags of the RegistryConfig into ac.featureFlags.
+func (ac *assetContext) loadFeatureFlags() error {
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	featureFlags := registryConfig.FeatureFlags
+	if featureFlags == nil {
+		featureFlags = &FeatureFlags{}
+	}
+	ac.featureFlags = featureFlags
+
+	if featureFlags.JsonEncodingDisabled {
+		if es, ok := ac.events.ChaincodeStubInterface.(*encodingStub); ok {
+			encoding := RegistryConfig_PROTO
+			es.encoding = &encoding
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) setFeatureFlag() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	flag := ""
+	value := false
+
+	switch len(args) {
+	case 3:
+		var err error
+		flag = string(args[1])
+		if value, err = strconv.ParseBool(string(args[2])); err != nil {
+			return nil, fmt.Errorf("Error in setFeatureFlag, invalid value %s: %s", args[2], err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setFeatureFlag")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setFeatureFlag: %s", err)
+	}
+	if registryConfig.FeatureFlags == nil {
+		registryConfig.FeatureFlags = &FeatureFlags{}
+	}
+	flags := reflect.ValueOf(registryConfig.FeatureFlags).Elem()
+	found := false
+	for i, prop := range proto.GetProperties(flags.Type()).Prop {
+		if prop.Tag > 0 && prop.OrigName == flag && flags.Field(i).Kind() == reflect.Bool {
+			flags.Field(i).SetBool(value)
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in setFeatureFlag, unknown flag '%s'", flag)
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) getFeatureFlags() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getFeatureFlags")
+	}
+
+	featureFlagsBytes, err := proto.Marshal(ac.featureFlags)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling FeatureFlags in getFeatureFlags: %s", err)
+	}
+	return featureFlagsBytes, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package fixtures generates the demo dataset of the registry: the TCF workers, descriptors,
+// their bundles and the licenses granted on them. A Dataset is a pure function of its seed,
+// generated with a math/rand source whose sequence is fixed across Go releases, so every
+// rehearsal loading the same seed starts from identical state, on any peer. The chaincode loads
+// a Dataset with its loadDemoDataset admin function; tests and tools can generate the same
+// Dataset to know what to expect on the ledger.
+//
+// Every Dataset starts with the well-known fixtures, whatever the seed: the descriptor
+// WellKnownDescriptorKey with its associated bundle WellKnownBundleKey, licensed to the first
+// of the Licensees and run on the Worker of the first of the WorkerOperators, for scripts
+// that need a known asset to point at.
+//
+// The registry holds no worker assets. A descriptor that runs on a Worker pays its operator a
+// RoyaltySplit of the Worker's basis points, so the demo's invoices and royalty statements
+// have a worker side.
+package fixtures
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const (
+	WellKnownDescriptorKey = "tcf-demo-app"
+	WellKnownBundleKey     = "v1"
+)
+
+// LicenseeMspId is the organization of the Licensees.
+const LicenseeMspId = "Org2MSP"
+
+// Licensees are the well-known names of the identities licensing demo descriptors.
+var Licensees = []string{"acme-analytics", "globex-research", "initech-labs", "umbrella-health"}
+
+// WorkerOperatorMspId is the organization of the WorkerOperators.
+const WorkerOperatorMspId = "Org3MSP"
+
+// WorkerOperators are the well-known names of the identities operating the TCF workers, one
+// Worker each.
+var WorkerOperators = []string{"tcf-ops-east", "tcf-ops-west", "tcf-ops-eu"}
+
+// MaxDescriptors bounds the descriptors of a Dataset, well-known fixtures included, so a
+// Dataset loads in one transaction.
+const MaxDescriptors = 16
+
+// MaxBundlesPerDescriptor bounds the bundles of each descriptor.
+const MaxBundlesPerDescriptor = 3
+
+var domains = []string{"credit-scoring", "fraud-detection", "genomics", "image-classifier", "supply-chain", "weather-forecast", "sentiment", "recommender"}
+var qualifiers = []string{"edge", "secure", "batch", "realtime", "federated", "lite"}
+var tags = []string{"tee", "sgx", "ml", "analytics", "finance", "health", "logistics", "demo"}
+
+type Worker struct {
+	Key string
+	// One of the WorkerOperators.
+	Operator string
+	// The royalty the operator is paid by the descriptors running on the Worker.
+	BasisPoints uint32
+}
+
+type Descriptor struct {
+	Key         string
+	Description string
+	// In generation order, without duplicates.
+	Tags []string
+	// The key of an earlier Descriptor of the Dataset, or empty.
+	ParentKey string
+	// The key of the Worker of the Dataset the descriptor runs on, or empty.
+	WorkerKey string
+}
+
+type Bundle struct {
+	DescriptorKey string
+	Key           string
+	Artifacts     [][]byte
+	Restricted    bool
+	// Set on the bundle to associate with its descriptor, the last of each descriptor's.
+	Associated bool
+}
+
+type License struct {
+	DescriptorKey string
+	// One of the Licensees.
+	Licensee  string
+	Exclusive bool
+	Amount    uint64
+}
+
+// Dataset holds its assets in the order they are loaded, so a parent Descriptor precedes its
+// children.
+type Dataset struct {
+	Seed        int64
+	Workers     []Worker
+	Descriptors []Descriptor
+	Bundles     []Bundle
+	Licenses    []License
+}
+
+// Generate returns the Dataset of seed.
+func Generate(seed int64) *Dataset {
+	r := rand.New(rand.NewSource(seed))
+	dataset := &Dataset{Seed: seed}
+	for _, operator := range WorkerOperators {
+		dataset.Workers = append(dataset.Workers, Worker{Key: operator + "-worker", Operator: operator, BasisPoints: uint32(500 + 100*r.Intn(16))})
+	}
+	dataset.Descriptors = append(dataset.Descriptors, Descriptor{Key: WellKnownDescriptorKey, Description: "The well-known demo application", Tags: []string{"demo"}, WorkerKey: dataset.Workers[0].Key})
+	dataset.Bundles = append(dataset.Bundles, Bundle{DescriptorKey: WellKnownDescriptorKey, Key: WellKnownBundleKey, Artifacts: [][]byte{[]byte(WellKnownDescriptorKey + "/" + WellKnownBundleKey)}, Associated: true})
+	dataset.Licenses = append(dataset.Licenses, License{DescriptorKey: WellKnownDescriptorKey, Licensee: Licensees[0], Amount: 100})
+
+	taken := map[string]bool{WellKnownDescriptorKey: true}
+	count := 4 + r.Intn(MaxDescriptors-4)
+	for len(dataset.Descriptors) < count {
+		domain := domains[r.Intn(len(domains))]
+		qualifier := qualifiers[r.Intn(len(qualifiers))]
+		key := qualifier + "-" + domain
+		if taken[key] {
+			continue
+		}
+		taken[key] = true
+
+		descriptor := Descriptor{Key: key, Description: fmt.Sprintf("A %s %s workload", qualifier, domain)}
+		for _, i := range r.Perm(len(tags))[:1+r.Intn(3)] {
+			descriptor.Tags = append(descriptor.Tags, tags[i])
+		}
+		// One in four descriptors derives from an earlier one
+		if r.Intn(4) == 0 {
+			descriptor.ParentKey = dataset.Descriptors[r.Intn(len(dataset.Descriptors))].Key
+		}
+		// Three in four run on a Worker
+		if r.Intn(4) != 0 {
+			descriptor.WorkerKey = dataset.Workers[r.Intn(len(dataset.Workers))].Key
+		}
+		dataset.add(r, descriptor, 1+r.Intn(MaxBundlesPerDescriptor))
+
+		// Licensed non-exclusively to a few Licensees, or exclusively to one
+		if r.Intn(5) == 0 {
+			dataset.Licenses = append(dataset.Licenses, License{DescriptorKey: key, Licensee: Licensees[r.Intn(len(Licensees))], Exclusive: true, Amount: uint64(1000 + 100*r.Intn(50))})
+			continue
+		}
+		for _, i := range r.Perm(len(Licensees))[:r.Intn(len(Licensees))] {
+			dataset.Licenses = append(dataset.Licenses, License{DescriptorKey: key, Licensee: Licensees[i], Amount: uint64(10 * (1 + r.Intn(50)))})
+		}
+	}
+	return dataset
+}
+
+// Worker returns the Worker of the Dataset keyed key.
+func (d *Dataset) Worker(key string) (Worker, bool) {
+	for _, worker := range d.Workers {
+		if worker.Key == key {
+			return worker, true
+		}
+	}
+	return Worker{}, false
+}
+
+// add appends descriptor and bundles of it, numbered from v1.
+func (d *Dataset) add(r *rand.Rand, descriptor Descriptor, bundles int) {
+	d.Descriptors = append(d.Descriptors, descriptor)
+	for i := 1; i <= bundles; i++ {
+		bundle := Bundle{DescriptorKey: descriptor.Key, Key: fmt.Sprintf("v%d", i), Restricted: r.Intn(4) == 0, Associated: i == bundles}
+		artifacts := 1 + r.Intn(3)
+		for j := 0; j < artifacts; j++ {
+			artifact := make([]byte, 32)
+			r.Read(artifact)
+			bundle.Artifacts = append(bundle.Artifacts, artifact)
+		}
+		d.Bundles = append(d.Bundles, bundle)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// With the function_stats FeatureFlag set, every successful write function counts its
+// invocation in a FunctionCounter of the function and the caller, giving operators a
+// ledger-native view of which registry operations are used. Functions run by wrappers are
+// counted as themselves, and only if the wrapper commits their writes, so validateOnly counts
+// nothing. Query functions commit nothing and are not counted. Neither are failures: a failed
+// invocation is not committed, so its count could never reach the ledger.
+//
+// Counters are kept per caller, like RateCounters, so concurrent writes by different
+// identities do not conflict at validation; getFunctionStats sums them.
+
+// countInvocation counts a successful invocation of ac.function by the caller.
+func (ac *assetContext) countInvocation() error {
+	if !ac.featureFlags.GetFunctionStats() {
+		return nil
+	}
+	key_parts := []string{ac.function, ac.identity}
+	functionCounter := &FunctionCounter{Function: ac.function, CallerId: ac.identity}
+	if _, err := ac.getAsset(COMPOSITE_KEY_FUNCTION_STATS_OBJECTTYPE, key_parts, functionCounter); err != nil {
+		return err
+	}
+	invoked_at, err := ac.txTimestamp()
+	if err != nil {
+		return err
+	}
+	functionCounter.Invocations++
+	functionCounter.LastInvokedAt = invoked_at
+	_, err = ac.putAsset(COMPOSITE_KEY_FUNCTION_STATS_OBJECTTYPE, key_parts, functionCounter)
+	return err
+}
+
+func (ac *assetContext) getFunctionStats() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var key_parts []string
+
+	switch len(args) {
+	case 2:
+		key_parts = []string{string(args[1])}
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getFunctionStats")
+	}
+
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_FUNCTION_STATS_OBJECTTYPE, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getFunctionStats: %s", err)
+	}
+	defer stateQueryIterator.Close()
+
+	functions := make(map[string]*FunctionStats_Function)
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getFunctionStats: %s", err)
+		}
+		functionCounter := &FunctionCounter{}
+		if err := proto.Unmarshal(kv.Value, functionCounter); err != nil {
+			return nil, fmt.Errorf("Error in getFunctionStats, cannot unmarshal FunctionCounter %s: %s", kv.Key, err)
+		}
+		function, ok := functions[functionCounter.Function]
+		if !ok {
+			function = &FunctionStats_Function{Function: functionCounter.Function}
+			functions[functionCounter.Function] = function
+		}
+		function.Invocations += functionCounter.Invocations
+		function.Callers++
+		if functionCounter.LastInvokedAt > function.LastInvokedAt {
+			function.LastInvokedAt = functionCounter.LastInvokedAt
+		}
+	}
+
+	functionStats := &FunctionStats{}
+	for _, function := range functions {
+		functionStats.Functions = append(functionStats.Functions, function)
+	}
+	sort.Slice(functionStats.Functions, func(i, j int) bool { return functionStats.Functions[i].Function < functionStats.Functions[j].Function })
+	functionStatsBytes, err := proto.Marshal(functionStats)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling FunctionStats in getFunctionStats: %s", err)
+	}
+	return functionStatsBytes, nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// An AppBundle is associated with its AppDescriptor only once it passes every association
+// gate. The gates are listed once, in associationGates, and evaluated in that order by
+// associateDescriptorWithBundle, by getBundleGates, which reports the state of each gate, and
+// by getReadiness, so a gating feature is added as a gate here rather than as another check in
+// the handler.
+//
+// Most gates derive their state from the assets they govern, e.g. the ScanResults of the
+// bundle. Conditions tracked outside the registry, such as a pending release approval or an
+// open security advisory, are recorded centrally as named holds in the BundleGates of the
+// bundle: admins place a hold with placeBundleHold and release it with releaseBundleHold, and
+// the holds gate stays closed while any hold is placed.
+
+// MAX_HOLD_NAME_LENGTH bounds the names of holds, e.g. "release-approval" or "CVE-2019-1234".
+const MAX_HOLD_NAME_LENGTH = 64
+
+// associationGate is a condition an AppBundle must meet to be associated; check returns why
+// the gate is closed. A gate that can be closed for several reasons at once enumerates them
+// with blockers, see readiness.go.
+type associationGate struct {
+	name     string
+	check    func(ac *assetContext, app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error
+	blockers func(ac *assetContext, app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) ([]*ReadinessReport_Blocker, error)
+}
+
+var associationGates = []associationGate{
+	{name: "holds", check: (*assetContext).checkBundleHolds, blockers: (*assetContext).holdBlockers},
+	{name: "scan", check: func(ac *assetContext, app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+		return ac.checkScanGate(app_descriptor_key_part, app_bundle_key_part)
+	}, blockers: (*assetContext).scanBlockers},
+	{name: "policy", check: func(ac *assetContext, app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+		return ac.evaluatePolicyRules(appBundle, app_descriptor_key_part, app_bundle_key_part)
+	}, blockers: (*assetContext).policyBlockers},
+	{name: "strict", check: (*assetContext).checkStrictAssociation, blockers: (*assetContext).strictBlockers},
+}
+
+// evaluateAssociationGates returns why the first closed gate blocks the association of the
+// AppBundle, if any.
+func (ac *assetContext) evaluateAssociationGates(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+	for _, gate := range associationGates {
+		if err := gate.check(ac, app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) getBundleGatesAsset(app_descriptor_key_part string, app_bundle_key_part string) (*BundleGates, error) {
+	bundleGates := &BundleGates{DescriptorId: app_descriptor_key_part, BundleKey: app_bundle_key_part}
+	if _, err := ac.getAsset(COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, bundleGates); err != nil {
+		return nil, err
+	}
+	return bundleGates, nil
+}
+
+func (ac *assetContext) checkBundleHolds(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+	bundleGates, err := ac.getBundleGatesAsset(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return err
+	}
+	if len(bundleGates.Holds) == 0 {
+		return nil
+	}
+	var holds []string
+	for _, hold := range bundleGates.Holds {
+		holds = append(holds, fmt.Sprintf("%s (%s)", hold.Name, hold.Reason))
+	}
+	return fmt.Errorf("AppBundle %s of AppDescriptor %s is held: %s", app_bundle_key_part, app_descriptor_key_part, strings.Join(holds, ", "))
+}
+
+// holdArgs parses the <app_descriptor_key>, <app_bundle_key>, <name> arguments of function,
+// returning the BundleGates of the AppBundle they name.
+func (ac *assetContext) holdArgs(function string) (*BundleGates, string, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 4 {
+		return nil, "", fmt.Errorf("Wrong number of arguments to %s", function)
+	}
+	name := string(args[3])
+	if len(name) == 0 || len(name) > MAX_HOLD_NAME_LENGTH {
+		return nil, "", fmt.Errorf("Error in %s, the hold name must be between 1 and %d bytes", function, MAX_HOLD_NAME_LENGTH)
+	}
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(string(args[1]))
+	if err != nil {
+		return nil, "", fmt.Errorf("Error in %s: %s", function, err)
+	}
+	app_bundle_key_part := string(args[2])
+	if _, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part); err != nil {
+		return nil, "", fmt.Errorf("Error in %s: %s", function, err)
+	}
+	bundleGates, err := ac.getBundleGatesAsset(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error in %s: %s", function, err)
+	}
+	return bundleGates, name, nil
+}
+
+func (ac *assetContext) putBundleGates(bundleGates *BundleGates) ([]byte, error) {
+	key_parts := []string{bundleGates.DescriptorId, bundleGates.BundleKey}
+	if len(bundleGates.Holds) == 0 {
+		compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE, key_parts)
+		if err != nil {
+			return nil, err
+		}
+		if err := ac.stub.DelState(compositeKey); err != nil {
+			return nil, fmt.Errorf("Could not delete state for key %s: %s", compositeKey, err)
+		}
+		return proto.Marshal(bundleGates)
+	}
+	return ac.putAsset(COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE, key_parts, bundleGates)
+}
+
+// placeBundleHold places the named hold on an AppBundle, replacing the reason of a hold of the
+// same name.
+func (ac *assetContext) placeBundleHold() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) != 5 {
+		return nil, fmt.Errorf("Wrong number of arguments to placeBundleHold")
+	}
+	reason := string(args[4])
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("Error in placeBundleHold: a reason is required")
+	}
+	bundleGates, name, err := ac.holdArgs("placeBundleHold")
+	if err != nil {
+		return nil, err
+	}
+	placed_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in placeBundleHold: %s", err)
+	}
+
+	hold := &BundleGates_Hold{Name: name, Reason: reason, PlacedBy: ac.creator, PlacedAt: placed_at}
+	var holds []*BundleGates_Hold
+	for _, placed := range bundleGates.Holds {
+		if placed.Name != name {
+			holds = append(holds, placed)
+		}
+	}
+	bundleGates.Holds = append(holds, hold)
+	sort.Slice(bundleGates.Holds, func(i, j int) bool { return bundleGates.Holds[i].Name < bundleGates.Holds[j].Name })
+	bundleGatesBytes, err := ac.putBundleGates(bundleGates)
+	if err != nil {
+		return nil, fmt.Errorf("Error in placeBundleHold: %s", err)
+	}
+	return bundleGatesBytes, nil
+}
+
+func (ac *assetContext) releaseBundleHold() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) != 4 {
+		return nil, fmt.Errorf("Wrong number of arguments to releaseBundleHold")
+	}
+	bundleGates, name, err := ac.holdArgs("releaseBundleHold")
+	if err != nil {
+		return nil, err
+	}
+
+	var holds []*BundleGates_Hold
+	for _, placed := range bundleGates.Holds {
+		if placed.Name != name {
+			holds = append(holds, placed)
+		}
+	}
+	if len(holds) == len(bundleGates.Holds) {
+		return nil, fmt.Errorf("Error in releaseBundleHold: AppBundle %s has no hold %s", bundleGates.BundleKey, name)
+	}
+	bundleGates.Holds = holds
+	bundleGatesBytes, err := ac.putBundleGates(bundleGates)
+	if err != nil {
+		return nil, fmt.Errorf("Error in releaseBundleHold: %s", err)
+	}
+	return bundleGatesBytes, nil
+}
+
+// getBundleGates evaluates every association gate of an AppBundle as associating it would.
+func (ac *assetContext) getBundleGates() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getBundleGates")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getBundleGates: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getBundleGates: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getBundleGates: %s", err)
+	}
+
+	// The gates that depend on the function, such as policy rules, apply as to an association
+	association := *ac
+	association.function = "associateDescriptorWithBundle"
+	gateReport := &GateReport{DescriptorId: app_descriptor_key_part, BundleKey: app_bundle_key_part, Passed: true}
+	for _, gate := range associationGates {
+		entry := &GateReport_Gate{Name: gate.name, Passed: true}
+		if err := gate.check(&association, app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+			entry.Passed = false
+			entry.Failure = err.Error()
+			gateReport.Passed = false
+		}
+		gateReport.Gates = append(gateReport.Gates, entry)
+	}
+	gateReportBytes, err := proto.Marshal(gateReport)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling GateReport in getBundleGates: %s", err)
+	}
+	return gateReportBytes, nil
+}
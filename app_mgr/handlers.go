@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+// handler describes an invocable function and the middleware dispatch applies to it.
+type handler struct {
+	fn        func(ac *assetContext) ([]byte, error)
+	write     bool // The function writes state; those that neither write nor wrap are query functions
+	admin     bool // Only registry admins may call the function, or namespace admins where delegated
+	wrapper   bool // The function runs other functions through dispatch, which applies their own middleware
+	migration bool // The function migrates data and remains available in maintenance mode
+}
+
+// handlers maps each function name accepted by Invoke to its handler. It is populated in init
+// because the wrapper handlers refer back to dispatch. The handlers of the functions of the
+// AppRegistry service are generated in dispatch.go; the others parse their own arguments.
+var handlers map[string]handler
+
+func init() {
+	handlers = map[string]handler{
+		"createAppDescriptor":               {fn: (*assetContext).createAppDescriptor, write: true},
+		"createAppBundle":                   {fn: (*assetContext).createAppBundle, write: true},
+		"associateDescriptorWithBundle":     {fn: (*assetContext).associateDescriptorWithBundle, write: true},
+		"getAppDescriptors":                 {fn: (*assetContext).getAppDescriptors},
+		"getAppDescriptor":                  {fn: (*assetContext).getAppDescriptor},
+		"getAppDescriptorsByKeys":           {fn: (*assetContext).getAppDescriptorsByKeys},
+		"getAppBundlesByKeys":               {fn: (*assetContext).getAppBundlesByKeys},
+		"descriptorExists":                  {fn: (*assetContext).descriptorExists},
+		"bundleExists":                      {fn: (*assetContext).bundleExists},
+		"getAppBundleKeySetForDescriptor":   {fn: (*assetContext).getAppBundleKeySetForDescriptor},
+		"getAppBundleForDescriptor":         {fn: (*assetContext).getAppBundleForDescriptor},
+		"getChildDescriptors":               {fn: (*assetContext).getChildDescriptors},
+		"createCollection":                  {fn: (*assetContext).createCollection, write: true},
+		"addDescriptorToCollection":         {fn: (*assetContext).addDescriptorToCollection, write: true},
+		"getCollection":                     {fn: (*assetContext).getCollection},
+		"pinDescriptor":                     {fn: (*assetContext).pinDescriptor, write: true},
+		"unpinDescriptor":                   {fn: (*assetContext).unpinDescriptor, write: true},
+		"getMyPinnedDescriptors":            {fn: (*assetContext).getMyPinnedDescriptors},
+		"requestAccess":                     {fn: (*assetContext).requestAccess, write: true},
+		"grantAccess":                       {fn: func(ac *assetContext) ([]byte, error) { return ac.decideAccess(AccessRequest_GRANTED) }, write: true},
+		"denyAccess":                        {fn: func(ac *assetContext) ([]byte, error) { return ac.decideAccess(AccessRequest_DENIED) }, write: true},
+		"promoteBundle":                     {fn: (*assetContext).promoteBundle, write: true},
+		"exportAssetForChannel":             {fn: (*assetContext).exportAssetForChannel},
+		"importAssetFromChannel":            {fn: (*assetContext).importAssetFromChannel, write: true},
+		"computeRegistryChecksum":           {fn: (*assetContext).computeRegistryChecksum},
+		"validateOnly":                      {fn: (*assetContext).validateOnly, wrapper: true},
+		"executeScript":                     {fn: (*assetContext).executeScript, wrapper: true},
+		"ifMatch":                           {fn: (*assetContext).ifMatch, wrapper: true},
+		"setWriteRateLimit":                 {fn: (*assetContext).setWriteRateLimit, write: true, admin: true},
+		"pauseRegistry":                     {fn: (*assetContext).pauseRegistry, write: true, admin: true},
+		"resumeRegistry":                    {fn: (*assetContext).resumeRegistry, write: true, admin: true},
+		"continueMigration":                 {fn: (*assetContext).continueMigration, write: true, admin: true, migration: true},
+		"getMigrationState":                 {fn: (*assetContext).getMigrationState, migration: true},
+		"backfill":                          {fn: (*assetContext).backfill, write: true, admin: true, migration: true},
+		"setStorageEncoding":                {fn: (*assetContext).setStorageEncoding, write: true, admin: true},
+		"setQueryLimits":                    {fn: (*assetContext).setQueryLimits, write: true, admin: true},
+		"queryAssetsByOwner":                {fn: (*assetContext).queryAssetsByOwner},
+		"queryAccessRequestsByStatus":       {fn: (*assetContext).queryAccessRequestsByStatus},
+		"queryDescriptorsByTag":             {fn: (*assetContext).queryDescriptorsByTag},
+		"createPrivateAppBundle":            {fn: (*assetContext).createPrivateAppBundle, write: true},
+		"getPrivateAppBundles":              {fn: (*assetContext).getPrivateAppBundles},
+		"createConfidentialAppBundle":       {fn: (*assetContext).createConfidentialAppBundle, write: true},
+		"getConfidentialAppBundle":          {fn: (*assetContext).getConfidentialAppBundle},
+		"getPrivateBundleRecord":            {fn: (*assetContext).getPrivateBundleRecord},
+		"setDescriptorPrivateDetails":       {fn: (*assetContext).setDescriptorPrivateDetails, write: true},
+		"setFieldCommitments":               {fn: (*assetContext).setFieldCommitments, write: true},
+		"verifyFieldCommitment":             {fn: (*assetContext).verifyFieldCommitment},
+		"openAuction":                       {fn: (*assetContext).openAuction, write: true},
+		"placeBid":                          {fn: (*assetContext).placeBid, write: true},
+		"revealBid":                         {fn: (*assetContext).revealBid, write: true},
+		"closeAuction":                      {fn: (*assetContext).closeAuction, write: true},
+		"getAuction":                        {fn: (*assetContext).getAuction},
+		"getLicense":                        {fn: (*assetContext).getLicense},
+		"makeOffer":                         {fn: (*assetContext).makeOffer, write: true},
+		"counterOffer":                      {fn: (*assetContext).counterOffer, write: true},
+		"acceptOffer":                       {fn: (*assetContext).acceptOffer, write: true},
+		"rejectOffer":                       {fn: (*assetContext).rejectOffer, write: true},
+		"getOffer":                          {fn: (*assetContext).getOffer},
+		"setPricingTiers":                   {fn: (*assetContext).setPricingTiers, write: true},
+		"getPricingForDescriptor":           {fn: (*assetContext).getPricingForDescriptor},
+		"recordUsage":                       {fn: (*assetContext).recordUsage, write: true},
+		"generateInvoices":                  {fn: (*assetContext).generateInvoices, write: true, admin: true},
+		"markInvoiceSettled":                {fn: (*assetContext).markInvoiceSettled, write: true},
+		"getInvoice":                        {fn: (*assetContext).getInvoice},
+		"setTokenChaincode":                 {fn: (*assetContext).setTokenChaincode, write: true, admin: true},
+		"setRoyaltySplits":                  {fn: (*assetContext).setRoyaltySplits, write: true},
+		"getRoyaltyStatement":               {fn: (*assetContext).getRoyaltyStatement},
+		"setFeatured":                       {fn: (*assetContext).setFeatured, write: true, admin: true},
+		"unsetFeatured":                     {fn: (*assetContext).unsetFeatured, write: true, admin: true},
+		"getFeaturedDescriptors":            {fn: (*assetContext).getFeaturedDescriptors},
+		"reportActivity":                    {fn: (*assetContext).reportActivity, write: true},
+		"getTrendingDescriptors":            {fn: (*assetContext).getTrendingDescriptors},
+		"rollupActivity":                    {fn: (*assetContext).rollupActivity, write: true, admin: true},
+		"getActivityRollup":                 {fn: (*assetContext).getActivityRollup},
+		"getApiDescriptor":                  {fn: (*assetContext).getApiDescriptor},
+		"checkIntegrity":                    {fn: (*assetContext).checkIntegrity, admin: true},
+		"repairDescriptorBundlePointer":     {fn: (*assetContext).repairDescriptorBundlePointer, write: true, admin: true},
+		"rebuildIndexEntry":                 {fn: (*assetContext).rebuildIndexEntry, write: true, admin: true},
+		"getRepairRecord":                   {fn: (*assetContext).getRepairRecord},
+		"renameDescriptor":                  {fn: (*assetContext).renameDescriptor, write: true},
+		"reassignOwnership":                 {fn: (*assetContext).reassignOwnership, write: true, admin: true},
+		"attachComplianceAttestation":       {fn: (*assetContext).attachComplianceAttestation, write: true},
+		"getAttestationsForBundle":          {fn: (*assetContext).getAttestationsForBundle},
+		"registerScanner":                   {fn: (*assetContext).registerScanner, write: true, admin: true},
+		"setRequiredScanPasses":             {fn: (*assetContext).setRequiredScanPasses, write: true, admin: true},
+		"recordScanResult":                  {fn: (*assetContext).recordScanResult, write: true},
+		"attachSbom":                        {fn: (*assetContext).attachSbom, write: true},
+		"getSbomForBundle":                  {fn: (*assetContext).getSbomForBundle},
+		"findBundlesUsingComponent":         {fn: (*assetContext).findBundlesUsingComponent},
+		"setAllowedArtifactLicenses":        {fn: (*assetContext).setAllowedArtifactLicenses, write: true, admin: true},
+		"approveArtifactLicenseException":   {fn: (*assetContext).approveArtifactLicenseException, write: true, admin: true},
+		"putPolicyRule":                     {fn: (*assetContext).putPolicyRule, write: true, admin: true},
+		"deletePolicyRule":                  {fn: (*assetContext).deletePolicyRule, write: true, admin: true},
+		"getPolicyRules":                    {fn: (*assetContext).getPolicyRules},
+		"setValidationProfile":              {fn: (*assetContext).setValidationProfile, write: true, admin: true},
+		"setFeatureFlag":                    {fn: (*assetContext).setFeatureFlag, write: true, admin: true},
+		"getFeatureFlags":                   {fn: (*assetContext).getFeatureFlags},
+		"getConfig":                         {fn: (*assetContext).getConfig},
+		"updateConfig":                      {fn: (*assetContext).updateConfig, write: true, admin: true},
+		"getConfigHistory":                  {fn: (*assetContext).getConfigHistory},
+		"getBundlesForDescriptorByLanguage": {fn: (*assetContext).getBundlesForDescriptorByLanguage},
+		"getBundlesForDescriptorByPlatform": {fn: (*assetContext).getBundlesForDescriptorByPlatform},
+		"getCompatibleBundles":              {fn: (*assetContext).getCompatibleBundles},
+		"createRollout":                     {fn: (*assetContext).createRollout, write: true},
+		"advanceRollout":                    {fn: (*assetContext).advanceRollout, write: true},
+		"haltRollout":                       {fn: (*assetContext).haltRollout, write: true},
+		"getRollout":                        {fn: (*assetContext).getRollout},
+		"watchDescriptor":                   {fn: (*assetContext).watchDescriptor, write: true},
+		"unwatchDescriptor":                 {fn: (*assetContext).unwatchDescriptor, write: true},
+		"verifyBundleIntegrity":             {fn: (*assetContext).verifyBundleIntegrity},
+		"listQueryFunctions":                {fn: (*assetContext).listQueryFunctions},
+		"exportAssetJSON":                   {fn: (*assetContext).exportAssetJSON},
+		"appointNamespaceAdmin":             {fn: (*assetContext).appointNamespaceAdmin, write: true, admin: true},
+		"revokeNamespaceAdmin":              {fn: (*assetContext).revokeNamespaceAdmin, write: true, admin: true},
+		"reserveDescriptorKey":              {fn: (*assetContext).reserveDescriptorKey, write: true},
+		"getArtifactChunk":                  {fn: (*assetContext).getArtifactChunk},
+		"traced":                            {fn: (*assetContext).traced, wrapper: true},
+		"getFunctionStats":                  {fn: (*assetContext).getFunctionStats},
+		"demoteToCold":                      {fn: (*assetContext).demoteToCold, write: true, admin: true},
+		"issueOwnershipChallenge":           {fn: (*assetContext).issueOwnershipChallenge, write: true},
+		"proveOwnership":                    {fn: (*assetContext).proveOwnership, write: true},
+		"getOwnershipProof":                 {fn: (*assetContext).getOwnershipProof},
+		"associateDescriptorsWithBundles":   {fn: (*assetContext).associateDescriptorsWithBundles, write: true},
+		"placeBundleHold":                   {fn: (*assetContext).placeBundleHold, write: true, admin: true},
+		"releaseBundleHold":                 {fn: (*assetContext).releaseBundleHold, write: true, admin: true},
+		"getBundleGates":                    {fn: (*assetContext).getBundleGates},
+		"recordConsumerCheckpoint":          {fn: (*assetContext).recordConsumerCheckpoint, write: true},
+		"getConsumerCheckpoint":             {fn: (*assetContext).getConsumerCheckpoint},
+		"silenceEventNamespace":             {fn: (*assetContext).silenceEventNamespace, write: true, admin: true},
+		"acquireLock":                       {fn: (*assetContext).acquireLock, write: true},
+		"releaseLock":                       {fn: (*assetContext).releaseLock, write: true},
+		"getLock":                           {fn: (*assetContext).getLock},
+		"getDescriptorWithBundles":          {fn: (*assetContext).getDescriptorWithBundles},
+		"mineOnly":                          {fn: (*assetContext).runMineOnly, wrapper: true},
+		"loadDemoDataset":                   {fn: (*assetContext).loadDemoDataset, write: true, admin: true},
+		"simulateScript":                    {fn: (*assetContext).simulateScript, wrapper: true},
+		"republishBundle":                   {fn: (*assetContext).republishBundle, write: true},
+		"getSignatureChain":                 {fn: (*assetContext).getSignatureChain},
+		"setDescriptorSupport":              {fn: (*assetContext).setDescriptorSupport, write: true},
+		"getReadiness":                      {fn: (*assetContext).getReadiness},
+		"emitDigest":                        {fn: (*assetContext).emitDigest, write: true, admin: true},
+		"getDescriptorAsOf":                 {fn: (*assetContext).getDescriptorAsOf},
+		"compressed":                        {fn: (*assetContext).compressed, wrapper: true},
+		"attachTimestampToken":              {fn: (*assetContext).attachTimestampToken, write: true},
+		"annotateAsset":                     {fn: (*assetContext).annotateAsset, write: true},
+		"getAnnotations":                    {fn: (*assetContext).getAnnotations},
+		"moderateAnnotation":                {fn: (*assetContext).moderateAnnotation, write: true},
+		"getCollectionHash":                 {fn: (*assetContext).getCollectionHash},
+		"setReplicationTrustRoots":          {fn: (*assetContext).setReplicationTrustRoots, write: true, admin: true},
+	}
+}
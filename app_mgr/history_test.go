@@ -0,0 +1,166 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// The MockStub keeps no history, and Fabric 1.x peers return the history of a key oldest first
+// while 2.x peers return it newest first. historyStub serves a fixed history in either order, so
+// the history functions are tested against both.
+
+// historyStub returns histories[key] as the history of key, newest first if reversed is set.
+type historyStub struct {
+	*shim.MockStub
+	args      [][]byte
+	histories map[string][]*queryresult.KeyModification
+	reversed  bool
+}
+
+func (hs *historyStub) GetArgs() [][]byte {
+	return hs.args
+}
+
+func (hs *historyStub) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
+	var keyModifications []*queryresult.KeyModification
+	for _, keyModification := range hs.histories[key] {
+		if hs.reversed {
+			keyModifications = append([]*queryresult.KeyModification{keyModification}, keyModifications...)
+		} else {
+			keyModifications = append(keyModifications, keyModification)
+		}
+	}
+	return &historyIterator{keyModifications: keyModifications}, nil
+}
+
+type historyIterator struct {
+	keyModifications []*queryresult.KeyModification
+}
+
+func (hi *historyIterator) HasNext() bool {
+	return len(hi.keyModifications) > 0
+}
+
+func (hi *historyIterator) Next() (*queryresult.KeyModification, error) {
+	keyModification := hi.keyModifications[0]
+	hi.keyModifications = hi.keyModifications[1:]
+	return keyModification, nil
+}
+
+func (hi *historyIterator) Close() error {
+	return nil
+}
+
+// keyModification returns a modification of a key by tx_id at seconds, writing message, or
+// deleting the key if message is nil.
+func keyModification(t *testing.T, tx_id string, seconds int64, message proto.Message) *queryresult.KeyModification {
+	keyModification := &queryresult.KeyModification{TxId: tx_id, Timestamp: &timestamp.Timestamp{Seconds: seconds}, IsDelete: message == nil}
+	if message != nil {
+		value, err := proto.Marshal(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyModification.Value = value
+	}
+	return keyModification
+}
+
+// newHistoryContext returns an assetContext invoked with args over a historyStub of histories.
+func newHistoryContext(histories map[string][]*queryresult.KeyModification, reversed bool, args ...string) *assetContext {
+	stub := &historyStub{MockStub: shim.NewMockStub("appmgr", new(AssetRegistry)), histories: histories, reversed: reversed}
+	for _, arg := range args {
+		stub.args = append(stub.args, []byte(arg))
+	}
+	system := newSystemStub(stub)
+	return &assetContext{stub: newEncodingStub(system), system: system}
+}
+
+// The ConfigHistory is most recent first, across the legacy key and the current one.
+func TestConfigHistoryOrder(t *testing.T) {
+	stub := shim.NewMockStub("appmgr", new(AssetRegistry))
+	currentKey, err := newSystemStub(stub).CreateCompositeKey(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyKey, err := stub.CreateCompositeKey(COMPOSITE_KEY_CONFIG_OBJECTTYPE, REGISTRY_CONFIG_KEY_PARTS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	histories := map[string][]*queryresult.KeyModification{
+		legacyKey: {
+			keyModification(t, "tx1", 1, &RegistryConfig{Version: 1}),
+			keyModification(t, "tx2", 2, &RegistryConfig{Version: 2}),
+			keyModification(t, "tx3", 3, nil),
+		},
+		currentKey: {
+			keyModification(t, "tx3", 3, &RegistryConfig{Version: 2}),
+			keyModification(t, "tx4", 4, &RegistryConfig{Version: 3}),
+		},
+	}
+
+	for _, reversed := range []bool{false, true} {
+		configHistoryBytes, err := newHistoryContext(histories, reversed, "getConfigHistory").getConfigHistory()
+		if err != nil {
+			t.Fatal(err)
+		}
+		configHistory := &ConfigHistory{}
+		if err := proto.Unmarshal(configHistoryBytes, configHistory); err != nil {
+			t.Fatal(err)
+		}
+		var tx_ids []string
+		for _, entry := range configHistory.Entries {
+			tx_ids = append(tx_ids, entry.TxId)
+		}
+		if got, want := fmt.Sprint(tx_ids), "[tx4 tx3 tx2 tx1]"; got != want {
+			t.Errorf("with the history newest first %v, getConfigHistory lists %s, want %s", reversed, got, want)
+		}
+	}
+}
+
+// getDescriptorAsOf returns the last version written at or before the time asked for.
+func TestDescriptorAsOfOrder(t *testing.T) {
+	compositeKey, err := shim.NewMockStub("appmgr", new(AssetRegistry)).CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{"app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	histories := map[string][]*queryresult.KeyModification{
+		compositeKey: {
+			keyModification(t, "tx1", 10, &AppDescriptor{Description: "first"}),
+			keyModification(t, "tx2", 20, &AppDescriptor{Description: "second"}),
+			keyModification(t, "tx3", 30, nil),
+			keyModification(t, "tx4", 40, &AppDescriptor{Description: "recreated"}),
+		},
+	}
+
+	for _, reversed := range []bool{false, true} {
+		for as_of, want := range map[string]string{"15": "tx1", "25": "tx2", "45": "tx4"} {
+			snapshotBytes, err := newHistoryContext(histories, reversed, "getDescriptorAsOf", "app", as_of).getDescriptorAsOf()
+			if err != nil {
+				t.Fatal(err)
+			}
+			snapshot := &DescriptorSnapshot{}
+			if err := proto.Unmarshal(snapshotBytes, snapshot); err != nil {
+				t.Fatal(err)
+			}
+			if snapshot.TxId != want {
+				t.Errorf("with the history newest first %v, the AppDescriptor as of %s is the one of %s, want %s", reversed, as_of, snapshot.TxId, want)
+			}
+		}
+		for _, as_of := range []string{"5", "35"} {
+			if _, err := newHistoryContext(histories, reversed, "getDescriptorAsOf", "app", as_of).getDescriptorAsOf(); err == nil {
+				t.Errorf("with the history newest first %v, getDescriptorAsOf returned an AppDescriptor as of %s, when it did not exist", reversed, as_of)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/msp"
+)
+
+// certificateFromIdentity extracts the x509 certificate from a serialized MSP identity,
+// such as the creator of a transaction.
+func certificateFromIdentity(serializedIdentity []byte) (*x509.Certificate, error) {
+	sId := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sId); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal serialized identity: %s", err)
+	}
+	block, _ := pem.Decode(sId.IdBytes)
+	if block == nil {
+		return nil, fmt.Errorf("Could not decode PEM certificate of identity from MSP %s", sId.Mspid)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse certificate of identity from MSP %s: %s", sId.Mspid, err)
+	}
+	return cert, nil
+}
+
+// mspIdFromIdentity returns the MSP ID of a serialized MSP identity.
+func mspIdFromIdentity(serializedIdentity []byte) (string, error) {
+	sId := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sId); err != nil {
+		return "", fmt.Errorf("Could not unmarshal serialized identity: %s", err)
+	}
+	if len(sId.Mspid) == 0 {
+		return "", fmt.Errorf("Serialized identity has no MSP ID")
+	}
+	return sId.Mspid, nil
+}
+
+// mspStamped is implemented by the assets that record the organizations submitting their
+// creation and last change, separately from their owner: an owner identity of one org may
+// have its assets changed by a client of another, such as an admin or a relay.
+type mspStamped interface {
+	setUpdatedMspId(mspId string)
+}
+
+func (a *AppDescriptor) setUpdatedMspId(mspId string) { a.UpdatedMspId = mspId }
+func (a *AppBundle) setUpdatedMspId(mspId string)     { a.UpdatedMspId = mspId }
+func (c *Collection) setUpdatedMspId(mspId string)    { c.UpdatedMspId = mspId }
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// verifyIdentitySignature checks that signature is a valid ASN.1 DER encoded ECDSA signature by
+// serializedIdentity over the SHA-256 digest of message.
+func verifyIdentitySignature(serializedIdentity []byte, message []byte, signature []byte) error {
+	cert, err := certificateFromIdentity(serializedIdentity)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("Only ECDSA identities are supported for signature verification")
+	}
+	sig := &ecdsaSignature{}
+	if _, err := asn1.Unmarshal(signature, sig); err != nil {
+		return fmt.Errorf("Could not unmarshal ECDSA signature: %s", err)
+	}
+	digest := sha256.Sum256(message)
+	if !ecdsa.Verify(publicKey, digest[:], sig.R, sig.S) {
+		return fmt.Errorf("Signature verification failed")
+	}
+	return nil
+}
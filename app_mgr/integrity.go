@@ -0,0 +1,239 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// checkIntegrity lets operators verify the references between assets, which the chaincode
+// maintains itself but which migrations, replication or earlier versions of the chaincode may
+// have broken. Like a backfill it walks one namespace (object type) a batch at a time, passing
+// back the returned bookmark until the IntegrityReport is done, but it changes nothing: each
+// batch reports the violations found so the operator can repair them. The checks cover the keys
+// assets refer to, key parts that repeat a field, and fields derived from others such as the
+// owner_id the CouchDB indexes are built on.
+
+// integrityCheck returns the violations of the asset stored under key_parts.
+type integrityCheck func(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error)
+
+var integrityChecks = map[string]integrityCheck{
+	COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE:             checkDescriptorIntegrity,
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:                 checkBundleIntegrity,
+	COMPOSITE_KEY_COLLECTION_OBJECTTYPE:                 checkCollectionIntegrity,
+	COMPOSITE_KEY_PIN_OBJECTTYPE:                        checkPinIntegrity,
+	COMPOSITE_KEY_FEATURED_OBJECTTYPE:                   checkDescriptorKeyIntegrity,
+	COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE:             checkBundleKeyIntegrity,
+	COMPOSITE_KEY_PERMISSION_OBJECTTYPE:                 checkBundleKeyIntegrity,
+	COMPOSITE_KEY_PROMOTION_OBJECTTYPE:                  checkBundleKeyIntegrity,
+	COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE:     checkBundleKeyIntegrity,
+	COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE:                checkBundleKeyIntegrity,
+	COMPOSITE_KEY_SBOM_OBJECTTYPE:                       checkBundleKeyIntegrity,
+	COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE:             checkSbomComponentIntegrity,
+	COMPOSITE_KEY_ARTIFACT_LICENSE_EXCEPTION_OBJECTTYPE: checkDescriptorKeyIntegrity,
+	COMPOSITE_KEY_ROLLOUT_OBJECTTYPE:                    checkBundleKeyIntegrity,
+	COMPOSITE_KEY_WATCH_OBJECTTYPE:                      checkDescriptorKeyIntegrity,
+	COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE:               checkBundleKeyIntegrity,
+}
+
+// integrityChecker collects the violations of one asset.
+type integrityChecker struct {
+	ac         *assetContext
+	key_parts  []string
+	violations []*IntegrityReport_Violation
+	err        error
+}
+
+func (c *integrityChecker) violation(field string, format string, args ...interface{}) {
+	c.violations = append(c.violations, &IntegrityReport_Violation{KeyParts: c.key_parts, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// requireKey records a violation of field if no asset of objectType exists under key_parts.
+func (c *integrityChecker) requireKey(field string, objectType string, key_parts ...string) {
+	if c.err != nil {
+		return
+	}
+	exists, err := c.ac.keyExists(objectType, key_parts)
+	if err != nil {
+		c.err = err
+		return
+	}
+	if !exists {
+		c.violation(field, "refers to %s %v, which does not exist", objectType, key_parts)
+	}
+}
+
+// requireDescriptor records a violation of field if key_part does not refer to an AppDescriptor,
+// directly or through the Alias left by renaming it.
+func (c *integrityChecker) requireDescriptor(field string, key_part string) {
+	if c.err != nil {
+		return
+	}
+	resolved, err := c.ac.resolveDescriptorKey(key_part)
+	if err != nil {
+		c.err = err
+		return
+	}
+	c.requireKey(field, COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, resolved)
+}
+
+// requireOwnerId records a violation if owner_id is not the normalized owner.
+func (c *integrityChecker) requireOwnerId(owner []byte, owner_id string) {
+	if owner_id != normalizeIdentity(owner) {
+		c.violation("owner_id", "is %q, want the normalized owner %s", owner_id, normalizeIdentity(owner))
+	}
+}
+
+// malformed reports an asset that cannot be unmarshaled.
+func malformed(key_parts []string, err error) []*IntegrityReport_Violation {
+	return []*IntegrityReport_Violation{{KeyParts: key_parts, Message: fmt.Sprintf("cannot be unmarshaled: %s", err)}}
+}
+
+func (c *integrityChecker) result() ([]*IntegrityReport_Violation, error) {
+	return c.violations, c.err
+}
+
+func checkDescriptorIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	appDescriptor := &AppDescriptor{}
+	if err := proto.Unmarshal(value, appDescriptor); err != nil {
+		return malformed(key_parts, err), nil
+	}
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	if len(appDescriptor.BundleId) != 0 {
+		c.requireKey("bundle_id", COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts[0], appDescriptor.BundleId)
+	}
+	if len(appDescriptor.ParentDescriptorKey) != 0 {
+		c.requireDescriptor("parent_descriptor_key", appDescriptor.ParentDescriptorKey)
+	}
+	// In environment order, so every endorser reports the violations in the same order
+	var environments []string
+	for environment := range appDescriptor.EnvironmentBundleIds {
+		environments = append(environments, environment)
+	}
+	sort.Strings(environments)
+	for _, environment := range environments {
+		c.requireKey("environment_bundle_ids."+environment, COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts[0], appDescriptor.EnvironmentBundleIds[environment])
+	}
+	c.requireOwnerId(appDescriptor.Owner, appDescriptor.OwnerId)
+	return c.result()
+}
+
+func checkBundleIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	appBundle := &AppBundle{}
+	if err := proto.Unmarshal(value, appBundle); err != nil {
+		return malformed(key_parts, err), nil
+	}
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	if appBundle.DescriptorId != key_parts[0] {
+		c.violation("descriptor_id", "is %q, want the key prefix %q", appBundle.DescriptorId, key_parts[0])
+	}
+	c.requireKey("descriptor_id", COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, key_parts[0])
+	c.requireOwnerId(appBundle.Owner, appBundle.OwnerId)
+	return c.result()
+}
+
+func checkCollectionIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	collection := &Collection{}
+	if err := proto.Unmarshal(value, collection); err != nil {
+		return malformed(key_parts, err), nil
+	}
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	for _, app_descriptor_key_part := range collection.DescriptorKeys {
+		c.requireDescriptor("descriptor_keys", app_descriptor_key_part)
+	}
+	c.requireOwnerId(collection.Owner, collection.OwnerId)
+	return c.result()
+}
+
+// checkPinIntegrity checks a Pin, keyed by the normalized owner and the pinned descriptor.
+func checkPinIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	pin := &Pin{}
+	if err := proto.Unmarshal(value, pin); err != nil {
+		return malformed(key_parts, err), nil
+	}
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	if normalizeIdentity(pin.Owner) != key_parts[0] {
+		c.violation("owner", "is not the identity of the key prefix %s", key_parts[0])
+	}
+	if pin.DescriptorKey != key_parts[1] {
+		c.violation("descriptor_key", "is %q, want the key part %q", pin.DescriptorKey, key_parts[1])
+	}
+	c.requireDescriptor("descriptor_key", key_parts[1])
+	return c.result()
+}
+
+// checkDescriptorKeyIntegrity checks an asset keyed by the descriptor it belongs to.
+func checkDescriptorKeyIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	c.requireKey("key_parts", COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, key_parts[0])
+	return c.result()
+}
+
+// checkBundleKeyIntegrity checks an asset keyed by the descriptor and bundle it belongs to.
+func checkBundleKeyIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	c.requireKey("key_parts", COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_parts[0], key_parts[1])
+	return c.result()
+}
+
+// checkSbomComponentIntegrity checks a component index entry, keyed by package, version,
+// descriptor and bundle.
+func checkSbomComponentIntegrity(ac *assetContext, key_parts []string, value []byte) ([]*IntegrityReport_Violation, error) {
+	c := &integrityChecker{ac: ac, key_parts: key_parts}
+	c.requireKey("key_parts", COMPOSITE_KEY_SBOM_OBJECTTYPE, key_parts[2], key_parts[3])
+	return c.result()
+}
+
+func (ac *assetContext) checkIntegrity() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 3:
+		bookmark = string(args[2])
+		fallthrough
+	case 2:
+		namespace = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to checkIntegrity")
+	}
+
+	check, ok := integrityChecks[namespace]
+	if !ok {
+		return nil, fmt.Errorf("Error in checkIntegrity: namespace %s has no integrity checks", namespace)
+	}
+
+	integrityReport := &IntegrityReport{Namespace: namespace}
+	bookmark, done, err := ac.scanBatch(namespace, []string{}, bookmark, MIGRATION_BATCH_SIZE, func(compositeKey string, value []byte) error {
+		_, key_parts, err := ac.stub.SplitCompositeKey(compositeKey)
+		if err != nil {
+			return fmt.Errorf("Error splitting composite key %s: %s", compositeKey, err)
+		}
+		violations, err := check(ac, key_parts, value)
+		if err != nil {
+			return fmt.Errorf("Cannot check %s %v: %s", namespace, key_parts, err)
+		}
+		integrityReport.Violations = append(integrityReport.Violations, violations...)
+		integrityReport.CheckedCount++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in checkIntegrity: %s", err)
+	}
+	integrityReport.Bookmark = bookmark
+	integrityReport.Done = done
+
+	integrityReportBytes, err := proto.Marshal(integrityReport)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling IntegrityReport in checkIntegrity: %s", err)
+	}
+	return integrityReportBytes, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Licenses are keyed by descriptor and normalized licensee, so one descriptor may carry
+// several non-exclusive Licenses or a single exclusive one. Auctions and Offers both grant
+// through grantLicense, which enforces that rule.
+
+// checkLicenseGrantable fails if a License for the descriptor would conflict with the
+// Licenses already granted for it.
+func (ac *assetContext) checkLicenseGrantable(app_descriptor_key_part string, exclusive bool) error {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_LICENSE_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return err
+	}
+	defer stateQueryIterator.Close()
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return err
+		}
+		license := &License{}
+		if err := proto.Unmarshal(kv.Value, license); err != nil {
+			return fmt.Errorf("Cannot unmarshal License %s: %s", kv.Key, err)
+		}
+		if license.Exclusive {
+			return fmt.Errorf("AppDescriptor %s is exclusively licensed", app_descriptor_key_part)
+		}
+		if exclusive {
+			return fmt.Errorf("AppDescriptor %s already has Licenses and cannot be licensed exclusively", app_descriptor_key_part)
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) grantLicense(license *License) error {
+	license.LicenseeId = normalizeIdentity(license.Licensee)
+	exists, err := ac.keyExists(COMPOSITE_KEY_LICENSE_OBJECTTYPE, []string{license.DescriptorId, license.LicenseeId})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("Licensee already holds a License for AppDescriptor %s", license.DescriptorId)
+	}
+	if err := ac.checkLicenseGrantable(license.DescriptorId, license.Exclusive); err != nil {
+		return err
+	}
+	_, err = ac.putAsset(COMPOSITE_KEY_LICENSE_OBJECTTYPE, []string{license.DescriptorId, license.LicenseeId}, license)
+	return err
+}
+
+func (ac *assetContext) GetLicense(request *GetLicenseRequest) (*License, error) {
+	app_descriptor_key_part := request.AppDescriptorKey
+	licensee_id := request.LicenseeId
+	if len(licensee_id) == 0 {
+		licensee_id = ac.identity
+	}
+
+	license := &License{}
+	found, err := ac.getAsset(COMPOSITE_KEY_LICENSE_OBJECTTYPE, []string{app_descriptor_key_part, licensee_id}, license)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getLicense: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getLicense, no License for AppDescriptor %s and licensee %s", app_descriptor_key_part, licensee_id)
+	}
+	return license, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Flows that span several transactions, such as migrations and backfills, which run a batch
+// per transaction, hold a Lock on a resource so no other identity interleaves its own run with
+// theirs. Clients coordinating work outside the registry, e.g. a build farm re-signing the
+// bundles of a descriptor, use the same Locks through acquireLock and releaseLock. A Lock lasts
+// for a ttl of at most MAX_LOCK_SECONDS and is renewed by acquiring it again; expiry is
+// measured against transaction timestamps, so a holder that dies simply lets its Lock lapse.
+// Resources starting with INTERNAL_LOCK_PREFIX are locked only by the registry's own flows,
+// and their Locks are held by the identity running the flow. Admins may release any Lock.
+
+const MAX_LOCK_SECONDS = 24 * 60 * 60
+
+// MAX_LOCK_RESOURCE_LENGTH bounds the names of locked resources.
+const MAX_LOCK_RESOURCE_LENGTH = 256
+
+// INTERNAL_LOCK_PREFIX begins the resources locked by the registry's own flows.
+const INTERNAL_LOCK_PREFIX = "registry:"
+
+// FLOW_LOCK_SECONDS is the ttl of the Locks of the registry's own flows, renewed by every batch.
+const FLOW_LOCK_SECONDS = 15 * 60
+
+// MIGRATION_LOCK_RESOURCE is locked while continueMigration runs the pending migrations.
+const MIGRATION_LOCK_RESOURCE = INTERNAL_LOCK_PREFIX + "migration"
+
+// backfillLockResource returns the resource locked while field is backfilled in namespace.
+func backfillLockResource(field string, namespace string) string {
+	return INTERNAL_LOCK_PREFIX + "backfill:" + field + ":" + namespace
+}
+
+// readLock returns the Lock of resource, and whether it is active.
+func (ac *assetContext) readLock(resource string) (*Lock, bool, error) {
+	lock := &Lock{}
+	found, err := ac.getAsset(COMPOSITE_KEY_LOCK_OBJECTTYPE, []string{resource}, lock)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, false, err
+	}
+	return lock, now < lock.ExpiresAt, nil
+}
+
+// holdLock acquires the Lock of resource for the caller for ttl seconds, or renews it, unless
+// another identity holds it.
+func (ac *assetContext) holdLock(resource string, ttl int64) (*Lock, error) {
+	lock, active, err := ac.readLock(resource)
+	if err != nil {
+		return nil, err
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	if active && !bytes.Equal(lock.Holder, ac.creator) {
+		return nil, fmt.Errorf("%s is locked by %s until %d", resource, lock.HolderId, lock.ExpiresAt)
+	}
+	if !active {
+		lock = &Lock{Resource: resource, Holder: ac.creator, HolderId: ac.identity, AcquiredAt: now}
+	}
+	lock.ExpiresAt = now + ttl
+	if _, err := ac.putAsset(COMPOSITE_KEY_LOCK_OBJECTTYPE, []string{resource}, lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// dropLock removes the Lock of resource.
+func (ac *assetContext) dropLock(resource string) error {
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_LOCK_OBJECTTYPE, []string{resource})
+	if err != nil {
+		return err
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return fmt.Errorf("could not delete the Lock of %s: %s", resource, err)
+	}
+	return nil
+}
+
+func validateLockResource(resource string) error {
+	if len(resource) == 0 || len(resource) > MAX_LOCK_RESOURCE_LENGTH {
+		return fmt.Errorf("the resource must be between 1 and %d bytes", MAX_LOCK_RESOURCE_LENGTH)
+	}
+	return nil
+}
+
+func (ac *assetContext) AcquireLock(request *AcquireLockRequest) (*Lock, error) {
+	resource := request.Resource
+
+	if request.TtlSeconds <= 0 || request.TtlSeconds > MAX_LOCK_SECONDS {
+		return nil, fmt.Errorf("Error in acquireLock, ttl must be between 1 and %d seconds", MAX_LOCK_SECONDS)
+	}
+	if err := validateLockResource(resource); err != nil {
+		return nil, fmt.Errorf("Error in acquireLock: %s", err)
+	}
+	if strings.HasPrefix(resource, INTERNAL_LOCK_PREFIX) {
+		return nil, fmt.Errorf("Error in acquireLock: %s is locked only by the registry", resource)
+	}
+
+	lock, err := ac.holdLock(resource, request.TtlSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("Error in acquireLock: %s", err)
+	}
+	return lock, nil
+}
+
+func (ac *assetContext) ReleaseLock(request *LockRequest) (*Empty, error) {
+	resource := request.Resource
+
+	lock, active, err := ac.readLock(resource)
+	if err != nil {
+		return nil, fmt.Errorf("Error in releaseLock: %s", err)
+	}
+	if !active {
+		return nil, fmt.Errorf("Error in releaseLock: %s is not locked", resource)
+	}
+	if !bytes.Equal(lock.Holder, ac.creator) {
+		admin, err := ac.isAdmin()
+		if err != nil {
+			return nil, fmt.Errorf("Error in releaseLock: %s", err)
+		}
+		if !admin {
+			return nil, fmt.Errorf("Error in releaseLock: only the holder or an admin may release the Lock of %s", resource)
+		}
+	}
+	if err := ac.dropLock(resource); err != nil {
+		return nil, fmt.Errorf("Error in releaseLock: %s", err)
+	}
+	return &Empty{}, nil
+}
+
+func (ac *assetContext) GetLock(request *LockRequest) (*Lock, error) {
+	resource := request.Resource
+
+	lock, active, err := ac.readLock(resource)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getLock: %s", err)
+	}
+	if !active {
+		return nil, fmt.Errorf("Error in getLock: %s is not locked", resource)
+	}
+	return lock, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Data migrations upgrade stored assets when a new chaincode version changes how they are
+// stored. Registries deployed before migrations existed are at schema version 1. Each
+// migrationStep upgrades from one version to the next and processes at most a batch of keys
+// per call, so Init (on upgrade) and continueMigration can each make bounded progress; the
+// MigrationState records how far the pending step got so the migration can be resumed.
+// Admins should put the registry in MAINTENANCE mode while a migration is pending.
+
+var MIGRATION_STATE_KEY_PARTS = []string{"migration"}
+
+// The number of keys a single Init or continueMigration call migrates.
+const MIGRATION_BATCH_SIZE = 100
+
+type migrationStep struct {
+	description string
+	// run migrates up to batchSize keys after bookmark, returning the new bookmark and
+	// whether the step is complete.
+	run func(ac *assetContext, bookmark string, batchSize int) (string, bool, error)
+}
+
+// migrations[i] upgrades schema version i+1 to i+2.
+var migrations = []migrationStep{
+	{
+		description: "Label the PROD association of descriptors associated before promotions existed",
+		run:         migrateProdEnvironmentLabels,
+	},
+	{
+		description: "Move the registry's own records under the SYSTEM prefix",
+		run:         migrateSystemRecords,
+	},
+}
+
+// CURRENT_SCHEMA_VERSION is the schema version written by this chaincode.
+var CURRENT_SCHEMA_VERSION = uint32(len(migrations) + 1)
+
+func migrateProdEnvironmentLabels(ac *assetContext, bookmark string, batchSize int) (string, bool, error) {
+	return ac.scanBatch(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{}, bookmark, batchSize, func(compositeKey string, value []byte) error {
+		appDescriptor := &AppDescriptor{}
+		if err := proto.Unmarshal(value, appDescriptor); err != nil {
+			return fmt.Errorf("Cannot unmarshal AppDescriptor %s: %s", compositeKey, err)
+		}
+		if len(appDescriptor.BundleId) == 0 || len(appDescriptor.EnvironmentBundleIds) != 0 {
+			return nil
+		}
+		appDescriptor.EnvironmentBundleIds = map[string]string{Promotion_PROD.String(): appDescriptor.BundleId}
+		appDescriptorBytes, err := marshalDeterministic(appDescriptor)
+		if err != nil {
+			return fmt.Errorf("Error marshaling proto: %s", err)
+		}
+		return ac.stub.PutState(compositeKey, appDescriptorBytes)
+	})
+}
+
+func (ac *assetContext) getMigrationStateAsset() (*MigrationState, error) {
+	migrationState := &MigrationState{}
+	found, err := ac.getAsset(COMPOSITE_KEY_CONFIG_OBJECTTYPE, MIGRATION_STATE_KEY_PARTS, migrationState)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get MigrationState: %s", err)
+	}
+	if !found {
+		migrationState.SchemaVersion = 1
+	}
+	return migrationState, nil
+}
+
+// runMigrations migrates up to batchSize keys per pending step and records the progress made.
+func (ac *assetContext) runMigrations(batchSize int) (*MigrationState, error) {
+	migrationState, err := ac.getMigrationStateAsset()
+	if err != nil {
+		return nil, err
+	}
+
+	for migrationState.SchemaVersion < CURRENT_SCHEMA_VERSION && batchSize > 0 {
+		step := migrations[migrationState.SchemaVersion-1]
+		fmt.Printf("Running migration from schema version %d: %s\n", migrationState.SchemaVersion, step.description)
+		bookmark, done, err := step.run(ac, migrationState.Bookmark, batchSize)
+		if err != nil {
+			return nil, fmt.Errorf("Migration from schema version %d failed: %s", migrationState.SchemaVersion, err)
+		}
+		if !done {
+			migrationState.Bookmark = bookmark
+			break
+		}
+		migrationState.SchemaVersion++
+		migrationState.Bookmark = ""
+	}
+
+	if _, err := ac.putAsset(COMPOSITE_KEY_CONFIG_OBJECTTYPE, MIGRATION_STATE_KEY_PARTS, migrationState); err != nil {
+		return nil, err
+	}
+	return migrationState, nil
+}
+
+// migrateOnInit runs a batch of pending migrations from Init.
+func migrateOnInit(system *systemStub) error {
+	creator, err := system.GetCreator()
+	if err != nil {
+		return fmt.Errorf("Could not get creator: %s", err)
+	}
+	ac := &assetContext{stub: newEncodingStub(system), creator: creator, identity: normalizeIdentity(creator), function: "Init", system: system}
+	migrationState, err := ac.runMigrations(MIGRATION_BATCH_SIZE)
+	if err != nil {
+		return fmt.Errorf("Error in Init: %s", err)
+	}
+	if migrationState.SchemaVersion < CURRENT_SCHEMA_VERSION {
+		fmt.Printf("Migration to schema version %d is incomplete, call continueMigration to resume\n", CURRENT_SCHEMA_VERSION)
+	}
+	return nil
+}
+
+func (ac *assetContext) continueMigration() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to continueMigration")
+	}
+
+	// The batches of one admin are not interleaved with another's
+	if _, err := ac.holdLock(MIGRATION_LOCK_RESOURCE, FLOW_LOCK_SECONDS); err != nil {
+		return nil, fmt.Errorf("Error in continueMigration: %s", err)
+	}
+	migrationState, err := ac.runMigrations(MIGRATION_BATCH_SIZE)
+	if err != nil {
+		return nil, fmt.Errorf("Error in continueMigration: %s", err)
+	}
+	if migrationState.SchemaVersion == CURRENT_SCHEMA_VERSION {
+		if err := ac.dropLock(MIGRATION_LOCK_RESOURCE); err != nil {
+			return nil, fmt.Errorf("Error in continueMigration: %s", err)
+		}
+	}
+	migrationStateBytes, err := proto.Marshal(migrationState)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling MigrationState in continueMigration: %s", err)
+	}
+	return migrationStateBytes, nil
+}
+
+func (ac *assetContext) getMigrationState() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getMigrationState")
+	}
+
+	migrationState, err := ac.getMigrationStateAsset()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getMigrationState: %s", err)
+	}
+	migrationStateBytes, err := proto.Marshal(migrationState)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling MigrationState in getMigrationState: %s", err)
+	}
+	return migrationStateBytes, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Listing queries, the key scans of ac.query and the rich queries, return every asset of the
+// catalog by default. A caller running one through mineOnly gets only the assets it owns, by
+// the owner_id the owner index is built on, and the AppBundles it has been granted access to.
+// Multi-tenant deployments turn on the strict_acls FeatureFlag so members do not see each
+// other's catalog: every listing of a caller other than an admin is then mine-only. Filtered
+// entries count toward neither the query limits nor the bookmark, so a page may be short
+// while more results follow. Point reads and admin-curated lists such as the featured
+// descriptors are not filtered.
+
+// listsMineOnly reports whether the listing queries of the invocation are mine-only.
+func (ac *assetContext) listsMineOnly() (bool, error) {
+	if ac.mineOnly {
+		return true, nil
+	}
+	if !ac.featureFlags.GetStrictAcls() {
+		return false, nil
+	}
+	admin, err := ac.isAdmin()
+	if err != nil {
+		return false, err
+	}
+	return !admin, nil
+}
+
+// isMine reports whether the caller owns asset, stored under key_parts of objectType, or has
+// been granted access to it. Assets of the types without an owner_id are always listed.
+func (ac *assetContext) isMine(objectType string, key_parts []string, asset proto.Message) (bool, error) {
+	identified, ok := asset.(ownerIdentified)
+	if !ok {
+		return true, nil
+	}
+	if identified.GetOwnerId() == ac.identity {
+		return true, nil
+	}
+	// owner_id is empty on assets written before it was backfilled
+	if owned, ok := asset.(ownedAsset); ok && len(identified.GetOwnerId()) == 0 && bytes.Equal(owned.GetOwner(), ac.creator) {
+		return true, nil
+	}
+	if objectType != COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE || len(key_parts) != 2 {
+		return false, nil
+	}
+	return ac.getAsset(COMPOSITE_KEY_PERMISSION_OBJECTTYPE, []string{key_parts[0], key_parts[1], ac.identity}, &Permission{})
+}
+
+// isStoredMine is isMine for the stored value of a listed key.
+func (ac *assetContext) isStoredMine(objectType string, key_parts []string, value []byte) (bool, error) {
+	newAsset, ok := jsonDocumentTypes[objectType]
+	if !ok {
+		return true, nil
+	}
+	decoded, err := decodeStored(objectType, value)
+	if err != nil {
+		return false, err
+	}
+	asset := newAsset()
+	if err := proto.Unmarshal(decoded, asset); err != nil {
+		return false, fmt.Errorf("Cannot unmarshal %s (%v): %s", objectType, key_parts, err)
+	}
+	return ac.isMine(objectType, key_parts, asset)
+}
+
+// runMineOnly runs the query function named by its second argument with the remaining
+// arguments, listing only the caller's assets.
+func (ac *assetContext) runMineOnly() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("Wrong number of arguments to mineOnly")
+	}
+	function := string(args[1])
+	h, ok := handlers[function]
+	if !ok {
+		return nil, fmt.Errorf("Error in mineOnly, unknown function %s", function)
+	}
+	if !h.isQuery() {
+		return nil, fmt.Errorf("Error in mineOnly, %s is not a query function", function)
+	}
+
+	inner := *ac
+	inner.stub = newOverlayStub(ac.stub, args[1:])
+	inner.function = function
+	inner.mineOnly = true
+	return inner.dispatch()
+}
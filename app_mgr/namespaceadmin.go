@@ -0,0 +1,166 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// The registry admins may appoint namespace admins, who administer a single namespace of the
+// registry, the assets of one object type. A namespace admin may call the admin functions that
+// act on one namespace, such as its ValidationProfile, its integrity checks and repairs, and
+// the approvals for its assets, but only for the namespace they were appointed to; every other
+// admin function, including appointing namespace admins, remains with the registry admins.
+// The appointments are kept in the RegistryConfig and checked by the admin middleware of
+// dispatch, so the handlers need no changes to be delegated.
+
+// namespaceAdminFunctions maps the admin functions namespace admins may call to the namespace
+// an invocation, given its arguments, administers.
+var namespaceAdminFunctions = map[string]func(args [][]byte) string{
+	"setValidationProfile":            namespaceArg(1),
+	"checkIntegrity":                  namespaceArg(1),
+	"rebuildIndexEntry":               namespaceArg(1),
+	"backfill":                        namespaceArg(2),
+	"repairDescriptorBundlePointer":   fixedNamespace(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE),
+	"approveArtifactLicenseException": fixedNamespace(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE),
+	"demoteToCold":                    fixedNamespace(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE),
+	"placeBundleHold":                 fixedNamespace(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE),
+	"releaseBundleHold":               fixedNamespace(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE),
+	"silenceEventNamespace":           namespaceArg(1),
+}
+
+// namespaceArg returns the namespace passed as args[i].
+func namespaceArg(i int) func(args [][]byte) string {
+	return func(args [][]byte) string {
+		if len(args) <= i {
+			return ""
+		}
+		return string(args[i])
+	}
+}
+
+func fixedNamespace(namespace string) func(args [][]byte) string {
+	return func(args [][]byte) string { return namespace }
+}
+
+// isNamespaceAdmin reports whether the caller is a namespace admin of the namespace the invoked
+// function administers.
+func (ac *assetContext) isNamespaceAdmin() (bool, error) {
+	namespaceOf, ok := namespaceAdminFunctions[ac.function]
+	if !ok {
+		return false, nil
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return false, err
+	}
+	for _, admin := range registryConfig.NamespaceAdmins[namespaceOf(ac.stub.GetArgs())].GetAdmins() {
+		if bytes.Equal(admin, ac.creator) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateNamespaceAdmins checks the namespace_admins of a RegistryConfig.
+func validateNamespaceAdmins(namespaceAdmins map[string]*RegistryConfig_NamespaceAdmins) error {
+	var namespaces []string
+	for namespace := range namespaceAdmins {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		admins := namespaceAdmins[namespace]
+		if _, ok := Query_ObjectType_value[namespace]; !ok || systemObjectTypes[namespace] {
+			return fmt.Errorf("namespace_admins: %s is not a namespace", namespace)
+		}
+		if len(admins.GetAdmins()) == 0 {
+			return fmt.Errorf("namespace_admins: namespace %s has no admins", namespace)
+		}
+		for i, admin := range admins.Admins {
+			if _, err := mspIdFromIdentity(admin); err != nil {
+				return fmt.Errorf("namespace_admins: %s admins[%d] is not a serialized identity: %s", namespace, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// namespaceAdminArgs parses the <namespace>, <identity> arguments of function.
+func (ac *assetContext) namespaceAdminArgs(function string) (string, []byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	var identity []byte
+
+	switch len(args) {
+	case 3:
+		namespace = string(args[1])
+		identity = args[2]
+	default:
+		return "", nil, fmt.Errorf("Wrong number of arguments to %s", function)
+	}
+	if _, ok := Query_ObjectType_value[namespace]; !ok || systemObjectTypes[namespace] {
+		return "", nil, fmt.Errorf("Error in %s, %s is not a namespace", function, namespace)
+	}
+	if _, err := mspIdFromIdentity(identity); err != nil {
+		return "", nil, fmt.Errorf("Error in %s, identity must be a serialized identity: %s", function, err)
+	}
+	return namespace, identity, nil
+}
+
+func (ac *assetContext) appointNamespaceAdmin() ([]byte, error) {
+	namespace, identity, err := ac.namespaceAdminArgs("appointNamespaceAdmin")
+	if err != nil {
+		return nil, err
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in appointNamespaceAdmin: %s", err)
+	}
+	if registryConfig.NamespaceAdmins == nil {
+		registryConfig.NamespaceAdmins = make(map[string]*RegistryConfig_NamespaceAdmins)
+	}
+	namespaceAdmins := registryConfig.NamespaceAdmins[namespace]
+	if namespaceAdmins == nil {
+		namespaceAdmins = &RegistryConfig_NamespaceAdmins{}
+		registryConfig.NamespaceAdmins[namespace] = namespaceAdmins
+	}
+	for _, admin := range namespaceAdmins.Admins {
+		if bytes.Equal(admin, identity) {
+			return nil, fmt.Errorf("Error in appointNamespaceAdmin: the identity is already an admin of namespace %s", namespace)
+		}
+	}
+	namespaceAdmins.Admins = append(namespaceAdmins.Admins, identity)
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) revokeNamespaceAdmin() ([]byte, error) {
+	namespace, identity, err := ac.namespaceAdminArgs("revokeNamespaceAdmin")
+	if err != nil {
+		return nil, err
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in revokeNamespaceAdmin: %s", err)
+	}
+	namespaceAdmins := registryConfig.NamespaceAdmins[namespace]
+	for i, admin := range namespaceAdmins.GetAdmins() {
+		if !bytes.Equal(admin, identity) {
+			continue
+		}
+		namespaceAdmins.Admins = append(namespaceAdmins.Admins[:i], namespaceAdmins.Admins[i+1:]...)
+		if len(namespaceAdmins.Admins) == 0 {
+			delete(registryConfig.NamespaceAdmins, namespace)
+		}
+		return ac.putRegistryConfig(registryConfig)
+	}
+	return nil, fmt.Errorf("Error in revokeNamespaceAdmin: the identity is not an admin of namespace %s", namespace)
+}
@@ -0,0 +1,194 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Offers let a consumer negotiate License terms directly with the publisher (the owner) of
+// an AppDescriptor. The consumer opens with makeOffer, after which the parties take turns:
+// the awaited party may counter with new terms, which passes the turn to the other party,
+// or accept, which grants the License in the same transaction. Either party may reject an
+// open Offer.
+
+func (ac *assetContext) getOfferAsset(offer_key_part string) (*Offer, error) {
+	offer := &Offer{}
+	found, err := ac.getAsset(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part}, offer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Offer not found for key_part %s", offer_key_part)
+	}
+	return offer, nil
+}
+
+// checkOfferTurn fails unless the Offer is open and the caller is the awaited party.
+func (ac *assetContext) checkOfferTurn(offer *Offer, offer_key_part string) error {
+	if offer.Status != Offer_OPEN {
+		return fmt.Errorf("Offer %s is %s", offer_key_part, offer.Status)
+	}
+	awaited := offer.Publisher
+	if offer.Awaiting == Offer_CONSUMER {
+		awaited = offer.Consumer
+	}
+	if !bytes.Equal(awaited, ac.creator) {
+		return fmt.Errorf("Offer %s is awaiting the %s", offer_key_part, offer.Awaiting)
+	}
+	return nil
+}
+
+func (ac *assetContext) MakeOffer(request *MakeOfferRequest) (*Offer, error) {
+	offer_key_part := request.OfferKey
+	app_descriptor_key_part := request.AppDescriptorKey
+	amount := request.Amount
+	exclusive := request.Exclusive
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in makeOffer: %s", err)
+	}
+	if bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Error in makeOffer, the owner of AppDescriptor %s cannot make an Offer for it", app_descriptor_key_part)
+	}
+	if err := ac.checkLicenseGrantable(app_descriptor_key_part, exclusive); err != nil {
+		return nil, fmt.Errorf("Error in makeOffer: %s", err)
+	}
+	exists, err := ac.keyExists(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in makeOffer: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Cannot make an Offer whose key_part already exists")
+	}
+
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in makeOffer: %s", err)
+	}
+	offer := &Offer{
+		DescriptorId: app_descriptor_key_part,
+		Consumer:     ac.creator,
+		Publisher:    appDescriptor.Owner,
+		Status:       Offer_OPEN,
+		Awaiting:     Offer_PUBLISHER,
+		Amount:       amount,
+		Exclusive:    exclusive,
+		Round:        1,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part}, offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (ac *assetContext) CounterOffer(request *CounterOfferRequest) (*Offer, error) {
+	offer_key_part := request.OfferKey
+
+	offer, err := ac.getOfferAsset(offer_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in counterOffer: %s", err)
+	}
+	if err := ac.checkOfferTurn(offer, offer_key_part); err != nil {
+		return nil, fmt.Errorf("Error in counterOffer: %s", err)
+	}
+	if offer.UpdatedAt, err = ac.txTimestamp(); err != nil {
+		return nil, fmt.Errorf("Error in counterOffer: %s", err)
+	}
+	offer.Amount = request.Amount
+	offer.Exclusive = request.Exclusive
+	offer.Round++
+	if offer.Awaiting == Offer_PUBLISHER {
+		offer.Awaiting = Offer_CONSUMER
+	} else {
+		offer.Awaiting = Offer_PUBLISHER
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part}, offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (ac *assetContext) AcceptOffer(request *OfferRequest) (*Offer, error) {
+	offer_key_part := request.OfferKey
+
+	offer, err := ac.getOfferAsset(offer_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+	if err := ac.checkOfferTurn(offer, offer_key_part); err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+
+	// An Offer made before its descriptor was renamed holds an Alias
+	if offer.DescriptorId, err = ac.resolveDescriptorKey(offer.DescriptorId); err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+	// The publisher agreed to these terms as owner, so the ownership must not have moved since
+	appDescriptor, err := ac.getDescriptor(offer.DescriptorId)
+	if err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, offer.Publisher) {
+		return nil, fmt.Errorf("Error in acceptOffer, ownership of AppDescriptor %s has changed", offer.DescriptorId)
+	}
+
+	if offer.UpdatedAt, err = ac.txTimestamp(); err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+	offer.Status = Offer_ACCEPTED
+	license := &License{
+		DescriptorId: offer.DescriptorId,
+		Licensee:     offer.Consumer,
+		Exclusive:    offer.Exclusive,
+		OfferId:      offer_key_part,
+		Amount:       offer.Amount,
+		GrantedAt:    offer.UpdatedAt,
+	}
+	if err := ac.grantLicense(license); err != nil {
+		return nil, fmt.Errorf("Error in acceptOffer: %s", err)
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part}, offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (ac *assetContext) RejectOffer(request *OfferRequest) (*Offer, error) {
+	offer_key_part := request.OfferKey
+
+	offer, err := ac.getOfferAsset(offer_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rejectOffer: %s", err)
+	}
+	if offer.Status != Offer_OPEN {
+		return nil, fmt.Errorf("Error in rejectOffer, Offer %s is %s", offer_key_part, offer.Status)
+	}
+	if !bytes.Equal(offer.Consumer, ac.creator) && !bytes.Equal(offer.Publisher, ac.creator) {
+		return nil, fmt.Errorf("Error in rejectOffer, only the parties to Offer %s may reject it", offer_key_part)
+	}
+	if offer.UpdatedAt, err = ac.txTimestamp(); err != nil {
+		return nil, fmt.Errorf("Error in rejectOffer: %s", err)
+	}
+	offer.Status = Offer_REJECTED
+	if _, err := ac.putAsset(COMPOSITE_KEY_OFFER_OBJECTTYPE, []string{offer_key_part}, offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (ac *assetContext) GetOffer(request *OfferRequest) (*Offer, error) {
+	offer, err := ac.getOfferAsset(request.OfferKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getOffer: %s", err)
+	}
+	return offer, nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The listings promise their entries in composite key order, the order bookmarks page through.
+// These tests create assets out of order and page through the listings one or two entries at a
+// time, so a listing that returned its entries in any other order, e.g. map order, would fail.
+
+// orderingKeys are created in this order; composite key order sorts them as strings.
+var orderingKeys = []string{"d3", "d1", "d10", "d2", "d20"}
+
+func sortedOrderingKeys() []string {
+	sorted := append([]string{}, orderingKeys...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func checkOrder(t *testing.T, listing string, got []string) {
+	want := sortedOrderingKeys()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("%s returned %v, want %v", listing, got, want)
+	}
+}
+
+func TestAppDescriptorsOrder(t *testing.T) {
+	s := newTestRegistry(t)
+	for _, key := range orderingKeys {
+		s.mustInvoke(t, "createAppDescriptor", key, &AppDescriptor{Description: key})
+	}
+
+	for _, max_results := range []string{"1", "2", "100"} {
+		s.mustInvoke(t, "setQueryLimits", max_results, "0")
+		var keys []string
+		bookmark := ""
+		for page := 0; ; page++ {
+			if page > len(orderingKeys) {
+				t.Fatalf("getAppDescriptors did not finish paging")
+			}
+			appDescriptors := &AppDescriptors{}
+			if err := proto.Unmarshal(s.mustInvoke(t, "getAppDescriptors", bookmark), appDescriptors); err != nil {
+				t.Fatal(err)
+			}
+			for _, entry := range appDescriptors.Descriptors {
+				if entry.AppDescriptor.Description != entry.Key {
+					t.Fatalf("entry %s holds the AppDescriptor of %s", entry.Key, entry.AppDescriptor.Description)
+				}
+				keys = append(keys, entry.Key)
+			}
+			if !appDescriptors.HasMore {
+				break
+			}
+			bookmark = appDescriptors.Bookmark
+		}
+		checkOrder(t, "getAppDescriptors with max_results "+max_results, keys)
+	}
+}
+
+func TestAppBundleKeySetOrder(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "createAppDescriptor", "descriptor", &AppDescriptor{Description: "bundles"})
+	for _, key := range orderingKeys {
+		s.mustInvoke(t, "createAppBundle", key, &AppBundle{DescriptorId: "descriptor", Artifacts: [][]byte{[]byte(key)}})
+	}
+
+	for _, max_results := range []string{"1", "2", "100"} {
+		s.mustInvoke(t, "setQueryLimits", max_results, "0")
+		var keys []string
+		bookmark := ""
+		for page := 0; ; page++ {
+			if page > len(orderingKeys) {
+				t.Fatalf("getAppBundleKeySetForDescriptor did not finish paging")
+			}
+			appBundleKeySet := &AppBundleKeySet{}
+			if err := proto.Unmarshal(s.mustInvoke(t, "getAppBundleKeySetForDescriptor", "descriptor", bookmark), appBundleKeySet); err != nil {
+				t.Fatal(err)
+			}
+			if len(appBundleKeySet.BundleHashes) != len(appBundleKeySet.BundleKeys) {
+				t.Fatalf("got %d bundle hashes for %d bundle keys", len(appBundleKeySet.BundleHashes), len(appBundleKeySet.BundleKeys))
+			}
+			keys = append(keys, appBundleKeySet.BundleKeys...)
+			if !appBundleKeySet.HasMore {
+				break
+			}
+			bookmark = appBundleKeySet.Bookmark
+		}
+		checkOrder(t, "getAppBundleKeySetForDescriptor with max_results "+max_results, keys)
+	}
+}
+
+func TestAnnotationsOrder(t *testing.T) {
+	s := newTestRegistry(t)
+	admin := s.Creator
+	s.mustInvoke(t, "createAppDescriptor", "descriptor", &AppDescriptor{Description: "annotated"})
+	s.Creator = testIdentity(t, "annotator")
+	payload_hash := sha256.Sum256([]byte("payload"))
+	for _, key := range orderingKeys {
+		s.mustInvoke(t, "annotateAsset", "APP_DESCRIPTOR", "descriptor", key, payload_hash[:])
+	}
+
+	for _, max_results := range []string{"1", "2", "100"} {
+		s.Creator = admin
+		s.mustInvoke(t, "setQueryLimits", max_results, "0")
+		s.Creator = testIdentity(t, "annotator")
+		var keys []string
+		bookmark := ""
+		for page := 0; ; page++ {
+			if page > len(orderingKeys) {
+				t.Fatalf("getAnnotations did not finish paging")
+			}
+			annotations := &Annotations{}
+			if err := proto.Unmarshal(s.mustInvoke(t, "getAnnotations", "APP_DESCRIPTOR", "descriptor", bookmark), annotations); err != nil {
+				t.Fatal(err)
+			}
+			for _, annotation := range annotations.Annotations {
+				keys = append(keys, annotation.Type)
+			}
+			if !annotations.HasMore {
+				break
+			}
+			bookmark = annotations.Bookmark
+		}
+		checkOrder(t, "getAnnotations with max_results "+max_results, keys)
+	}
+}
+
+// Each page of a RoyaltyStatement carries the totals of the whole period.
+func TestRoyaltyStatementOrder(t *testing.T) {
+	s := newTestRegistry(t)
+	publisher := testIdentity(t, "publisher")
+	system := newSystemStub(s)
+	ac := &assetContext{stub: newEncodingStub(system), system: system}
+	s.MockTransactionStart("settle")
+	for i, key := range orderingKeys {
+		invoice := &Invoice{Period: "2019-04", DescriptorId: key, Publisher: publisher, ConsumerId: "consumer", CurrencyCode: []string{"USD", "EUR"}[i%2], Total: uint64(i + 1)}
+		if err := ac.creditRoyalties(invoice); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.MockTransactionEnd("settle")
+
+	for _, max_results := range []string{"1", "2", "100"} {
+		s.mustInvoke(t, "setQueryLimits", max_results, "0")
+		var keys []string
+		bookmark := ""
+		for page := 0; ; page++ {
+			if page > len(orderingKeys) {
+				t.Fatalf("getRoyaltyStatement did not finish paging")
+			}
+			royaltyStatement := &RoyaltyStatement{}
+			if err := proto.Unmarshal(s.mustInvoke(t, "getRoyaltyStatement", normalizeIdentity(publisher), "2019-04", bookmark), royaltyStatement); err != nil {
+				t.Fatal(err)
+			}
+			for _, royaltyEntry := range royaltyStatement.Entries {
+				keys = append(keys, royaltyEntry.DescriptorId)
+			}
+			if got, want := fmt.Sprint(royaltyStatement.Totals), fmt.Sprint([]*RoyaltyStatement_Total{{CurrencyCode: "EUR", Amount: 6}, {CurrencyCode: "USD", Amount: 9}}); got != want {
+				t.Fatalf("getRoyaltyStatement with max_results %s totals %s, want %s", max_results, got, want)
+			}
+			if !royaltyStatement.HasMore {
+				break
+			}
+			bookmark = royaltyStatement.Bookmark
+		}
+		checkOrder(t, "getRoyaltyStatement with max_results "+max_results, keys)
+	}
+}
+
+func TestQueryResultOrder(t *testing.T) {
+	s := newTestRegistry(t)
+	for _, key := range orderingKeys {
+		s.mustInvoke(t, "createAppDescriptor", key, &AppDescriptor{Description: key})
+	}
+	s.mustInvoke(t, "setQueryLimits", "2", "0")
+
+	// query reads the QueryLimits through the stubs the handlers see
+	system := newSystemStub(s)
+	ac := &assetContext{stub: newEncodingStub(system), system: system}
+	var keys []string
+	var composite_keys []string
+	query := &Query{ObjectType: Query_APP_DESCRIPTOR}
+	for page := 0; ; page++ {
+		if page > len(orderingKeys) {
+			t.Fatalf("query did not finish paging")
+		}
+		queryResult, err := ac.query(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(queryResult.Results) > 2 {
+			t.Fatalf("query returned %d results over max_results 2", len(queryResult.Results))
+		}
+		for _, entry := range queryResult.Results {
+			keys = append(keys, entry.Key)
+		}
+		composite_keys = append(composite_keys, queryResult.Bookmark)
+		if !queryResult.HasMore {
+			break
+		}
+		query = &Query{ObjectType: Query_APP_DESCRIPTOR, Bookmark: queryResult.Bookmark}
+	}
+	checkOrder(t, "query", keys)
+	if !sort.StringsAreSorted(composite_keys) {
+		t.Fatalf("bookmarks %v are not in composite key order", composite_keys)
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// overlayStub buffers the writes of a handler instead of sending them to the peer. Reads see
+// the buffered writes, including partial composite key queries, so a handler run over an
+// overlay behaves as if its writes had been committed. An event the handler sets is buffered
+// with its writes.
+//
+// Private data cannot be buffered, since only the peers of its collection hold it, so an
+// overlay rejects private data writes rather than let them reach the peer unreported; reads
+// of private data pass through, and see every private write because there are none. Rich
+// queries and paginated queries are evaluated by the peer and cannot see the buffered writes,
+// so an overlay rejects them once it holds any.
+type overlayStub struct {
+	shim.ChaincodeStubInterface
+	args   [][]byte
+	writes map[string][]byte // A nil value marks a delete
+	order  []string          // Written keys, in first-write order
+	event  *handlerEvent
+}
+
+func newOverlayStub(stub shim.ChaincodeStubInterface, args [][]byte) *overlayStub {
+	return &overlayStub{ChaincodeStubInterface: stub, args: args, writes: make(map[string][]byte)}
+}
+
+func (ov *overlayStub) GetArgs() [][]byte {
+	return ov.args
+}
+
+func (ov *overlayStub) GetStringArgs() []string {
+	var strargs []string
+	for _, arg := range ov.args {
+		strargs = append(strargs, string(arg))
+	}
+	return strargs
+}
+
+func (ov *overlayStub) GetFunctionAndParameters() (string, []string) {
+	strargs := ov.GetStringArgs()
+	if len(strargs) == 0 {
+		return "", []string{}
+	}
+	return strargs[0], strargs[1:]
+}
+
+func (ov *overlayStub) GetState(key string) ([]byte, error) {
+	if value, written := ov.writes[key]; written {
+		return value, nil
+	}
+	return ov.ChaincodeStubInterface.GetState(key)
+}
+
+func (ov *overlayStub) record(key string, value []byte) {
+	if _, written := ov.writes[key]; !written {
+		ov.order = append(ov.order, key)
+	}
+	ov.writes[key] = value
+}
+
+func (ov *overlayStub) PutState(key string, value []byte) error {
+	if len(value) == 0 {
+		return fmt.Errorf("PutState called with an empty value for key %s", key)
+	}
+	ov.record(key, value)
+	return nil
+}
+
+func (ov *overlayStub) DelState(key string) error {
+	ov.record(key, nil)
+	return nil
+}
+
+// noPrivateData is the error of a private data write made over an overlay.
+func (ov *overlayStub) noPrivateData() error {
+	return fmt.Errorf("%s writes private data and cannot be run over an overlay", ov.args[0])
+}
+
+func (ov *overlayStub) PutPrivateData(collection string, key string, value []byte) error {
+	return ov.noPrivateData()
+}
+
+func (ov *overlayStub) DelPrivateData(collection string, key string) error {
+	return ov.noPrivateData()
+}
+
+func (ov *overlayStub) SetPrivateDataValidationParameter(collection string, key string, ep []byte) error {
+	return ov.noPrivateData()
+}
+
+func (ov *overlayStub) SetStateValidationParameter(key string, ep []byte) error {
+	return fmt.Errorf("%s sets a key-level endorsement policy and cannot be run over an overlay", ov.args[0])
+}
+
+// checkUnbuffered fails if the overlay holds buffered writes a query evaluated by the peer
+// would not see.
+func (ov *overlayStub) checkUnbuffered() error {
+	if len(ov.order) > 0 {
+		return fmt.Errorf("%s runs a query that cannot see the writes buffered before it", ov.args[0])
+	}
+	return nil
+}
+
+func (ov *overlayStub) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	if err := ov.checkUnbuffered(); err != nil {
+		return nil, err
+	}
+	return ov.ChaincodeStubInterface.GetQueryResult(query)
+}
+
+func (ov *overlayStub) GetQueryResultWithPagination(query string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if err := ov.checkUnbuffered(); err != nil {
+		return nil, nil, err
+	}
+	return ov.ChaincodeStubInterface.GetQueryResultWithPagination(query, pageSize, bookmark)
+}
+
+func (ov *overlayStub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if err := ov.checkUnbuffered(); err != nil {
+		return nil, nil, err
+	}
+	return ov.ChaincodeStubInterface.GetStateByPartialCompositeKeyWithPagination(objectType, keys, pageSize, bookmark)
+}
+
+func (ov *overlayStub) SetEvent(name string, payload []byte) error {
+	ov.event = &handlerEvent{name: name, payload: payload}
+	return nil
+}
+
+func (ov *overlayStub) GetStateByPartialCompositeKey(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+	prefix, err := ov.CreateCompositeKey(objectType, attributes)
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := ov.ChaincodeStubInterface.GetStateByPartialCompositeKey(objectType, attributes)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	merged := make(map[string][]byte)
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		merged[kv.Key] = kv.Value
+	}
+	for key, value := range ov.writes {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if value == nil {
+			delete(merged, key)
+		} else {
+			merged[key] = value
+		}
+	}
+
+	var keys []string
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var kvs []*queryresult.KV
+	for _, key := range keys {
+		kvs = append(kvs, &queryresult.KV{Key: key, Value: merged[key]})
+	}
+	return &overlayIterator{kvs: kvs}, nil
+}
+
+// stateWrites returns the buffered writes in first-write order.
+func (ov *overlayStub) stateWrites() ([]*StateWrite, error) {
+	var stateWrites []*StateWrite
+	for _, key := range ov.order {
+		objectType, key_parts, err := ov.SplitCompositeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("Could not split composite key %s: %s", key, err)
+		}
+		value := ov.writes[key]
+		stateWrites = append(stateWrites, &StateWrite{ObjectType: objectType, KeyParts: key_parts, Value: value, IsDelete: value == nil})
+	}
+	return stateWrites, nil
+}
+
+// flush sends the buffered writes to the underlying stub in first-write order, then the
+// buffered event.
+func (ov *overlayStub) flush() error {
+	for _, key := range ov.order {
+		value := ov.writes[key]
+		var err error
+		if value == nil {
+			err = ov.ChaincodeStubInterface.DelState(key)
+		} else {
+			err = ov.ChaincodeStubInterface.PutState(key, value)
+		}
+		if err != nil {
+			return fmt.Errorf("Could not write state for key %s: %s", key, err)
+		}
+	}
+	if ov.event != nil {
+		return ov.ChaincodeStubInterface.SetEvent(ov.event.name, ov.event.payload)
+	}
+	return nil
+}
+
+// overlayIterator iterates over a sorted, in-memory copy of a range query result.
+type overlayIterator struct {
+	kvs []*queryresult.KV
+}
+
+func (oi *overlayIterator) HasNext() bool {
+	return len(oi.kvs) > 0
+}
+
+func (oi *overlayIterator) Next() (*queryresult.KV, error) {
+	if len(oi.kvs) == 0 {
+		return nil, fmt.Errorf("No more results")
+	}
+	kv := oi.kvs[0]
+	oi.kvs = oi.kvs[1:]
+	return kv, nil
+}
+
+func (oi *overlayIterator) Close() error {
+	return nil
+}
+
+// isComposableFunction reports whether function may be run inside validateOnly or a Script.
+func isComposableFunction(function string) bool {
+	return !handlers[function].wrapper
+}
+
+// validateOnly runs the function named by the first argument, with the remaining arguments,
+// over an overlayStub. It performs all of the function's validation and returns the function's
+// result along with the writes it would have made, without writing anything.
+func (ac *assetContext) validateOnly() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("Wrong number of arguments to validateOnly")
+	}
+	function := string(args[1])
+	if !isComposableFunction(function) || !utf8.ValidString(function) {
+		return nil, fmt.Errorf("Error in validateOnly, cannot validate function '%s'", function)
+	}
+
+	overlay := newOverlayStub(ac.stub, args[1:])
+	inner := *ac
+	inner.stub = overlay
+	inner.function = function
+
+	result, err := inner.dispatch()
+	if err != nil {
+		return nil, fmt.Errorf("Validation of %s failed: %s", function, err)
+	}
+
+	stateWrites, err := overlay.stateWrites()
+	if err != nil {
+		return nil, fmt.Errorf("Error in validateOnly: %s", err)
+	}
+	dryRunResultBytes, err := proto.Marshal(&DryRunResult{Result: result, Writes: stateWrites})
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling DryRunResult in validateOnly: %s", err)
+	}
+	return dryRunResultBytes, nil
+}
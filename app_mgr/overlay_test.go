@@ -0,0 +1,88 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// An overlay's reads see its buffered writes and deletes, and nothing reaches the stub beneath
+// it until it is flushed.
+func TestOverlayStub(t *testing.T) {
+	stub := shim.NewMockStub("appmgr", new(AssetRegistry))
+	key := func(key_part string) string {
+		compositeKey, err := stub.CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{key_part})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return compositeKey
+	}
+	stub.MockTransactionStart("test-tx")
+	defer stub.MockTransactionEnd("test-tx")
+	stub.PutState(key("kept"), []byte("kept"))
+	stub.PutState(key("overwritten"), []byte("old"))
+	stub.PutState(key("deleted"), []byte("deleted"))
+
+	overlay := newOverlayStub(stub, [][]byte{[]byte("test")})
+	overlay.PutState(key("overwritten"), []byte("new"))
+	overlay.DelState(key("deleted"))
+	overlay.PutState(key("added"), []byte("added"))
+
+	tests := []struct {
+		key_part string
+		want     string
+		beneath  string
+	}{
+		{"kept", "kept", "kept"},
+		{"overwritten", "new", "old"},
+		{"deleted", "", "deleted"},
+		{"added", "added", ""},
+	}
+	for _, test := range tests {
+		if value, _ := overlay.GetState(key(test.key_part)); string(value) != test.want {
+			t.Errorf("overlay read %s as %q, want %q", test.key_part, value, test.want)
+		}
+		if value, _ := stub.GetState(key(test.key_part)); string(value) != test.beneath {
+			t.Errorf("stub read %s as %q before the flush, want %q", test.key_part, value, test.beneath)
+		}
+	}
+
+	iterator, err := overlay.GetStateByPartialCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ranged []string
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ranged = append(ranged, string(kv.Value))
+	}
+	if got, want := fmt.Sprint(ranged), "[added kept new]"; got != want {
+		t.Errorf("overlay range read %s, want %s", got, want)
+	}
+
+	if _, err := overlay.GetQueryResult(`{"selector":{}}`); err == nil {
+		t.Errorf("a rich query ran over buffered writes")
+	}
+	if err := overlay.PutPrivateData("details", key("kept"), []byte("details")); err == nil {
+		t.Errorf("a private data write was buffered")
+	}
+
+	if err := overlay.flush(); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		if value, _ := stub.GetState(key(test.key_part)); string(value) != test.want {
+			t.Errorf("stub read %s as %q after the flush, want %q", test.key_part, value, test.want)
+		}
+	}
+}
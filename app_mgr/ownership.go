@@ -0,0 +1,143 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// When a member leaves the consortium its identities can no longer sign, so the assets they
+// own would be stranded. An admin transfers them with reassignOwnership, a batch at a time,
+// passing back the returned bookmark until the OwnershipReassignment is done. The scan covers
+// every owned namespace in turn without relying on the CouchDB owner index, so it works on any
+// state database. Each batch is one transaction, so its RegistryEvent lists every asset
+// transferred in it.
+//
+// Only ownership is transferred. Records naming an identity as a party are left as they are,
+// since moving them would rewrite what the identity agreed or subscribed to: Licenses, Watches
+// and Pins are keyed by the licensee, watcher and pinning member, Offers name their publisher
+// and consumer, Auctions their seller and Invoices their publisher. Their namespaces are
+// reported in unreassigned_namespaces, for the admins to settle with the other parties.
+
+// reassignableObjectTypes lists the namespaces of owned assets, in the order they are scanned.
+var reassignableObjectTypes = []string{
+	COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE,
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE,
+	COMPOSITE_KEY_COLLECTION_OBJECTTYPE,
+}
+
+// unreassignedObjectTypes lists the namespaces of records naming identities that
+// reassignOwnership leaves alone.
+var unreassignedObjectTypes = []string{
+	COMPOSITE_KEY_AUCTION_OBJECTTYPE,
+	COMPOSITE_KEY_INVOICE_OBJECTTYPE,
+	COMPOSITE_KEY_LICENSE_OBJECTTYPE,
+	COMPOSITE_KEY_OFFER_OBJECTTYPE,
+	COMPOSITE_KEY_PIN_OBJECTTYPE,
+	COMPOSITE_KEY_WATCH_OBJECTTYPE,
+}
+
+// reassignableAsset is an asset whose owner can be changed.
+type reassignableAsset interface {
+	ownedAsset
+	setOwner(owner []byte)
+}
+
+func (a *AppDescriptor) setOwner(owner []byte) { a.Owner, a.OwnerId = owner, normalizeIdentity(owner) }
+func (a *AppBundle) setOwner(owner []byte)     { a.Owner, a.OwnerId = owner, normalizeIdentity(owner) }
+func (c *Collection) setOwner(owner []byte)    { c.Owner, c.OwnerId = owner, normalizeIdentity(owner) }
+
+func (ac *assetContext) reassignOwnership() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	from_owner_id := ""
+	var to_identity []byte
+	bookmark := ""
+
+	switch len(args) {
+	case 4:
+		bookmark = string(args[3])
+		fallthrough
+	case 3:
+		from_owner_id = string(args[1])
+		to_identity = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to reassignOwnership")
+	}
+	if _, err := mspIdFromIdentity(to_identity); err != nil {
+		return nil, fmt.Errorf("Error in reassignOwnership, to_identity must be a serialized identity: %s", err)
+	}
+	to_owner_id := normalizeIdentity(to_identity)
+	if to_owner_id == from_owner_id {
+		return nil, fmt.Errorf("Error in reassignOwnership: the assets are already owned by %s", to_owner_id)
+	}
+
+	// The bookmark is a composite key, which names the namespace to resume in
+	start := 0
+	if len(bookmark) != 0 {
+		objectType, _, err := ac.stub.SplitCompositeKey(bookmark)
+		if err != nil {
+			return nil, fmt.Errorf("Error in reassignOwnership, invalid bookmark: %s", err)
+		}
+		for start < len(reassignableObjectTypes) && reassignableObjectTypes[start] != objectType {
+			start++
+		}
+		if start == len(reassignableObjectTypes) {
+			return nil, fmt.Errorf("Error in reassignOwnership, invalid bookmark namespace %s", objectType)
+		}
+	}
+
+	ownershipReassignment := &OwnershipReassignment{FromOwnerId: from_owner_id, ToOwnerId: to_owner_id, Done: true, UnreassignedNamespaces: unreassignedObjectTypes}
+	budget := MIGRATION_BATCH_SIZE
+	for _, namespace := range reassignableObjectTypes[start:] {
+		scanned := 0
+		newAsset := replicableObjectTypes[Query_ObjectType(Query_ObjectType_value[namespace])]
+		last, done, err := ac.scanBatch(namespace, []string{}, bookmark, budget, func(compositeKey string, value []byte) error {
+			scanned++
+			asset := newAsset().(reassignableAsset)
+			if err := proto.Unmarshal(value, asset); err != nil {
+				return fmt.Errorf("Cannot unmarshal %s %s: %s", namespace, compositeKey, err)
+			}
+			if normalizeIdentity(asset.GetOwner()) != from_owner_id {
+				return nil
+			}
+			asset.setOwner(to_identity)
+			_, key_parts, err := ac.stub.SplitCompositeKey(compositeKey)
+			if err != nil {
+				return fmt.Errorf("Error splitting composite key %s: %s", compositeKey, err)
+			}
+			if _, err := ac.putAsset(namespace, key_parts, asset); err != nil {
+				return err
+			}
+			ownershipReassignment.ReassignedCount++
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error in reassignOwnership: %s", err)
+		}
+		if !done {
+			ownershipReassignment.Bookmark = last
+			ownershipReassignment.Done = false
+			break
+		}
+		budget -= scanned
+		if budget == 0 && namespace != reassignableObjectTypes[len(reassignableObjectTypes)-1] {
+			// Resuming after the last key of the namespace moves on to the next one
+			ownershipReassignment.Bookmark = last
+			ownershipReassignment.Done = false
+			break
+		}
+		bookmark = ""
+	}
+
+	ownershipReassignmentBytes, err := proto.Marshal(ownershipReassignment)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling OwnershipReassignment in reassignOwnership: %s", err)
+	}
+	return ownershipReassignmentBytes, nil
+}
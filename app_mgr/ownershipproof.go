@@ -0,0 +1,186 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Off-chain services that cannot inspect Fabric identities, e.g. a package mirror or a
+// storefront, can have the owner of an asset prove it holds the owner's key. The service, or
+// anyone, asks issueOwnershipChallenge for a nonce for the asset; the nonce is derived from the
+// transaction ID, so it is the same on every endorser and unknown before it is issued. The
+// owner signs the nonce with its key and submits the signature to proveOwnership, which checks
+// it against the identity owning the asset and records an OwnershipProof holding the
+// signature and the owner's certificate, so the service can verify the proof itself. A
+// challenge is consumed by its proof, and expires after OWNERSHIP_CHALLENGE_SECONDS.
+
+const OWNERSHIP_CHALLENGE_SECONDS = 15 * 60
+
+// getOwnedAsset returns the asset of namespace, one of reassignableObjectTypes, at key_parts,
+// with its key parts once the descriptor key of an AppDescriptor or AppBundle is resolved.
+func (ac *assetContext) getOwnedAsset(namespace string, key_parts []string) (ownedAsset, []string, error) {
+	var expected_key_parts int
+	switch namespace {
+	case COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, COMPOSITE_KEY_COLLECTION_OBJECTTYPE:
+		expected_key_parts = 1
+	case COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:
+		expected_key_parts = 2
+	default:
+		return nil, nil, fmt.Errorf("%s is not a namespace of owned assets", namespace)
+	}
+	if len(key_parts) != expected_key_parts {
+		return nil, nil, fmt.Errorf("%s assets have %d key parts", namespace, expected_key_parts)
+	}
+	key_parts = append([]string{}, key_parts...)
+	if namespace != COMPOSITE_KEY_COLLECTION_OBJECTTYPE {
+		resolved, err := ac.resolveDescriptorKey(key_parts[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		key_parts[0] = resolved
+	}
+
+	asset := replicableObjectTypes[Query_ObjectType(Query_ObjectType_value[namespace])]().(ownedAsset)
+	found, err := ac.getAsset(namespace, key_parts, asset)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("%s (%v) not found", namespace, key_parts)
+	}
+	return asset, key_parts, nil
+}
+
+func (ac *assetContext) issueOwnershipChallenge() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 3 {
+		return nil, fmt.Errorf("Wrong number of arguments to issueOwnershipChallenge")
+	}
+	namespace := string(args[1])
+	var key_parts []string
+	for _, arg := range args[2:] {
+		key_parts = append(key_parts, string(arg))
+	}
+
+	_, key_parts, err := ac.getOwnedAsset(namespace, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in issueOwnershipChallenge: %s", err)
+	}
+	issued_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in issueOwnershipChallenge: %s", err)
+	}
+	digest := sha256.New()
+	for _, part := range append([]string{ac.stub.GetTxID(), namespace}, key_parts...) {
+		digest.Write([]byte(part))
+		digest.Write([]byte{0})
+	}
+	nonce := hex.EncodeToString(digest.Sum(nil))
+
+	ownershipChallengeBytes, err := ac.putAsset(COMPOSITE_KEY_OWNERSHIP_CHALLENGE_OBJECTTYPE, []string{nonce}, &OwnershipChallenge{
+		Nonce:      nonce,
+		ObjectType: namespace,
+		KeyParts:   key_parts,
+		IssuedBy:   ac.creator,
+		IssuedAt:   issued_at,
+		ExpiresAt:  issued_at + OWNERSHIP_CHALLENGE_SECONDS,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in issueOwnershipChallenge: %s", err)
+	}
+	return ownershipChallengeBytes, nil
+}
+
+func (ac *assetContext) proveOwnership() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	nonce := ""
+	var signature []byte
+
+	switch len(args) {
+	case 3:
+		nonce = string(args[1])
+		signature = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to proveOwnership")
+	}
+
+	ownershipChallenge := &OwnershipChallenge{}
+	found, err := ac.getAsset(COMPOSITE_KEY_OWNERSHIP_CHALLENGE_OBJECTTYPE, []string{nonce}, ownershipChallenge)
+	if err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in proveOwnership: no OwnershipChallenge was issued with nonce %s", nonce)
+	}
+	proven_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership: %s", err)
+	}
+	if proven_at >= ownershipChallenge.ExpiresAt {
+		return nil, fmt.Errorf("Error in proveOwnership: the OwnershipChallenge expired at %d", ownershipChallenge.ExpiresAt)
+	}
+	asset, key_parts, err := ac.getOwnedAsset(ownershipChallenge.ObjectType, ownershipChallenge.KeyParts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership: %s", err)
+	}
+	if err := verifyIdentitySignature(asset.GetOwner(), []byte(nonce), signature); err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership, the nonce is not signed by the owner: %s", err)
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_OWNERSHIP_CHALLENGE_OBJECTTYPE, []string{nonce})
+	if err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership: %s", err)
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership, could not delete the OwnershipChallenge: %s", err)
+	}
+	ownershipProofBytes, err := ac.putAsset(COMPOSITE_KEY_OWNERSHIP_PROOF_OBJECTTYPE, []string{nonce}, &OwnershipProof{
+		Nonce:      nonce,
+		ObjectType: ownershipChallenge.ObjectType,
+		KeyParts:   key_parts,
+		Owner:      asset.GetOwner(),
+		OwnerId:    normalizeIdentity(asset.GetOwner()),
+		Signature:  signature,
+		ProvenAt:   proven_at,
+		TxId:       ac.stub.GetTxID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in proveOwnership: %s", err)
+	}
+	return ownershipProofBytes, nil
+}
+
+func (ac *assetContext) getOwnershipProof() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	nonce := ""
+
+	switch len(args) {
+	case 2:
+		nonce = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getOwnershipProof")
+	}
+
+	ownershipProof := &OwnershipProof{}
+	found, err := ac.getAsset(COMPOSITE_KEY_OWNERSHIP_PROOF_OBJECTTYPE, []string{nonce}, ownershipProof)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getOwnershipProof: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getOwnershipProof: no OwnershipProof for nonce %s", nonce)
+	}
+	ownershipProofBytes, err := proto.Marshal(ownershipProof)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling OwnershipProof in getOwnershipProof: %s", err)
+	}
+	return ownershipProofBytes, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The pause mode is an emergency circuit breaker: while paused, dispatch rejects every write
+// (WRITES_PAUSED) or every call (ALL_PAUSED) other than admin functions, without requiring a
+// chaincode upgrade. In MAINTENANCE mode admins may additionally call migration functions and
+// those on the maintenance allowlist, so data migrations can run while other traffic is blocked.
+
+// checkNotPaused returns an error if the registry's pause mode blocks h.
+func (ac *assetContext) checkNotPaused(h handler) error {
+	if h.wrapper {
+		// The wrapped functions are checked when they are dispatched
+		return nil
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	switch registryConfig.PauseMode {
+	case RegistryConfig_ALL_PAUSED:
+		return fmt.Errorf("The registry is paused, %s is not available", ac.function)
+	case RegistryConfig_WRITES_PAUSED:
+		if h.write {
+			return fmt.Errorf("The registry is paused for writes, %s is not available", ac.function)
+		}
+	case RegistryConfig_MAINTENANCE:
+		allowed := h.migration
+		for _, function := range registryConfig.MaintenanceAllowedFunctions {
+			allowed = allowed || function == ac.function
+		}
+		if !allowed {
+			return fmt.Errorf("The registry is in maintenance mode, %s is not available", ac.function)
+		}
+		admin, err := ac.isAdmin()
+		if err != nil {
+			return err
+		}
+		if !admin {
+			return fmt.Errorf("The registry is in maintenance mode, only admins may call %s", ac.function)
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) pauseRegistry() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var pauseMode = RegistryConfig_WRITES_PAUSED
+	var allowlist = &KeyList{}
+
+	switch len(args) {
+	case 3:
+		if string(args[1]) != "MAINTENANCE" {
+			return nil, fmt.Errorf("Error in pauseRegistry, an allowlist is only accepted for MAINTENANCE")
+		}
+		if err := proto.Unmarshal(args[2], allowlist); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal KeyList, err = %s", err)
+		}
+		fallthrough
+	case 2:
+		switch string(args[1]) {
+		case "WRITES":
+		case "ALL":
+			pauseMode = RegistryConfig_ALL_PAUSED
+		case "MAINTENANCE":
+			pauseMode = RegistryConfig_MAINTENANCE
+		default:
+			return nil, fmt.Errorf("Error in pauseRegistry, scope must be WRITES, ALL or MAINTENANCE")
+		}
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to pauseRegistry")
+	}
+
+	for _, function := range allowlist.Keys {
+		if _, ok := handlers[function]; !ok {
+			return nil, fmt.Errorf("Error in pauseRegistry, unknown function '%s' in allowlist", function)
+		}
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in pauseRegistry: %s", err)
+	}
+	registryConfig.PauseMode = pauseMode
+	registryConfig.MaintenanceAllowedFunctions = allowlist.Keys
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) resumeRegistry() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to resumeRegistry")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in resumeRegistry: %s", err)
+	}
+	registryConfig.PauseMode = RegistryConfig_RUNNING
+	registryConfig.MaintenanceAllowedFunctions = nil
+	return ac.putRegistryConfig(registryConfig)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Pins are per-identity bookmarks of AppDescriptors, keyed by the caller's normalized
+// identity followed by the descriptor key.
+
+func (ac *assetContext) pinDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to pinDescriptor")
+	}
+
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in pinDescriptor: %s", err)
+	}
+
+	pin := &Pin{Owner: ac.creator, DescriptorKey: app_descriptor_key_part}
+	return ac.putAsset(COMPOSITE_KEY_PIN_OBJECTTYPE, []string{ac.identity, app_descriptor_key_part}, pin)
+}
+
+func (ac *assetContext) unpinDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to unpinDescriptor")
+	}
+
+	found, err := ac.getAsset(COMPOSITE_KEY_PIN_OBJECTTYPE, []string{ac.identity, app_descriptor_key_part}, &Pin{})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unpinDescriptor: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("AppDescriptor %s is not pinned", app_descriptor_key_part)
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_PIN_OBJECTTYPE, []string{ac.identity, app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unpinDescriptor, could not create pin composite key: %s", err)
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return nil, fmt.Errorf("Error in unpinDescriptor, could not delete state for key %s: %s", compositeKey, err)
+	}
+	return nil, nil
+}
+
+func (ac *assetContext) getMyPinnedDescriptors() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	bookmark := ""
+
+	switch len(args) {
+	case 2:
+		bookmark = string(args[1])
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getMyPinnedDescriptors")
+	}
+
+	var query *Query = &Query{ObjectType: Query_PIN, KeyParts: []string{ac.identity}, Bookmark: bookmark}
+	var query_results, err = ac.query(query)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getMyPinnedDescriptors: %s", err)
+	}
+	var appDescriptors = &AppDescriptors{HasMore: query_results.HasMore, Bookmark: query_results.Bookmark}
+	for _, entry := range query_results.Results {
+		app_descriptor_key_part, err := ac.resolveDescriptorKey(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getMyPinnedDescriptors: %s", err)
+		}
+		appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getMyPinnedDescriptors: %s", err)
+		}
+		appDescriptors.Descriptors = append(appDescriptors.Descriptors, &AppDescriptors_Entry{Key: entry.Key, AppDescriptor: appDescriptor})
+	}
+	var appDescriptorsBytes, err_marshalling = proto.Marshal(appDescriptors)
+	if err_marshalling != nil {
+		return nil, fmt.Errorf("Error marshalling AppDescriptors in getMyPinnedDescriptors: %s", err_marshalling.Error())
+	}
+	return appDescriptorsBytes, nil
+}
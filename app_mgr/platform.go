@@ -0,0 +1,88 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// An AppBundle built for particular hardware lists its target Platforms, so an operator can
+// find a build their peers can run, e.g. on s390x, with getBundlesForDescriptorByPlatform.
+// Bundles listing no platform run anywhere and match every platform.
+
+// validateTargetPlatforms checks the target platforms of an AppBundle to be created.
+func validateTargetPlatforms(targetPlatforms []*Platform) error {
+	seen := make(map[string]bool)
+	for i, platform := range targetPlatforms {
+		if platform.Architecture == Platform_ARCHITECTURE_UNSPECIFIED {
+			return fmt.Errorf("target_platforms[%d] has no architecture", i)
+		}
+		for _, c := range platform.Os {
+			if !('a' <= c && c <= 'z' || '0' <= c && c <= '9') {
+				return fmt.Errorf("target_platforms[%d] os %q is not a GOOS name", i, platform.Os)
+			}
+		}
+		key := platform.Os + "/" + platform.Architecture.String()
+		if seen[key] {
+			return fmt.Errorf("target_platforms[%d] repeats %s", i, key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// runsOn reports whether an AppBundle with targetPlatforms runs on os and architecture. An
+// empty os, in the query or a target platform, matches any.
+func runsOn(targetPlatforms []*Platform, os string, architecture Platform_Architecture) bool {
+	if len(targetPlatforms) == 0 {
+		return true
+	}
+	for _, platform := range targetPlatforms {
+		if platform.Architecture == architecture && (len(os) == 0 || len(platform.Os) == 0 || platform.Os == os) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ac *assetContext) getBundlesForDescriptorByPlatform() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	os := ""
+	architecture_name := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 5:
+		bookmark = string(args[4])
+		fallthrough
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		os = string(args[2])
+		architecture_name = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getBundlesForDescriptorByPlatform")
+	}
+	architecture_value, ok := Platform_Architecture_value[architecture_name]
+	if !ok || Platform_Architecture(architecture_value) == Platform_ARCHITECTURE_UNSPECIFIED {
+		return nil, fmt.Errorf("Error in getBundlesForDescriptorByPlatform, unknown architecture '%s'", architecture_name)
+	}
+
+	appBundleKeySet, err := ac.filterAppBundleKeySet(app_descriptor_key_part, bookmark, func(appBundle *AppBundle) bool {
+		return runsOn(appBundle.TargetPlatforms, os, Platform_Architecture(architecture_value))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getBundlesForDescriptorByPlatform: %s", err)
+	}
+	appBundleKeySetBytes, err := proto.Marshal(appBundleKeySet)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling AppBundleKeySet in getBundlesForDescriptorByPlatform: %s", err)
+	}
+	return appBundleKeySetBytes, nil
+}
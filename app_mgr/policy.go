@@ -0,0 +1,292 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Governance rules live in the RegistryConfig as PolicyRules rather than in code, so admins
+// change them by transaction. Each write function that creates or associates an asset passes it to
+// evaluatePolicyRules, which checks every rule listing that function: field predicates on the
+// asset, and for AppBundles the number of owner endorsements and the ComplianceAttestation
+// types it carries. Fields are named as in app.proto and looked up through the properties
+// golang/protobuf reflects from the generated structs, so a rule can name any field of the
+// asset. putPolicyRule checks a rule against the assets of its functions, so a stored rule
+// always evaluates.
+
+// policySubjects maps the functions PolicyRules apply to, to the type of the asset they check.
+var policySubjects = map[string]reflect.Type{
+	"createAppDescriptor":           reflect.TypeOf(AppDescriptor{}),
+	"createAppBundle":               reflect.TypeOf(AppBundle{}),
+	"createConfidentialAppBundle":   reflect.TypeOf(AppBundle{}),
+	"createPrivateAppBundle":        reflect.TypeOf(AppBundle{}),
+	"associateDescriptorWithBundle": reflect.TypeOf(AppBundle{}),
+}
+
+// policyField returns the index and properties of the field of subjectType named in app.proto.
+func policyField(subjectType reflect.Type, name string) (int, *proto.Properties, bool) {
+	for i, prop := range proto.GetProperties(subjectType).Prop {
+		if prop.Tag > 0 && prop.OrigName == name {
+			return i, prop, true
+		}
+	}
+	return 0, nil, false
+}
+
+// isCollectionField reports whether a field is repeated or a map, rather than a single value.
+func isCollectionField(field reflect.StructField) bool {
+	kind := field.Type.Kind()
+	return kind == reflect.Map || kind == reflect.Slice && field.Type.Elem().Kind() != reflect.Uint8
+}
+
+// validatePredicate checks that predicate can be evaluated on an asset of subjectType.
+func validatePredicate(subjectType reflect.Type, predicate *PolicyRule_Predicate) error {
+	i, _, ok := policyField(subjectType, predicate.Field)
+	if !ok {
+		return fmt.Errorf("%s has no field %s", subjectType.Name(), predicate.Field)
+	}
+	field := subjectType.Field(i)
+	switch predicate.Op {
+	case PolicyRule_Predicate_EQUALS, PolicyRule_Predicate_NOT_EQUALS:
+		if isCollectionField(field) || field.Type.Kind() == reflect.Slice || field.Type.Kind() == reflect.Ptr {
+			return fmt.Errorf("%s %s cannot compare %s.%s, which is not a scalar", predicate.Field, predicate.Op, subjectType.Name(), predicate.Field)
+		}
+	case PolicyRule_Predicate_MIN_COUNT, PolicyRule_Predicate_MAX_COUNT:
+		if !isCollectionField(field) {
+			return fmt.Errorf("%s %s cannot count %s.%s, which is not repeated", predicate.Field, predicate.Op, subjectType.Name(), predicate.Field)
+		}
+		if _, err := strconv.ParseUint(predicate.Value, 10, 32); err != nil {
+			return fmt.Errorf("%s %s has an invalid count: %s", predicate.Field, predicate.Op, err)
+		}
+	}
+	return nil
+}
+
+// scalarString returns the value of a scalar field as compared by EQUALS, enums by name.
+func scalarString(value reflect.Value, prop *proto.Properties) string {
+	if stringer, ok := value.Interface().(fmt.Stringer); ok && len(prop.Enum) > 0 {
+		return stringer.String()
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// evaluatePredicate returns a description of how subject fails predicate, or "" if it holds.
+func evaluatePredicate(subject reflect.Value, predicate *PolicyRule_Predicate) string {
+	i, prop, _ := policyField(subject.Type(), predicate.Field)
+	value := subject.Field(i)
+	switch predicate.Op {
+	case PolicyRule_Predicate_PRESENT:
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Map {
+			if value.Len() == 0 {
+				return fmt.Sprintf("%s must be set", predicate.Field)
+			}
+		} else if reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface()) {
+			return fmt.Sprintf("%s must be set", predicate.Field)
+		}
+	case PolicyRule_Predicate_ABSENT:
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Map {
+			if value.Len() != 0 {
+				return fmt.Sprintf("%s must not be set", predicate.Field)
+			}
+		} else if !reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface()) {
+			return fmt.Sprintf("%s must not be set", predicate.Field)
+		}
+	case PolicyRule_Predicate_EQUALS:
+		if actual := scalarString(value, prop); actual != predicate.Value {
+			return fmt.Sprintf("%s is %q, must be %q", predicate.Field, actual, predicate.Value)
+		}
+	case PolicyRule_Predicate_NOT_EQUALS:
+		if scalarString(value, prop) == predicate.Value {
+			return fmt.Sprintf("%s must not be %q", predicate.Field, predicate.Value)
+		}
+	case PolicyRule_Predicate_MIN_COUNT:
+		if count, _ := strconv.Atoi(predicate.Value); value.Len() < count {
+			return fmt.Sprintf("%s has %d elements, at least %d are required", predicate.Field, value.Len(), count)
+		}
+	case PolicyRule_Predicate_MAX_COUNT:
+		if count, _ := strconv.Atoi(predicate.Value); value.Len() > count {
+			return fmt.Sprintf("%s has %d elements, at most %d are allowed", predicate.Field, value.Len(), count)
+		}
+	}
+	return ""
+}
+
+// validatePolicyRule checks that policyRule can be evaluated by each of its functions.
+func validatePolicyRule(policyRule *PolicyRule) error {
+	for _, function := range policyRule.Functions {
+		subjectType, ok := policySubjects[function]
+		if !ok {
+			return fmt.Errorf("PolicyRules cannot apply to %s", function)
+		}
+		for _, predicate := range policyRule.Predicates {
+			if err := validatePredicate(subjectType, predicate); err != nil {
+				return err
+			}
+		}
+		if subjectType != reflect.TypeOf(AppBundle{}) && policyRule.MinEndorsements > 0 {
+			return fmt.Errorf("min_endorsements only applies to AppBundles, not to the %s of %s", subjectType.Name(), function)
+		}
+		if function != "associateDescriptorWithBundle" && len(policyRule.RequiredAttestations) > 0 {
+			return fmt.Errorf("required_attestations can only be checked by associateDescriptorWithBundle, not %s", function)
+		}
+	}
+	return nil
+}
+
+// evaluatePolicyRules returns an error if subject, the asset of the invoked function, breaks a
+// PolicyRule of that function. app_bundle_key_part is empty for AppDescriptors. Rules are not
+// evaluated in LENIENT namespaces.
+func (ac *assetContext) evaluatePolicyRules(subject proto.Message, app_descriptor_key_part string, app_bundle_key_part string) error {
+	breaches, err := ac.policyBreaches(subject, app_descriptor_key_part, app_bundle_key_part)
+	if err != nil || len(breaches) == 0 {
+		return err
+	}
+	return breaches[0]
+}
+
+// policyBreach is the first condition of a PolicyRule that its subject fails.
+type policyBreach struct {
+	rule    string
+	failure string
+}
+
+func (breach policyBreach) Error() string {
+	return fmt.Sprintf("PolicyRule %s: %s", breach.rule, breach.failure)
+}
+
+// policyBreaches returns a policyBreach for every PolicyRule of the invoked function that
+// subject breaks, in the order of the RegistryConfig, as evaluatePolicyRules evaluates them.
+func (ac *assetContext) policyBreaches(subject proto.Message, app_descriptor_key_part string, app_bundle_key_part string) ([]policyBreach, error) {
+	if lenient, err := ac.isLenient(); err != nil || lenient {
+		return nil, err
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, err
+	}
+	var breaches []policyBreach
+	for _, policyRule := range registryConfig.PolicyRules {
+		applies := false
+		for _, function := range policyRule.Functions {
+			applies = applies || function == ac.function
+		}
+		if !applies {
+			continue
+		}
+		failure, err := ac.policyRuleFailure(policyRule, subject, app_descriptor_key_part, app_bundle_key_part)
+		if err != nil {
+			return nil, err
+		}
+		if len(failure) != 0 {
+			breaches = append(breaches, policyBreach{rule: policyRule.Name, failure: failure})
+		}
+	}
+	return breaches, nil
+}
+
+// policyRuleFailure describes the first condition of policyRule that subject fails, if any.
+func (ac *assetContext) policyRuleFailure(policyRule *PolicyRule, subject proto.Message, app_descriptor_key_part string, app_bundle_key_part string) (string, error) {
+	for _, predicate := range policyRule.Predicates {
+		if failure := evaluatePredicate(reflect.ValueOf(subject).Elem(), predicate); len(failure) != 0 {
+			return failure, nil
+		}
+	}
+	if appBundle, ok := subject.(*AppBundle); ok && uint32(len(appBundle.OwnerEndorsements)) < policyRule.MinEndorsements {
+		return fmt.Sprintf("the AppBundle has %d owner endorsements, at least %d are required", len(appBundle.OwnerEndorsements), policyRule.MinEndorsements), nil
+	}
+	for _, attestation_type := range policyRule.RequiredAttestations {
+		attested, err := ac.hasAssetsUnder(COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE, app_descriptor_key_part, app_bundle_key_part, attestation_type)
+		if err != nil {
+			return "", err
+		}
+		if !attested {
+			return fmt.Sprintf("AppBundle %s of AppDescriptor %s has no %s ComplianceAttestation", app_bundle_key_part, app_descriptor_key_part, attestation_type), nil
+		}
+	}
+	return "", nil
+}
+
+func (ac *assetContext) putPolicyRule() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	policyRule := &PolicyRule{}
+
+	switch len(args) {
+	case 2:
+		if err := proto.Unmarshal(args[1], policyRule); err != nil {
+			return nil, fmt.Errorf("Error in putPolicyRule, cannot unmarshal PolicyRule: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to putPolicyRule")
+	}
+	if err := validatePolicyRule(policyRule); err != nil {
+		return nil, fmt.Errorf("Error in putPolicyRule, invalid PolicyRule %s: %s", policyRule.Name, err)
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in putPolicyRule: %s", err)
+	}
+	i := sort.Search(len(registryConfig.PolicyRules), func(i int) bool { return registryConfig.PolicyRules[i].Name >= policyRule.Name })
+	if i < len(registryConfig.PolicyRules) && registryConfig.PolicyRules[i].Name == policyRule.Name {
+		registryConfig.PolicyRules[i] = policyRule
+	} else {
+		registryConfig.PolicyRules = append(registryConfig.PolicyRules, nil)
+		copy(registryConfig.PolicyRules[i+1:], registryConfig.PolicyRules[i:])
+		registryConfig.PolicyRules[i] = policyRule
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) deletePolicyRule() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	name := ""
+
+	switch len(args) {
+	case 2:
+		name = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to deletePolicyRule")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in deletePolicyRule: %s", err)
+	}
+	for i, policyRule := range registryConfig.PolicyRules {
+		if policyRule.Name == name {
+			registryConfig.PolicyRules = append(registryConfig.PolicyRules[:i], registryConfig.PolicyRules[i+1:]...)
+			return ac.putRegistryConfig(registryConfig)
+		}
+	}
+	return nil, fmt.Errorf("Error in deletePolicyRule: no PolicyRule named %s", name)
+}
+
+func (ac *assetContext) getPolicyRules() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getPolicyRules")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getPolicyRules: %s", err)
+	}
+	policyRulesBytes, err := proto.Marshal(&PolicyRules{Rules: registryConfig.PolicyRules})
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling PolicyRules in getPolicyRules: %s", err)
+	}
+	return policyRulesBytes, nil
+}
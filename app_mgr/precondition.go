@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// checkPreconditions returns an error describing the first Precondition that does not hold.
+func (ac *assetContext) checkPreconditions(preconditions *Preconditions) error {
+	for _, precondition := range preconditions.Preconditions {
+		if err := checkNotSystemObjectType(precondition.ObjectType.String()); err != nil {
+			return err
+		}
+		compositeKey, err := ac.stub.CreateCompositeKey(precondition.ObjectType.String(), precondition.KeyParts)
+		if err != nil {
+			return fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", precondition.ObjectType, precondition.KeyParts, err)
+		}
+		valueBytes, err := ac.stub.GetState(compositeKey)
+		if err != nil {
+			return fmt.Errorf("Error in GetState using composite key (%v): %s", compositeKey, err)
+		}
+
+		if len(precondition.ExpectedHash) == 0 {
+			if valueBytes != nil {
+				return fmt.Errorf("Precondition failed, %s (%v) exists", precondition.ObjectType, precondition.KeyParts)
+			}
+			continue
+		}
+		if valueBytes == nil {
+			return fmt.Errorf("Precondition failed, %s (%v) does not exist", precondition.ObjectType, precondition.KeyParts)
+		}
+		digest := sha256.Sum256(valueBytes)
+		if !bytes.Equal(digest[:], precondition.ExpectedHash) {
+			return fmt.Errorf("Precondition failed, %s (%v) has changed", precondition.ObjectType, precondition.KeyParts)
+		}
+	}
+	return nil
+}
+
+// ifMatch verifies all Preconditions before running the function named by its second argument
+// with the remaining arguments, so stateless clients can make a mutation conditional on the
+// values they last read.
+func (ac *assetContext) ifMatch() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 3 {
+		return nil, fmt.Errorf("Wrong number of arguments to ifMatch")
+	}
+	function := string(args[2])
+	if !isComposableFunction(function) {
+		return nil, fmt.Errorf("Error in ifMatch, function '%s' cannot be made conditional", function)
+	}
+
+	preconditions := &Preconditions{}
+	if err := proto.Unmarshal(args[1], preconditions); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal Preconditions, err = %s", err)
+	}
+	if err := ac.checkPreconditions(preconditions); err != nil {
+		return nil, fmt.Errorf("Error in ifMatch: %s", err)
+	}
+
+	overlay := newOverlayStub(ac.stub, args[2:])
+	inner := *ac
+	inner.stub = overlay
+	inner.function = function
+	result, err := inner.dispatch()
+	if err != nil {
+		return nil, err
+	}
+	if err := overlay.flush(); err != nil {
+		return nil, fmt.Errorf("Error in ifMatch: %s", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// listedHash returns the hash getAppDescriptors lists for the AppDescriptor key.
+func listedHash(t *testing.T, s *testRegistry, key string) []byte {
+	appDescriptors := &AppDescriptors{}
+	if err := proto.Unmarshal(s.mustInvoke(t, "getAppDescriptors"), appDescriptors); err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range appDescriptors.Descriptors {
+		if entry.Key == key {
+			return entry.Hash
+		}
+	}
+	t.Fatalf("getAppDescriptors did not list %s", key)
+	return nil
+}
+
+// A client makes a write conditional on the hash a listing returned, in either StorageEncoding,
+// and a write whose Preconditions fail writes nothing.
+func TestIfMatchPreconditions(t *testing.T) {
+	for _, encoding := range []string{"PROTO", "JSON"} {
+		s := newTestRegistry(t)
+		s.mustInvoke(t, "setStorageEncoding", encoding)
+		s.mustInvoke(t, "createAppDescriptor", "d", &AppDescriptor{Description: "conditional"})
+		s.mustInvoke(t, "createAppBundle", "b", &AppBundle{DescriptorId: "d", Artifacts: [][]byte{[]byte("artifact")}})
+		s.mustInvoke(t, "promoteBundle", "d", "b", "DEV")
+		s.mustInvoke(t, "promoteBundle", "d", "b", "STAGING")
+		listed := listedHash(t, s, "d")
+
+		descriptor := func(key string, expected_hash []byte) *Precondition {
+			return &Precondition{ObjectType: Query_APP_DESCRIPTOR, KeyParts: []string{key}, ExpectedHash: expected_hash}
+		}
+		tests := []struct {
+			name          string
+			preconditions []*Precondition
+			err           string
+		}{
+			{"stale hash", []*Precondition{descriptor("d", []byte("stale"))}, "has changed"},
+			{"absent asset expected to exist", []*Precondition{descriptor("missing", listed)}, "does not exist"},
+			{"existing asset expected absent", []*Precondition{descriptor("d", nil)}, "exists"},
+			{"system record", []*Precondition{{ObjectType: Query_CONFIG, KeyParts: []string{"registry"}}}, "reserved to the registry"},
+			{"one of several fails", []*Precondition{descriptor("d", listed), descriptor("d", nil)}, "exists"},
+			{"listed hash", []*Precondition{descriptor("d", listed), descriptor("missing", nil)}, ""},
+		}
+		for _, test := range tests {
+			response := s.invoke(t, "ifMatch", &Preconditions{Preconditions: test.preconditions}, "promoteBundle", "d", "b", "PROD")
+			appDescriptor := &AppDescriptor{}
+			if err := proto.Unmarshal(s.mustInvoke(t, "getAppDescriptor", "d"), appDescriptor); err != nil {
+				t.Fatal(err)
+			}
+			promoted := appDescriptor.EnvironmentBundleIds["PROD"] == "b"
+			if len(test.err) == 0 {
+				if response.Status != shim.OK || !promoted {
+					t.Errorf("%s, %s: %s", encoding, test.name, response.Message)
+				}
+				continue
+			}
+			if response.Status == shim.OK || !strings.Contains(response.Message, test.err) {
+				t.Errorf("%s, %s: got %q, want an error containing %q", encoding, test.name, response.Message, test.err)
+			}
+			if promoted {
+				t.Fatalf("%s, %s: promoted the bundle", encoding, test.name)
+			}
+		}
+	}
+}
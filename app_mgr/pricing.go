@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Pricing tiers publish what using an app costs, so a marketplace UI can render prices
+// straight from the ledger. Each tier prices one metered unit in the minor unit of an
+// ISO 4217 currency; tiers are validated whenever a descriptor is created or repriced.
+
+// validatePricingTiers checks that each tier is uniquely named and fully specified.
+func validatePricingTiers(pricingTiers []*PricingTier) error {
+	names := make(map[string]bool)
+	for _, pricingTier := range pricingTiers {
+		if len(pricingTier.Name) == 0 {
+			return fmt.Errorf("PricingTier must have a name")
+		}
+		if names[pricingTier.Name] {
+			return fmt.Errorf("Duplicate PricingTier %s", pricingTier.Name)
+		}
+		names[pricingTier.Name] = true
+		if len(pricingTier.Unit) == 0 {
+			return fmt.Errorf("PricingTier %s must have a metered unit", pricingTier.Name)
+		}
+		if !isCurrencyCode(pricingTier.CurrencyCode) {
+			return fmt.Errorf("PricingTier %s has invalid currency code '%s'", pricingTier.Name, pricingTier.CurrencyCode)
+		}
+	}
+	return nil
+}
+
+// isCurrencyCode reports whether code has the form of an ISO 4217 alphabetic code.
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func (ac *assetContext) SetPricingTiers(request *SetPricingTiersRequest) (*AppDescriptor, error) {
+	app_descriptor_key_part := request.AppDescriptorKey
+	pricingTiers := request.PricingTiers
+	if pricingTiers == nil {
+		pricingTiers = &PricingTiers{}
+	}
+	if err := validatePricingTiers(pricingTiers.Tiers); err != nil {
+		return nil, fmt.Errorf("Error in setPricingTiers: %s", err)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setPricingTiers: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may set its pricing", app_descriptor_key_part)
+	}
+	appDescriptor.PricingTiers = pricingTiers.Tiers
+	if _, err := ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor); err != nil {
+		return nil, err
+	}
+	return appDescriptor, nil
+}
+
+func (ac *assetContext) GetPricingForDescriptor(request *DescriptorRequest) (*PricingTiers, error) {
+	appDescriptor, err := ac.getDescriptor(request.AppDescriptorKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getPricingForDescriptor: %s", err)
+	}
+	return &PricingTiers{Tiers: appDescriptor.PricingTiers}, nil
+}
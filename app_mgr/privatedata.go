@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// Orgs may keep AppBundles in a private data collection, under the same composite keys as
+// public bundles, so that only collection members hold the artifacts while the channel only
+// sees their hashes. The bundle is passed in the transient map, as the app_bundle of the
+// request, so it is not recorded in the transaction. The AppDescriptor stays public.
+
+func (ac *assetContext) CreatePrivateAppBundle(request *CreatePrivateAppBundleRequest) (*PrivateBundleRecord, error) {
+	collection := request.Collection
+	key_part := request.AppBundleKey
+
+	appBundle, err := ac.checkNewAppBundle(key_part, request.AppBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{appBundle.DescriptorId, key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating composite key_part for %s using base component (%s):  %s", COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, key_part, err)
+	}
+	appBundleBytesFromStore, err := ac.stub.GetPrivateData(collection, compositeKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error in createPrivateAppBundle, could not get private data: %s", err)
+	}
+	if appBundleBytesFromStore != nil {
+		return nil, fmt.Errorf("Cannot create an AppBundle whose key_part already exists in collection %s: %s", collection, compositeKey)
+	}
+
+	appBundleBytes, err := marshalDeterministic(appBundle)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling proto: %s", err)
+	}
+	if err := ac.stub.PutPrivateData(collection, compositeKey, appBundleBytes); err != nil {
+		return nil, fmt.Errorf("Could not put private data for key_part %s in collection %s: %s", compositeKey, collection, err)
+	}
+	// The response is recorded in the transaction, so it carries the key and digest only
+	digest := sha256.Sum256(appBundleBytes)
+	privateBundleRecord := &PrivateBundleRecord{
+		DescriptorId: appBundle.DescriptorId,
+		BundleKey:    key_part,
+		Collection:   collection,
+		OwnerMspid:   ac.mspId,
+		Owner:        appBundle.Owner,
+		BundleHash:   digest[:],
+		CreatedAt:    appBundle.CreatedAt,
+	}
+	return privateBundleRecord, nil
+}
+
+// getPrivateAppBundles queries the AppBundles in a collection. The query is either a marshaled
+// Query, whose key_parts select the bundles by composite key prefix (e.g. a descriptor), or a
+// CouchDB selector query string (one starting with '{'). Private data does not support
+// pagination: selector queries fail beyond the QueryLimits, while key queries stop at them
+// and return a bookmark to set as the bookmark of the next Query.
+func (ac *assetContext) getPrivateAppBundles() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	collection := ""
+	var queryBytes = []byte{}
+
+	switch len(args) {
+	case 3:
+		collection = string(args[1])
+		queryBytes = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getPrivateAppBundles")
+	}
+
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getPrivateAppBundles: %s", err)
+	}
+
+	var stateQueryIterator shim.StateQueryIteratorInterface
+	var query = &Query{}
+	selector := len(queryBytes) > 0 && queryBytes[0] == '{'
+	if selector {
+		stateQueryIterator, err = ac.stub.GetPrivateDataQueryResult(collection, string(queryBytes))
+	} else {
+		if err := proto.Unmarshal(queryBytes, query); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal Query, err = %s", err)
+		}
+		if query.ObjectType != Query_APP_BUNDLE {
+			return nil, fmt.Errorf("Error in getPrivateAppBundles, object_type must be %s", Query_APP_BUNDLE)
+		}
+		stateQueryIterator, err = ac.stub.GetPrivateDataByPartialCompositeKey(collection, COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, query.KeyParts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error in getPrivateAppBundles querying collection %s: %s", collection, err)
+	}
+	defer stateQueryIterator.Close()
+
+	richQueryResult := &RichQueryResult{}
+	var result_bytes uint64
+	last_key := ""
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in getPrivateAppBundles querying collection %s: %s", collection, err)
+		}
+		if kv.Key <= query.Bookmark {
+			continue
+		}
+		objectType, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil || len(key_parts) != 2 {
+			// Selector queries see every document in the collection
+			continue
+		}
+		if objectType != COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE && objectType != COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE+JSON_DOCUMENT_OBJECTTYPE_SUFFIX {
+			continue
+		}
+
+		result_bytes += uint64(len(kv.Key) + len(kv.Value))
+		if uint32(len(richQueryResult.Entries)) == queryLimits.MaxResults || result_bytes > queryLimits.MaxBytes {
+			if selector || len(richQueryResult.Entries) == 0 {
+				return nil, fmt.Errorf("Error in getPrivateAppBundles: results exceed the query limits, refine the query")
+			}
+			richQueryResult.Bookmark = last_key
+			break
+		}
+		last_key = kv.Key
+
+		value, err := decodeStored(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getPrivateAppBundles: %s", err)
+		}
+		appBundle := &AppBundle{}
+		if err := proto.Unmarshal(value, appBundle); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal AppBundle %s: %s", kv.Key, err)
+		}
+		entry := &BulkGetResult_Entry{KeyParts: key_parts, Found: true, Value: value, Hash: contentHash(value)}
+		if err := ac.checkBundleReadAccess(key_parts[0], key_parts[1], appBundle); err != nil {
+			entry.Value = nil
+			entry.Hash = nil
+			entry.Error = err.Error()
+		}
+		richQueryResult.Entries = append(richQueryResult.Entries, entry)
+	}
+	richQueryResult.FetchedRecordsCount = int32(len(richQueryResult.Entries))
+
+	richQueryResultBytes, err := proto.Marshal(richQueryResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RichQueryResult in getPrivateAppBundles: %s", err)
+	}
+	return richQueryResultBytes, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Bundles are promoted through the environments DEV -> STAGING -> PROD in order. Each
+// promotion is recorded as a Promotion asset keyed by descriptor, bundle and environment,
+// and labels the descriptor's association for that environment. Promoting to PROD also
+// associates the bundle with the descriptor.
+
+func (ac *assetContext) promoteBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	environment_name := ""
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		environment_name = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to promoteBundle")
+	}
+
+	environment_value, ok := Promotion_Environment_value[environment_name]
+	if !ok {
+		return nil, fmt.Errorf("Error in promoteBundle, unknown environment '%s'", environment_name)
+	}
+	environment := Promotion_Environment(environment_value)
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in promoteBundle: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may promote its bundles", app_descriptor_key_part)
+	}
+
+	if _, err := ac.getAppBundleForDescriptorByKey(app_descriptor_key_part, app_bundle_key_part); err != nil {
+		return nil, fmt.Errorf("Error in promoteBundle: %s", err)
+	}
+
+	// The bundle must already have been promoted to the next lower environment
+	if environment != Promotion_DEV {
+		lower := Promotion_Environment(environment_value - 1)
+		found, err := ac.getAsset(COMPOSITE_KEY_PROMOTION_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part, lower.String()}, &Promotion{})
+		if err != nil {
+			return nil, fmt.Errorf("Error in promoteBundle: %s", err)
+		}
+		if !found {
+			return nil, fmt.Errorf("AppBundle %s must be promoted to %s before %s", app_bundle_key_part, lower, environment)
+		}
+	}
+
+	promoted_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in promoteBundle: %s", err)
+	}
+	promotion := &Promotion{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		Environment:  environment,
+		PromotedBy:   ac.creator,
+		PromotedAt:   promoted_at,
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_PROMOTION_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part, environment.String()}, promotion); err != nil {
+		return nil, fmt.Errorf("Error in promoteBundle: %s", err)
+	}
+
+	if appDescriptor.EnvironmentBundleIds == nil {
+		appDescriptor.EnvironmentBundleIds = make(map[string]string)
+	}
+	appDescriptor.EnvironmentBundleIds[environment.String()] = app_bundle_key_part
+	if environment == Promotion_PROD {
+		appDescriptor.BundleId = app_bundle_key_part
+	}
+	return ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor)
+}
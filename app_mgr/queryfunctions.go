@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// Query functions are the handlers that are neither write nor wrapper handlers. They have no
+// side effects, so gateways may evaluate them on a single peer rather than submit them for
+// ordering; listQueryFunctions tells gateways which they are. dispatch runs them on a
+// readOnlyStub, so a query function that tried to write would fail on every peer rather than
+// have its writes silently dropped when evaluated.
+
+// isQuery reports whether h is the handler of a query function.
+func (h handler) isQuery() bool {
+	return !h.write && !h.wrapper
+}
+
+// readOnlyStub rejects every state or event write made by the query function it runs.
+type readOnlyStub struct {
+	shim.ChaincodeStubInterface
+	function string
+}
+
+func (rs *readOnlyStub) readOnly() error {
+	return fmt.Errorf("%s is a query function and cannot write state", rs.function)
+}
+
+func (rs *readOnlyStub) PutState(key string, value []byte) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) DelState(key string) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) SetStateValidationParameter(key string, ep []byte) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) PutPrivateData(collection string, key string, value []byte) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) DelPrivateData(collection string, key string) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) SetPrivateDataValidationParameter(collection string, key string, ep []byte) error {
+	return rs.readOnly()
+}
+
+func (rs *readOnlyStub) SetEvent(name string, payload []byte) error {
+	return rs.readOnly()
+}
+
+func (ac *assetContext) listQueryFunctions() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+
+	switch len(args) {
+	case 1:
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to listQueryFunctions")
+	}
+
+	queryFunctions := &QueryFunctions{}
+	for function, h := range handlers {
+		if h.isQuery() {
+			queryFunctions.Functions = append(queryFunctions.Functions, function)
+		}
+	}
+	sort.Strings(queryFunctions.Functions)
+	queryFunctionsBytes, err := proto.Marshal(queryFunctions)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling QueryFunctions in listQueryFunctions: %s", err)
+	}
+	return queryFunctionsBytes, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Query limits cap the number of results and the bytes a single query may accumulate, so a
+// query over a large registry can exhaust neither peer memory nor the gRPC message size limit.
+// A truncated query sets has_more and returns a bookmark to continue from. Admins may lower
+// or raise the defaults below with setQueryLimits, which also sets the size above which the
+// payloads of compressed invocations are compressed.
+
+const DEFAULT_MAX_QUERY_RESULTS = 1000
+
+// DEFAULT_MAX_QUERY_BYTES leaves headroom below the 4 MiB default gRPC message size.
+const DEFAULT_MAX_QUERY_BYTES = 2 * 1024 * 1024
+
+const DEFAULT_COMPRESSION_THRESHOLD = 64 * 1024
+
+// queryLimits returns the registry's QueryLimits with the defaults applied.
+func (ac *assetContext) queryLimits() (*QueryLimits, error) {
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, err
+	}
+	queryLimits := &QueryLimits{MaxResults: DEFAULT_MAX_QUERY_RESULTS, MaxBytes: DEFAULT_MAX_QUERY_BYTES, CompressionThreshold: DEFAULT_COMPRESSION_THRESHOLD}
+	if registryConfig.QueryLimits != nil {
+		if registryConfig.QueryLimits.MaxResults != 0 {
+			queryLimits.MaxResults = registryConfig.QueryLimits.MaxResults
+		}
+		if registryConfig.QueryLimits.MaxBytes != 0 {
+			queryLimits.MaxBytes = registryConfig.QueryLimits.MaxBytes
+		}
+		if registryConfig.QueryLimits.CompressionThreshold != 0 {
+			queryLimits.CompressionThreshold = registryConfig.QueryLimits.CompressionThreshold
+		}
+	}
+	return queryLimits, nil
+}
+
+func (ac *assetContext) setQueryLimits() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var max_results, max_bytes, compression_threshold uint64
+	var err error
+	compression_threshold_set := false
+
+	switch len(args) {
+	case 4:
+		if compression_threshold, err = strconv.ParseUint(string(args[3]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Error in setQueryLimits, invalid compression_threshold: %s", err)
+		}
+		compression_threshold_set = true
+		fallthrough
+	case 3:
+		if max_results, err = strconv.ParseUint(string(args[1]), 10, 32); err != nil {
+			return nil, fmt.Errorf("Error in setQueryLimits, invalid max_results: %s", err)
+		}
+		if max_bytes, err = strconv.ParseUint(string(args[2]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Error in setQueryLimits, invalid max_bytes: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setQueryLimits")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setQueryLimits: %s", err)
+	}
+	// The compression threshold is kept unless given
+	if !compression_threshold_set {
+		compression_threshold = registryConfig.QueryLimits.GetCompressionThreshold()
+	}
+	registryConfig.QueryLimits = &QueryLimits{MaxResults: uint32(max_results), MaxBytes: max_bytes, CompressionThreshold: compression_threshold}
+	return ac.putRegistryConfig(registryConfig)
+}
+
+// checkQueryKeyCount returns an error if a bulk get of key_count keys exceeds max_results.
+func (ac *assetContext) checkQueryKeyCount(key_count int) error {
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return err
+	}
+	if uint64(key_count) > uint64(queryLimits.MaxResults) {
+		return fmt.Errorf("%d keys requested, the limit is %d", key_count, queryLimits.MaxResults)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+)
+
+// enforceWriteRateLimit counts a write by the caller against the configured RateLimit and
+// returns an error once the caller exceeds it. Counters are kept per normalized identity in
+// fixed windows that start at the identity's first write after the previous window ended,
+// measured by transaction timestamps. No counter is kept while no limit is configured, or
+// while the quotas_disabled FeatureFlag is set.
+//
+// Every limited write also writes the caller's counter, so concurrent writes by the same
+// identity within a block will conflict at validation.
+func (ac *assetContext) enforceWriteRateLimit() error {
+	if ac.featureFlags.GetQuotasDisabled() {
+		return nil
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	rateLimit := registryConfig.WriteRateLimit
+	if rateLimit == nil || rateLimit.MaxWrites == 0 {
+		return nil
+	}
+
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return err
+	}
+
+	rateCounter := &RateCounter{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_RATE_COUNTER_OBJECTTYPE, []string{ac.identity}, rateCounter); err != nil {
+		return err
+	}
+	if now >= rateCounter.WindowStart+rateLimit.WindowSeconds {
+		rateCounter = &RateCounter{WindowStart: now}
+	}
+	if rateCounter.Count >= rateLimit.MaxWrites {
+		return fmt.Errorf("Write rate limit of %d writes per %d seconds exceeded, retry after %d", rateLimit.MaxWrites, rateLimit.WindowSeconds, rateCounter.WindowStart+rateLimit.WindowSeconds)
+	}
+	rateCounter.Count++
+
+	if _, err := ac.putAsset(COMPOSITE_KEY_RATE_COUNTER_OBJECTTYPE, []string{ac.identity}, rateCounter); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A publisher preparing to go live asks getReadiness what still stands in the way. Where
+// getBundleGates reports why each association gate is closed, getReadiness also enumerates
+// everything that keeps a closed gate closed: each placed hold, be it a pending approval or an
+// open advisory, each scanner whose scan has not passed, each broken PolicyRule, and in a
+// STRICT namespace the missing owner signatures and compliance attestations. A gate without
+// blockers of its own reports its failure as its only Blocker. The gates are evaluated as
+// associateDescriptorWithBundle evaluates them, so a ReadinessReport without blockers means the
+// AppBundle may be associated.
+
+func (ac *assetContext) holdBlockers(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) ([]*ReadinessReport_Blocker, error) {
+	bundleGates, err := ac.getBundleGatesAsset(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, err
+	}
+	var blockers []*ReadinessReport_Blocker
+	for _, hold := range bundleGates.Holds {
+		blockers = append(blockers, &ReadinessReport_Blocker{Gate: "holds", Subject: hold.Name, Reason: hold.Reason})
+	}
+	return blockers, nil
+}
+
+// scanBlockers returns a Blocker for each scanner of the ScanPolicy that has not passed the
+// AppBundle, when too few have.
+func (ac *assetContext) scanBlockers(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) ([]*ReadinessReport_Blocker, error) {
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, err
+	}
+	var blockers []*ReadinessReport_Blocker
+	for _, scanner := range registryConfig.ScanPolicy.GetScanners() {
+		scanResult := &ScanResult{}
+		found, err := ac.getAsset(COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part, scanner.ScannerId}, scanResult)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !found:
+			blockers = append(blockers, &ReadinessReport_Blocker{Gate: "scan", Subject: scanner.ScannerId, Reason: "no ScanResult has been recorded"})
+		case scanResult.Verdict != ScanResult_PASS:
+			blockers = append(blockers, &ReadinessReport_Blocker{Gate: "scan", Subject: scanner.ScannerId, Reason: fmt.Sprintf("the verdict is %s", scanResult.Verdict)})
+		}
+	}
+	return blockers, nil
+}
+
+func (ac *assetContext) policyBlockers(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) ([]*ReadinessReport_Blocker, error) {
+	breaches, err := ac.policyBreaches(appBundle, app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, err
+	}
+	var blockers []*ReadinessReport_Blocker
+	for _, breach := range breaches {
+		blockers = append(blockers, &ReadinessReport_Blocker{Gate: "policy", Subject: breach.rule, Reason: breach.failure})
+	}
+	return blockers, nil
+}
+
+// strictBlockers returns the signatures and attestations checkStrictAssociation finds missing,
+// both of them if need be.
+func (ac *assetContext) strictBlockers(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) ([]*ReadinessReport_Blocker, error) {
+	if strict, err := ac.isStrict(); err != nil || !strict {
+		return nil, err
+	}
+	var blockers []*ReadinessReport_Blocker
+	if err := verifyOwnerEndorsements(appBundle); err != nil {
+		blockers = append(blockers, &ReadinessReport_Blocker{Gate: "strict", Subject: "signatures", Reason: err.Error()})
+	}
+	attested, err := ac.hasAssetsUnder(COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE, app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, err
+	}
+	if !attested {
+		blockers = append(blockers, &ReadinessReport_Blocker{Gate: "strict", Subject: "attestations", Reason: "the AppBundle has no ComplianceAttestation"})
+	}
+	return blockers, nil
+}
+
+// newestBundleKey returns the key of the AppBundle of the descriptor created last, the greatest
+// key among those created in the same second.
+func (ac *assetContext) newestBundleKey(app_descriptor_key_part string) (string, error) {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return "", fmt.Errorf("Error reading the AppBundles of AppDescriptor %s: %s", app_descriptor_key_part, err)
+	}
+	defer stateQueryIterator.Close()
+
+	newest_key := ""
+	var newest_created_at int64
+	for stateQueryIterator.HasNext() {
+		queryResult, err := stateQueryIterator.Next()
+		if err != nil {
+			return "", fmt.Errorf("Error reading the AppBundles of AppDescriptor %s: %s", app_descriptor_key_part, err)
+		}
+		_, key_parts, err := ac.stub.SplitCompositeKey(queryResult.Key)
+		if err != nil {
+			return "", err
+		}
+		decoded, err := decodeStored(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, queryResult.Value)
+		if err != nil {
+			return "", err
+		}
+		appBundle := &AppBundle{}
+		if err := proto.Unmarshal(decoded, appBundle); err != nil {
+			return "", fmt.Errorf("Cannot unmarshal AppBundle %v: %s", key_parts, err)
+		}
+		if len(newest_key) == 0 || appBundle.CreatedAt >= newest_created_at {
+			newest_key = key_parts[len(key_parts)-1]
+			newest_created_at = appBundle.CreatedAt
+		}
+	}
+	if len(newest_key) == 0 {
+		return "", fmt.Errorf("AppDescriptor %s has no AppBundle", app_descriptor_key_part)
+	}
+	return newest_key, nil
+}
+
+// getReadiness evaluates an AppBundle of a descriptor for going live: the one named, else the
+// associated one, else the newest.
+func (ac *assetContext) getReadiness() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_bundle_key_part = string(args[2])
+		fallthrough
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getReadiness")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getReadiness: %s", err)
+	}
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getReadiness: %s", err)
+	}
+	if len(app_bundle_key_part) == 0 {
+		app_bundle_key_part = appDescriptor.BundleId
+	}
+	if len(app_bundle_key_part) == 0 {
+		if app_bundle_key_part, err = ac.newestBundleKey(app_descriptor_key_part); err != nil {
+			return nil, fmt.Errorf("Error in getReadiness: %s", err)
+		}
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getReadiness: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getReadiness: %s", err)
+	}
+
+	association := *ac
+	association.function = "associateDescriptorWithBundle"
+	readinessReport := &ReadinessReport{DescriptorId: app_descriptor_key_part, BundleKey: app_bundle_key_part, Associated: appDescriptor.BundleId == app_bundle_key_part, Ready: true}
+	for _, gate := range associationGates {
+		entry := &GateReport_Gate{Name: gate.name, Passed: true}
+		readinessReport.Gates = append(readinessReport.Gates, entry)
+		failure := gate.check(&association, app_descriptor_key_part, app_bundle_key_part, appBundle)
+		if failure == nil {
+			continue
+		}
+		entry.Passed = false
+		entry.Failure = failure.Error()
+		readinessReport.Ready = false
+
+		var blockers []*ReadinessReport_Blocker
+		if gate.blockers != nil {
+			if blockers, err = gate.blockers(&association, app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+				return nil, fmt.Errorf("Error in getReadiness, gate %s: %s", gate.name, err)
+			}
+		}
+		if len(blockers) == 0 {
+			blockers = []*ReadinessReport_Blocker{{Gate: gate.name, Reason: failure.Error()}}
+		}
+		readinessReport.Blockers = append(readinessReport.Blockers, blockers...)
+	}
+	readinessReportBytes, err := proto.Marshal(readinessReport)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ReadinessReport in getReadiness: %s", err)
+	}
+	return readinessReportBytes, nil
+}
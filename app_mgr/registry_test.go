@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/msp"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// testRegistry is an initialized registry on a MockStub, invoked by the identity in Creator.
+// The identity that initializes it is an admin.
+type testRegistry struct {
+	*shim.MockStub
+	tx_count int
+}
+
+// testIdentity returns the serialized identity of name in Org1MSP.
+func testIdentity(t *testing.T, name string) []byte {
+	identity, err := proto.Marshal(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte(name)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return identity
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	s := &testRegistry{MockStub: shim.NewMockStub("appmgr", new(AssetRegistry))}
+	s.Creator = testIdentity(t, "registry-admin")
+	if response := s.MockInit("init", [][]byte{}); response.Status != shim.OK {
+		t.Fatalf("MockInit: %s", response.Message)
+	}
+	return s
+}
+
+// invoke invokes function with args, given as strings, bytes or proto messages.
+func (s *testRegistry) invoke(t *testing.T, function string, args ...interface{}) sc.Response {
+	var invoke_args = [][]byte{[]byte(function)}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			invoke_args = append(invoke_args, []byte(v))
+		case []byte:
+			invoke_args = append(invoke_args, v)
+		case proto.Message:
+			argBytes, err := proto.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			invoke_args = append(invoke_args, argBytes)
+		default:
+			t.Fatalf("cannot pass %T as an argument", arg)
+		}
+	}
+	s.tx_count++
+	return s.MockInvoke(fmt.Sprintf("test-tx-%d", s.tx_count), invoke_args)
+}
+
+// mustInvoke invokes function and returns its payload, failing the test if it fails.
+func (s *testRegistry) mustInvoke(t *testing.T, function string, args ...interface{}) []byte {
+	response := s.invoke(t, function, args...)
+	if response.Status != shim.OK {
+		t.Fatalf("%s %v: %s", function, args, response.Message)
+	}
+	return response.Payload
+}
+
+// mustFail invokes function and returns its error message, failing the test if it succeeds.
+func (s *testRegistry) mustFail(t *testing.T, function string, args ...interface{}) string {
+	response := s.invoke(t, function, args...)
+	if response.Status == shim.OK {
+		t.Fatalf("%s %v succeeded", function, args)
+	}
+	return response.Message
+}
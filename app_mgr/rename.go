@@ -0,0 +1,300 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// renameDescriptor re-keys an AppDescriptor in a single transaction, together with the assets
+// keyed under it: its AppBundles and the bundle-scoped assets listed in renamedObjectTypes,
+// its Sboms, the Annotations of the descriptor and its bundles, and its featured entry. An
+// Alias is left at the old key, and the read functions that take a descriptor key resolve it,
+// so bookmarks, pins, collections and parent pointers holding the old key keep working. Writes
+// must use the new key. Descriptors with private details or confidential bundles cannot be
+// renamed, since their private data is keyed by descriptor too and is only held by some peers.
+// Nor can licensed descriptors, or descriptors with an open Offer or Auction: a License is
+// keyed by descriptor and licensee, the UsageRecords, Invoices and RoyaltyEntries billed
+// against it are keyed by period first, and Offers and Auctions by their own keys, so none of
+// them can be moved with the descriptor, and a License left at the old key would no longer be
+// found by recordUsage, getLicense or checkLicenseGrantable. Closed Offers and Auctions keep the
+// old key, which resolves through the Alias.
+
+// MAX_ALIAS_HOPS bounds the chain of Aliases followed after repeated renames.
+const MAX_ALIAS_HOPS = 8
+
+// descriptorScoped is implemented by the assets keyed under an AppDescriptor that record the
+// descriptor's key.
+type descriptorScoped interface {
+	proto.Message
+	setDescriptorId(descriptor_id string)
+}
+
+func (a *AppBundle) setDescriptorId(descriptor_id string)             { a.DescriptorId = descriptor_id }
+func (a *AccessRequest) setDescriptorId(descriptor_id string)         { a.DescriptorId = descriptor_id }
+func (p *Permission) setDescriptorId(descriptor_id string)            { p.DescriptorId = descriptor_id }
+func (p *Promotion) setDescriptorId(descriptor_id string)             { p.DescriptorId = descriptor_id }
+func (c *ComplianceAttestation) setDescriptorId(descriptor_id string) { c.DescriptorId = descriptor_id }
+func (s *ScanResult) setDescriptorId(descriptor_id string)            { s.DescriptorId = descriptor_id }
+func (r *Rollout) setDescriptorId(descriptor_id string)               { r.DescriptorId = descriptor_id }
+func (w *Watch) setDescriptorId(descriptor_id string)                 { w.DescriptorId = descriptor_id }
+func (g *BundleGates) setDescriptorId(descriptor_id string)           { g.DescriptorId = descriptor_id }
+func (e *ArtifactLicenseException) setDescriptorId(descriptor_id string) {
+	e.DescriptorId = descriptor_id
+}
+
+// renamedObjectTypes returns an empty asset for each namespace whose keys start with the key of
+// the AppDescriptor they belong to.
+var renamedObjectTypes = map[string]func() descriptorScoped{
+	COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE:                 func() descriptorScoped { return &AppBundle{} },
+	COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE:             func() descriptorScoped { return &AccessRequest{} },
+	COMPOSITE_KEY_PERMISSION_OBJECTTYPE:                 func() descriptorScoped { return &Permission{} },
+	COMPOSITE_KEY_PROMOTION_OBJECTTYPE:                  func() descriptorScoped { return &Promotion{} },
+	COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE:     func() descriptorScoped { return &ComplianceAttestation{} },
+	COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE:                func() descriptorScoped { return &ScanResult{} },
+	COMPOSITE_KEY_ARTIFACT_LICENSE_EXCEPTION_OBJECTTYPE: func() descriptorScoped { return &ArtifactLicenseException{} },
+	COMPOSITE_KEY_ROLLOUT_OBJECTTYPE:                    func() descriptorScoped { return &Rollout{} },
+	COMPOSITE_KEY_WATCH_OBJECTTYPE:                      func() descriptorScoped { return &Watch{} },
+	COMPOSITE_KEY_BUNDLE_GATES_OBJECTTYPE:               func() descriptorScoped { return &BundleGates{} },
+}
+
+// resolveDescriptorKey returns the key an AppDescriptor key refers to, following any Aliases.
+func (ac *assetContext) resolveDescriptorKey(key_part string) (string, error) {
+	for hops := 0; hops < MAX_ALIAS_HOPS; hops++ {
+		alias := &Alias{}
+		found, err := ac.getAsset(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{key_part}, alias)
+		if err != nil || !found {
+			return key_part, err
+		}
+		key_part = alias.TargetKey
+	}
+	return "", fmt.Errorf("Too many Aliases resolving AppDescriptor key %s", key_part)
+}
+
+// moveAssets re-keys every asset of objectType under old_key_part to new_key_part.
+func (ac *assetContext) moveAssets(objectType string, old_key_part string, new_key_part string) error {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(objectType, []string{old_key_part})
+	if err != nil {
+		return fmt.Errorf("Error reading %s of %s: %s", objectType, old_key_part, err)
+	}
+	// Collect the assets before changing any, leaving the iterator undisturbed
+	var moved []*queryresult.KV
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			stateQueryIterator.Close()
+			return fmt.Errorf("Error reading %s of %s: %s", objectType, old_key_part, err)
+		}
+		moved = append(moved, kv)
+	}
+	stateQueryIterator.Close()
+
+	for _, kv := range moved {
+		_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return fmt.Errorf("Error splitting composite key %s: %s", kv.Key, err)
+		}
+		asset := renamedObjectTypes[objectType]()
+		if err := proto.Unmarshal(kv.Value, asset); err != nil {
+			return fmt.Errorf("Cannot unmarshal %s %v: %s", objectType, key_parts, err)
+		}
+		asset.setDescriptorId(new_key_part)
+		if err := ac.stub.DelState(kv.Key); err != nil {
+			return fmt.Errorf("Could not delete state for key %s: %s", kv.Key, err)
+		}
+		key_parts[0] = new_key_part
+		if _, err := ac.putAsset(objectType, key_parts, asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveAsset re-keys the asset of objectType at old_key_parts, if any, to new_key_parts.
+func (ac *assetContext) moveAsset(objectType string, old_key_parts []string, new_key_parts []string) error {
+	oldCompositeKey, err := ac.stub.CreateCompositeKey(objectType, old_key_parts)
+	if err != nil {
+		return fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, old_key_parts, err)
+	}
+	value, err := ac.stub.GetState(oldCompositeKey)
+	if err != nil || value == nil {
+		return err
+	}
+	newCompositeKey, err := ac.stub.CreateCompositeKey(objectType, new_key_parts)
+	if err != nil {
+		return fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, new_key_parts, err)
+	}
+	if err := ac.stub.DelState(oldCompositeKey); err != nil {
+		return fmt.Errorf("Could not delete state for key %s: %s", oldCompositeKey, err)
+	}
+	return ac.stub.PutState(newCompositeKey, value)
+}
+
+func (ac *assetContext) renameDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	old_key_part := ""
+	new_key_part := ""
+
+	switch len(args) {
+	case 3:
+		old_key_part = string(args[1])
+		new_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to renameDescriptor")
+	}
+	if len(new_key_part) == 0 || new_key_part == old_key_part {
+		return nil, fmt.Errorf("Error in renameDescriptor: the new key must be non-empty and differ from the old key")
+	}
+
+	appDescriptor, err := ac.getDescriptor(old_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may rename it", old_key_part)
+	}
+	if len(appDescriptor.PrivateCollection) != 0 {
+		return nil, fmt.Errorf("Error in renameDescriptor: AppDescriptor %s has private details and cannot be renamed", old_key_part)
+	}
+	confidential, err := ac.hasAssetsUnder(COMPOSITE_KEY_PRIVATE_BUNDLE_RECORD_OBJECTTYPE, old_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if confidential {
+		return nil, fmt.Errorf("Error in renameDescriptor: AppDescriptor %s has confidential AppBundles and cannot be renamed", old_key_part)
+	}
+	licensed, err := ac.hasAssetsUnder(COMPOSITE_KEY_LICENSE_OBJECTTYPE, old_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if licensed {
+		return nil, fmt.Errorf("Error in renameDescriptor: AppDescriptor %s is licensed and cannot be renamed", old_key_part)
+	}
+	if err := ac.checkNoOpenDeals(old_key_part, COMPOSITE_KEY_AUCTION_OBJECTTYPE, COMPOSITE_KEY_OFFER_OBJECTTYPE); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+
+	// The new key must be free, unless it is an Alias left by renaming this descriptor
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{new_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Error in renameDescriptor: an AppDescriptor already exists at %s", new_key_part)
+	}
+	if err := ac.claimReservation(new_key_part); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	alias := &Alias{}
+	aliased, err := ac.getAsset(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{new_key_part}, alias)
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if aliased && alias.TargetKey != old_key_part {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s is an Alias of AppDescriptor %s", new_key_part, alias.TargetKey)
+	}
+	if aliased {
+		aliasCompositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{new_key_part})
+		if err != nil {
+			return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+		}
+		if err := ac.stub.DelState(aliasCompositeKey); err != nil {
+			return nil, fmt.Errorf("Error in renameDescriptor, could not delete Alias %s: %s", new_key_part, err)
+		}
+	}
+
+	// Moved in key order, so every endorser writes the same set in the same order
+	var objectTypes []string
+	for objectType := range renamedObjectTypes {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	for _, objectType := range objectTypes {
+		if err := ac.moveAssets(objectType, old_key_part, new_key_part); err != nil {
+			return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+		}
+	}
+	if err := ac.moveAsset(COMPOSITE_KEY_FEATURED_OBJECTTYPE, []string{old_key_part}, []string{new_key_part}); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if err := ac.moveSboms(old_key_part, new_key_part); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if err := ac.moveAnnotations(old_key_part, new_key_part); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+
+	oldCompositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{old_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if err := ac.stub.DelState(oldCompositeKey); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor, could not delete AppDescriptor %s: %s", old_key_part, err)
+	}
+	created_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	if _, err := ac.putAsset(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{old_key_part}, &Alias{TargetKey: new_key_part, CreatedBy: ac.creator, CreatedAt: created_at}); err != nil {
+		return nil, fmt.Errorf("Error in renameDescriptor: %s", err)
+	}
+	return ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{new_key_part}, appDescriptor)
+}
+
+// hasAssetsUnder reports whether any asset of objectType is keyed under key_parts.
+func (ac *assetContext) hasAssetsUnder(objectType string, key_parts ...string) (bool, error) {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(objectType, key_parts)
+	if err != nil {
+		return false, fmt.Errorf("Error reading %s of %v: %s", objectType, key_parts, err)
+	}
+	defer stateQueryIterator.Close()
+	return stateQueryIterator.HasNext(), nil
+}
+
+// checkNoOpenDeals fails if an open asset of objectTypes, Auctions or Offers, is for the
+// AppDescriptor at key_part.
+func (ac *assetContext) checkNoOpenDeals(key_part string, objectTypes ...string) error {
+	for _, objectType := range objectTypes {
+		stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(objectType, []string{})
+		if err != nil {
+			return fmt.Errorf("Error reading %s: %s", objectType, err)
+		}
+		for stateQueryIterator.HasNext() {
+			kv, err := stateQueryIterator.Next()
+			if err != nil {
+				stateQueryIterator.Close()
+				return fmt.Errorf("Error reading %s: %s", objectType, err)
+			}
+			open := false
+			descriptor_id := ""
+			if objectType == COMPOSITE_KEY_AUCTION_OBJECTTYPE {
+				auction := &Auction{}
+				err = proto.Unmarshal(kv.Value, auction)
+				open, descriptor_id = auction.Status == Auction_OPEN, auction.DescriptorId
+			} else {
+				offer := &Offer{}
+				err = proto.Unmarshal(kv.Value, offer)
+				open, descriptor_id = offer.Status == Offer_OPEN, offer.DescriptorId
+			}
+			if err != nil {
+				stateQueryIterator.Close()
+				return fmt.Errorf("Cannot unmarshal %s %s: %s", objectType, kv.Key, err)
+			}
+			if open && descriptor_id == key_part {
+				stateQueryIterator.Close()
+				return fmt.Errorf("AppDescriptor %s has an open %s", key_part, objectType)
+			}
+		}
+		stateQueryIterator.Close()
+	}
+	return nil
+}
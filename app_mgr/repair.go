@@ -0,0 +1,171 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Repairs fix the inconsistencies reported by checkIntegrity on-chain, so operators never need
+// to edit state by hand. Each repair is an admin-only transaction that changes a single asset
+// and stores a RepairRecord holding the asset before and after, who repaired it and why, under
+// the repair's transaction ID. A reason is required, since the record is all that explains a
+// change made outside the usual functions.
+
+// recordRepair stores the RepairRecord of a repair of the asset of namespace under key_parts.
+func (ac *assetContext) recordRepair(namespace string, key_parts []string, reason string, before []byte, after []byte) ([]byte, error) {
+	repaired_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	repairRecord := &RepairRecord{
+		Function:        ac.function,
+		Namespace:       namespace,
+		KeyParts:        key_parts,
+		Reason:          reason,
+		Before:          before,
+		After:           after,
+		RepairedBy:      ac.creator,
+		RepairedByMspId: ac.mspId,
+		RepairedAt:      repaired_at,
+		TraceId:         ac.traceId,
+	}
+	return ac.putAsset(COMPOSITE_KEY_REPAIR_OBJECTTYPE, []string{ac.stub.GetTxID()}, repairRecord)
+}
+
+// repairDescriptorBundlePointer repoints the broken bundle_id of an AppDescriptor at one of its
+// existing AppBundles, or clears it if <app_bundle_key> is empty.
+func (ac *assetContext) repairDescriptorBundlePointer() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	reason := ""
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		reason = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to repairDescriptorBundlePointer")
+	}
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: a reason is required")
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: %s", err)
+	}
+	if len(appDescriptor.BundleId) == 0 {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: AppDescriptor %s has no bundle_id to repair", app_descriptor_key_part)
+	}
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, appDescriptor.BundleId})
+	if err != nil {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: the bundle_id %s of AppDescriptor %s is not broken, use associateDescriptorWithBundle", appDescriptor.BundleId, app_descriptor_key_part)
+	}
+	if len(app_bundle_key_part) != 0 {
+		if _, err := ac.getAppBundleForDescriptorByKey(app_descriptor_key_part, app_bundle_key_part); err != nil {
+			return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: %s", err)
+		}
+	}
+
+	before, err := marshalDeterministic(appDescriptor)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling proto: %s", err)
+	}
+	appDescriptor.BundleId = app_bundle_key_part
+	after, err := ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor)
+	if err != nil {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: %s", err)
+	}
+	repairRecordBytes, err := ac.recordRepair(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, reason, before, after)
+	if err != nil {
+		return nil, fmt.Errorf("Error in repairDescriptorBundlePointer: %s", err)
+	}
+	return repairRecordBytes, nil
+}
+
+// rebuildIndexEntry recomputes the owner_id of an asset, the field the owner indexes are built
+// on, and rewrites the asset so its storage encoding carries the indexed fields again.
+func (ac *assetContext) rebuildIndexEntry() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 4 {
+		return nil, fmt.Errorf("Wrong number of arguments to rebuildIndexEntry")
+	}
+	namespace := string(args[1])
+	reason := string(args[2])
+	var key_parts []string
+	for _, arg := range args[3:] {
+		key_parts = append(key_parts, string(arg))
+	}
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: a reason is required")
+	}
+
+	newAsset, ok := backfillAssetTypes[namespace]
+	if !ok {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: namespace %s has no index entries", namespace)
+	}
+	asset := newAsset()
+	found, err := ac.getAsset(namespace, key_parts, asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: %s %v not found", namespace, key_parts)
+	}
+	before, err := marshalDeterministic(asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshaling proto: %s", err)
+	}
+
+	switch a := asset.(type) {
+	case *AppDescriptor:
+		a.OwnerId = normalizeIdentity(a.Owner)
+	case *AppBundle:
+		a.OwnerId = normalizeIdentity(a.Owner)
+	case *Collection:
+		a.OwnerId = normalizeIdentity(a.Owner)
+	}
+	after, err := ac.putAsset(namespace, key_parts, asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: %s", err)
+	}
+	repairRecordBytes, err := ac.recordRepair(namespace, key_parts, reason, before, after)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rebuildIndexEntry: %s", err)
+	}
+	return repairRecordBytes, nil
+}
+
+func (ac *assetContext) getRepairRecord() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	tx_id := ""
+
+	switch len(args) {
+	case 2:
+		tx_id = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getRepairRecord")
+	}
+
+	repairRecord := &RepairRecord{}
+	found, err := ac.getAsset(COMPOSITE_KEY_REPAIR_OBJECTTYPE, []string{tx_id}, repairRecord)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getRepairRecord: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getRepairRecord, RepairRecord not found for tx_id %s", tx_id)
+	}
+	return proto.Marshal(repairRecord)
+}
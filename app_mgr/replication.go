@@ -0,0 +1,319 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Assets published on the registry channel can be mirrored to application channels by a
+// client relay: the owner exports an AssetEnvelope, signs it with the key of the identity
+// recorded in it, and submits the SignedAssetEnvelope to importAssetFromChannel on the
+// target channel, which verifies the signature before writing the asset. The exporter's
+// certificate must chain to the replication_trust_roots configured for its MSP, the CAs of the
+// source channel's MSP, since the target channel's MSPs need not include it; and the asset
+// must pass the validation it would have passed had the exporter created it on the target
+// channel, under the target's ValidationProfiles and PolicyRules. Mirrored assets keep their
+// source created_at. A mirrored descriptor drops its bundle_id and environment_bundle_ids, as
+// a created one has none, and a mirrored bundle its signature_chain, as a created one is its
+// own original publication. A descriptor with private details cannot be mirrored, since the
+// details are held in a collection of the source channel.
+
+// replicableObjectTypes maps the object types that may be mirrored to a constructor for their
+// asset message.
+var replicableObjectTypes = map[Query_ObjectType]func() proto.Message{
+	Query_APP_DESCRIPTOR: func() proto.Message { return &AppDescriptor{} },
+	Query_APP_BUNDLE:     func() proto.Message { return &AppBundle{} },
+	Query_COLLECTION:     func() proto.Message { return &Collection{} },
+}
+
+type ownedAsset interface {
+	proto.Message
+	GetOwner() []byte
+}
+
+func (ac *assetContext) exportAssetForChannel() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 3 {
+		return nil, fmt.Errorf("Wrong number of arguments to exportAssetForChannel")
+	}
+	object_type_name := string(args[1])
+	var key_parts []string
+	for _, arg := range args[2:] {
+		key_parts = append(key_parts, string(arg))
+	}
+
+	object_type_value, ok := Query_ObjectType_value[object_type_name]
+	newAsset, replicable := replicableObjectTypes[Query_ObjectType(object_type_value)]
+	if !ok || !replicable {
+		return nil, fmt.Errorf("Error in exportAssetForChannel, object_type '%s' cannot be exported", object_type_name)
+	}
+	object_type := Query_ObjectType(object_type_value)
+
+	asset := newAsset().(ownedAsset)
+	found, err := ac.getAsset(object_type.String(), key_parts, asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error in exportAssetForChannel: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in exportAssetForChannel, %s not found for key_parts (%v)", object_type, key_parts)
+	}
+	if !bytes.Equal(asset.GetOwner(), ac.creator) {
+		return nil, fmt.Errorf("Only the owner of an asset may export it")
+	}
+
+	value, err := marshalDeterministic(asset)
+	if err != nil {
+		return nil, fmt.Errorf("Error in exportAssetForChannel, error marshaling proto: %s", err)
+	}
+	exported_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in exportAssetForChannel: %s", err)
+	}
+
+	assetEnvelope := &AssetEnvelope{
+		ObjectType:    object_type,
+		KeyParts:      key_parts,
+		Value:         value,
+		SourceChannel: ac.stub.GetChannelID(),
+		SourceTxId:    ac.stub.GetTxID(),
+		ExportedBy:    ac.creator,
+		ExportedAt:    exported_at,
+	}
+	// The envelope is signed as returned, so every endorser must return the same bytes
+	assetEnvelopeBytes, err := marshalDeterministic(assetEnvelope)
+	if err != nil {
+		return nil, fmt.Errorf("Error in exportAssetForChannel, error marshaling proto: %s", err)
+	}
+	return assetEnvelopeBytes, nil
+}
+
+func (ac *assetContext) importAssetFromChannel() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var signedAssetEnvelopeBytes = []byte{}
+
+	switch len(args) {
+	case 2:
+		signedAssetEnvelopeBytes = args[1]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to importAssetFromChannel")
+	}
+
+	signedAssetEnvelope := &SignedAssetEnvelope{}
+	if err := proto.Unmarshal(signedAssetEnvelopeBytes, signedAssetEnvelope); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal SignedAssetEnvelope, err = %s", err)
+	}
+	assetEnvelope := &AssetEnvelope{}
+	if err := proto.Unmarshal(signedAssetEnvelope.Envelope, assetEnvelope); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal AssetEnvelope, err = %s", err)
+	}
+
+	if assetEnvelope.SourceChannel == ac.stub.GetChannelID() {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, cannot import an asset into its source channel %s", assetEnvelope.SourceChannel)
+	}
+	if err := verifyIdentitySignature(assetEnvelope.ExportedBy, signedAssetEnvelope.Envelope, signedAssetEnvelope.Signature); err != nil {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, could not verify origin signature: %s", err)
+	}
+
+	newAsset, replicable := replicableObjectTypes[assetEnvelope.ObjectType]
+	if !replicable {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, object_type '%s' cannot be imported", assetEnvelope.ObjectType)
+	}
+	asset := newAsset().(ownedAsset)
+	if err := proto.Unmarshal(assetEnvelope.Value, asset); err != nil {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, cannot unmarshal %s: %s", assetEnvelope.ObjectType, err)
+	}
+	if !bytes.Equal(asset.GetOwner(), assetEnvelope.ExportedBy) {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, asset was not exported by its owner")
+	}
+
+	if err := ac.verifyExporter(assetEnvelope.ExportedBy); err != nil {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, the exporter is not trusted: %s", err)
+	}
+	key_parts := assetEnvelope.KeyParts
+	if err := checkEnvelopeKeyParts(assetEnvelope.ObjectType, key_parts, asset); err != nil {
+		return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+	}
+
+	found, err := ac.getAsset(assetEnvelope.ObjectType.String(), key_parts, newAsset())
+	if err != nil {
+		return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+	}
+	if found {
+		return nil, fmt.Errorf("Error in importAssetFromChannel, %s already exists for key_parts (%v)", assetEnvelope.ObjectType, key_parts)
+	}
+
+	// The asset is validated as created by the exporter, with the checks of the function that
+	// creates it
+	creation := *ac
+	creation.creator = assetEnvelope.ExportedBy
+	creation.identity = normalizeIdentity(assetEnvelope.ExportedBy)
+	creation.mspId, _ = mspIdFromIdentity(assetEnvelope.ExportedBy)
+	creation.warnings = nil
+	switch a := asset.(type) {
+	case *AppDescriptor:
+		if len(a.PrivateCollection) != 0 {
+			return nil, fmt.Errorf("Error in importAssetFromChannel, AppDescriptor %s has private details and cannot be imported", key_parts[0])
+		}
+		// The promoted bundles are those of the source channel; the bundles mirrored to the
+		// target channel are promoted there
+		a.BundleId = ""
+		a.EnvironmentBundleIds = nil
+		created_at := a.CreatedAt
+		creation.function = "createAppDescriptor"
+		if err := creation.prepareAppDescriptor(key_parts[0], a); err != nil {
+			return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+		}
+		a.CreatedAt = created_at
+	case *AppBundle:
+		// Bundles are mirrored with their owner endorsements and timestamp intact. The
+		// signature_chain is the exporter's word only, see signaturechain.go
+		a.SignatureChain = nil
+		if err := verifyCarriedEndorsements(a); err != nil {
+			return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+		}
+		if err := verifyCarriedTimestampToken(a); err != nil {
+			return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+		}
+		created_at := a.CreatedAt
+		creation.function = "createAppBundle"
+		if _, err := creation.prepareAppBundle(key_parts[1], a); err != nil {
+			return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+		}
+		a.CreatedAt = created_at
+	case *Collection:
+		for _, app_descriptor_key_part := range a.DescriptorKeys {
+			if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+				return nil, fmt.Errorf("Error in importAssetFromChannel: %s", err)
+			}
+		}
+		a.OwnerId = normalizeIdentity(a.Owner)
+	}
+
+	return ac.putAsset(assetEnvelope.ObjectType.String(), key_parts, asset)
+}
+
+// checkEnvelopeKeyParts checks that the key_parts of an AssetEnvelope are those the asset is
+// stored under.
+func checkEnvelopeKeyParts(object_type Query_ObjectType, key_parts []string, asset ownedAsset) error {
+	want := 1
+	if object_type == Query_APP_BUNDLE {
+		want = 2
+	}
+	if len(key_parts) != want {
+		return fmt.Errorf("%s is keyed by %d key_parts, the AssetEnvelope has %d", object_type, want, len(key_parts))
+	}
+	for _, key_part := range key_parts {
+		if len(key_part) == 0 {
+			return fmt.Errorf("the key_parts of the AssetEnvelope must be non-empty")
+		}
+	}
+	if appBundle, ok := asset.(*AppBundle); ok && key_parts[0] != appBundle.DescriptorId {
+		return fmt.Errorf("the AppBundle has descriptor_id %s but the AssetEnvelope keys it under %s", appBundle.DescriptorId, key_parts[0])
+	}
+	return nil
+}
+
+// verifyExporter checks that the certificate of the serialized identity exported_by chains to
+// the replication_trust_roots of its MSP, at the transaction time.
+func (ac *assetContext) verifyExporter(exported_by []byte) error {
+	mspid, err := mspIdFromIdentity(exported_by)
+	if err != nil {
+		return err
+	}
+	cert, err := certificateFromIdentity(exported_by)
+	if err != nil {
+		return err
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	trustRoots := registryConfig.ReplicationTrustRoots[mspid]
+	if len(trustRoots.GetCertificates()) == 0 {
+		return fmt.Errorf("no replication trust roots are configured for MSP %s", mspid)
+	}
+	roots := x509.NewCertPool()
+	for _, certificate := range trustRoots.Certificates {
+		roots.AppendCertsFromPEM(certificate)
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return err
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, CurrentTime: time.Unix(now, 0), KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return fmt.Errorf("the certificate does not chain to the trust roots of MSP %s: %s", mspid, err)
+	}
+	return nil
+}
+
+// validateReplicationTrustRoots checks the replication_trust_roots of a RegistryConfig.
+func validateReplicationTrustRoots(replicationTrustRoots map[string]*RegistryConfig_TrustRoots) error {
+	var mspids []string
+	for mspid := range replicationTrustRoots {
+		mspids = append(mspids, mspid)
+	}
+	sort.Strings(mspids)
+	for _, mspid := range mspids {
+		certificates := replicationTrustRoots[mspid].GetCertificates()
+		if len(mspid) == 0 || len(certificates) == 0 {
+			return fmt.Errorf("replication_trust_roots: MSP '%s' must have a name and certificates", mspid)
+		}
+		for i, certificate := range certificates {
+			if err := validatePEMCertificate(certificate); err != nil {
+				return fmt.Errorf("replication_trust_roots: %s certificates[%d]: %s", mspid, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validatePEMCertificate(certificate []byte) error {
+	block, rest := pem.Decode(certificate)
+	if block == nil || block.Type != "CERTIFICATE" || len(bytes.TrimSpace(rest)) != 0 {
+		return fmt.Errorf("must be a single PEM encoded CERTIFICATE")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return fmt.Errorf("cannot parse certificate: %s", err)
+	}
+	return nil
+}
+
+// setReplicationTrustRoots sets the trust roots of the exporters of <mspid> to the PEM encoded
+// CA certificates passed, or removes them if none are passed.
+func (ac *assetContext) setReplicationTrustRoots() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 2 {
+		return nil, fmt.Errorf("Wrong number of arguments to setReplicationTrustRoots")
+	}
+	mspid := string(args[1])
+	trustRoots := &RegistryConfig_TrustRoots{Certificates: args[2:]}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setReplicationTrustRoots: %s", err)
+	}
+	if len(trustRoots.Certificates) == 0 {
+		delete(registryConfig.ReplicationTrustRoots, mspid)
+	} else {
+		if err := validateReplicationTrustRoots(map[string]*RegistryConfig_TrustRoots{mspid: trustRoots}); err != nil {
+			return nil, fmt.Errorf("Error in setReplicationTrustRoots: %s", err)
+		}
+		if registryConfig.ReplicationTrustRoots == nil {
+			registryConfig.ReplicationTrustRoots = make(map[string]*RegistryConfig_TrustRoots)
+		}
+		registryConfig.ReplicationTrustRoots[mspid] = trustRoots
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// A publisher preparing a coordinated launch reserves the key of its AppDescriptor ahead of
+// time, so no other publisher can take the name while the descriptor is being finished. A
+// Reservation lasts for a ttl of at most MAX_RESERVATION_SECONDS and may be renewed by its
+// holder. While it lasts, only its holder may create an AppDescriptor at the key or rename one
+// to it, which consumes the Reservation. Expiry is measured against transaction timestamps, so
+// an expired Reservation simply stops counting; it is removed when the key is next claimed.
+
+const MAX_RESERVATION_SECONDS = 7 * 24 * 60 * 60
+
+// getReservation returns the Reservation of an AppDescriptor key, and whether it is active.
+func (ac *assetContext) getReservation(key_part string) (*Reservation, bool, error) {
+	reservation := &Reservation{}
+	found, err := ac.getAsset(COMPOSITE_KEY_RESERVATION_OBJECTTYPE, []string{key_part}, reservation)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, false, err
+	}
+	return reservation, now < reservation.ExpiresAt, nil
+}
+
+// claimReservation lets the caller create an AppDescriptor at key_part, unless another
+// identity holds an active Reservation of it, and removes any Reservation of key_part.
+func (ac *assetContext) claimReservation(key_part string) error {
+	reservation, active, err := ac.getReservation(key_part)
+	if err != nil || reservation == nil {
+		return err
+	}
+	if active && !bytes.Equal(reservation.ReservedBy, ac.creator) {
+		return fmt.Errorf("the key %s is reserved by another identity until %d", key_part, reservation.ExpiresAt)
+	}
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_RESERVATION_OBJECTTYPE, []string{key_part})
+	if err != nil {
+		return err
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return fmt.Errorf("could not delete the Reservation of %s: %s", key_part, err)
+	}
+	return nil
+}
+
+func (ac *assetContext) reserveDescriptorKey() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	key_part := ""
+	var ttl int64
+
+	switch len(args) {
+	case 3:
+		key_part = string(args[1])
+		value, err := strconv.ParseInt(string(args[2]), 10, 64)
+		if err != nil || value <= 0 || value > MAX_RESERVATION_SECONDS {
+			return nil, fmt.Errorf("Error in reserveDescriptorKey, ttl must be between 1 and %d seconds", MAX_RESERVATION_SECONDS)
+		}
+		ttl = value
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to reserveDescriptorKey")
+	}
+	if len(key_part) == 0 {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: key must be non-empty")
+	}
+
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: an AppDescriptor already exists at %s", key_part)
+	}
+	aliased, err := ac.keyExists(COMPOSITE_KEY_ALIAS_OBJECTTYPE, []string{key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s", err)
+	}
+	if aliased {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s is the Alias of a renamed AppDescriptor", key_part)
+	}
+	reservation, active, err := ac.getReservation(key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s", err)
+	}
+	if active && !bytes.Equal(reservation.ReservedBy, ac.creator) {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s is reserved by another identity until %d", key_part, reservation.ExpiresAt)
+	}
+
+	reserved_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s", err)
+	}
+	reservationBytes, err := ac.putAsset(COMPOSITE_KEY_RESERVATION_OBJECTTYPE, []string{key_part}, &Reservation{
+		DescriptorId: key_part,
+		ReservedBy:   ac.creator,
+		ReservedAt:   reserved_at,
+		ExpiresAt:    reserved_at + ttl,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error in reserveDescriptorKey: %s", err)
+	}
+	return reservationBytes, nil
+}
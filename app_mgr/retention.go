@@ -0,0 +1,117 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// AppBundles are created HOT, holding their artifacts and deployment specs on-chain. Once the
+// content has been copied off-chain, e.g. to an artifact repository, the admins may demote an
+// AppBundle to COLD, which strips the content from world state and keeps only its recorded
+// digests and the URIs of the external copies, so world state does not grow with every
+// release ever published. demoteToCold first re-hashes the stored content, refusing to strip
+// content that no longer matches the digests recorded when the AppBundle was created. The
+// chaincode cannot fetch the copies itself, so it is given the digest of each copy, as computed
+// by whoever made it, and refuses copies whose digest does not match. That digest is only a
+// claim: nothing on-chain verifies that the external copy exists or holds that content.
+// Readers of a COLD AppBundle fetch its content from the external URIs and check it against
+// the digests.
+
+// MAX_EXTERNAL_URI_LENGTH bounds the external URIs of a COLD AppBundle.
+const MAX_EXTERNAL_URI_LENGTH = 2048
+
+func validateExternalUri(uri string) error {
+	if len(uri) == 0 || len(uri) > MAX_EXTERNAL_URI_LENGTH {
+		return fmt.Errorf("the uri must be between 1 and %d bytes", MAX_EXTERNAL_URI_LENGTH)
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid uri %s: %s", uri, err)
+	}
+	if !parsed.IsAbs() {
+		return fmt.Errorf("the uri %s is not absolute", uri)
+	}
+	return nil
+}
+
+func (ac *assetContext) demoteToCold() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	coldCopies := &ColdCopies{}
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		if err := proto.Unmarshal(args[3], coldCopies); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal ColdCopies, err = %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to demoteToCold")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in demoteToCold: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in demoteToCold: %s", err)
+	}
+	if appBundle.RetentionTier == AppBundle_COLD {
+		return nil, fmt.Errorf("Error in demoteToCold: AppBundle %s is already COLD", app_bundle_key_part)
+	}
+	contents, names := bundleContents(appBundle)
+	if len(appBundle.MerkleRoot) == 0 || len(appBundle.ContentDigests) != len(contents) {
+		return nil, fmt.Errorf("Error in demoteToCold: AppBundle %s has no recorded content digests, backfill its merkle_root first", app_bundle_key_part)
+	}
+	if !bytes.Equal(merkleRoot(appBundle.ContentDigests), appBundle.MerkleRoot) {
+		return nil, fmt.Errorf("Error in demoteToCold: the content digests of AppBundle %s do not match its merkle_root", app_bundle_key_part)
+	}
+	// The recorded digests are what readers check the external copies against, so the content
+	// they were computed over must still be the content being stripped
+	for i, digest := range contentDigests(contents) {
+		if !bytes.Equal(digest, appBundle.ContentDigests[i]) {
+			return nil, fmt.Errorf("Error in demoteToCold: the stored %s of AppBundle %s does not match its recorded digest, see verifyBundleIntegrity", names[i], app_bundle_key_part)
+		}
+	}
+	if len(coldCopies.Copies) != len(contents) {
+		return nil, fmt.Errorf("Error in demoteToCold: %d copies are given for the %d artifacts and deployment specs of AppBundle %s", len(coldCopies.Copies), len(contents), app_bundle_key_part)
+	}
+
+	var external_uris []string
+	for i, coldCopy := range coldCopies.Copies {
+		if err := validateExternalUri(coldCopy.Uri); err != nil {
+			return nil, fmt.Errorf("Error in demoteToCold, copies[%d]: %s", i, err)
+		}
+		if !bytes.Equal(coldCopy.Digest, appBundle.ContentDigests[i]) {
+			return nil, fmt.Errorf("Error in demoteToCold: the copy of %s at %s does not match its recorded digest", names[i], coldCopy.Uri)
+		}
+		external_uris = append(external_uris, coldCopy.Uri)
+	}
+
+	// The slots are kept, so the content keeps its names
+	for i := range appBundle.Artifacts {
+		appBundle.Artifacts[i] = []byte{}
+	}
+	for i := range appBundle.ChaincodeDeploymentSpecs {
+		appBundle.ChaincodeDeploymentSpecs[i] = []byte{}
+	}
+	appBundle.RetentionTier = AppBundle_COLD
+	appBundle.ExternalUris = external_uris
+	appBundleBytes, err := ac.putAsset(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, appBundle)
+	if err != nil {
+		return nil, fmt.Errorf("Error in demoteToCold: %s", err)
+	}
+	return appBundleBytes, nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Rich queries find assets by field using CouchDB selector queries against the JSON documents
+// written when the storage encoding is JSON or PROTO_AND_JSON (see encoding.go). Each query
+// names its index in use_index, and the index definitions ship with the chaincode in
+// META-INF/statedb/couchdb/indexes. The owner and status queries are served by indexes on
+// doc_type and the field. A json index cannot serve $elemMatch on an array, so the tag query
+// uses the index on doc_type alone: CouchDB reads the AppDescriptor documents, but no others,
+// and filters them by tag.
+// Results are paginated, page_size being capped by the QueryLimits; selector queries are only
+// supported in read-only transactions.
+//
+// LevelDB does not support selector queries. There the functions fall back to scanning page_size
+// keys of the namespace and filtering them in the chaincode, setting RichQueryResult.scanned, so
+// a page may hold fewer entries than page_size while more results follow. The fallback also
+// finds assets stored as proto bytes.
+
+// richQuery is a selector query on one field of the documents of objectType, using the index
+// named index.
+type richQuery struct {
+	objectType string
+	index      string
+	field      string
+	selector   interface{}                    // The selector on field
+	match      func(asset proto.Message) bool // The equivalent filter, for the LevelDB fallback
+}
+
+func (q *richQuery) queryString() (string, error) {
+	query := map[string]interface{}{
+		"selector": map[string]interface{}{
+			JSON_DOCUMENT_TYPE_FIELD: q.objectType,
+			q.field:                  q.selector,
+		},
+		"use_index": []string{"_design/" + q.index + "Doc", q.index},
+	}
+	queryBytes, err := json.Marshal(query)
+	if err != nil {
+		return "", fmt.Errorf("Error marshaling query: %s", err)
+	}
+	return string(queryBytes), nil
+}
+
+// parsePageArgs parses the <page_size> and optional <bookmark> arguments of a rich query.
+func parsePageArgs(args [][]byte) (int32, string, error) {
+	var bookmark = ""
+	switch len(args) {
+	case 2:
+		bookmark = string(args[1])
+		fallthrough
+	case 1:
+		page_size, err := strconv.ParseInt(string(args[0]), 10, 32)
+		if err != nil || page_size <= 0 {
+			return 0, "", fmt.Errorf("Invalid page_size %s", args[0])
+		}
+		return int32(page_size), bookmark, nil
+	}
+	return 0, "", fmt.Errorf("Wrong number of page arguments")
+}
+
+// isSelectorQueryUnsupported reports whether err is the peer's error for a selector query
+// against LevelDB.
+func isSelectorQueryUnsupported(err error) bool {
+	return strings.Contains(err.Error(), "not supported for leveldb")
+}
+
+func (ac *assetContext) runRichQuery(q *richQuery, page_size int32, bookmark string) ([]byte, error) {
+	queryString, err := q.queryString()
+	if err != nil {
+		return nil, err
+	}
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, err
+	}
+	if uint32(page_size) > queryLimits.MaxResults {
+		page_size = int32(queryLimits.MaxResults)
+	}
+
+	mine_only, err := ac.listsMineOnly()
+	if err != nil {
+		return nil, err
+	}
+
+	richQueryResult := &RichQueryResult{}
+	stateQueryIterator, queryResponseMetadata, err := ac.stub.GetQueryResultWithPagination(queryString, page_size, bookmark)
+	if err != nil && isSelectorQueryUnsupported(err) {
+		richQueryResult.Scanned = true
+		stateQueryIterator, queryResponseMetadata, err = ac.stub.GetStateByPartialCompositeKeyWithPagination(q.objectType, []string{}, page_size, bookmark)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error in rich query %s: %s", queryString, err)
+	}
+	defer stateQueryIterator.Close()
+
+	var result_bytes uint64
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in rich query %s: %s", queryString, err)
+		}
+		// A page cannot be resumed part way through, so an oversized page fails
+		if result_bytes += uint64(len(kv.Key) + len(kv.Value)); result_bytes > queryLimits.MaxBytes {
+			return nil, fmt.Errorf("Error in rich query %s: results exceed max_bytes, use a smaller page_size", queryString)
+		}
+		// Documents written in PROTO_AND_JSON mode are under the companion key
+		_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("Error in rich query, could not split composite key %s: %s", kv.Key, err)
+		}
+		value, err := decodeStored(q.objectType, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		asset := jsonDocumentTypes[q.objectType]()
+		if err := proto.Unmarshal(value, asset); err != nil {
+			return nil, fmt.Errorf("Cannot unmarshal %s %s: %s", q.objectType, kv.Key, err)
+		}
+		if richQueryResult.Scanned && !q.match(asset) {
+			continue
+		}
+		if mine_only {
+			mine, err := ac.isMine(q.objectType, key_parts, asset)
+			if err != nil {
+				return nil, fmt.Errorf("Error in rich query %s: %s", queryString, err)
+			}
+			if !mine {
+				continue
+			}
+		}
+		if accessRequest, ok := asset.(*AccessRequest); ok {
+			readable, err := ac.canReadAccessRequest(key_parts, accessRequest)
+			if err != nil {
+				return nil, fmt.Errorf("Error in rich query %s: %s", queryString, err)
+			}
+			if !readable {
+				continue
+			}
+		}
+
+		entry := &BulkGetResult_Entry{KeyParts: key_parts, Found: true, Value: value, Hash: contentHash(value)}
+		if appBundle, ok := asset.(*AppBundle); ok && len(key_parts) == 2 {
+			if err := ac.checkBundleReadAccess(key_parts[0], key_parts[1], appBundle); err != nil {
+				entry.Value = nil
+				entry.Hash = nil
+				entry.Error = err.Error()
+			}
+		}
+		richQueryResult.Entries = append(richQueryResult.Entries, entry)
+	}
+	richQueryResult.Bookmark = queryResponseMetadata.Bookmark
+	richQueryResult.FetchedRecordsCount = queryResponseMetadata.FetchedRecordsCount
+
+	richQueryResultBytes, err := proto.Marshal(richQueryResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RichQueryResult: %s", err)
+	}
+	return richQueryResultBytes, nil
+}
+
+type ownerIdentified interface {
+	GetOwnerId() string
+}
+
+func (ac *assetContext) queryAssetsByOwner() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	owner_id := ""
+
+	switch len(args) {
+	case 4, 5:
+		namespace = string(args[1])
+		owner_id = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to queryAssetsByOwner")
+	}
+	page_size, bookmark, err := parsePageArgs(args[3:])
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryAssetsByOwner: %s", err)
+	}
+	if _, ok := jsonIndexedFields[namespace]["owner_id"]; !ok {
+		return nil, fmt.Errorf("Error in queryAssetsByOwner: namespace %s has no owner_id", namespace)
+	}
+
+	q := &richQuery{
+		objectType: namespace,
+		index:      "indexOwner",
+		field:      "owner_id",
+		selector:   owner_id,
+		match: func(asset proto.Message) bool {
+			return asset.(ownerIdentified).GetOwnerId() == owner_id
+		},
+	}
+	result, err := ac.runRichQuery(q, page_size, bookmark)
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryAssetsByOwner: %s", err)
+	}
+	return result, nil
+}
+
+func (ac *assetContext) queryAccessRequestsByStatus() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var status AccessRequest_Status
+
+	switch len(args) {
+	case 3, 4:
+		value, ok := AccessRequest_Status_value[string(args[1])]
+		if !ok {
+			return nil, fmt.Errorf("Error in queryAccessRequestsByStatus, invalid status %s", args[1])
+		}
+		status = AccessRequest_Status(value)
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to queryAccessRequestsByStatus")
+	}
+	page_size, bookmark, err := parsePageArgs(args[2:])
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryAccessRequestsByStatus: %s", err)
+	}
+
+	q := &richQuery{
+		objectType: COMPOSITE_KEY_ACCESS_REQUEST_OBJECTTYPE,
+		index:      "indexStatus",
+		field:      "status",
+		// Documents hold the status name; ones written in the encoding/json form its number
+		selector: map[string]interface{}{"$in": []interface{}{status.String(), int32(status)}},
+		match: func(asset proto.Message) bool {
+			return asset.(*AccessRequest).Status == status
+		},
+	}
+	result, err := ac.runRichQuery(q, page_size, bookmark)
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryAccessRequestsByStatus: %s", err)
+	}
+	return result, nil
+}
+
+func (ac *assetContext) queryDescriptorsByTag() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	tag := ""
+
+	switch len(args) {
+	case 3, 4:
+		tag = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to queryDescriptorsByTag")
+	}
+	page_size, bookmark, err := parsePageArgs(args[2:])
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryDescriptorsByTag: %s", err)
+	}
+
+	q := &richQuery{
+		objectType: COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE,
+		index:      "indexDocType",
+		field:      "tags",
+		selector:   map[string]interface{}{"$elemMatch": map[string]interface{}{"$eq": tag}},
+		match: func(asset proto.Message) bool {
+			for _, descriptor_tag := range asset.(*AppDescriptor).Tags {
+				if descriptor_tag == tag {
+					return true
+				}
+			}
+			return false
+		},
+	}
+	result, err := ac.runRichQuery(q, page_size, bookmark)
+	if err != nil {
+		return nil, fmt.Errorf("Error in queryDescriptorsByTag: %s", err)
+	}
+	return result, nil
+}
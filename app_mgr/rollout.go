@@ -0,0 +1,257 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A descriptor owner rolls an AppBundle out to consumers in stages, each a cohort given as a
+// percentage of consumers or as named organizations, recorded as a Rollout keyed by descriptor
+// and bundle. advanceRollout starts the stages in order and completes the rollout after the
+// last one; haltRollout stops it until advanceRollout resumes it. Each change of status is
+// appended to the Rollout's updates, so the history of a rollout can be read on-chain. The
+// registry only records the plan and its progress: consumers and their tooling decide which
+// cohort they belong to.
+
+// validateRolloutStages checks the stages of a new Rollout.
+func validateRolloutStages(stages []*Rollout_Stage) error {
+	if len(stages) == 0 {
+		return fmt.Errorf("a Rollout must have at least 1 stage")
+	}
+	names := make(map[string]bool)
+	var percentage uint32
+	for i, stage := range stages {
+		if len(stage.Name) == 0 || names[stage.Name] {
+			return fmt.Errorf("stages[%d] must have a unique name", i)
+		}
+		names[stage.Name] = true
+		if stage.StartedAt != 0 {
+			return fmt.Errorf("stages[%d] started_at is set by advanceRollout", i)
+		}
+		switch {
+		case stage.Percentage != 0 && len(stage.MspIds) != 0:
+			return fmt.Errorf("stages[%d] has both a percentage and msp_ids", i)
+		case stage.Percentage != 0:
+			if stage.Percentage <= percentage || stage.Percentage > 100 {
+				return fmt.Errorf("stages[%d] percentage %d must be above %d and at most 100", i, stage.Percentage, percentage)
+			}
+			percentage = stage.Percentage
+		case len(stage.MspIds) == 0:
+			return fmt.Errorf("stages[%d] has neither a percentage nor msp_ids", i)
+		}
+	}
+	return nil
+}
+
+// getOwnedRollout returns the Rollout of an AppBundle if the caller owns its AppDescriptor.
+func (ac *assetContext) getOwnedRollout(app_descriptor_key_part string, app_bundle_key_part string) (*Rollout, error) {
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may change the rollouts of its bundles", app_descriptor_key_part)
+	}
+	rollout := &Rollout{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ROLLOUT_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, rollout)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("AppBundle %s of AppDescriptor %s has no Rollout", app_bundle_key_part, app_descriptor_key_part)
+	}
+	return rollout, nil
+}
+
+// updateRollout appends an update with status to rollout and stores it.
+func (ac *assetContext) updateRollout(rollout *Rollout, status Rollout_Status, stage string, reason string) ([]byte, error) {
+	updated_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	rollout.Status = status
+	rollout.Updates = append(rollout.Updates, &Rollout_Update{
+		Status:    status,
+		Stage:     stage,
+		Reason:    reason,
+		UpdatedBy: ac.creator,
+		UpdatedAt: updated_at,
+	})
+	return ac.putAsset(COMPOSITE_KEY_ROLLOUT_OBJECTTYPE, []string{rollout.DescriptorId, rollout.BundleKey}, rollout)
+}
+
+func (ac *assetContext) createRollout() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	plan := &Rollout{}
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		if err := proto.Unmarshal(args[3], plan); err != nil {
+			return nil, fmt.Errorf("Error in createRollout, cannot unmarshal Rollout: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to createRollout")
+	}
+	if err := validateRolloutStages(plan.Stages); err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may roll out its bundles", app_descriptor_key_part)
+	}
+	if _, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part); err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+	// A finished rollout may be replaced by a new plan, an active one must be halted first
+	existing := &Rollout{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ROLLOUT_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, existing)
+	if err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+	if found && (existing.Status == Rollout_PLANNED || existing.Status == Rollout_IN_PROGRESS) {
+		return nil, fmt.Errorf("Error in createRollout: AppBundle %s already has a %s Rollout", app_bundle_key_part, existing.Status)
+	}
+
+	created_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+	rollout := &Rollout{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		Stages:       plan.Stages,
+		CreatedBy:    ac.creator,
+		CreatedAt:    created_at,
+	}
+	rolloutBytes, err := ac.updateRollout(rollout, Rollout_PLANNED, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error in createRollout: %s", err)
+	}
+	return rolloutBytes, nil
+}
+
+func (ac *assetContext) advanceRollout() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	reason := ""
+
+	switch len(args) {
+	case 4:
+		reason = string(args[3])
+		fallthrough
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to advanceRollout")
+	}
+
+	rollout, err := ac.getOwnedRollout(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in advanceRollout: %s", err)
+	}
+	if rollout.Status == Rollout_COMPLETED {
+		return nil, fmt.Errorf("Error in advanceRollout: the Rollout of AppBundle %s is COMPLETED", app_bundle_key_part)
+	}
+
+	var rolloutBytes []byte
+	if rollout.StartedStages == uint32(len(rollout.Stages)) {
+		rolloutBytes, err = ac.updateRollout(rollout, Rollout_COMPLETED, "", reason)
+	} else {
+		stage := rollout.Stages[rollout.StartedStages]
+		if stage.StartedAt, err = ac.txTimestamp(); err != nil {
+			return nil, fmt.Errorf("Error in advanceRollout: %s", err)
+		}
+		rollout.StartedStages++
+		rolloutBytes, err = ac.updateRollout(rollout, Rollout_IN_PROGRESS, stage.Name, reason)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error in advanceRollout: %s", err)
+	}
+	return rolloutBytes, nil
+}
+
+func (ac *assetContext) haltRollout() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	reason := ""
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		reason = string(args[3])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to haltRollout")
+	}
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("Error in haltRollout: reason must be non-empty")
+	}
+
+	rollout, err := ac.getOwnedRollout(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in haltRollout: %s", err)
+	}
+	if rollout.Status != Rollout_PLANNED && rollout.Status != Rollout_IN_PROGRESS {
+		return nil, fmt.Errorf("Error in haltRollout: the Rollout of AppBundle %s is %s", app_bundle_key_part, rollout.Status)
+	}
+	stage := ""
+	if rollout.StartedStages > 0 {
+		stage = rollout.Stages[rollout.StartedStages-1].Name
+	}
+	rolloutBytes, err := ac.updateRollout(rollout, Rollout_HALTED, stage, reason)
+	if err != nil {
+		return nil, fmt.Errorf("Error in haltRollout: %s", err)
+	}
+	return rolloutBytes, nil
+}
+
+func (ac *assetContext) getRollout() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getRollout")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getRollout: %s", err)
+	}
+	rollout := &Rollout{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ROLLOUT_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, rollout)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getRollout: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getRollout: AppBundle %s of AppDescriptor %s has no Rollout", app_bundle_key_part, app_descriptor_key_part)
+	}
+	rolloutBytes, err := proto.Marshal(rollout)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling Rollout in getRollout: %s", err)
+	}
+	return rolloutBytes, nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Rollups keep state size bounded. Once a month has ended, rollupActivity folds its hourly
+// ActivityReports and its UsageRecords into one DescriptorRollup per descriptor and deletes
+// the raw records, preserving the monthly figures for reporting. UsageRecords are only rolled
+// up once an Invoice covers them, so billing never loses input; the rest are retained.
+//
+// Like backfills, a rollup runs a batch per transaction: an admin calls rollupActivity for
+// the period until the returned RollupProgress is done.
+
+const ROLLUP_BATCH_SIZE = 100
+
+// rollupAccumulator collects the DescriptorRollups touched by a batch.
+type rollupAccumulator struct {
+	ac      *assetContext
+	period  string
+	rollups map[string]*DescriptorRollup
+}
+
+// rollupFor returns the period's DescriptorRollup for a descriptor, loading it on first use.
+func (r *rollupAccumulator) rollupFor(descriptor_id string) (*DescriptorRollup, error) {
+	if rollup, ok := r.rollups[descriptor_id]; ok {
+		return rollup, nil
+	}
+	rollup := &DescriptorRollup{}
+	found, err := r.ac.getAsset(COMPOSITE_KEY_ROLLUP_OBJECTTYPE, []string{r.period, descriptor_id}, rollup)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		rollup = &DescriptorRollup{Period: r.period, DescriptorId: descriptor_id}
+	}
+	r.rollups[descriptor_id] = rollup
+	return rollup, nil
+}
+
+func (r *rollupAccumulator) addActivity(compositeKey string, value []byte) error {
+	_, key_parts, err := r.ac.stub.SplitCompositeKey(compositeKey)
+	if err != nil {
+		return err
+	}
+	activityReport := &ActivityReport{}
+	if err := proto.Unmarshal(value, activityReport); err != nil {
+		return fmt.Errorf("cannot unmarshal ActivityReport %s: %s", compositeKey, err)
+	}
+	rollup, err := r.rollupFor(key_parts[1])
+	if err != nil {
+		return err
+	}
+	switch activityReport.Kind {
+	case ActivityReport_VIEW:
+		rollup.ViewCount++
+	case ActivityReport_DOWNLOAD:
+		rollup.DownloadCount++
+	case ActivityReport_INSTALL:
+		rollup.InstallCount++
+	}
+	return nil
+}
+
+func (r *rollupAccumulator) addUsage(usageRecord *UsageRecord) error {
+	rollup, err := r.rollupFor(usageRecord.DescriptorId)
+	if err != nil {
+		return err
+	}
+	rollup.UsageRecordCount++
+	var tierUsage *DescriptorRollup_TierUsage
+	for _, candidate := range rollup.Usage {
+		if candidate.Tier == usageRecord.Tier && candidate.Unit == usageRecord.Unit {
+			tierUsage = candidate
+		}
+	}
+	if tierUsage == nil {
+		tierUsage = &DescriptorRollup_TierUsage{Tier: usageRecord.Tier, Unit: usageRecord.Unit}
+		rollup.Usage = append(rollup.Usage, tierUsage)
+	}
+	tierUsage.Quantity, err = addUint64(tierUsage.Quantity, usageRecord.Quantity)
+	return err
+}
+
+// store writes the touched DescriptorRollups in descriptor order.
+func (r *rollupAccumulator) store() error {
+	var descriptor_ids []string
+	for descriptor_id := range r.rollups {
+		descriptor_ids = append(descriptor_ids, descriptor_id)
+	}
+	sort.Strings(descriptor_ids)
+	for _, descriptor_id := range descriptor_ids {
+		if _, err := r.ac.putAsset(COMPOSITE_KEY_ROLLUP_OBJECTTYPE, []string{r.period, descriptor_id}, r.rollups[descriptor_id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) rollupActivity() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	period := ""
+
+	switch len(args) {
+	case 2:
+		period = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to rollupActivity")
+	}
+	period_start, err := time.Parse(PERIOD_LAYOUT, period)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rollupActivity, period must be YYYY-MM: %s", err)
+	}
+	period_end := period_start.AddDate(0, 1, 0)
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in rollupActivity: %s", err)
+	}
+	// getTrendingDescriptors may still count the period's last hours
+	if period_end.Add(MAX_TRENDING_WINDOW_HOURS*time.Hour).Unix() > now {
+		return nil, fmt.Errorf("Error in rollupActivity, period %s can be rolled up %d hours after it ends", period, MAX_TRENDING_WINDOW_HOURS)
+	}
+
+	rollupProgress := &RollupProgress{Period: period}
+	if _, err := ac.getAsset(COMPOSITE_KEY_ROLLUP_PROGRESS_OBJECTTYPE, []string{period}, rollupProgress); err != nil {
+		return nil, fmt.Errorf("Error in rollupActivity: %s", err)
+	}
+	if rollupProgress.Done {
+		rollupProgressBytes, err := proto.Marshal(rollupProgress)
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling RollupProgress in rollupActivity: %s", err)
+		}
+		return rollupProgressBytes, nil
+	}
+
+	accumulator := &rollupAccumulator{ac: ac, period: period, rollups: make(map[string]*DescriptorRollup)}
+	hours := uint32(period_end.Sub(period_start) / time.Hour)
+	budget := ROLLUP_BATCH_SIZE
+	for !rollupProgress.ActivityDone && budget > 0 {
+		bucket := period_start.Add(time.Duration(rollupProgress.Hour) * time.Hour).Format(ACTIVITY_BUCKET_LAYOUT)
+		bookmark, done, err := ac.scanBatch(COMPOSITE_KEY_ACTIVITY_OBJECTTYPE, []string{bucket}, rollupProgress.Bookmark, budget, func(compositeKey string, value []byte) error {
+			if err := accumulator.addActivity(compositeKey, value); err != nil {
+				return err
+			}
+			budget--
+			rollupProgress.RolledUpCount++
+			return ac.stub.DelState(compositeKey)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error in rollupActivity: %s", err)
+		}
+		rollupProgress.Bookmark = bookmark
+		if done {
+			rollupProgress.Bookmark = ""
+			rollupProgress.Hour++
+			rollupProgress.ActivityDone = rollupProgress.Hour == hours
+		}
+	}
+
+	if rollupProgress.ActivityDone && !rollupProgress.UsageDone && budget > 0 {
+		bookmark, done, err := ac.scanBatch(COMPOSITE_KEY_USAGE_OBJECTTYPE, []string{period}, rollupProgress.Bookmark, budget, func(compositeKey string, value []byte) error {
+			usageRecord := &UsageRecord{}
+			if err := proto.Unmarshal(value, usageRecord); err != nil {
+				return fmt.Errorf("cannot unmarshal UsageRecord %s: %s", compositeKey, err)
+			}
+			invoiced, err := ac.keyExists(COMPOSITE_KEY_INVOICE_OBJECTTYPE, []string{period, usageRecord.DescriptorId, usageRecord.ConsumerId, usageRecord.CurrencyCode})
+			if err != nil {
+				return err
+			}
+			if !invoiced {
+				rollupProgress.RetainedCount++
+				return nil
+			}
+			if err := accumulator.addUsage(usageRecord); err != nil {
+				return fmt.Errorf("usage of %s: %s", usageRecord.DescriptorId, err)
+			}
+			rollupProgress.RolledUpCount++
+			return ac.stub.DelState(compositeKey)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error in rollupActivity: %s", err)
+		}
+		rollupProgress.Bookmark = bookmark
+		if done {
+			rollupProgress.Bookmark = ""
+			rollupProgress.UsageDone = true
+		}
+	}
+	rollupProgress.Done = rollupProgress.ActivityDone && rollupProgress.UsageDone
+
+	if err := accumulator.store(); err != nil {
+		return nil, fmt.Errorf("Error in rollupActivity: %s", err)
+	}
+	return ac.putAsset(COMPOSITE_KEY_ROLLUP_PROGRESS_OBJECTTYPE, []string{period}, rollupProgress)
+}
+
+func (ac *assetContext) getActivityRollup() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	period := ""
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 3:
+		period = string(args[1])
+		app_descriptor_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getActivityRollup")
+	}
+
+	rollup := &DescriptorRollup{}
+	found, err := ac.getAsset(COMPOSITE_KEY_ROLLUP_OBJECTTYPE, []string{period, app_descriptor_key_part}, rollup)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getActivityRollup: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in getActivityRollup, no rollup of AppDescriptor %s for period %s", app_descriptor_key_part, period)
+	}
+	rollupBytes, err := proto.Marshal(rollup)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling DescriptorRollup in getActivityRollup: %s", err)
+	}
+	return rollupBytes, nil
+}
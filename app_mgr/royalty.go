@@ -0,0 +1,210 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Royalty splits let a descriptor share its revenue, e.g. between the app publisher and the
+// operator of the TCF worker it runs on. Each split is a number of basis points; the owner
+// receives whatever the splits leave. The division of an Invoice is fixed when it is
+// generated, and when it is settled each party is credited a RoyaltyEntry keyed by party,
+// period and invoice, so getRoyaltyStatement pages through a party's month with range queries
+// under the registry's QueryLimits. The totals of the month are kept in a running record under
+// ROYALTY_TOTAL as each Invoice is settled, rather than summed from every entry on each read;
+// settlements crediting the same party in the same period therefore conflict on it, and
+// commit one per block.
+
+// TOTAL_BASIS_POINTS is the whole of the revenue.
+const TOTAL_BASIS_POINTS = 10000
+
+// validateRoyaltySplits checks that each party other than owner appears once and that
+// the splits do not exceed the whole.
+func validateRoyaltySplits(royaltySplits []*RoyaltySplit, owner []byte) error {
+	parties := make(map[string]bool)
+	var total uint32
+	for _, royaltySplit := range royaltySplits {
+		if len(royaltySplit.Party) == 0 {
+			return fmt.Errorf("RoyaltySplit must name a party")
+		}
+		if bytes.Equal(royaltySplit.Party, owner) {
+			return fmt.Errorf("RoyaltySplit cannot name the owner, who receives the remainder")
+		}
+		party_id := normalizeIdentity(royaltySplit.Party)
+		if parties[party_id] {
+			return fmt.Errorf("Duplicate RoyaltySplit for party %s", party_id)
+		}
+		parties[party_id] = true
+		if royaltySplit.BasisPoints == 0 || royaltySplit.BasisPoints > TOTAL_BASIS_POINTS {
+			return fmt.Errorf("RoyaltySplit for party %s must be between 1 and %d basis points", party_id, TOTAL_BASIS_POINTS)
+		}
+		total += royaltySplit.BasisPoints
+	}
+	if total > TOTAL_BASIS_POINTS {
+		return fmt.Errorf("RoyaltySplits total %d basis points, more than %d", total, TOTAL_BASIS_POINTS)
+	}
+	return nil
+}
+
+// computeRoyaltyShares divides amount among the royaltySplits, rounding each share down,
+// and gives the remainder to publisher. Parties whose share rounds to zero are omitted.
+func computeRoyaltyShares(amount uint64, publisher []byte, royaltySplits []*RoyaltySplit) []*RoyaltyShare {
+	var royaltyShares []*RoyaltyShare
+	remainder := amount
+	for _, royaltySplit := range royaltySplits {
+		// Split the multiplication so that it cannot overflow
+		basis_points := uint64(royaltySplit.BasisPoints)
+		share := (amount/TOTAL_BASIS_POINTS)*basis_points + (amount%TOTAL_BASIS_POINTS)*basis_points/TOTAL_BASIS_POINTS
+		if share == 0 {
+			continue
+		}
+		royaltyShares = append(royaltyShares, &RoyaltyShare{Party: royaltySplit.Party, PartyId: normalizeIdentity(royaltySplit.Party), Amount: share})
+		remainder -= share
+	}
+	if remainder != 0 {
+		royaltyShares = append(royaltyShares, &RoyaltyShare{Party: publisher, PartyId: normalizeIdentity(publisher), Amount: remainder})
+	}
+	return royaltyShares
+}
+
+// creditRoyalties records a RoyaltyEntry for each party's share of a settled invoice.
+func (ac *assetContext) creditRoyalties(invoice *Invoice) error {
+	royaltyShares := invoice.RoyaltyShares
+	if len(royaltyShares) == 0 {
+		royaltyShares = computeRoyaltyShares(invoice.Total, invoice.Publisher, nil)
+	}
+	for _, royaltyShare := range royaltyShares {
+		royaltyEntry := &RoyaltyEntry{
+			Period:       invoice.Period,
+			DescriptorId: invoice.DescriptorId,
+			ConsumerId:   invoice.ConsumerId,
+			CurrencyCode: invoice.CurrencyCode,
+			Amount:       royaltyShare.Amount,
+			PaymentRef:   invoice.PaymentRef,
+		}
+		key_parts := []string{royaltyShare.PartyId, invoice.Period, invoice.DescriptorId, invoice.ConsumerId, invoice.CurrencyCode}
+		if _, err := ac.putAsset(COMPOSITE_KEY_ROYALTY_OBJECTTYPE, key_parts, royaltyEntry); err != nil {
+			return err
+		}
+		if err := ac.addRoyaltyTotal(royaltyShare.PartyId, royaltyEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addRoyaltyTotal adds royaltyEntry to the running totals of its party and period.
+func (ac *assetContext) addRoyaltyTotal(party_id string, royaltyEntry *RoyaltyEntry) error {
+	key_parts := []string{party_id, royaltyEntry.Period}
+	royaltyTotals := &RoyaltyStatement{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_ROYALTY_TOTAL_OBJECTTYPE, key_parts, royaltyTotals); err != nil {
+		return err
+	}
+	var total *RoyaltyStatement_Total
+	for _, t := range royaltyTotals.Totals {
+		if t.CurrencyCode == royaltyEntry.CurrencyCode {
+			total = t
+		}
+	}
+	if total == nil {
+		total = &RoyaltyStatement_Total{CurrencyCode: royaltyEntry.CurrencyCode}
+		royaltyTotals.Totals = append(royaltyTotals.Totals, total)
+		sort.Slice(royaltyTotals.Totals, func(i, j int) bool {
+			return royaltyTotals.Totals[i].CurrencyCode < royaltyTotals.Totals[j].CurrencyCode
+		})
+	}
+	var err error
+	if total.Amount, err = addUint64(total.Amount, royaltyEntry.Amount); err != nil {
+		return fmt.Errorf("Royalty %s total of %s in %s: %s", royaltyEntry.CurrencyCode, party_id, royaltyEntry.Period, err)
+	}
+	royaltyTotals.PartyId = party_id
+	royaltyTotals.Period = royaltyEntry.Period
+	_, err = ac.putAsset(COMPOSITE_KEY_ROYALTY_TOTAL_OBJECTTYPE, key_parts, royaltyTotals)
+	return err
+}
+
+func (ac *assetContext) setRoyaltySplits() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	var royaltySplitsBytes = []byte{}
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		royaltySplitsBytes = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setRoyaltySplits")
+	}
+
+	royaltySplits := &RoyaltySplits{}
+	if err := proto.Unmarshal(royaltySplitsBytes, royaltySplits); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal RoyaltySplits, err = %s", err)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setRoyaltySplits: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may set its royalty splits", app_descriptor_key_part)
+	}
+	if err := validateRoyaltySplits(royaltySplits.Splits, appDescriptor.Owner); err != nil {
+		return nil, fmt.Errorf("Error in setRoyaltySplits: %s", err)
+	}
+	appDescriptor.RoyaltySplits = royaltySplits.Splits
+	return ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor)
+}
+
+func (ac *assetContext) getRoyaltyStatement() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	party_id := ""
+	period := ""
+	bookmark := ""
+
+	switch len(args) {
+	case 4:
+		bookmark = string(args[3])
+		fallthrough
+	case 3:
+		party_id = string(args[1])
+		period = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getRoyaltyStatement")
+	}
+
+	royaltyStatement := &RoyaltyStatement{}
+	if _, err := ac.getAsset(COMPOSITE_KEY_ROYALTY_TOTAL_OBJECTTYPE, []string{party_id, period}, royaltyStatement); err != nil {
+		return nil, fmt.Errorf("Error in getRoyaltyStatement: %s", err)
+	}
+	royaltyStatement.PartyId = party_id
+	royaltyStatement.Period = period
+
+	query_results, err := ac.query(&Query{ObjectType: Query_ROYALTY, KeyParts: []string{party_id, period}, ReturnValues: true, Bookmark: bookmark})
+	if err != nil {
+		return nil, fmt.Errorf("Error in getRoyaltyStatement: %s", err)
+	}
+	royaltyStatement.HasMore = query_results.HasMore
+	royaltyStatement.Bookmark = query_results.Bookmark
+	for _, entry := range query_results.Results {
+		royaltyEntry := &RoyaltyEntry{}
+		if err := proto.Unmarshal(entry.Value, royaltyEntry); err != nil {
+			return nil, fmt.Errorf("Error in getRoyaltyStatement, cannot unmarshal RoyaltyEntry %s: %s", entry.Key, err)
+		}
+		royaltyStatement.Entries = append(royaltyStatement.Entries, royaltyEntry)
+	}
+
+	royaltyStatementBytes, err := proto.Marshal(royaltyStatement)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling RoyaltyStatement in getRoyaltyStatement: %s", err)
+	}
+	return royaltyStatementBytes, nil
+}
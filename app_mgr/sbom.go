@@ -0,0 +1,268 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+)
+
+// The owner of an AppBundle may attach its software bill of materials, an SPDX or CycloneDX
+// document held inline or as a hash and URI. The chaincode does not parse the document: the
+// components it declares are listed as package URLs in the Sbom, and each is indexed under
+// SBOM_COMPONENT [<package>, <version>, <descriptor>, <bundle>], where the package is the purl
+// without its version, qualifiers and subpath. findBundlesUsingComponent reads that index, so
+// operators can find every registered bundle declaring a vulnerable package from the ledger.
+// Attaching a new Sbom replaces the previous one and its index entries.
+
+// splitPurl returns the package and version of a package URL.
+func splitPurl(purl string) (string, string, error) {
+	if !strings.HasPrefix(purl, "pkg:") {
+		return "", "", fmt.Errorf("%q is not a package URL", purl)
+	}
+	pkg := purl
+	if i := strings.IndexAny(pkg, "?#"); i >= 0 {
+		pkg = pkg[:i]
+	}
+	version := ""
+	if i := strings.LastIndex(pkg, "@"); i >= 0 {
+		pkg, version = pkg[:i], pkg[i+1:]
+	}
+	if len(pkg) == len("pkg:") {
+		return "", "", fmt.Errorf("%q is not a package URL", purl)
+	}
+	return pkg, version, nil
+}
+
+// indexSbom adds or, if remove is set, deletes the SBOM_COMPONENT entries of sbom.
+func (ac *assetContext) indexSbom(sbom *Sbom, remove bool) error {
+	for _, purl := range sbom.Components {
+		pkg, version, err := splitPurl(purl)
+		if err != nil {
+			return err
+		}
+		var key_parts = []string{pkg, version, sbom.DescriptorId, sbom.BundleKey}
+		if !remove {
+			if _, err := ac.putAsset(COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE, key_parts, &SbomComponent{Purl: purl}); err != nil {
+				return err
+			}
+			continue
+		}
+		compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE, key_parts)
+		if err != nil {
+			return fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE, key_parts, err)
+		}
+		if err := ac.stub.DelState(compositeKey); err != nil {
+			return fmt.Errorf("Could not delete state for key %s: %s", compositeKey, err)
+		}
+	}
+	return nil
+}
+
+// moveSboms re-keys the Sboms of the AppBundles of a renamed AppDescriptor, re-indexing their
+// components, which are not keyed by descriptor first.
+func (ac *assetContext) moveSboms(old_key_part string, new_key_part string) error {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_SBOM_OBJECTTYPE, []string{old_key_part})
+	if err != nil {
+		return fmt.Errorf("Error reading %s of %s: %s", COMPOSITE_KEY_SBOM_OBJECTTYPE, old_key_part, err)
+	}
+	var moved []*queryresult.KV
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			stateQueryIterator.Close()
+			return fmt.Errorf("Error reading %s of %s: %s", COMPOSITE_KEY_SBOM_OBJECTTYPE, old_key_part, err)
+		}
+		moved = append(moved, kv)
+	}
+	stateQueryIterator.Close()
+
+	for _, kv := range moved {
+		sbom := &Sbom{}
+		if err := proto.Unmarshal(kv.Value, sbom); err != nil {
+			return fmt.Errorf("Cannot unmarshal Sbom %s: %s", kv.Key, err)
+		}
+		if err := ac.indexSbom(sbom, true); err != nil {
+			return err
+		}
+		if err := ac.stub.DelState(kv.Key); err != nil {
+			return fmt.Errorf("Could not delete state for key %s: %s", kv.Key, err)
+		}
+		sbom.DescriptorId = new_key_part
+		if _, err := ac.putAsset(COMPOSITE_KEY_SBOM_OBJECTTYPE, []string{new_key_part, sbom.BundleKey}, sbom); err != nil {
+			return err
+		}
+		if err := ac.indexSbom(sbom, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ac *assetContext) attachSbom() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	sbom := &Sbom{}
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		if err := proto.Unmarshal(args[3], sbom); err != nil {
+			return nil, fmt.Errorf("Error in attachSbom, cannot unmarshal Sbom: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to attachSbom")
+	}
+	if len(sbom.Document) == 0 && (len(sbom.DocumentHash) == 0 || len(sbom.Uri) == 0) {
+		return nil, fmt.Errorf("Error in attachSbom: the Sbom must hold the document, or its document_hash and uri")
+	}
+	declared := make(map[string]bool)
+	for _, purl := range sbom.Components {
+		if _, _, err := splitPurl(purl); err != nil {
+			return nil, fmt.Errorf("Error in attachSbom: %s", err)
+		}
+		if declared[purl] {
+			return nil, fmt.Errorf("Error in attachSbom: component %s is declared twice", purl)
+		}
+		declared[purl] = true
+	}
+
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachSbom: %s", err)
+	}
+	if !bytes.Equal(appBundle.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppBundle %s may attach its Sbom", app_bundle_key_part)
+	}
+
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part}
+	previous := &Sbom{}
+	found, err := ac.getAsset(COMPOSITE_KEY_SBOM_OBJECTTYPE, key_parts, previous)
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachSbom: %s", err)
+	}
+	if found {
+		if err := ac.indexSbom(previous, true); err != nil {
+			return nil, fmt.Errorf("Error in attachSbom: %s", err)
+		}
+	}
+
+	attached_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachSbom: %s", err)
+	}
+	sbom.DescriptorId = app_descriptor_key_part
+	sbom.BundleKey = app_bundle_key_part
+	sbom.AttachedBy = ac.creator
+	sbom.AttachedAt = attached_at
+	if err := ac.indexSbom(sbom, false); err != nil {
+		return nil, fmt.Errorf("Error in attachSbom: %s", err)
+	}
+	return ac.putAsset(COMPOSITE_KEY_SBOM_OBJECTTYPE, key_parts, sbom)
+}
+
+func (ac *assetContext) getSbomForBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getSbomForBundle")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getSbomForBundle: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getSbomForBundle: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getSbomForBundle: %s", err)
+	}
+	sbom := &Sbom{}
+	found, err := ac.getAsset(COMPOSITE_KEY_SBOM_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, sbom)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getSbomForBundle: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("AppBundle %s of AppDescriptor %s has no Sbom", app_bundle_key_part, app_descriptor_key_part)
+	}
+	sbomBytes, err := proto.Marshal(sbom)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling Sbom in getSbomForBundle: %s", err)
+	}
+	return sbomBytes, nil
+}
+
+func (ac *assetContext) findBundlesUsingComponent() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	purl := ""
+
+	switch len(args) {
+	case 2:
+		purl = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to findBundlesUsingComponent")
+	}
+	pkg, version, err := splitPurl(purl)
+	if err != nil {
+		return nil, fmt.Errorf("Error in findBundlesUsingComponent: %s", err)
+	}
+	var key_parts = []string{pkg}
+	if len(version) != 0 {
+		key_parts = append(key_parts, version)
+	}
+
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in findBundlesUsingComponent: %s", err)
+	}
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_SBOM_COMPONENT_OBJECTTYPE, key_parts)
+	if err != nil {
+		return nil, fmt.Errorf("Error in findBundlesUsingComponent: %s", err)
+	}
+	defer stateQueryIterator.Close()
+
+	componentUsage := &ComponentUsage{}
+	for stateQueryIterator.HasNext() {
+		if uint64(len(componentUsage.Entries)) == uint64(queryLimits.MaxResults) {
+			componentUsage.HasMore = true
+			break
+		}
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error in findBundlesUsingComponent: %s", err)
+		}
+		_, index_key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("Error in findBundlesUsingComponent: %s", err)
+		}
+		sbomComponent := &SbomComponent{}
+		if err := proto.Unmarshal(kv.Value, sbomComponent); err != nil {
+			return nil, fmt.Errorf("Error in findBundlesUsingComponent, cannot unmarshal SbomComponent %s: %s", kv.Key, err)
+		}
+		componentUsage.Entries = append(componentUsage.Entries, &ComponentUsage_Entry{DescriptorId: index_key_parts[2], BundleKey: index_key_parts[3], Purl: sbomComponent.Purl})
+	}
+
+	componentUsageBytes, err := proto.Marshal(componentUsage)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ComponentUsage in findBundlesUsingComponent: %s", err)
+	}
+	return componentUsageBytes, nil
+}
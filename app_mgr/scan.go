@@ -0,0 +1,190 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// Security scanners registered by the admins record a PASS or FAIL ScanResult for each AppBundle
+// they scan, together with the hash of their off-chain report. The ScanPolicy in the
+// RegistryConfig requires the latest verdict of required_passes registered scanners to be PASS
+// before associateDescriptorWithBundle will point a descriptor at a bundle, so only scanned
+// bundles are served. Results are keyed by descriptor, bundle and scanner, so a rescan replaces
+// the scanner's previous verdict, and results of deregistered scanners no longer count.
+
+// registeredScanner returns the index of scanner_id in scanPolicy.Scanners, or -1.
+func registeredScanner(scanPolicy *ScanPolicy, scanner_id string) int {
+	for i, scanner := range scanPolicy.GetScanners() {
+		if scanner.ScannerId == scanner_id {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkScanGate returns an error unless the AppBundle passes the registry's ScanPolicy. LENIENT
+// namespaces are not gated.
+func (ac *assetContext) checkScanGate(app_descriptor_key_part string, app_bundle_key_part string) error {
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return err
+	}
+	scanPolicy := registryConfig.ScanPolicy
+	if scanPolicy.GetRequiredPasses() == 0 {
+		return nil
+	}
+	if lenient, err := ac.isLenient(); err != nil || lenient {
+		return err
+	}
+	var passes uint32
+	for _, scanner := range scanPolicy.Scanners {
+		scanResult := &ScanResult{}
+		found, err := ac.getAsset(COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part, scanner.ScannerId}, scanResult)
+		if err != nil {
+			return err
+		}
+		if found && scanResult.Verdict == ScanResult_PASS {
+			passes++
+		}
+	}
+	if passes < scanPolicy.RequiredPasses {
+		return fmt.Errorf("AppBundle %s of AppDescriptor %s has passed %d of the %d required security scans", app_bundle_key_part, app_descriptor_key_part, passes, scanPolicy.RequiredPasses)
+	}
+	return nil
+}
+
+func (ac *assetContext) registerScanner() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	scanner_id := ""
+	var identity []byte
+
+	switch len(args) {
+	case 3:
+		scanner_id = string(args[1])
+		identity = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to registerScanner")
+	}
+	if len(scanner_id) == 0 {
+		return nil, fmt.Errorf("Error in registerScanner: scanner_id must be non-empty")
+	}
+	if len(identity) != 0 {
+		if _, err := mspIdFromIdentity(identity); err != nil {
+			return nil, fmt.Errorf("Error in registerScanner, identity must be a serialized identity: %s", err)
+		}
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in registerScanner: %s", err)
+	}
+	if registryConfig.ScanPolicy == nil {
+		registryConfig.ScanPolicy = &ScanPolicy{}
+	}
+	scanPolicy := registryConfig.ScanPolicy
+	i := registeredScanner(scanPolicy, scanner_id)
+	switch {
+	case len(identity) == 0 && i < 0:
+		return nil, fmt.Errorf("Error in registerScanner: scanner %s is not registered", scanner_id)
+	case len(identity) == 0:
+		scanPolicy.Scanners = append(scanPolicy.Scanners[:i], scanPolicy.Scanners[i+1:]...)
+		if int(scanPolicy.RequiredPasses) > len(scanPolicy.Scanners) {
+			return nil, fmt.Errorf("Error in registerScanner: deregistering %s would leave fewer scanners than the %d required passes", scanner_id, scanPolicy.RequiredPasses)
+		}
+	case i < 0:
+		scanPolicy.Scanners = append(scanPolicy.Scanners, &ScanPolicy_Scanner{ScannerId: scanner_id, Identity: identity})
+	default:
+		scanPolicy.Scanners[i].Identity = identity
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) setRequiredScanPasses() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	var required_passes uint64
+	var err error
+
+	switch len(args) {
+	case 2:
+		if required_passes, err = strconv.ParseUint(string(args[1]), 10, 32); err != nil {
+			return nil, fmt.Errorf("Error in setRequiredScanPasses, invalid required_passes: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setRequiredScanPasses")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setRequiredScanPasses: %s", err)
+	}
+	if registryConfig.ScanPolicy == nil {
+		registryConfig.ScanPolicy = &ScanPolicy{}
+	}
+	if required_passes > uint64(len(registryConfig.ScanPolicy.Scanners)) {
+		return nil, fmt.Errorf("Error in setRequiredScanPasses: only %d scanners are registered", len(registryConfig.ScanPolicy.Scanners))
+	}
+	registryConfig.ScanPolicy.RequiredPasses = uint32(required_passes)
+	return ac.putRegistryConfig(registryConfig)
+}
+
+func (ac *assetContext) recordScanResult() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	scanner_id := ""
+	verdict_name := ""
+	var report_hash []byte
+
+	switch len(args) {
+	case 6:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		scanner_id = string(args[3])
+		verdict_name = string(args[4])
+		report_hash = args[5]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to recordScanResult")
+	}
+	verdict_value, ok := ScanResult_Verdict_value[verdict_name]
+	if !ok {
+		return nil, fmt.Errorf("Error in recordScanResult, unknown verdict '%s'", verdict_name)
+	}
+	if len(report_hash) == 0 {
+		return nil, fmt.Errorf("Error in recordScanResult: report_hash must be non-empty")
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordScanResult: %s", err)
+	}
+	i := registeredScanner(registryConfig.ScanPolicy, scanner_id)
+	if i < 0 || !bytes.Equal(registryConfig.ScanPolicy.Scanners[i].Identity, ac.creator) {
+		return nil, fmt.Errorf("Only the registered identity of scanner %s may record its results", scanner_id)
+	}
+	if _, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part); err != nil {
+		return nil, fmt.Errorf("Error in recordScanResult: %s", err)
+	}
+
+	recorded_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in recordScanResult: %s", err)
+	}
+	scanResult := &ScanResult{
+		DescriptorId: app_descriptor_key_part,
+		BundleKey:    app_bundle_key_part,
+		ScannerId:    scanner_id,
+		Verdict:      ScanResult_Verdict(verdict_value),
+		ReportHash:   report_hash,
+		RecordedBy:   ac.creator,
+		RecordedAt:   recorded_at,
+	}
+	var key_parts = []string{app_descriptor_key_part, app_bundle_key_part, scanner_id}
+	return ac.putAsset(COMPOSITE_KEY_SCAN_RESULT_OBJECTTYPE, key_parts, scanResult)
+}
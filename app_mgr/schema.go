@@ -0,0 +1,354 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Marshaled message arguments are checked against their message type before the handler runs,
+// so a malformed or mismatched payload fails with the path of the offending field, e.g.
+// "PricingTiers.tiers[1].unit_price", rather than with a generic unmarshal error. The payload
+// is walked using the field properties golang/protobuf reflects from the generated structs:
+// every field must be declared by the message and have the wire type of its declaration, and
+// enum values must be declared by their enum. proto3 cannot declare required fields, so those
+// are listed per function in payloadSchemas.
+
+// payloadSchema describes a marshaled message argument of a function.
+type payloadSchema struct {
+	arg      int           // The index of the argument in GetArgs
+	message  proto.Message // The type the argument must unmarshal into
+	required []string      // Paths of fields that must be set to a non-zero value, e.g. "tiers.name" for every tier
+}
+
+// payloadSchemas lists the message arguments of the functions that parse their own arguments,
+// and the required fields of any message argument. The message fields of the request of a
+// function generated from the AppRegistry service are added by payloadSchemasFor.
+var payloadSchemas = map[string][]payloadSchema{
+	"createAppDescriptor":             {{arg: 2, message: &AppDescriptor{}}},
+	"createAppBundle":                 {{arg: 2, message: &AppBundle{}, required: []string{"descriptor_id"}}},
+	"createCollection":                {{arg: 2, message: &Collection{}}},
+	"getAppDescriptorsByKeys":         {{arg: 1, message: &KeyList{}}},
+	"getAppBundlesByKeys":             {{arg: 1, message: &BundleKeyList{}, required: []string{"keys.descriptor_id", "keys.bundle_key"}}},
+	"setFieldCommitments":             {{arg: 2, message: &FieldCommitments{}, required: []string{"commitments.field", "commitments.commitment"}}},
+	"importAssetFromChannel":          {{arg: 1, message: &SignedAssetEnvelope{}, required: []string{"envelope", "signature"}}},
+	"setRoyaltySplits":                {{arg: 2, message: &RoyaltySplits{}, required: []string{"splits.party", "splits.basis_points"}}},
+	"setDescriptorSupport":            {{arg: 2, message: &SupportInfo{}, required: []string{"support_url"}}},
+	"executeScript":                   {{arg: 1, message: &Script{}, required: []string{"operations", "operations.function"}}},
+	"simulateScript":                  {{arg: 1, message: &Script{}, required: []string{"operations", "operations.function"}}},
+	"setPricingTiers":                 {{arg: 2, message: &PricingTiers{}, required: []string{"tiers.name", "tiers.unit", "tiers.currency_code"}}},
+	"attachSbom":                      {{arg: 3, message: &Sbom{}}},
+	"putPolicyRule":                   {{arg: 1, message: &PolicyRule{}, required: []string{"name", "functions", "predicates.field"}}},
+	"createRollout":                   {{arg: 3, message: &Rollout{}, required: []string{"stages", "stages.name"}}},
+	"updateConfig":                    {{arg: 2, message: &RegistryConfig{}, required: []string{"admins"}}},
+	"associateDescriptorsWithBundles": {{arg: 1, message: &BundleKeyList{}, required: []string{"keys", "keys.descriptor_id", "keys.bundle_key"}}},
+	"demoteToCold":                    {{arg: 3, message: &ColdCopies{}, required: []string{"copies", "copies.uri", "copies.digest"}}},
+}
+
+var wireTypeNames = map[int]string{
+	proto.WireVarint:     "varint",
+	proto.WireFixed64:    "fixed64",
+	proto.WireBytes:      "length-delimited",
+	proto.WireStartGroup: "start-group",
+	proto.WireEndGroup:   "end-group",
+	proto.WireFixed32:    "fixed32",
+}
+
+// requestField is a field of the request of a function generated from the AppRegistry service.
+type requestField struct {
+	prop  *proto.Properties
+	field reflect.StructField
+	// The index of the argument in GetArgs, counted back from the last argument as -1, -2...
+	// for the fields after a repeated field that is not the last, or 0 for a transient field
+	arg       int
+	transient bool
+}
+
+// requestFields returns the fields of the request of function, a function generated from the
+// AppRegistry service, in the order they are passed: the arguments in field number order,
+// then the transient fields.
+func requestFields(function string) []requestField {
+	newRequest, ok := serviceRequests[function]
+	if !ok {
+		return nil
+	}
+	transient := make(map[string]bool)
+	for _, name := range serviceTransientFields[function] {
+		transient[name] = true
+	}
+	requestType := reflect.TypeOf(newRequest()).Elem()
+	var fields, transientFields []requestField
+	for i, prop := range proto.GetProperties(requestType).Prop {
+		if prop.Tag == 0 {
+			continue
+		}
+		field := requestField{prop: prop, field: requestType.Field(i), transient: transient[prop.OrigName]}
+		if field.transient {
+			transientFields = append(transientFields, field)
+		} else {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].prop.Tag < fields[j].prop.Tag })
+	sort.Slice(transientFields, func(i, j int) bool { return transientFields[i].prop.Tag < transientFields[j].prop.Tag })
+	trailing := false
+	for i := range fields {
+		if trailing {
+			fields[i].arg = i - len(fields)
+		} else {
+			fields[i].arg = i + 1
+		}
+		trailing = trailing || fields[i].prop.Repeated
+	}
+	return append(fields, transientFields...)
+}
+
+// payloadSchemasFor returns the schemas of the message arguments of function.
+func payloadSchemasFor(function string) []payloadSchema {
+	schemas := payloadSchemas[function]
+	for _, field := range requestFields(function) {
+		// Transient fields are not arguments, and cmd/gendispatch lets no message field follow a
+		// repeated one
+		if field.arg <= 0 || field.field.Type.Kind() != reflect.Ptr || field.prop.Repeated {
+			continue
+		}
+		declared := false
+		for _, schema := range schemas {
+			declared = declared || schema.arg == field.arg
+		}
+		if !declared {
+			schemas = append(schemas, payloadSchema{arg: field.arg, message: reflect.New(field.field.Type.Elem()).Interface().(proto.Message)})
+		}
+	}
+	return schemas
+}
+
+// validatePayloads checks the message arguments of the invoked function, unless its namespace
+// is LENIENT. Arguments that are missing are left for the handler to report.
+func (ac *assetContext) validatePayloads() error {
+	var args = ac.stub.GetArgs()
+	if lenient, err := ac.isLenient(); err != nil || lenient {
+		return err
+	}
+	for _, schema := range payloadSchemasFor(ac.function) {
+		if schema.arg >= len(args) {
+			continue
+		}
+		messageType := reflect.TypeOf(schema.message).Elem()
+		if err := validateMessageWire(args[schema.arg], messageType, messageType.Name(), schema.required); err != nil {
+			return fmt.Errorf("Invalid argument %d to %s: %s", schema.arg, ac.function, err)
+		}
+	}
+	return nil
+}
+
+// wireValue is a single field value read from the wire.
+type wireValue struct {
+	varint uint64 // Set for varint and fixed values
+	bytes  []byte // Set for length-delimited values
+}
+
+// readWireValue reads a value of wireType from b and returns it with the rest of b.
+func readWireValue(b []byte, wireType int) (wireValue, []byte, error) {
+	switch wireType {
+	case proto.WireVarint:
+		x, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return wireValue{}, nil, fmt.Errorf("truncated varint")
+		}
+		return wireValue{varint: x}, b[n:], nil
+	case proto.WireFixed64, proto.WireFixed32:
+		size := 8
+		if wireType == proto.WireFixed32 {
+			size = 4
+		}
+		if len(b) < size {
+			return wireValue{}, nil, fmt.Errorf("truncated %s", wireTypeNames[wireType])
+		}
+		var x uint64
+		for i := size - 1; i >= 0; i-- {
+			x = x<<8 | uint64(b[i])
+		}
+		return wireValue{varint: x}, b[size:], nil
+	case proto.WireBytes:
+		length, n := proto.DecodeVarint(b)
+		if n == 0 || length > uint64(len(b)-n) {
+			return wireValue{}, nil, fmt.Errorf("truncated length-delimited value")
+		}
+		return wireValue{bytes: b[n : n+int(length)]}, b[n+int(length):], nil
+	default:
+		return wireValue{}, nil, fmt.Errorf("unsupported wire type %d", wireType)
+	}
+}
+
+// validateMessageWire checks that b is a valid encoding of messageType, whose path is used in
+// errors, and that the required fields are set.
+func validateMessageWire(b []byte, messageType reflect.Type, path string, required []string) error {
+	// Split the required paths into the fields of this message and those of its fields
+	var requiredFields []string
+	nestedRequired := make(map[string][]string)
+	for _, requiredPath := range required {
+		if i := strings.Index(requiredPath, "."); i >= 0 {
+			nestedRequired[requiredPath[:i]] = append(nestedRequired[requiredPath[:i]], requiredPath[i+1:])
+		} else {
+			requiredFields = append(requiredFields, requiredPath)
+		}
+	}
+
+	structProperties := proto.GetProperties(messageType)
+	fieldIndexes := make(map[int]int)
+	for i, prop := range structProperties.Prop {
+		if prop.Tag > 0 {
+			fieldIndexes[prop.Tag] = i
+		}
+	}
+	set := make(map[string]bool)
+	counts := make(map[int]int)
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return fmt.Errorf("%s: truncated field key", path)
+		}
+		b = b[n:]
+		tag, wireType := int(key>>3), int(key&7)
+		i, ok := fieldIndexes[tag]
+		if !ok {
+			return fmt.Errorf("%s: unknown field number %d", path, tag)
+		}
+		prop := structProperties.Prop[i]
+		field := messageType.Field(i)
+		fieldPath := path + "." + prop.OrigName
+		if prop.Repeated {
+			fieldPath = fmt.Sprintf("%s[%d]", fieldPath, counts[tag])
+			counts[tag]++
+		}
+
+		value, rest, err := readWireValue(b, wireType)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fieldPath, err)
+		}
+		b = rest
+
+		switch {
+		case field.Type.Kind() == reflect.Map:
+			if wireType != proto.WireBytes {
+				return fmt.Errorf("%s: has wire type %s, want length-delimited", fieldPath, wireTypeNames[wireType])
+			}
+			if err := validateMapEntryWire(value.bytes, field, fieldPath); err != nil {
+				return err
+			}
+		case prop.Repeated && prop.WireType != proto.WireBytes && wireType == proto.WireBytes:
+			// A packed run of scalars
+			for packed := value.bytes; len(packed) > 0; {
+				var element wireValue
+				if element, packed, err = readWireValue(packed, prop.WireType); err != nil {
+					return fmt.Errorf("%s: %s", fieldPath, err)
+				}
+				if err := validateScalarWire(element, prop, fieldPath); err != nil {
+					return err
+				}
+			}
+		case wireType != prop.WireType:
+			return fmt.Errorf("%s: has wire type %s, want %s", fieldPath, wireTypeNames[wireType], wireTypeNames[prop.WireType])
+		case wireType == proto.WireBytes && messageFieldType(field.Type) != nil:
+			if err := validateMessageWire(value.bytes, messageFieldType(field.Type), fieldPath, nestedRequired[prop.OrigName]); err != nil {
+				return err
+			}
+			set[prop.OrigName] = true
+		default:
+			if err := validateScalarWire(value, prop, fieldPath); err != nil {
+				return err
+			}
+		}
+		if value.varint != 0 || len(value.bytes) > 0 {
+			set[prop.OrigName] = true
+		}
+	}
+
+	for _, name := range requiredFields {
+		if !set[name] {
+			return fmt.Errorf("%s.%s is required", path, name)
+		}
+	}
+	return nil
+}
+
+// messageFieldType returns the message struct type of a singular or repeated message field, or
+// nil if the field is not a message.
+func messageFieldType(fieldType reflect.Type) reflect.Type {
+	if fieldType.Kind() == reflect.Slice {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct {
+		return fieldType.Elem()
+	}
+	return nil
+}
+
+// validateScalarWire checks that an enum value is declared by its enum.
+func validateScalarWire(value wireValue, prop *proto.Properties, path string) error {
+	if len(prop.Enum) == 0 {
+		return nil
+	}
+	values := proto.EnumValueMap(prop.Enum)
+	if values == nil {
+		return nil
+	}
+	for _, declared := range values {
+		if int64(declared) == int64(int32(value.varint)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: %d is not a value of %s", path, int32(value.varint), prop.Enum)
+}
+
+// validateMapEntryWire checks an entry of a map field, a message whose key is field 1 and
+// value is field 2.
+func validateMapEntryWire(b []byte, field reflect.StructField, path string) error {
+	keyProp, valueProp := &proto.Properties{}, &proto.Properties{}
+	keyProp.Parse(field.Tag.Get("protobuf_key"))
+	valueProp.Parse(field.Tag.Get("protobuf_val"))
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return fmt.Errorf("%s: truncated field key", path)
+		}
+		b = b[n:]
+		tag, wireType := int(key>>3), int(key&7)
+		var prop *proto.Properties
+		var entryPath string
+		switch tag {
+		case 1:
+			prop, entryPath = keyProp, path+".key"
+		case 2:
+			prop, entryPath = valueProp, path+".value"
+		default:
+			return fmt.Errorf("%s: unknown field number %d", path, tag)
+		}
+		value, rest, err := readWireValue(b, wireType)
+		if err != nil {
+			return fmt.Errorf("%s: %s", entryPath, err)
+		}
+		b = rest
+		if wireType != prop.WireType {
+			return fmt.Errorf("%s: has wire type %s, want %s", entryPath, wireTypeNames[wireType], wireTypeNames[prop.WireType])
+		}
+		if valueType := messageFieldType(field.Type.Elem()); tag == 2 && valueType != nil {
+			if err := validateMessageWire(value.bytes, valueType, entryPath, nil); err != nil {
+				return err
+			}
+		} else if err := validateScalarWire(value, prop, entryPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// resolveScriptArgs replaces "$<n>" references in args by the key of step n. keys holds the
+// keys of the steps executed so far. "$$" escapes a leading "$": the argument is passed with
+// its first "$" removed and is not a reference.
+func resolveScriptArgs(args [][]byte, keys []string) ([][]byte, error) {
+	var resolved [][]byte
+	for _, arg := range args {
+		if reference := string(arg); strings.HasPrefix(reference, "$$") {
+			arg = arg[1:]
+		} else if strings.HasPrefix(reference, "$") {
+			step, err := strconv.Atoi(reference[1:])
+			if err == nil {
+				if step < 1 || step > len(keys) {
+					return nil, fmt.Errorf("Reference %s does not refer to a previous step", reference)
+				}
+				arg = []byte(keys[step-1])
+			}
+		}
+		resolved = append(resolved, arg)
+	}
+	return resolved, nil
+}
+
+// parseScript parses the Script argument of the current function.
+func (ac *assetContext) parseScript() (*Script, error) {
+	var args = ac.stub.GetArgs()
+	var scriptBytes = []byte{}
+
+	switch len(args) {
+	case 2:
+		scriptBytes = args[1]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to %s", ac.function)
+	}
+
+	script := &Script{}
+	if err := proto.Unmarshal(scriptBytes, script); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal Script, err = %s", err)
+	}
+	if len(script.Operations) == 0 {
+		return nil, fmt.Errorf("Error in %s, the Script has no operations", ac.function)
+	}
+	return script, nil
+}
+
+// runScript applies the operations of script in order over overlay, returning their results.
+func (ac *assetContext) runScript(script *Script, overlay *overlayStub) ([][]byte, error) {
+	var results [][]byte
+	var keys []string
+	for i, operation := range script.Operations {
+		if !isComposableFunction(operation.Function) {
+			return nil, fmt.Errorf("Error in %s, step %d: function '%s' cannot be used in a Script", ac.function, i+1, operation.Function)
+		}
+		operationArgs, err := resolveScriptArgs(operation.Args, keys)
+		if err != nil {
+			return nil, fmt.Errorf("Error in %s, step %d: %s", ac.function, i+1, err)
+		}
+
+		overlay.args = append([][]byte{[]byte(operation.Function)}, operationArgs...)
+		step := *ac
+		step.stub = overlay
+		step.function = operation.Function
+		result, err := step.dispatch()
+		if err != nil {
+			return nil, fmt.Errorf("Error in %s, step %d (%s) failed: %s", ac.function, i+1, operation.Function, err)
+		}
+
+		key := ""
+		if len(operationArgs) > 0 {
+			key = string(operationArgs[0])
+		}
+		keys = append(keys, key)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// executeScript applies the operations of a Script in order within this transaction. Each
+// operation sees the writes of the ones before it, and the writes are only sent to the peer
+// once every operation has succeeded, so a script is applied all or nothing.
+func (ac *assetContext) executeScript() ([]byte, error) {
+	script, err := ac.parseScript()
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := newOverlayStub(ac.stub, nil)
+	results, err := ac.runScript(script, overlay)
+	if err != nil {
+		return nil, err
+	}
+	scriptResult := &ScriptResult{Results: results}
+
+	if err := overlay.flush(); err != nil {
+		return nil, fmt.Errorf("Error in executeScript: %s", err)
+	}
+
+	scriptResultBytes, err := proto.Marshal(scriptResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling ScriptResult in executeScript: %s", err)
+	}
+	return scriptResultBytes, nil
+}
+
+// readRecorder records the keys and ranges a simulated Script reads from the ledger, and
+// rejects the reads a StateRead cannot describe: rich queries, key ranges and history.
+type readRecorder struct {
+	shim.ChaincodeStubInterface
+	reads map[readKey]*StateRead
+	order []readKey
+}
+
+// readKey identifies a recorded read, so that a key and a range with the same composite key
+// prefix, or two pages of one range, are recorded apart.
+type readKey struct {
+	collection string
+	key        string
+	is_range   bool
+	page_size  int32
+	bookmark   string
+}
+
+func (rr *readRecorder) record(key readKey, stateRead *StateRead) {
+	if _, read := rr.reads[key]; !read {
+		rr.order = append(rr.order, key)
+	}
+	rr.reads[key] = stateRead
+}
+
+// recordKey records the read of key from collection, or from the state if collection is empty.
+func (rr *readRecorder) recordKey(collection string, key string, value []byte) error {
+	objectType, key_parts, err := rr.SplitCompositeKey(key)
+	if err != nil {
+		return fmt.Errorf("Could not split composite key %s: %s", key, err)
+	}
+	rr.record(readKey{collection: collection, key: key}, &StateRead{ObjectType: objectType, KeyParts: key_parts, Found: value != nil, Collection: collection})
+	return nil
+}
+
+// readRange reads iterator to the end, which a Script's own range reads do too, and returns
+// its entries.
+func readRange(iterator shim.StateQueryIteratorInterface) ([]*queryresult.KV, error) {
+	defer iterator.Close()
+	var kvs []*queryresult.KV
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+func (rr *readRecorder) GetState(key string) ([]byte, error) {
+	value, err := rr.ChaincodeStubInterface.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := rr.recordKey("", key, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (rr *readRecorder) GetPrivateData(collection string, key string) ([]byte, error) {
+	value, err := rr.ChaincodeStubInterface.GetPrivateData(collection, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := rr.recordKey(collection, key, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (rr *readRecorder) GetStateByPartialCompositeKey(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+	prefix, err := rr.CreateCompositeKey(objectType, attributes)
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := rr.ChaincodeStubInterface.GetStateByPartialCompositeKey(objectType, attributes)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := readRange(iterator)
+	if err != nil {
+		return nil, err
+	}
+	rr.record(readKey{key: prefix, is_range: true}, &StateRead{ObjectType: objectType, KeyParts: attributes, IsRange: true, RangeCount: uint32(len(kvs))})
+	return &overlayIterator{kvs: kvs}, nil
+}
+
+func (rr *readRecorder) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	prefix, err := rr.CreateCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	iterator, queryResponseMetadata, err := rr.ChaincodeStubInterface.GetStateByPartialCompositeKeyWithPagination(objectType, keys, pageSize, bookmark)
+	if err != nil {
+		return nil, nil, err
+	}
+	kvs, err := readRange(iterator)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr.record(readKey{key: prefix, is_range: true, page_size: pageSize, bookmark: bookmark}, &StateRead{ObjectType: objectType, KeyParts: keys, IsRange: true, RangeCount: uint32(len(kvs)), PageSize: pageSize, Bookmark: bookmark})
+	return &overlayIterator{kvs: kvs}, queryResponseMetadata, nil
+}
+
+func (rr *readRecorder) GetPrivateDataByPartialCompositeKey(collection string, objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	prefix, err := rr.CreateCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := rr.ChaincodeStubInterface.GetPrivateDataByPartialCompositeKey(collection, objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := readRange(iterator)
+	if err != nil {
+		return nil, err
+	}
+	rr.record(readKey{collection: collection, key: prefix, is_range: true}, &StateRead{ObjectType: objectType, KeyParts: keys, IsRange: true, RangeCount: uint32(len(kvs)), Collection: collection})
+	return &overlayIterator{kvs: kvs}, nil
+}
+
+// unrecorded is the error of a read a StateRead cannot describe.
+func (rr *readRecorder) unrecorded(read string) error {
+	return fmt.Errorf("the Script runs a %s, whose reads cannot be simulated", read)
+}
+
+func (rr *readRecorder) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, rr.unrecorded("rich query")
+}
+
+func (rr *readRecorder) GetQueryResultWithPagination(query string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, rr.unrecorded("rich query")
+}
+
+func (rr *readRecorder) GetPrivateDataQueryResult(collection, query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, rr.unrecorded("rich query")
+}
+
+func (rr *readRecorder) GetStateByRange(startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	return nil, rr.unrecorded("key range query")
+}
+
+func (rr *readRecorder) GetStateByRangeWithPagination(startKey, endKey string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, rr.unrecorded("key range query")
+}
+
+func (rr *readRecorder) GetPrivateDataByRange(collection, startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	return nil, rr.unrecorded("key range query")
+}
+
+func (rr *readRecorder) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
+	return nil, rr.unrecorded("history query")
+}
+
+// stateReads returns the recorded reads in first-read order.
+func (rr *readRecorder) stateReads() []*StateRead {
+	var stateReads []*StateRead
+	for _, key := range rr.order {
+		stateReads = append(stateReads, rr.reads[key])
+	}
+	return stateReads
+}
+
+// simulateScript applies the operations of a Script over an overlay of the current state, as
+// executeScript would, and returns the SimulationResult instead of writing anything, so
+// integrators can preview a publication flow before submitting it.
+func (ac *assetContext) simulateScript() ([]byte, error) {
+	script, err := ac.parseScript()
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &readRecorder{ChaincodeStubInterface: ac.stub, reads: make(map[readKey]*StateRead)}
+	overlay := newOverlayStub(recorder, nil)
+	results, err := ac.runScript(script, overlay)
+	if err != nil {
+		return nil, err
+	}
+	stateWrites, err := overlay.stateWrites()
+	if err != nil {
+		return nil, fmt.Errorf("Error in simulateScript: %s", err)
+	}
+
+	simulationResultBytes, err := proto.Marshal(&SimulationResult{Results: results, Reads: recorder.stateReads(), Writes: stateWrites})
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling SimulationResult in simulateScript: %s", err)
+	}
+	return simulationResultBytes, nil
+}
@@ -0,0 +1,214 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+func TestResolveScriptArgs(t *testing.T) {
+	keys := []string{"descriptor", "bundle"}
+	tests := []struct {
+		arg  string
+		want string
+		err  bool
+	}{
+		{"plain", "plain", false},
+		{"$1", "descriptor", false},
+		{"$2", "bundle", false},
+		{"$0", "", true},
+		{"$3", "", true},
+		{"$price", "$price", false},
+		{"$$1", "$1", false},
+		{"$$$2", "$$2", false},
+		{"$$", "$", false},
+		{"a$1", "a$1", false},
+	}
+	for _, test := range tests {
+		resolved, err := resolveScriptArgs([][]byte{[]byte(test.arg)}, keys)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s resolved to %s", test.arg, resolved[0])
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.arg, err)
+		} else if got := string(resolved[0]); got != test.want {
+			t.Errorf("%s resolved to %s, want %s", test.arg, got, test.want)
+		}
+	}
+}
+
+// scriptOperation returns the ScriptOperation of function with args, given as strings or proto
+// messages.
+func scriptOperation(t *testing.T, function string, args ...interface{}) *ScriptOperation {
+	operation := &ScriptOperation{Function: function}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			operation.Args = append(operation.Args, []byte(v))
+		case proto.Message:
+			argBytes, err := proto.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			operation.Args = append(operation.Args, argBytes)
+		}
+	}
+	return operation
+}
+
+// A Script is applied all or nothing: a failing step leaves none of the writes of the steps
+// before it, and a simulated Script writes nothing.
+func TestExecuteScriptIsAllOrNothing(t *testing.T) {
+	s := newTestRegistry(t)
+	s.mustInvoke(t, "createAppDescriptor", "existing", &AppDescriptor{Description: "existing"})
+
+	tests := []struct {
+		name       string
+		function   string
+		operations []*ScriptOperation
+		err        string
+		created    []string
+	}{
+		{
+			"last step fails",
+			"executeScript",
+			[]*ScriptOperation{
+				scriptOperation(t, "createAppDescriptor", "first", &AppDescriptor{Description: "first"}),
+				scriptOperation(t, "createAppBundle", "b", &AppBundle{DescriptorId: "first", Artifacts: [][]byte{[]byte("artifact")}}),
+				scriptOperation(t, "createAppDescriptor", "existing", &AppDescriptor{Description: "again"}),
+			},
+			"step 3 (createAppDescriptor) failed",
+			nil,
+		},
+		{
+			"function that cannot be scripted",
+			"executeScript",
+			[]*ScriptOperation{
+				scriptOperation(t, "createAppDescriptor", "first", &AppDescriptor{Description: "first"}),
+				scriptOperation(t, "ifMatch"),
+			},
+			"cannot be used in a Script",
+			nil,
+		},
+		{
+			"simulated",
+			"simulateScript",
+			[]*ScriptOperation{
+				scriptOperation(t, "createAppDescriptor", "first", &AppDescriptor{Description: "first"}),
+				scriptOperation(t, "createAppBundle", "b", &AppBundle{DescriptorId: "first", Artifacts: [][]byte{[]byte("artifact")}}),
+			},
+			"",
+			nil,
+		},
+		{
+			"every step succeeds",
+			"executeScript",
+			[]*ScriptOperation{
+				scriptOperation(t, "createAppDescriptor", "first", &AppDescriptor{Description: "first"}),
+				scriptOperation(t, "createAppDescriptor", "second", &AppDescriptor{Description: "second", ParentDescriptorKey: "first"}),
+			},
+			"",
+			[]string{"first", "second"},
+		},
+	}
+	for _, test := range tests {
+		response := s.invoke(t, test.function, &Script{Operations: test.operations})
+		if len(test.err) == 0 && response.Status != shim.OK {
+			t.Fatalf("%s: %s", test.name, response.Message)
+		}
+		if len(test.err) != 0 && (response.Status == shim.OK || !strings.Contains(response.Message, test.err)) {
+			t.Fatalf("%s: got %q, want an error containing %q", test.name, response.Message, test.err)
+		}
+		for _, key := range []string{"first", "second"} {
+			created := false
+			for _, want := range test.created {
+				created = created || want == key
+			}
+			exists := s.invoke(t, "getAppDescriptor", key).Status == shim.OK
+			if exists != created {
+				t.Fatalf("%s: AppDescriptor %s exists %v, want %v", test.name, key, exists, created)
+			}
+		}
+	}
+}
+
+// A key and a range with the same composite key prefix, and the same key read from the state
+// and from a collection, are distinct reads of a simulated Script.
+func TestReadRecorder(t *testing.T) {
+	stub := shim.NewMockStub("appmgr", new(AssetRegistry))
+	key, err := stub.CreateCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{"d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stub.MockTransactionStart("test-tx")
+	stub.PutState(key, []byte("descriptor"))
+	stub.PutPrivateData("details", key, []byte("details"))
+	stub.MockTransactionEnd("test-tx")
+
+	recorder := &readRecorder{ChaincodeStubInterface: stub, reads: make(map[readKey]*StateRead)}
+	reads := []struct {
+		name string
+		read func() error
+		want *StateRead
+	}{
+		{
+			"key",
+			func() error { _, err := recorder.GetState(key); return err },
+			&StateRead{ObjectType: COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, KeyParts: []string{"d"}, Found: true},
+		},
+		{
+			"range with the key as its prefix",
+			func() error {
+				_, err := recorder.GetStateByPartialCompositeKey(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{"d"})
+				return err
+			},
+			&StateRead{ObjectType: COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, KeyParts: []string{"d"}, IsRange: true, RangeCount: 1},
+		},
+		{
+			"private key",
+			func() error { _, err := recorder.GetPrivateData("details", key); return err },
+			&StateRead{ObjectType: COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, KeyParts: []string{"d"}, Found: true, Collection: "details"},
+		},
+		{
+			"missing private key",
+			func() error { _, err := recorder.GetPrivateData("other", key); return err },
+			&StateRead{ObjectType: COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, KeyParts: []string{"d"}, Collection: "other"},
+		},
+	}
+	for _, read := range reads {
+		// Reading twice records the read once
+		for i := 0; i < 2; i++ {
+			if err := read.read(); err != nil {
+				t.Fatalf("%s: %s", read.name, err)
+			}
+		}
+	}
+
+	stateReads := recorder.stateReads()
+	if len(stateReads) != len(reads) {
+		t.Fatalf("recorded %d reads, want %d: %v", len(stateReads), len(reads), stateReads)
+	}
+	for i, read := range reads {
+		if !proto.Equal(stateReads[i], read.want) {
+			t.Errorf("%s: recorded %v, want %v", read.name, stateReads[i], read.want)
+		}
+	}
+
+	if _, err := recorder.GetQueryResult(`{"selector":{}}`); err == nil {
+		t.Errorf("a rich query was simulated")
+	}
+	if _, err := recorder.GetHistoryForKey(key); err == nil {
+		t.Errorf("a history query was simulated")
+	}
+}
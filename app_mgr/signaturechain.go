@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// An AppBundle republished under another descriptor with republishBundle keeps the content its
+// original publisher signed and carries that signature forward instead of being re-signed by
+// whoever republishes it. The republished AppBundle belongs to the caller and records in its
+// signature_chain a SignatureLink for each publication it descends from, the original first,
+// so consumers can trace and verify its provenance with getSignatureChain. The original
+// publisher's owner endorsements are verified when an AppBundle is republished, and stand in
+// for the owner endorsements a STRICT namespace requires of the republished AppBundle. Only
+// republishBundle writes a signature_chain, from the AppBundles on the ledger: an AppBundle
+// mirrored from another channel with importAssetFromChannel arrives with the chain its exporter
+// states, which nothing on this ledger vouches for, so it is imported without it, as its own
+// original publication.
+
+// publicationLink returns the SignatureLink of the publication of appBundle.
+func publicationLink(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) *SignatureLink {
+	return &SignatureLink{
+		DescriptorId:      app_descriptor_key_part,
+		BundleKey:         app_bundle_key_part,
+		Publisher:         appBundle.Owner,
+		PublisherId:       appBundle.OwnerId,
+		OwnerEndorsements: appBundle.OwnerEndorsements,
+		PublishedAt:       appBundle.CreatedAt,
+	}
+}
+
+// verifySignatureChain checks that the original publication in the signature_chain of
+// appBundle has owner endorsements, and that the endorsements of every publication are their
+// publisher's signatures over the content of appBundle.
+func verifySignatureChain(appBundle *AppBundle) error {
+	if appBundle.RetentionTier == AppBundle_COLD {
+		return fmt.Errorf("the content of a COLD AppBundle is not on the ledger")
+	}
+	if len(appBundle.SignatureChain) == 0 || len(appBundle.SignatureChain[0].OwnerEndorsements) == 0 {
+		return fmt.Errorf("the original publication of the AppBundle has no owner endorsements")
+	}
+	for _, link := range appBundle.SignatureChain {
+		if err := verifyEndorsements(appBundle, link.Publisher, link.OwnerEndorsements); err != nil {
+			return fmt.Errorf("publication as %s of AppDescriptor %s: %s", link.BundleKey, link.DescriptorId, err)
+		}
+	}
+	return nil
+}
+
+// verifyCarriedEndorsements checks the owner endorsements of an AppBundle arriving from
+// elsewhere, if it has any.
+func verifyCarriedEndorsements(appBundle *AppBundle) error {
+	if len(appBundle.OwnerEndorsements) == 0 {
+		return nil
+	}
+	return verifyEndorsements(appBundle, appBundle.Owner, appBundle.OwnerEndorsements)
+}
+
+func (ac *assetContext) republishBundle() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	source_descriptor_key_part := ""
+	source_bundle_key_part := ""
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 5:
+		source_descriptor_key_part = string(args[1])
+		source_bundle_key_part = string(args[2])
+		app_descriptor_key_part = string(args[3])
+		app_bundle_key_part = string(args[4])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to republishBundle")
+	}
+
+	source_descriptor_key_part, err := ac.resolveDescriptorKey(source_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	source, err := ac.getAppBundle(source_descriptor_key_part, source_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(source_descriptor_key_part, source_bundle_key_part, source); err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	if source.RetentionTier == AppBundle_COLD {
+		return nil, fmt.Errorf("Error in republishBundle: AppBundle %s is COLD, its content is not on the ledger", source_bundle_key_part)
+	}
+	exists, err := ac.keyExists(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Error in republishBundle: AppBundle %s of AppDescriptor %s already exists", app_bundle_key_part, app_descriptor_key_part)
+	}
+
+	appBundle := &AppBundle{
+		DescriptorId:             app_descriptor_key_part,
+		Artifacts:                source.Artifacts,
+		ChaincodeDeploymentSpecs: source.ChaincodeDeploymentSpecs,
+		Restricted:               source.Restricted,
+		ArtifactLicenses:         source.ArtifactLicenses,
+		TargetPlatforms:          source.TargetPlatforms,
+		MinFabricVersion:         source.MinFabricVersion,
+		TimestampToken:           source.TimestampToken,
+		SignatureChain:           append(append([]*SignatureLink{}, source.SignatureChain...), publicationLink(source_descriptor_key_part, source_bundle_key_part, source)),
+	}
+	if err := verifySignatureChain(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	if appBundle, err = ac.prepareAppBundle(app_bundle_key_part, appBundle); err != nil {
+		return nil, err
+	}
+	appBundleBytes, err := ac.putAsset(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, appBundle)
+	if err != nil {
+		return nil, fmt.Errorf("Error in republishBundle: %s", err)
+	}
+	return appBundleBytes, nil
+}
+
+func (ac *assetContext) getSignatureChain() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to getSignatureChain")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getSignatureChain: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in getSignatureChain: %s", err)
+	}
+	if err := ac.checkBundleReadAccess(app_descriptor_key_part, app_bundle_key_part, appBundle); err != nil {
+		return nil, fmt.Errorf("Error in getSignatureChain: %s", err)
+	}
+
+	signatureChain := &SignatureChain{Links: append(append([]*SignatureLink{}, appBundle.SignatureChain...), publicationLink(app_descriptor_key_part, app_bundle_key_part, appBundle))}
+	if err := verifyOwnerEndorsements(appBundle); err != nil {
+		signatureChain.VerificationError = err.Error()
+	} else {
+		signatureChain.Verified = true
+	}
+	signatureChainBytes, err := proto.Marshal(signatureChain)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling SignatureChain in getSignatureChain: %s", err)
+	}
+	return signatureChainBytes, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/msp"
+	sc "github.com/hyperledger/fabric/protos/peer"
+)
+
+// testCA issues the certificates of testSigners of Org1MSP.
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	pem     []byte
+	serials int64
+}
+
+// testSigner is a serialized identity of Org1MSP whose certificate is issued by a testCA, and
+// the key it signs with.
+type testSigner struct {
+	identity []byte
+	key      *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca.org1"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), serials: 1}
+}
+
+// issue returns a testSigner named name.
+func (ca *testCA) issue(t *testing.T, name string) *testSigner {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca.serials++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serials),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := proto.Marshal(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigner{identity: identity, key: key}
+}
+
+// sign returns the DER encoded ECDSA signature of signer over the SHA-256 digest of message.
+func (signer *testSigner) sign(t *testing.T, message []byte) []byte {
+	digest := sha256.Sum256(message)
+	r, s, err := ecdsa.Sign(rand.Reader, signer.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := asn1.Marshal(ecdsaSignature{R: r, S: s})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signature
+}
+
+// endorse returns an owner endorsement of appBundle by signer.
+func (signer *testSigner) endorse(t *testing.T, appBundle *AppBundle) []byte {
+	var message []byte
+	for _, artifact := range appBundle.Artifacts {
+		message = append(message, artifact...)
+	}
+	for _, chaincodeDeploymentSpec := range appBundle.ChaincodeDeploymentSpecs {
+		message = append(message, chaincodeDeploymentSpec...)
+	}
+	message = append(message, signer.identity...)
+	endorsement, err := proto.Marshal(&sc.Endorsement{Endorser: signer.identity, Signature: signer.sign(t, message)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return endorsement
+}
+
+// mustGetSignatureChain returns the SignatureChain of an AppBundle.
+func (s *testRegistry) mustGetSignatureChain(t *testing.T, app_descriptor_key string, app_bundle_key string) *SignatureChain {
+	signatureChain := &SignatureChain{}
+	if err := proto.Unmarshal(s.mustInvoke(t, "getSignatureChain", app_descriptor_key, app_bundle_key), signatureChain); err != nil {
+		t.Fatal(err)
+	}
+	return signatureChain
+}
+
+// A republished AppBundle belongs to its republisher and is verified by the endorsements of its
+// original publisher, however many times it is republished.
+func TestRepublishBundle(t *testing.T) {
+	ca := newTestCA(t)
+	publisher := ca.issue(t, "publisher")
+	republisher := ca.issue(t, "republisher")
+
+	s := newTestRegistry(t)
+	s.Creator = publisher.identity
+	s.mustInvoke(t, "createAppDescriptor", "original", &AppDescriptor{Description: "original"})
+	appBundle := &AppBundle{DescriptorId: "original", Artifacts: [][]byte{[]byte("artifact")}}
+	appBundle.OwnerEndorsements = [][]byte{publisher.endorse(t, appBundle)}
+	s.mustInvoke(t, "createAppBundle", "endorsed", appBundle)
+	s.mustInvoke(t, "createAppBundle", "unendorsed", &AppBundle{DescriptorId: "original", Artifacts: [][]byte{[]byte("artifact")}})
+
+	s.Creator = republisher.identity
+	s.mustInvoke(t, "createAppDescriptor", "republished", &AppDescriptor{Description: "republished"})
+	s.mustInvoke(t, "republishBundle", "original", "endorsed", "republished", "once")
+	s.mustInvoke(t, "republishBundle", "republished", "once", "republished", "twice")
+	if message := s.mustFail(t, "republishBundle", "original", "unendorsed", "republished", "never"); !strings.Contains(message, "no owner endorsements") {
+		t.Fatalf("republishing an unendorsed AppBundle failed with %q", message)
+	}
+
+	signatureChain := s.mustGetSignatureChain(t, "republished", "twice")
+	if !signatureChain.Verified {
+		t.Fatalf("the signature chain does not verify: %s", signatureChain.VerificationError)
+	}
+	var links []string
+	for _, link := range signatureChain.Links {
+		links = append(links, link.DescriptorId+"/"+link.BundleKey)
+	}
+	if got, want := strings.Join(links, " "), "original/endorsed republished/once republished/twice"; got != want {
+		t.Errorf("the signature chain links %s, want %s", got, want)
+	}
+	if got, want := normalizeIdentity(signatureChain.Links[0].Publisher), normalizeIdentity(publisher.identity); got != want {
+		t.Errorf("the original publisher is %s, want %s", got, want)
+	}
+}
+
+// An imported AppBundle does not keep the signature_chain its exporter states: a forged link
+// would otherwise be reported as verified provenance.
+func TestImportBundleDropsSignatureChain(t *testing.T) {
+	ca := newTestCA(t)
+	publisher := ca.issue(t, "publisher")
+	republisher := ca.issue(t, "republisher")
+
+	source := newTestRegistry(t)
+	source.ChannelID = "registry"
+	source.Creator = publisher.identity
+	source.mustInvoke(t, "createAppDescriptor", "original", &AppDescriptor{Description: "original"})
+	appBundle := &AppBundle{DescriptorId: "original", Artifacts: [][]byte{[]byte("artifact")}}
+	appBundle.OwnerEndorsements = [][]byte{publisher.endorse(t, appBundle)}
+	source.mustInvoke(t, "createAppBundle", "endorsed", appBundle)
+	source.Creator = republisher.identity
+	source.mustInvoke(t, "createAppDescriptor", "republished", &AppDescriptor{Description: "republished"})
+	source.mustInvoke(t, "republishBundle", "original", "endorsed", "republished", "once")
+
+	target := newTestRegistry(t)
+	target.ChannelID = "app"
+	target.mustInvoke(t, "setReplicationTrustRoots", "Org1MSP", ca.pem)
+	target.Creator = republisher.identity
+	target.mustInvoke(t, "createAppDescriptor", "republished", &AppDescriptor{Description: "republished"})
+
+	// The exporter inserts a link claiming the bundle was also published by someone else
+	assetEnvelope := &AssetEnvelope{}
+	if err := proto.Unmarshal(source.mustInvoke(t, "exportAssetForChannel", "APP_BUNDLE", "republished", "once"), assetEnvelope); err != nil {
+		t.Fatal(err)
+	}
+	exported := &AppBundle{}
+	if err := proto.Unmarshal(assetEnvelope.Value, exported); err != nil {
+		t.Fatal(err)
+	}
+	if len(exported.SignatureChain) != 1 {
+		t.Fatalf("the exported AppBundle has %d links, want 1", len(exported.SignatureChain))
+	}
+	forged := &SignatureLink{DescriptorId: "forged", BundleKey: "forged", Publisher: ca.issue(t, "forger").identity, PublishedAt: 1}
+	exported.SignatureChain = append(exported.SignatureChain, forged)
+	value, err := proto.Marshal(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assetEnvelope.Value = value
+	envelope, err := proto.Marshal(assetEnvelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target.mustInvoke(t, "importAssetFromChannel", &SignedAssetEnvelope{Envelope: envelope, Signature: republisher.sign(t, envelope)})
+
+	signatureChain := target.mustGetSignatureChain(t, "republished", "once")
+	if len(signatureChain.Links) != 1 || signatureChain.Links[0].DescriptorId != "republished" {
+		t.Fatalf("the imported AppBundle has %d links, want only its own publication", len(signatureChain.Links))
+	}
+	if signatureChain.Verified {
+		t.Errorf("the imported AppBundle verifies without owner endorsements of its own")
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Split storage keeps the public part of an asset in world state and a private part in a
+// private data collection, under the asset's key parts and the object type
+// <object_type>_PRIVATE. The private part is passed in the transient map so it never appears
+// in the transaction, and only peers of the collection's member orgs hold it; reads merge it
+// into the public part on those peers for authorized callers.
+//
+// AppDescriptors use it for their DescriptorPrivateDetails (pricing, contacts), which the
+// descriptor owner and identities of the owner's org may read.
+
+// PRIVATE_PART_OBJECTTYPE_SUFFIX is appended to the object type of a split asset's private part.
+const PRIVATE_PART_OBJECTTYPE_SUFFIX = "_PRIVATE"
+
+// DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY is the transient map key holding
+// DescriptorPrivateDetails.
+const DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY = "private_details"
+
+// privatePartFromTransient reads the private part stored under transient_key in the transient
+// map into privatePart, returning false if there is none.
+func (ac *assetContext) privatePartFromTransient(transient_key string, privatePart proto.Message) (bool, error) {
+	transientMap, err := ac.stub.GetTransient()
+	if err != nil {
+		return false, fmt.Errorf("Could not get transient map: %s", err)
+	}
+	privatePartBytes, ok := transientMap[transient_key]
+	if !ok {
+		return false, nil
+	}
+	if err := proto.Unmarshal(privatePartBytes, privatePart); err != nil {
+		return false, fmt.Errorf("Cannot unmarshal transient %s: %s", transient_key, err)
+	}
+	return true, nil
+}
+
+func (ac *assetContext) putPrivatePart(collection string, objectType string, key_parts []string, privatePart proto.Message) error {
+	compositeKey, err := ac.stub.CreateCompositeKey(objectType+PRIVATE_PART_OBJECTTYPE_SUFFIX, key_parts)
+	if err != nil {
+		return fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, key_parts, err)
+	}
+	privatePartBytes, err := marshalDeterministic(privatePart)
+	if err != nil {
+		return fmt.Errorf("Error marshaling proto: %s", err)
+	}
+	if err := ac.stub.PutPrivateData(collection, compositeKey, privatePartBytes); err != nil {
+		return fmt.Errorf("Could not put private data for key %s in collection %s: %s", compositeKey, collection, err)
+	}
+	return nil
+}
+
+// getPrivatePart reads the private part of an asset into privatePart, returning false if this
+// peer does not hold it.
+func (ac *assetContext) getPrivatePart(collection string, objectType string, key_parts []string, privatePart proto.Message) (bool, error) {
+	compositeKey, err := ac.stub.CreateCompositeKey(objectType+PRIVATE_PART_OBJECTTYPE_SUFFIX, key_parts)
+	if err != nil {
+		return false, fmt.Errorf("Error creating composite key for object_type (%s) and key_parts (%v):  %s", objectType, key_parts, err)
+	}
+	privatePartBytes, err := ac.stub.GetPrivateData(collection, compositeKey)
+	if err != nil {
+		return false, fmt.Errorf("Could not get private data for key %s in collection %s: %s", compositeKey, collection, err)
+	}
+	if privatePartBytes == nil {
+		return false, nil
+	}
+	if err := proto.Unmarshal(privatePartBytes, privatePart); err != nil {
+		return false, fmt.Errorf("Cannot unmarshal private part of %s (%v): %s", objectType, key_parts, err)
+	}
+	return true, nil
+}
+
+// storeDescriptorPrivateDetails stores the DescriptorPrivateDetails passed in the transient map,
+// if any, in the descriptor's private_collection.
+func (ac *assetContext) storeDescriptorPrivateDetails(app_descriptor_key_part string, appDescriptor *AppDescriptor) error {
+	if appDescriptor.PrivateDetails != nil {
+		return fmt.Errorf("AppDescriptor private_details must be passed in the transient map under %s", DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY)
+	}
+	privateDetails := &DescriptorPrivateDetails{}
+	found, err := ac.privatePartFromTransient(DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY, privateDetails)
+	if err != nil || !found {
+		return err
+	}
+	if len(appDescriptor.PrivateCollection) == 0 {
+		return fmt.Errorf("AppDescriptor private_details require a private_collection")
+	}
+	return ac.putPrivatePart(appDescriptor.PrivateCollection, COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, privateDetails)
+}
+
+// canReadDescriptorPrivateDetails reports whether the caller is the descriptor owner or an
+// identity of the owner's org.
+func (ac *assetContext) canReadDescriptorPrivateDetails(appDescriptor *AppDescriptor) bool {
+	if bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return true
+	}
+	owner_mspid, err := mspIdFromIdentity(appDescriptor.Owner)
+	if err != nil {
+		return false
+	}
+	return ac.mspId == owner_mspid
+}
+
+// mergeDescriptorPrivateDetails sets appDescriptor.private_details if the caller may read them
+// and this peer holds them.
+func (ac *assetContext) mergeDescriptorPrivateDetails(app_descriptor_key_part string, appDescriptor *AppDescriptor) error {
+	if len(appDescriptor.PrivateCollection) == 0 || !ac.canReadDescriptorPrivateDetails(appDescriptor) {
+		return nil
+	}
+	privateDetails := &DescriptorPrivateDetails{}
+	found, err := ac.getPrivatePart(appDescriptor.PrivateCollection, COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, privateDetails)
+	if err != nil {
+		return err
+	}
+	if found {
+		appDescriptor.PrivateDetails = privateDetails
+	}
+	return nil
+}
+
+func (ac *assetContext) setDescriptorPrivateDetails() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setDescriptorPrivateDetails")
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setDescriptorPrivateDetails: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may set its private details", app_descriptor_key_part)
+	}
+	privateDetails := &DescriptorPrivateDetails{}
+	found, err := ac.privatePartFromTransient(DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY, privateDetails)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setDescriptorPrivateDetails: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("Error in setDescriptorPrivateDetails, the details must be passed in the transient map under %s", DESCRIPTOR_PRIVATE_DETAILS_TRANSIENT_KEY)
+	}
+	if len(appDescriptor.PrivateCollection) == 0 {
+		return nil, fmt.Errorf("Error in setDescriptorPrivateDetails, AppDescriptor %s has no private_collection", app_descriptor_key_part)
+	}
+	if err := ac.putPrivatePart(appDescriptor.PrivateCollection, COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, privateDetails); err != nil {
+		return nil, fmt.Errorf("Error in setDescriptorPrivateDetails: %s", err)
+	}
+	// Only the public part is returned, responses are recorded in the transaction
+	return marshalDeterministic(appDescriptor)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// The SupportInfo of an AppDescriptor tells its consumers where to report issues: a support
+// URL, a hash of the security contact and the SLA tier the publisher commits to. It is checked
+// whenever it is written, by createAppDescriptor or setDescriptorSupport, and returned with the
+// descriptor and in the trending summaries. Consortia whose onboarding policy requires support
+// details enforce it with a PolicyRule on createAppDescriptor with the predicate "support"
+// PRESENT.
+
+// MAX_SUPPORT_URL_LENGTH bounds the support_url of a SupportInfo.
+const MAX_SUPPORT_URL_LENGTH = 2048
+
+// validateSupportInfo checks the SupportInfo of a descriptor, which may be unset.
+func validateSupportInfo(supportInfo *SupportInfo) error {
+	if supportInfo == nil {
+		return nil
+	}
+	if len(supportInfo.SupportUrl) == 0 || len(supportInfo.SupportUrl) > MAX_SUPPORT_URL_LENGTH {
+		return fmt.Errorf("support.support_url must be between 1 and %d bytes", MAX_SUPPORT_URL_LENGTH)
+	}
+	supportUrl, err := url.Parse(supportInfo.SupportUrl)
+	if err != nil || supportUrl.Scheme != "https" || len(supportUrl.Host) == 0 {
+		return fmt.Errorf("support.support_url %s is not an https URL", supportInfo.SupportUrl)
+	}
+	if len(supportInfo.SecurityContactHash) != 0 && len(supportInfo.SecurityContactHash) != sha256.Size {
+		return fmt.Errorf("support.security_contact_hash must be a %d byte SHA-256 digest", sha256.Size)
+	}
+	if _, ok := SupportInfo_SlaTier_name[int32(supportInfo.SlaTier)]; !ok {
+		return fmt.Errorf("support.sla_tier %d is not an SlaTier", supportInfo.SlaTier)
+	}
+	return nil
+}
+
+func (ac *assetContext) setDescriptorSupport() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	var supportInfoBytes = []byte{}
+
+	switch len(args) {
+	case 3:
+		app_descriptor_key_part = string(args[1])
+		supportInfoBytes = args[2]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setDescriptorSupport")
+	}
+
+	supportInfo := &SupportInfo{}
+	if err := proto.Unmarshal(supportInfoBytes, supportInfo); err != nil {
+		return nil, fmt.Errorf("Cannot unmarshal SupportInfo, err = %s", err)
+	}
+
+	appDescriptor, err := ac.getDescriptor(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in setDescriptorSupport: %s", err)
+	}
+	if !bytes.Equal(appDescriptor.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppDescriptor %s may set its support", app_descriptor_key_part)
+	}
+	if err := validateSupportInfo(supportInfo); err != nil {
+		return nil, fmt.Errorf("Error in setDescriptorSupport: %s", err)
+	}
+	appDescriptor.Support = supportInfo
+	return ac.putAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{app_descriptor_key_part}, appDescriptor)
+}
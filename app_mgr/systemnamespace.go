@@ -0,0 +1,197 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// The registry's own records (its config, admins and migration state, the rate, function and
+// royalty counters, the audit trail of repairs, the progress of rollups and the index of renamed keys)
+// are stored under the reserved SYSTEM composite key prefix, apart from the assets of its
+// users, however many kinds of internal record the registry grows. The event journal, which is
+// read by key range, is kept under simple keys beginning SYSTEM. instead, see eventdigest.go.
+// systemStub applies the prefix below the handlers: they address a system record by its object
+// type as before, and its composite key becomes SYSTEM, <object type>, <key parts>. Functions
+// addressing assets by an object type their caller supplies refuse the system object types, so
+// system records are read and written only by the functions that own them.
+//
+// Registries deployed before the prefix existed keep their system records under their object
+// types until a migration moves them. Until then a read of a system record falls back to its
+// legacy key, and a delete deletes both, so the registry keeps working while it migrates.
+
+const COMPOSITE_KEY_SYSTEM_PREFIX = "SYSTEM"
+
+// systemObjectTypes holds the object types of the registry's own records.
+var systemObjectTypes = map[string]bool{
+	COMPOSITE_KEY_CONFIG_OBJECTTYPE:          true,
+	COMPOSITE_KEY_RATE_COUNTER_OBJECTTYPE:    true,
+	COMPOSITE_KEY_ROLLUP_PROGRESS_OBJECTTYPE: true,
+	COMPOSITE_KEY_REPAIR_OBJECTTYPE:          true,
+	COMPOSITE_KEY_ALIAS_OBJECTTYPE:           true,
+	COMPOSITE_KEY_FUNCTION_STATS_OBJECTTYPE:  true,
+	COMPOSITE_KEY_EVENT_JOURNAL_OBJECTTYPE:   true,
+	COMPOSITE_KEY_ROYALTY_TOTAL_OBJECTTYPE:   true,
+}
+
+// checkNotSystemObjectType refuses the object types of system records, for functions that
+// address assets by an object type supplied by their caller.
+func checkNotSystemObjectType(objectType string) error {
+	if systemObjectTypes[objectType] {
+		return fmt.Errorf("object_type %s is reserved to the registry", objectType)
+	}
+	return nil
+}
+
+type systemStub struct {
+	shim.ChaincodeStubInterface
+}
+
+func newSystemStub(stub shim.ChaincodeStubInterface) *systemStub {
+	return &systemStub{ChaincodeStubInterface: stub}
+}
+
+func (ss *systemStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
+	if !systemObjectTypes[objectType] {
+		return ss.ChaincodeStubInterface.CreateCompositeKey(objectType, attributes)
+	}
+	return ss.ChaincodeStubInterface.CreateCompositeKey(COMPOSITE_KEY_SYSTEM_PREFIX, append([]string{objectType}, attributes...))
+}
+
+// SplitCompositeKey returns the object type and key parts of a system record without the prefix.
+func (ss *systemStub) SplitCompositeKey(compositeKey string) (string, []string, error) {
+	objectType, attributes, err := ss.ChaincodeStubInterface.SplitCompositeKey(compositeKey)
+	if err != nil || objectType != COMPOSITE_KEY_SYSTEM_PREFIX || len(attributes) == 0 {
+		return objectType, attributes, err
+	}
+	return attributes[0], attributes[1:], nil
+}
+
+func (ss *systemStub) GetStateByPartialCompositeKey(objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	if !systemObjectTypes[objectType] {
+		return ss.ChaincodeStubInterface.GetStateByPartialCompositeKey(objectType, keys)
+	}
+	return ss.ChaincodeStubInterface.GetStateByPartialCompositeKey(COMPOSITE_KEY_SYSTEM_PREFIX, append([]string{objectType}, keys...))
+}
+
+func (ss *systemStub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if !systemObjectTypes[objectType] {
+		return ss.ChaincodeStubInterface.GetStateByPartialCompositeKeyWithPagination(objectType, keys, pageSize, bookmark)
+	}
+	return ss.ChaincodeStubInterface.GetStateByPartialCompositeKeyWithPagination(COMPOSITE_KEY_SYSTEM_PREFIX, append([]string{objectType}, keys...), pageSize, bookmark)
+}
+
+// legacyKey returns the key a system record had before the SYSTEM prefix, if compositeKey is
+// the key of a system record.
+func (ss *systemStub) legacyKey(compositeKey string) (string, bool, error) {
+	objectType, attributes, err := ss.ChaincodeStubInterface.SplitCompositeKey(compositeKey)
+	if err != nil || objectType != COMPOSITE_KEY_SYSTEM_PREFIX || len(attributes) == 0 {
+		return "", false, nil
+	}
+	legacyKey, err := ss.ChaincodeStubInterface.CreateCompositeKey(attributes[0], attributes[1:])
+	if err != nil {
+		return "", false, err
+	}
+	return legacyKey, true, nil
+}
+
+func (ss *systemStub) GetState(key string) ([]byte, error) {
+	value, err := ss.ChaincodeStubInterface.GetState(key)
+	if err != nil || value != nil {
+		return value, err
+	}
+	legacyKey, ok, err := ss.legacyKey(key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return ss.ChaincodeStubInterface.GetState(legacyKey)
+}
+
+func (ss *systemStub) DelState(key string) error {
+	if err := ss.ChaincodeStubInterface.DelState(key); err != nil {
+		return err
+	}
+	legacyKey, ok, err := ss.legacyKey(key)
+	if err != nil || !ok {
+		return err
+	}
+	return ss.ChaincodeStubInterface.DelState(legacyKey)
+}
+
+// moveLegacyRecords moves up to batchSize system records of objectType stored under their
+// legacy keys after bookmark to the SYSTEM prefix, in key order. A record written since the
+// chaincode was upgraded is newer than its legacy copy, which is then just deleted.
+func (ss *systemStub) moveLegacyRecords(objectType string, bookmark string, batchSize int) (string, int, error) {
+	stateQueryIterator, err := ss.ChaincodeStubInterface.GetStateByPartialCompositeKey(objectType, []string{})
+	if err != nil {
+		return "", 0, fmt.Errorf("Error scanning object_type %s: %s", objectType, err)
+	}
+	defer stateQueryIterator.Close()
+
+	moved := 0
+	for moved < batchSize && stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return "", 0, fmt.Errorf("Error scanning object_type %s: %s", objectType, err)
+		}
+		if kv.Key <= bookmark {
+			continue
+		}
+		_, key_parts, err := ss.ChaincodeStubInterface.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return "", 0, err
+		}
+		systemKey, err := ss.CreateCompositeKey(objectType, key_parts)
+		if err != nil {
+			return "", 0, err
+		}
+		current, err := ss.ChaincodeStubInterface.GetState(systemKey)
+		if err != nil {
+			return "", 0, err
+		}
+		if current == nil {
+			if err := ss.ChaincodeStubInterface.PutState(systemKey, kv.Value); err != nil {
+				return "", 0, fmt.Errorf("Could not put state for key %s: %s", systemKey, err)
+			}
+		}
+		if err := ss.ChaincodeStubInterface.DelState(kv.Key); err != nil {
+			return "", 0, fmt.Errorf("Could not delete state for key %s: %s", kv.Key, err)
+		}
+		bookmark = kv.Key
+		moved++
+	}
+	return bookmark, moved, nil
+}
+
+// migrateSystemRecords moves the system records of every system object type to the SYSTEM
+// prefix. The legacy keys of the object types sort in the order of their names, so a single
+// bookmark tracks the progress across them.
+func migrateSystemRecords(ac *assetContext, bookmark string, batchSize int) (string, bool, error) {
+	var objectTypes []string
+	for objectType := range systemObjectTypes {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	for _, objectType := range objectTypes {
+		var moved int
+		var err error
+		bookmark, moved, err = ac.system.moveLegacyRecords(objectType, bookmark, batchSize)
+		if err != nil {
+			return "", false, err
+		}
+		batchSize -= moved
+		if batchSize == 0 {
+			return bookmark, false, nil
+		}
+	}
+	return bookmark, true, nil
+}
@@ -0,0 +1,254 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// The ledger proves when an AppBundle was created only to members of the channel. To prove the
+// existence of its content to anyone else, the owner has its Merkle root timestamped by an
+// RFC 3161 timestamp authority and attaches the TimeStampToken to the AppBundle, in the payload
+// of createAppBundle or later with attachTimestampToken. The message imprint of the token is
+// the merkle_root itself, as the SHA-256 digest of the content, e.g. as requested with openssl
+// ts -query -sha256 -digest <merkle_root hex>. When the token is attached the registry checks
+// its hash linkage: the imprint must be the Merkle root, and the messageDigest attribute the
+// TSA signed must be the digest of the token's TSTInfo. It records the genTime as
+// timestamped_at. The TSA's signature and certificate are not verified on the ledger: relying
+// parties verify them with the trust anchors of their TSA, against the merkle_root they can
+// recompute from the content.
+
+// MAX_TIMESTAMP_TOKEN_LENGTH bounds a TimeStampToken, certificates included.
+const MAX_TIMESTAMP_TOKEN_LENGTH = 64 * 1024
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+)
+
+// timestampDigestAlgorithms maps the OIDs of the digest algorithms a TSA may sign with to their
+// hash functions.
+var timestampDigestAlgorithms = map[string]crypto.Hash{
+	oidSHA256.String(): crypto.SHA256,
+	asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}.String(): crypto.SHA384,
+	asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}.String(): crypto.SHA512,
+}
+
+// The ASN.1 structures of a TimeStampToken, a CMS ContentInfo of SignedData (RFC 5652)
+// encapsulating a TSTInfo (RFC 3161), reduced to the fields the hash linkage needs.
+
+type tsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type tsSignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo tsEncapContentInfo
+	Certificates     asn1.RawValue  `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue  `asn1:"optional,tag:1"`
+	SignerInfos      []tsSignerInfo `asn1:"set"`
+}
+
+type tsEncapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,tag:0"`
+}
+
+type tsSignerInfo struct {
+	Version            int
+	Sid                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        []tsAttribute `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type tsAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+type tsTSTInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint tsMessageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time `asn1:"generalized"`
+}
+
+type tsMessageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+// signedAttribute returns the single value of the attribute of type oid.
+func signedAttribute(signerInfo *tsSignerInfo, oid asn1.ObjectIdentifier) (asn1.RawValue, error) {
+	var value asn1.RawValue
+	for _, attribute := range signerInfo.SignedAttrs {
+		if !attribute.Type.Equal(oid) {
+			continue
+		}
+		rest, err := asn1.Unmarshal(attribute.Values.Bytes, &value)
+		if err != nil || len(rest) != 0 {
+			return value, fmt.Errorf("the signed attribute %s must have a single value", oid)
+		}
+		return value, nil
+	}
+	return value, fmt.Errorf("the signer has no signed attribute %s", oid)
+}
+
+// verifyTimestampToken checks that token is a DER TimeStampToken over merkle_root whose TSA
+// signed the digest of its TSTInfo, and returns its genTime in seconds since the epoch.
+func verifyTimestampToken(token []byte, merkle_root []byte) (int64, error) {
+	if len(token) > MAX_TIMESTAMP_TOKEN_LENGTH {
+		return 0, fmt.Errorf("the timestamp_token exceeds %d bytes", MAX_TIMESTAMP_TOKEN_LENGTH)
+	}
+	if len(merkle_root) == 0 {
+		return 0, fmt.Errorf("the AppBundle has no merkle_root to timestamp, see backfill")
+	}
+	contentInfo := &tsContentInfo{}
+	if rest, err := asn1.Unmarshal(token, contentInfo); err != nil || len(rest) != 0 {
+		return 0, fmt.Errorf("the timestamp_token is not a DER ContentInfo")
+	}
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return 0, fmt.Errorf("the timestamp_token is a %s ContentInfo, not SignedData", contentInfo.ContentType)
+	}
+	signedData := &tsSignedData{}
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, signedData); err != nil {
+		return 0, fmt.Errorf("the timestamp_token has no valid SignedData: %s", err)
+	}
+	if !signedData.EncapContentInfo.EContentType.Equal(oidTSTInfo) {
+		return 0, fmt.Errorf("the timestamp_token encapsulates %s content, not a TSTInfo", signedData.EncapContentInfo.EContentType)
+	}
+	tstInfo := &tsTSTInfo{}
+	if _, err := asn1.Unmarshal(signedData.EncapContentInfo.EContent, tstInfo); err != nil {
+		return 0, fmt.Errorf("the timestamp_token has no valid TSTInfo: %s", err)
+	}
+
+	if !tstInfo.MessageImprint.HashAlgorithm.Algorithm.Equal(oidSHA256) {
+		return 0, fmt.Errorf("the message imprint of the timestamp_token must be a SHA-256 digest")
+	}
+	if !bytes.Equal(tstInfo.MessageImprint.HashedMessage, merkle_root) {
+		return 0, fmt.Errorf("the message imprint of the timestamp_token is not the merkle_root")
+	}
+
+	// RFC 3161 allows the signature of the TSA only
+	if len(signedData.SignerInfos) != 1 {
+		return 0, fmt.Errorf("the timestamp_token has %d signers, a TimeStampToken has one", len(signedData.SignerInfos))
+	}
+	signerInfo := &signedData.SignerInfos[0]
+	hash, ok := timestampDigestAlgorithms[signerInfo.DigestAlgorithm.Algorithm.String()]
+	if !ok {
+		return 0, fmt.Errorf("the timestamp_token is signed with the unsupported digest algorithm %s", signerInfo.DigestAlgorithm.Algorithm)
+	}
+	contentType, err := signedAttribute(signerInfo, oidContentType)
+	if err != nil {
+		return 0, fmt.Errorf("timestamp_token: %s", err)
+	}
+	var signed_content_type asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(contentType.FullBytes, &signed_content_type); err != nil || !signed_content_type.Equal(oidTSTInfo) {
+		return 0, fmt.Errorf("the TSA of the timestamp_token did not sign a TSTInfo content type")
+	}
+	messageDigest, err := signedAttribute(signerInfo, oidMessageDigest)
+	if err != nil {
+		return 0, fmt.Errorf("timestamp_token: %s", err)
+	}
+	digest := hash.New()
+	digest.Write(signedData.EncapContentInfo.EContent)
+	if messageDigest.Tag != asn1.TagOctetString || !bytes.Equal(messageDigest.Bytes, digest.Sum(nil)) {
+		return 0, fmt.Errorf("the digest the TSA of the timestamp_token signed is not the digest of its TSTInfo")
+	}
+	return tstInfo.GenTime.Unix(), nil
+}
+
+// anchorTimestampToken verifies the timestamp_token of an AppBundle, if any, and sets its
+// timestamped_at.
+func anchorTimestampToken(appBundle *AppBundle) error {
+	appBundle.TimestampedAt = 0
+	if len(appBundle.TimestampToken) == 0 {
+		return nil
+	}
+	timestamped_at, err := verifyTimestampToken(appBundle.TimestampToken, appBundle.MerkleRoot)
+	if err != nil {
+		return err
+	}
+	appBundle.TimestampedAt = timestamped_at
+	return nil
+}
+
+// verifyCarriedTimestampToken checks the timestamp_token of an AppBundle arriving from
+// elsewhere, and that its timestamped_at is the token's.
+func verifyCarriedTimestampToken(appBundle *AppBundle) error {
+	if len(appBundle.TimestampToken) == 0 {
+		if appBundle.TimestampedAt != 0 {
+			return fmt.Errorf("the AppBundle has a timestamped_at but no timestamp_token")
+		}
+		return nil
+	}
+	timestamped_at, err := verifyTimestampToken(appBundle.TimestampToken, appBundle.MerkleRoot)
+	if err != nil {
+		return err
+	}
+	if timestamped_at != appBundle.TimestampedAt {
+		return fmt.Errorf("the timestamped_at of the AppBundle is not the genTime of its timestamp_token")
+	}
+	return nil
+}
+
+// attachTimestampToken attaches a TimeStampToken to an AppBundle that has none.
+func (ac *assetContext) attachTimestampToken() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+	app_bundle_key_part := ""
+	var timestamp_token []byte
+
+	switch len(args) {
+	case 4:
+		app_descriptor_key_part = string(args[1])
+		app_bundle_key_part = string(args[2])
+		timestamp_token = args[3]
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to attachTimestampToken")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachTimestampToken: %s", err)
+	}
+	appBundle, err := ac.getAppBundle(app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in attachTimestampToken: %s", err)
+	}
+	if !bytes.Equal(appBundle.Owner, ac.creator) {
+		return nil, fmt.Errorf("Only the owner of AppBundle %s may attach a timestamp token", app_bundle_key_part)
+	}
+	if len(appBundle.TimestampToken) != 0 {
+		return nil, fmt.Errorf("Error in attachTimestampToken: AppBundle %s already has a timestamp token", app_bundle_key_part)
+	}
+	if len(timestamp_token) == 0 {
+		return nil, fmt.Errorf("Error in attachTimestampToken: a timestamp token is required")
+	}
+	appBundle.TimestampToken = timestamp_token
+	if err := anchorTimestampToken(appBundle); err != nil {
+		return nil, fmt.Errorf("Error in attachTimestampToken: %s", err)
+	}
+	appBundle.UpdatedMspId = ac.mspId
+	return ac.putAsset(COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE, []string{app_descriptor_key_part, app_bundle_key_part}, appBundle)
+}
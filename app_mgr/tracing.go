@@ -0,0 +1,69 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+)
+
+// Clients correlate an invocation with their own distributed traces by running it through
+// traced with a trace ID of their choosing, e.g. the demo UI's request ID forwarded by the
+// gateway. The trace ID is recorded with the invocation wherever it leaves a trail: the
+// RegistryEvent, RepairRecords and the RegistryConfig, and it is echoed in the message of a
+// successful response. The registry does not interpret trace IDs, so they are only bounded
+// to printable ASCII of at most MAX_TRACE_ID_LENGTH bytes, safe in headers and logs, that
+// does not start with "{", which marks a response message carrying warnings.
+
+const MAX_TRACE_ID_LENGTH = 128
+
+func validateTraceId(trace_id string) error {
+	if len(trace_id) == 0 || len(trace_id) > MAX_TRACE_ID_LENGTH {
+		return fmt.Errorf("trace_id must be between 1 and %d bytes", MAX_TRACE_ID_LENGTH)
+	}
+	for i := 0; i < len(trace_id); i++ {
+		if trace_id[i] < 0x20 || trace_id[i] > 0x7e {
+			return fmt.Errorf("trace_id must be printable ASCII")
+		}
+	}
+	if trace_id[0] == '{' {
+		return fmt.Errorf("trace_id must not start with {")
+	}
+	return nil
+}
+
+// traced runs the function named by its second argument with the remaining arguments, under
+// the trace ID given by its first. Any function but traced itself may be traced, including
+// the other wrappers.
+func (ac *assetContext) traced() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	if len(args) < 3 {
+		return nil, fmt.Errorf("Wrong number of arguments to traced")
+	}
+	trace_id := string(args[1])
+	function := string(args[2])
+	if err := validateTraceId(trace_id); err != nil {
+		return nil, fmt.Errorf("Error in traced: %s", err)
+	}
+	if function == "traced" {
+		return nil, fmt.Errorf("Error in traced, an invocation has a single trace ID")
+	}
+
+	// Set on ac too, for the RegistryEvent and response emitted once traced returns
+	ac.traceId = trace_id
+	overlay := newOverlayStub(ac.stub, args[2:])
+	inner := *ac
+	inner.stub = overlay
+	inner.function = function
+	result, err := inner.dispatch()
+	if err != nil {
+		return nil, err
+	}
+	if err := overlay.flush(); err != nil {
+		return nil, fmt.Errorf("Error in traced: %s", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Trending ranks AppDescriptors by recent activity. Clients report activity explicitly with
+// reportActivity; each report is keyed by UTC hour, descriptor and reporter, so an identity
+// counts at most once per descriptor per hour and concurrent reports never conflict on a
+// shared counter. getTrendingDescriptors counts the reports of the last <window_hours> hours
+// with one range query per hour.
+
+// ACTIVITY_BUCKET_LAYOUT formats the UTC hour an ActivityReport is counted in.
+const ACTIVITY_BUCKET_LAYOUT = "2006-01-02T15"
+
+// MAX_TRENDING_WINDOW_HOURS bounds the range queries a single getTrendingDescriptors makes.
+const MAX_TRENDING_WINDOW_HOURS = 7 * 24
+
+const DEFAULT_TRENDING_LIMIT = 10
+
+func activityBucket(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(ACTIVITY_BUCKET_LAYOUT)
+}
+
+func (ac *assetContext) ReportActivity(request *ReportActivityRequest) (*ActivityReport, error) {
+	app_descriptor_key_part := request.AppDescriptorKey
+
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in reportActivity: %s", err)
+	}
+	reported_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in reportActivity: %s", err)
+	}
+	activityReport := &ActivityReport{Kind: request.Kind, Reporter: ac.creator, ReportedAt: reported_at}
+	if _, err := ac.putAsset(COMPOSITE_KEY_ACTIVITY_OBJECTTYPE, []string{activityBucket(reported_at), app_descriptor_key_part, ac.identity}, activityReport); err != nil {
+		return nil, err
+	}
+	return activityReport, nil
+}
+
+func (ac *assetContext) GetTrendingDescriptors(request *GetTrendingDescriptorsRequest) (*TrendingDescriptors, error) {
+	window_hours := uint64(request.WindowHours)
+	if window_hours == 0 || window_hours > MAX_TRENDING_WINDOW_HOURS {
+		return nil, fmt.Errorf("Error in getTrendingDescriptors, window_hours must be between 1 and %d", MAX_TRENDING_WINDOW_HOURS)
+	}
+	var limit uint64 = DEFAULT_TRENDING_LIMIT
+	if request.Limit > 0 {
+		limit = uint64(request.Limit)
+	}
+
+	queryLimits, err := ac.queryLimits()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+	}
+	if limit > uint64(queryLimits.MaxResults) {
+		limit = uint64(queryLimits.MaxResults)
+	}
+	now, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+	}
+
+	counts := make(map[string]uint32)
+	for hour := uint64(0); hour < window_hours; hour++ {
+		bucket := activityBucket(now - int64(hour)*3600)
+		stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_ACTIVITY_OBJECTTYPE, []string{bucket})
+		if err != nil {
+			return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+		}
+		for stateQueryIterator.HasNext() {
+			kv, err := stateQueryIterator.Next()
+			if err != nil {
+				stateQueryIterator.Close()
+				return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+			}
+			_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+			if err != nil {
+				stateQueryIterator.Close()
+				return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+			}
+			counts[key_parts[1]]++
+		}
+		stateQueryIterator.Close()
+	}
+
+	trendingDescriptors := &TrendingDescriptors{}
+	for descriptor_id, count := range counts {
+		trendingDescriptors.Entries = append(trendingDescriptors.Entries, &TrendingDescriptors_Entry{DescriptorId: descriptor_id, ActivityCount: count})
+	}
+	sort.Slice(trendingDescriptors.Entries, func(i, j int) bool {
+		a, b := trendingDescriptors.Entries[i], trendingDescriptors.Entries[j]
+		if a.ActivityCount != b.ActivityCount {
+			return a.ActivityCount > b.ActivityCount
+		}
+		return a.DescriptorId < b.DescriptorId
+	})
+	if uint64(len(trendingDescriptors.Entries)) > limit {
+		trendingDescriptors.Entries = trendingDescriptors.Entries[:limit]
+	}
+	for _, entry := range trendingDescriptors.Entries {
+		appDescriptor := &AppDescriptor{}
+		found, err := ac.getAsset(COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE, []string{entry.DescriptorId}, appDescriptor)
+		if err != nil {
+			return nil, fmt.Errorf("Error in getTrendingDescriptors: %s", err)
+		}
+		if found {
+			entry.Support = appDescriptor.Support
+		}
+	}
+	return trendingDescriptors, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// The admins select a ValidationProfile for the APP_DESCRIPTOR and APP_BUNDLE namespaces in
+// the RegistryConfig, so one chaincode can serve both production-like channels and quick demo
+// channels. A function's writes are validated under the profile of the namespace it writes:
+// AppBundle creation under APP_BUNDLE, and descriptor creation and association under
+// APP_DESCRIPTOR. STANDARD applies the usual checks. STRICT adds verification of the owner
+// endorsements of AppBundles, and requires an associated AppBundle to carry a
+// ComplianceAttestation. LENIENT drops the payload schema checks and the governance gates,
+// leaving only the checks the handlers need to store a well-formed asset.
+
+// validatedNamespaces maps the functions whose validation follows a ValidationProfile to the
+// namespace whose profile applies.
+var validatedNamespaces = map[string]string{
+	"createAppDescriptor":           COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE,
+	"associateDescriptorWithBundle": COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE,
+	"createAppBundle":               COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE,
+	"createConfidentialAppBundle":   COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE,
+	"createPrivateAppBundle":        COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE,
+}
+
+// validationProfile returns the ValidationProfile applying to the invoked function.
+func (ac *assetContext) validationProfile() (ValidationProfile, error) {
+	namespace, ok := validatedNamespaces[ac.function]
+	if !ok {
+		return ValidationProfile_STANDARD, nil
+	}
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return ValidationProfile_STANDARD, err
+	}
+	return registryConfig.ValidationProfiles[namespace], nil
+}
+
+func (ac *assetContext) isLenient() (bool, error) {
+	profile, err := ac.validationProfile()
+	return profile == ValidationProfile_LENIENT, err
+}
+
+func (ac *assetContext) isStrict() (bool, error) {
+	profile, err := ac.validationProfile()
+	return profile == ValidationProfile_STRICT, err
+}
+
+// verifyOwnerEndorsements checks that appBundle has owner endorsements, each a marshaled
+// Endorsement whose signature is the owner's over the artifacts, the chaincode deployment specs
+// and the endorser. A republished AppBundle is instead checked by its signature chain.
+func verifyOwnerEndorsements(appBundle *AppBundle) error {
+	if len(appBundle.SignatureChain) != 0 {
+		return verifySignatureChain(appBundle)
+	}
+	if len(appBundle.OwnerEndorsements) == 0 {
+		return fmt.Errorf("the AppBundle has no owner endorsements")
+	}
+	return verifyEndorsements(appBundle, appBundle.Owner, appBundle.OwnerEndorsements)
+}
+
+// verifyEndorsements checks that each of endorsements is the signature of publisher over the
+// content of appBundle.
+func verifyEndorsements(appBundle *AppBundle, publisher []byte, endorsements [][]byte) error {
+	var signed bytes.Buffer
+	for _, artifact := range appBundle.Artifacts {
+		signed.Write(artifact)
+	}
+	for _, chaincodeDeploymentSpec := range appBundle.ChaincodeDeploymentSpecs {
+		signed.Write(chaincodeDeploymentSpec)
+	}
+	for i, ownerEndorsement := range endorsements {
+		endorsement := &pb.Endorsement{}
+		if err := proto.Unmarshal(ownerEndorsement, endorsement); err != nil {
+			return fmt.Errorf("owner_endorsements[%d] is not an Endorsement: %s", i, err)
+		}
+		message := append(append([]byte{}, signed.Bytes()...), endorsement.Endorser...)
+		if err := verifyIdentitySignature(publisher, message, endorsement.Signature); err != nil {
+			return fmt.Errorf("owner_endorsements[%d] is not signed by the owner: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// checkStrictBundle verifies the owner endorsements of a new AppBundle in a STRICT namespace.
+func (ac *assetContext) checkStrictBundle(appBundle *AppBundle) error {
+	if strict, err := ac.isStrict(); err != nil || !strict {
+		return err
+	}
+	return verifyOwnerEndorsements(appBundle)
+}
+
+// checkStrictAssociation verifies the AppBundle to be associated in a STRICT namespace.
+func (ac *assetContext) checkStrictAssociation(app_descriptor_key_part string, app_bundle_key_part string, appBundle *AppBundle) error {
+	if strict, err := ac.isStrict(); err != nil || !strict {
+		return err
+	}
+	if err := verifyOwnerEndorsements(appBundle); err != nil {
+		return err
+	}
+	attested, err := ac.hasAssetsUnder(COMPOSITE_KEY_COMPLIANCE_ATTESTATION_OBJECTTYPE, app_descriptor_key_part, app_bundle_key_part)
+	if err != nil {
+		return err
+	}
+	if !attested {
+		return fmt.Errorf("AppBundle %s of AppDescriptor %s has no ComplianceAttestation", app_bundle_key_part, app_descriptor_key_part)
+	}
+	return nil
+}
+
+func (ac *assetContext) setValidationProfile() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	namespace := ""
+	profile_name := ""
+
+	switch len(args) {
+	case 3:
+		namespace = string(args[1])
+		profile_name = string(args[2])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to setValidationProfile")
+	}
+	if namespace != COMPOSITE_KEY_APP_DESCRIPTOR_OBJECTTYPE && namespace != COMPOSITE_KEY_APP_BUNDLE_OBJECTTYPE {
+		return nil, fmt.Errorf("Error in setValidationProfile, namespace %s has no ValidationProfile", namespace)
+	}
+	profile_value, ok := ValidationProfile_value[profile_name]
+	if !ok {
+		return nil, fmt.Errorf("Error in setValidationProfile, unknown profile '%s'", profile_name)
+	}
+
+	registryConfig, err := ac.getRegistryConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Error in setValidationProfile: %s", err)
+	}
+	if ValidationProfile(profile_value) == ValidationProfile_STANDARD {
+		delete(registryConfig.ValidationProfiles, namespace)
+	} else {
+		if registryConfig.ValidationProfiles == nil {
+			registryConfig.ValidationProfiles = make(map[string]ValidationProfile)
+		}
+		registryConfig.ValidationProfiles[namespace] = ValidationProfile(profile_value)
+	}
+	return ac.putRegistryConfig(registryConfig)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+)
+
+// Watches subscribe identities to the changes of an AppDescriptor. They are keyed by the
+// descriptor key followed by the watcher's normalized identity, so the RegistryEvent of a
+// change can list a descriptor's watchers with a single range read. The number of watchers of
+// a descriptor is bounded, since they are all carried in every event that changes it.
+
+const MAX_WATCHERS_PER_DESCRIPTOR = 100
+
+// descriptorWatcherIds returns the watcher_ids of the Watches of an AppDescriptor, in key order.
+func (ac *assetContext) descriptorWatcherIds(app_descriptor_key_part string) ([]string, error) {
+	stateQueryIterator, err := ac.stub.GetStateByPartialCompositeKey(COMPOSITE_KEY_WATCH_OBJECTTYPE, []string{app_descriptor_key_part})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the Watches of AppDescriptor %s: %s", app_descriptor_key_part, err)
+	}
+	defer stateQueryIterator.Close()
+
+	var watcher_ids []string
+	for stateQueryIterator.HasNext() {
+		kv, err := stateQueryIterator.Next()
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the Watches of AppDescriptor %s: %s", app_descriptor_key_part, err)
+		}
+		_, key_parts, err := ac.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("Error splitting composite key %s: %s", kv.Key, err)
+		}
+		watcher_ids = append(watcher_ids, key_parts[len(key_parts)-1])
+	}
+	return watcher_ids, nil
+}
+
+func (ac *assetContext) watchDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to watchDescriptor")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in watchDescriptor: %s", err)
+	}
+	if _, err := ac.getDescriptor(app_descriptor_key_part); err != nil {
+		return nil, fmt.Errorf("Error in watchDescriptor: %s", err)
+	}
+	watcher_ids, err := ac.descriptorWatcherIds(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in watchDescriptor: %s", err)
+	}
+	for _, watcher_id := range watcher_ids {
+		if watcher_id == ac.identity {
+			return nil, fmt.Errorf("Error in watchDescriptor: AppDescriptor %s is already watched", app_descriptor_key_part)
+		}
+	}
+	if len(watcher_ids) >= MAX_WATCHERS_PER_DESCRIPTOR {
+		return nil, fmt.Errorf("Error in watchDescriptor: AppDescriptor %s already has %d watchers", app_descriptor_key_part, len(watcher_ids))
+	}
+
+	created_at, err := ac.txTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("Error in watchDescriptor: %s", err)
+	}
+	watch := &Watch{DescriptorId: app_descriptor_key_part, Watcher: ac.creator, WatcherId: ac.identity, CreatedAt: created_at}
+	return ac.putAsset(COMPOSITE_KEY_WATCH_OBJECTTYPE, []string{app_descriptor_key_part, ac.identity}, watch)
+}
+
+func (ac *assetContext) unwatchDescriptor() ([]byte, error) {
+	var args = ac.stub.GetArgs()
+	app_descriptor_key_part := ""
+
+	switch len(args) {
+	case 2:
+		app_descriptor_key_part = string(args[1])
+	default:
+		return nil, fmt.Errorf("Wrong number of arguments to unwatchDescriptor")
+	}
+
+	app_descriptor_key_part, err := ac.resolveDescriptorKey(app_descriptor_key_part)
+	if err != nil {
+		return nil, fmt.Errorf("Error in unwatchDescriptor: %s", err)
+	}
+	found, err := ac.getAsset(COMPOSITE_KEY_WATCH_OBJECTTYPE, []string{app_descriptor_key_part, ac.identity}, &Watch{})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unwatchDescriptor: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("AppDescriptor %s is not watched", app_descriptor_key_part)
+	}
+
+	compositeKey, err := ac.stub.CreateCompositeKey(COMPOSITE_KEY_WATCH_OBJECTTYPE, []string{app_descriptor_key_part, ac.identity})
+	if err != nil {
+		return nil, fmt.Errorf("Error in unwatchDescriptor, could not create watch composite key: %s", err)
+	}
+	if err := ac.stub.DelState(compositeKey); err != nil {
+		return nil, fmt.Errorf("Error in unwatchDescriptor, could not delete state for key %s: %s", compositeKey, err)
+	}
+	return nil, nil
+}
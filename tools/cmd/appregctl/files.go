@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+// loadMessage reads msg from a JSON file, or a YAML file if its extension is .yaml or .yml.
+// YAML is converted to JSON first, so both formats use the same field names.
+func loadMessage(path string, msg proto.Message) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var document interface{}
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return fmt.Errorf("cannot parse %s: %s", path, err)
+		}
+		if document, err = jsonCompatible(document); err != nil {
+			return fmt.Errorf("cannot convert %s: %s", path, err)
+		}
+		if data, err = json.Marshal(document); err != nil {
+			return fmt.Errorf("cannot convert %s: %s", path, err)
+		}
+	}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("cannot read %T from %s: %s", msg, path, err)
+	}
+	return nil
+}
+
+// jsonCompatible replaces the map[interface{}]interface{} values produced by the YAML decoder
+// with map[string]interface{}, which encoding/json can marshal.
+func jsonCompatible(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			name, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("field name %v is not a string", key)
+			}
+			converted, err := jsonCompatible(element)
+			if err != nil {
+				return nil, err
+			}
+			m[name] = converted
+		}
+		return m, nil
+	case []interface{}:
+		for i, element := range v {
+			converted, err := jsonCompatible(element)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
+
+func sortedCommandNames() []string {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
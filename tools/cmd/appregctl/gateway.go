@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type gatewayConfig struct {
+	peer       string
+	tlsCert    string
+	serverName string
+	mspID      string
+	cert       string
+	key        string
+	channel    string
+	chaincode  string
+}
+
+// gatewayInvoker is a client.Invoker backed by a Fabric Gateway contract. Unlike the peer CLI,
+// which passes arguments as JSON strings, it passes marshaled protos through unchanged, and
+// unlike client.NewGatewayInvoker it passes the transient map of a submission on to the peers.
+type gatewayInvoker struct {
+	grpc     *grpc.ClientConn
+	gateway  *client.Gateway
+	contract *client.Contract
+}
+
+func newGatewayInvoker(config gatewayConfig) (*gatewayInvoker, error) {
+	certPEM, err := ioutil.ReadFile(config.cert)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := identity.CertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	id, err := identity.NewX509Identity(config.mspID, certificate)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := ioutil.ReadFile(config.key)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := identity.PrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	sign, err := identity.NewPrivateKeySign(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	transportCredentials, err := credentials.NewClientTLSFromFile(config.tlsCert, config.serverName)
+	if err != nil {
+		return nil, err
+	}
+	grpcConnection, err := grpc.Dial(config.peer, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := client.Connect(id,
+		client.WithSign(sign),
+		client.WithClientConnection(grpcConnection),
+		client.WithEvaluateTimeout(5*time.Second),
+		client.WithSubmitTimeout(30*time.Second),
+	)
+	if err != nil {
+		grpcConnection.Close()
+		return nil, err
+	}
+	return &gatewayInvoker{
+		grpc:     grpcConnection,
+		gateway:  gateway,
+		contract: gateway.GetNetwork(config.channel).GetContract(config.chaincode),
+	}, nil
+}
+
+func (g *gatewayInvoker) Submit(function string, args [][]byte, transient map[string][]byte) ([]byte, error) {
+	options := []client.ProposalOption{client.WithBytesArguments(args...)}
+	if len(transient) != 0 {
+		options = append(options, client.WithTransient(transient))
+	}
+	return g.contract.Submit(function, options...)
+}
+
+func (g *gatewayInvoker) Evaluate(function string, args [][]byte) ([]byte, error) {
+	return g.contract.Evaluate(function, client.WithBytesArguments(args...))
+}
+
+func (g *gatewayInvoker) close() {
+	g.gateway.Close()
+	g.grpc.Close()
+}
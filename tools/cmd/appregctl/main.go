@@ -0,0 +1,208 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// appregctl drives the app_mgr chaincode from the command line, so demo operators and CI can
+// create, read, associate, query and export assets without writing code. Messages are read
+// from JSON or YAML files whose field names are those of app.proto, with bytes fields in
+// base64, and results are printed as JSON. The chaincode is reached through the Fabric Gateway
+// of a peer, as the gateway reaches it.
+//
+// Usage:
+//
+//	appregctl -peer localhost:7051 -tls-cert tlsca.pem -msp-id Org1MSP -cert cert.pem -key key.pem [flags] <command> <arg>...
+//
+// Commands:
+//
+//	create-descriptor <key> <app_descriptor_file> [<private_details_file>]
+//	create-bundle <key> <app_bundle_file>
+//	create-collection <key> <collection_file>
+//	associate <app_descriptor_key> <app_bundle_key>
+//	get-descriptor <key>
+//	get-bundle <app_descriptor_key> <app_bundle_key>
+//	get-collection <key>
+//	query-descriptors [<bookmark>]
+//	query-by-owner <namespace> <owner_id> <page_size> [<bookmark>]
+//	export <object_type> <key_part>...
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+)
+
+// command runs one appregctl command with its arguments, returning the message to print.
+type command struct {
+	usage string
+	// The number of arguments, or the minimum if variadic.
+	args     int
+	variadic bool
+	run      func(c *client.Client, args []string) (proto.Message, error)
+}
+
+var commands = map[string]command{
+	"create-descriptor": {"<key> <app_descriptor_file> [<private_details_file>]", 2, true, createDescriptor},
+	"create-bundle":     {"<key> <app_bundle_file>", 2, false, createBundle},
+	"create-collection": {"<key> <collection_file>", 2, false, createCollection},
+	"associate":         {"<app_descriptor_key> <app_bundle_key>", 2, false, associate},
+	"get-descriptor":    {"<key>", 1, false, getDescriptor},
+	"get-bundle":        {"<app_descriptor_key> <app_bundle_key>", 2, false, getBundle},
+	"get-collection":    {"<key>", 1, false, getCollection},
+	"query-descriptors": {"[<bookmark>]", 0, true, queryDescriptors},
+	"query-by-owner":    {"<namespace> <owner_id> <page_size> [<bookmark>]", 3, true, queryByOwner},
+	"export":            {"<object_type> <key_part>...", 2, true, export},
+}
+
+func createDescriptor(c *client.Client, args []string) (proto.Message, error) {
+	appDescriptor := &client.AppDescriptor{}
+	if err := loadMessage(args[1], appDescriptor); err != nil {
+		return nil, err
+	}
+	var privateDetails *client.DescriptorPrivateDetails
+	if len(args) > 2 {
+		privateDetails = &client.DescriptorPrivateDetails{}
+		if err := loadMessage(args[2], privateDetails); err != nil {
+			return nil, err
+		}
+	}
+	return c.CreateAppDescriptor(args[0], appDescriptor, privateDetails)
+}
+
+func createBundle(c *client.Client, args []string) (proto.Message, error) {
+	appBundle := &client.AppBundle{}
+	if err := loadMessage(args[1], appBundle); err != nil {
+		return nil, err
+	}
+	return c.CreateAppBundle(args[0], appBundle)
+}
+
+func createCollection(c *client.Client, args []string) (proto.Message, error) {
+	collection := &client.Collection{}
+	if err := loadMessage(args[1], collection); err != nil {
+		return nil, err
+	}
+	return c.CreateCollection(args[0], collection)
+}
+
+func associate(c *client.Client, args []string) (proto.Message, error) {
+	return c.AssociateDescriptorWithBundle(args[0], args[1])
+}
+
+func getDescriptor(c *client.Client, args []string) (proto.Message, error) {
+	return c.GetAppDescriptor(args[0])
+}
+
+func getBundle(c *client.Client, args []string) (proto.Message, error) {
+	return c.GetAppBundleForDescriptor(args[0], args[1])
+}
+
+func getCollection(c *client.Client, args []string) (proto.Message, error) {
+	return c.GetCollection(args[0])
+}
+
+func queryDescriptors(c *client.Client, args []string) (proto.Message, error) {
+	bookmark := ""
+	if len(args) > 0 {
+		bookmark = args[0]
+	}
+	return c.GetAppDescriptors(bookmark)
+}
+
+func queryByOwner(c *client.Client, args []string) (proto.Message, error) {
+	namespace, err := objectType(args[0])
+	if err != nil {
+		return nil, err
+	}
+	page_size, err := strconv.ParseInt(args[2], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_size %s", args[2])
+	}
+	bookmark := ""
+	if len(args) > 3 {
+		bookmark = args[3]
+	}
+	return c.QueryAssetsByOwner(namespace, args[1], int32(page_size), bookmark)
+}
+
+func export(c *client.Client, args []string) (proto.Message, error) {
+	object_type, err := objectType(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.ExportAssetForChannel(object_type, args[1:]...)
+}
+
+func objectType(name string) (client.Query_ObjectType, error) {
+	value, ok := client.Query_ObjectType_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown object type %s", name)
+	}
+	return client.Query_ObjectType(value), nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: appregctl [flags] <command> <arg>...\n\nFlags:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\nCommands:\n")
+	for _, name := range sortedCommandNames() {
+		fmt.Fprintf(os.Stderr, "  %s %s\n", name, commands[name].usage)
+	}
+}
+
+func main() {
+	var config gatewayConfig
+	flag.StringVar(&config.peer, "peer", "localhost:7051", "Gateway peer endpoint")
+	flag.StringVar(&config.tlsCert, "tls-cert", "", "TLS CA certificate of the peer, PEM")
+	flag.StringVar(&config.serverName, "server-name", "", "Override of the peer's TLS server name")
+	flag.StringVar(&config.mspID, "msp-id", "", "MSP ID of the identity to invoke as")
+	flag.StringVar(&config.cert, "cert", "", "Certificate of the identity to invoke as, PEM")
+	flag.StringVar(&config.key, "key", "", "Private key of the identity to invoke as, PEM")
+	flag.StringVar(&config.channel, "channel", "mychannel", "Channel name")
+	flag.StringVar(&config.chaincode, "chaincode", "app_mgr", "Chaincode name")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	name, args := flag.Arg(0), flag.Args()[1:]
+	cmd, ok := commands[name]
+	if !ok || len(args) < cmd.args || (!cmd.variadic && len(args) > cmd.args) {
+		usage()
+		os.Exit(2)
+	}
+	if len(config.tlsCert) == 0 || len(config.mspID) == 0 || len(config.cert) == 0 || len(config.key) == 0 {
+		fmt.Fprintf(os.Stderr, "appregctl: -tls-cert, -msp-id, -cert and -key are required\n")
+		os.Exit(2)
+	}
+
+	invoker, err := newGatewayInvoker(config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "appregctl: %s\n", err)
+		os.Exit(1)
+	}
+	defer invoker.close()
+
+	result, err := cmd.run(client.New(invoker), args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "appregctl: %s\n", err)
+		invoker.close()
+		os.Exit(1)
+	}
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "appregctl: %s\n", err)
+		invoker.close()
+		os.Exit(1)
+	}
+	fmt.Println(string(out))
+}
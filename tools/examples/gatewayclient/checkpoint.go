@@ -0,0 +1,81 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// eventCheckpoint records how far the events have been handled. Events are replayed from a
+// whole block, so the transactions already handled in BlockNumber are kept to skip them.
+type eventCheckpoint struct {
+	BlockNumber    uint64   `json:"block_number"`
+	TransactionIds []string `json:"transaction_ids,omitempty"`
+}
+
+// loadCheckpoint reads the checkpoint in file, returning nil if there is none yet.
+func loadCheckpoint(file string) (*eventCheckpoint, error) {
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &eventCheckpoint{}
+	if err := json.Unmarshal(b, checkpoint); err != nil {
+		return nil, fmt.Errorf("cannot parse checkpoint %s: %s", file, err)
+	}
+	return checkpoint, nil
+}
+
+// handled reports whether the event of a transaction was handled before the checkpoint.
+func (c *eventCheckpoint) handled(blockNumber uint64, transactionId string) bool {
+	if blockNumber != c.BlockNumber {
+		return blockNumber < c.BlockNumber
+	}
+	for _, handled := range c.TransactionIds {
+		if handled == transactionId {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *eventCheckpoint) advance(blockNumber uint64, transactionId string) {
+	if blockNumber != c.BlockNumber {
+		c.BlockNumber, c.TransactionIds = blockNumber, nil
+	}
+	c.TransactionIds = append(c.TransactionIds, transactionId)
+}
+
+// save writes the checkpoint to file, replacing it atomically so a crash cannot leave a
+// truncated checkpoint.
+func (c *eventCheckpoint) save(file string) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file)+".")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
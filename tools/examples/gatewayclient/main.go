@@ -0,0 +1,194 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// gatewayclient is an example of an application integrating with the app_mgr chaincode
+// through the Fabric Gateway API. It submits a descriptor, a bundle and their association,
+// then follows the chaincode's RegistryEvents, recording a checkpoint after each one. When
+// restarted after downtime it replays the events committed since the checkpoint, so no
+// change is missed or handled twice.
+//
+// Usage:
+//
+//	gatewayclient -peer localhost:7051 -tls-cert tlsca.pem -msp-id Org1MSP -cert cert.pem -key key.pem [flags]
+//
+// With -submit=false only the events are followed. Without a checkpoint file the events are
+// read from -start-block.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	appmgr "github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// REGISTRY_EVENT_PREFIX begins the names the chaincode sets its RegistryEvents under,
+// REGISTRY.<namespaces>.<function>.
+const REGISTRY_EVENT_PREFIX = "REGISTRY."
+
+func main() {
+	peer := flag.String("peer", "localhost:7051", "Gateway peer endpoint")
+	tlsCert := flag.String("tls-cert", "", "TLS CA certificate of the peer, PEM")
+	serverName := flag.String("server-name", "", "Override of the peer's TLS server name")
+	mspID := flag.String("msp-id", "", "MSP ID of the client identity")
+	cert := flag.String("cert", "", "Certificate of the client identity, PEM")
+	key := flag.String("key", "", "Private key of the client identity, PEM")
+	channel := flag.String("channel", "mychannel", "Channel name")
+	chaincode := flag.String("chaincode", "app_mgr", "Chaincode name")
+	checkpointFile := flag.String("checkpoint", "gatewayclient.checkpoint", "File recording the last event handled")
+	startBlock := flag.Uint64("start-block", 0, "Block to read events from if there is no checkpoint")
+	submit := flag.Bool("submit", true, "Submit example transactions before following events")
+	flag.Parse()
+	if len(*tlsCert) == 0 || len(*mspID) == 0 || len(*cert) == 0 || len(*key) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	grpcConnection, gateway, err := connect(*peer, *tlsCert, *serverName, *mspID, *cert, *key)
+	if err != nil {
+		log.Fatalf("gatewayclient: cannot connect to %s: %s", *peer, err)
+	}
+	defer grpcConnection.Close()
+	defer gateway.Close()
+	network := gateway.GetNetwork(*channel)
+	registry := appmgr.New(appmgr.NewGatewayInvoker(network.GetContract(*chaincode)))
+
+	checkpoint, err := loadCheckpoint(*checkpointFile)
+	if err != nil {
+		log.Fatalf("gatewayclient: %s", err)
+	}
+	if checkpoint == nil {
+		checkpoint = &eventCheckpoint{BlockNumber: *startBlock}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	// Listen before submitting, so the example's own events are seen even without a checkpoint
+	events, err := network.ChaincodeEvents(ctx, *chaincode, client.WithStartBlock(checkpoint.BlockNumber))
+	if err != nil {
+		log.Fatalf("gatewayclient: cannot read chaincode events: %s", err)
+	}
+	if *submit {
+		if err := submitExample(registry); err != nil {
+			log.Fatalf("gatewayclient: %s", err)
+		}
+	}
+
+	log.Printf("gatewayclient: following events from block %d", checkpoint.BlockNumber)
+	for event := range events {
+		if checkpoint.handled(event.BlockNumber, event.TransactionID) {
+			continue
+		}
+		if strings.HasPrefix(event.EventName, REGISTRY_EVENT_PREFIX) {
+			registryEvent := &appmgr.RegistryEvent{}
+			if err := proto.Unmarshal(event.Payload, registryEvent); err != nil {
+				log.Fatalf("gatewayclient: cannot unmarshal the RegistryEvent of %s: %s", event.TransactionID, err)
+			}
+			handleRegistryEvent(event.BlockNumber, registryEvent)
+		}
+		checkpoint.advance(event.BlockNumber, event.TransactionID)
+		if err := checkpoint.save(*checkpointFile); err != nil {
+			log.Fatalf("gatewayclient: %s", err)
+		}
+	}
+}
+
+func connect(peer, tlsCert, serverName, mspID, cert, key string) (*grpc.ClientConn, *client.Gateway, error) {
+	certPEM, err := ioutil.ReadFile(cert)
+	if err != nil {
+		return nil, nil, err
+	}
+	certificate, err := identity.CertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := identity.NewX509Identity(mspID, certificate)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := ioutil.ReadFile(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	privateKey, err := identity.PrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	sign, err := identity.NewPrivateKeySign(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transportCredentials, err := credentials.NewClientTLSFromFile(tlsCert, serverName)
+	if err != nil {
+		return nil, nil, err
+	}
+	grpcConnection, err := grpc.Dial(peer, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, nil, err
+	}
+	gateway, err := client.Connect(id,
+		client.WithSign(sign),
+		client.WithClientConnection(grpcConnection),
+		client.WithEvaluateTimeout(5*time.Second),
+		client.WithSubmitTimeout(30*time.Second),
+	)
+	if err != nil {
+		grpcConnection.Close()
+		return nil, nil, err
+	}
+	return grpcConnection, gateway, nil
+}
+
+// submitExample creates a descriptor and a bundle under fresh keys and associates them.
+func submitExample(registry *appmgr.Client) error {
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	descriptorKey, bundleKey := "example-descriptor-"+suffix, "example-bundle-"+suffix
+
+	if _, err := registry.CreateAppDescriptor(descriptorKey, &appmgr.AppDescriptor{Description: "gatewayclient example", Tags: []string{"example"}}, nil); err != nil {
+		return err
+	}
+	log.Printf("gatewayclient: created descriptor %s", descriptorKey)
+	if _, err := registry.CreateAppBundle(bundleKey, &appmgr.AppBundle{DescriptorId: descriptorKey}); err != nil {
+		return err
+	}
+	log.Printf("gatewayclient: created bundle %s", bundleKey)
+	if _, err := registry.AssociateDescriptorWithBundle(descriptorKey, bundleKey); err != nil {
+		return err
+	}
+	log.Printf("gatewayclient: associated %s with %s", descriptorKey, bundleKey)
+	return nil
+}
+
+// handleRegistryEvent is where an application would update its own view of the registry.
+func handleRegistryEvent(blockNumber uint64, registryEvent *appmgr.RegistryEvent) {
+	for _, change := range registryEvent.Changes {
+		action := "put"
+		if change.Deleted {
+			action = "deleted"
+		}
+		log.Printf("gatewayclient: block %d tx %s %s: %s %s %v", blockNumber, registryEvent.TxId, registryEvent.Function, action, change.ObjectType, change.KeyParts)
+	}
+}
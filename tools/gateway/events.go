@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+	"golang.org/x/net/websocket"
+)
+
+// REGISTRY_EVENT_PREFIX begins the names the chaincode sets its RegistryEvents under,
+// REGISTRY.<namespaces>.<function>. The RegistryDigests of emitDigest are named
+// REGISTRY_DIGEST.<namespace> and are not relayed.
+const REGISTRY_EVENT_PREFIX = "REGISTRY"
+
+// eventNamespaces returns the namespaces named by the name of a RegistryEvent, or false if
+// eventName is not the name of a RegistryEvent.
+func eventNamespaces(eventName string) ([]string, bool) {
+	parts := strings.SplitN(eventName, ".", 3)
+	if len(parts) != 3 || parts[0] != REGISTRY_EVENT_PREFIX {
+		return nil, false
+	}
+	return strings.Split(parts[1], "+"), true
+}
+
+// namesNamespace reports whether the name of a RegistryEvent names namespace, or any if empty.
+func namesNamespace(eventName string, namespace string) bool {
+	namespaces, ok := eventNamespaces(eventName)
+	if !ok {
+		return false
+	}
+	for _, named := range namespaces {
+		if len(namespace) == 0 || named == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// eventMessage is a RegistryEvent as sent to websocket clients. BlockNumber lets a client
+// resume from where it left off with start_block.
+type eventMessage struct {
+	BlockNumber uint64                `json:"block_number"`
+	TxId        string                `json:"tx_id"`
+	Event       *client.RegistryEvent `json:"event"`
+}
+
+// eventsHandler streams the RegistryEvents of the chaincode to a websocket until the client
+// closes it, starting at the start_block query parameter if given, otherwise at the next block.
+// With the namespace query parameter, only the events changing assets of that namespace are
+// sent, as selected by their names.
+func eventsHandler(connection *gatewayConnection) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var startBlock *uint64
+		if value := r.URL.Query().Get("start_block"); len(value) > 0 {
+			blockNumber, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "start_block must be a block number: %s", err)
+				return
+			}
+			startBlock = &blockNumber
+		}
+		namespace := r.URL.Query().Get("namespace")
+		websocket.Handler(func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			// Clients send nothing, so a read returns only once the websocket is closed
+			go func() {
+				io.Copy(ioutil.Discard, ws)
+				cancel()
+			}()
+
+			events, err := connection.chaincodeEvents(ctx, startBlock)
+			if err != nil {
+				log.Printf("gateway: cannot read chaincode events: %s", err)
+				return
+			}
+			for event := range events {
+				if !namesNamespace(event.EventName, namespace) {
+					continue
+				}
+				registryEvent := &client.RegistryEvent{}
+				if err := proto.Unmarshal(event.Payload, registryEvent); err != nil {
+					log.Printf("gateway: cannot unmarshal the RegistryEvent of %s: %s", event.TransactionID, err)
+					continue
+				}
+				message := &eventMessage{BlockNumber: event.BlockNumber, TxId: event.TransactionID, Event: registryEvent}
+				if err := websocket.JSON.Send(ws, message); err != nil {
+					return
+				}
+			}
+		}).ServeHTTP(w, r)
+	})
+}
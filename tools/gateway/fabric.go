@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type gatewayConfig struct {
+	peer       string
+	tlsCert    string
+	serverName string
+	mspID      string
+	cert       string
+	key        string
+	channel    string
+	chaincode  string
+}
+
+// gatewayConnection is a Fabric Gateway connection to the chaincode.
+type gatewayConnection struct {
+	grpc      *grpc.ClientConn
+	gateway   *client.Gateway
+	network   *client.Network
+	contract  *client.Contract
+	chaincode string
+}
+
+func connect(config gatewayConfig) (*gatewayConnection, error) {
+	certPEM, err := ioutil.ReadFile(config.cert)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := identity.CertificateFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	id, err := identity.NewX509Identity(config.mspID, certificate)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := ioutil.ReadFile(config.key)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := identity.PrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	sign, err := identity.NewPrivateKeySign(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	transportCredentials, err := credentials.NewClientTLSFromFile(config.tlsCert, config.serverName)
+	if err != nil {
+		return nil, err
+	}
+	grpcConnection, err := grpc.Dial(config.peer, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := client.Connect(id,
+		client.WithSign(sign),
+		client.WithClientConnection(grpcConnection),
+		client.WithEvaluateTimeout(5*time.Second),
+		client.WithSubmitTimeout(30*time.Second),
+	)
+	if err != nil {
+		grpcConnection.Close()
+		return nil, err
+	}
+	network := gateway.GetNetwork(config.channel)
+	return &gatewayConnection{
+		grpc:      grpcConnection,
+		gateway:   gateway,
+		network:   network,
+		contract:  network.GetContract(config.chaincode),
+		chaincode: config.chaincode,
+	}, nil
+}
+
+func (c *gatewayConnection) Close() {
+	c.gateway.Close()
+	c.grpc.Close()
+}
+
+// chaincodeEvents returns the events of the chaincode until ctx is done, from startBlock if
+// set, otherwise from the next block committed.
+func (c *gatewayConnection) chaincodeEvents(ctx context.Context, startBlock *uint64) (<-chan *client.ChaincodeEvent, error) {
+	if startBlock == nil {
+		return c.network.ChaincodeEvents(ctx, c.chaincode)
+	}
+	return c.network.ChaincodeEvents(ctx, c.chaincode, client.WithStartBlock(*startBlock))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// gateway exposes the app_mgr chaincode to the demo UI as REST+JSON and streams its
+// RegistryEvents over websockets, so the UI needs no Fabric client of its own. It connects to
+// a peer through the Fabric Gateway client and learns the functions and messages from
+// getApiDescriptor at startup.
+//
+// Usage:
+//
+//	gateway -peer localhost:7051 -tls-cert tlsca.pem -msp-id Org1MSP -cert cert.pem -key key.pem [flags]
+//
+// Routes:
+//
+//	GET  /api                       The chaincode's ApiDescriptor
+//	POST /api/<function>            Evaluates a query function, or submits any other
+//	GET  /api/<function>?<arg>=...  Evaluates a query function with string arguments
+//	GET  /events[?start_block=<n>][&namespace=<ns>]
+//	                                Websocket of RegistryEvents, replayed from start_block,
+//	                                of those changing namespace if given
+//
+// Requests to functions whose arguments are all described take a JSON object of the named
+// arguments, with messages in their encoding/json form, and respond with the JSON of the
+// response message. Other functions take {"args": [...]} of the positional arguments after
+// the function name, strings or message objects, and respond with {"payload": <base64>}.
+// A request with an X-Trace-Id header is invoked under that trace ID, which is recorded in the
+// RegistryEvent it emits and echoed in the response's X-Trace-Id header.
+//
+// The gateway does not authenticate its callers. Every request is invoked as the one gateway
+// identity, so the chaincode's owner and admin checks see that identity, not the UI user: any
+// caller that reaches the gateway may do whatever the identity may do, including reading its
+// restricted AppBundles and changing the assets it owns. The gateway therefore listens on
+// localhost by default; expose it only behind a proxy that authenticates the UI's users, use an
+// identity that is not a registry admin, and restrict the functions it submits with -submit.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+)
+
+func main() {
+	var config gatewayConfig
+	addr := flag.String("addr", "localhost:8080", "HTTP listen address")
+	submit := flag.String("submit", "", "Comma-separated functions that may be submitted, all if empty")
+	flag.StringVar(&config.peer, "peer", "localhost:7051", "Gateway peer endpoint")
+	flag.StringVar(&config.tlsCert, "tls-cert", "", "TLS CA certificate of the peer, PEM")
+	flag.StringVar(&config.serverName, "server-name", "", "Override of the peer's TLS server name")
+	flag.StringVar(&config.mspID, "msp-id", "", "MSP ID of the gateway identity")
+	flag.StringVar(&config.cert, "cert", "", "Certificate of the gateway identity, PEM")
+	flag.StringVar(&config.key, "key", "", "Private key of the gateway identity, PEM")
+	flag.StringVar(&config.channel, "channel", "mychannel", "Channel name")
+	flag.StringVar(&config.chaincode, "chaincode", "app_mgr", "Chaincode name")
+	flag.Parse()
+	if len(config.tlsCert) == 0 || len(config.mspID) == 0 || len(config.cert) == 0 || len(config.key) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	connection, err := connect(config)
+	if err != nil {
+		log.Fatalf("gateway: cannot connect to %s: %s", config.peer, err)
+	}
+	defer connection.Close()
+
+	registry := client.New(client.NewGatewayInvoker(connection.contract))
+	apiDescriptor, err := registry.GetApiDescriptor()
+	if err != nil {
+		log.Fatalf("gateway: %s", err)
+	}
+
+	api := &apiHandler{registry: registry, apiDescriptor: apiDescriptor}
+	if len(*submit) != 0 {
+		api.submitFunctions = make(map[string]bool)
+		for _, function := range strings.Split(*submit, ",") {
+			if _, ok := apiDescriptor.Functions[function]; !ok {
+				log.Fatalf("gateway: -submit names unknown function %s", function)
+			}
+			api.submitFunctions[function] = true
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api", api)
+	mux.Handle("/api/", api)
+	mux.Handle("/events", eventsHandler(connection))
+	fmt.Printf("gateway: serving %s/%s on %s\n", config.channel, config.chaincode, *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
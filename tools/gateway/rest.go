@@ -0,0 +1,277 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client"
+)
+
+// MAX_REQUEST_BYTES bounds the JSON body of a request.
+const MAX_REQUEST_BYTES = 4 << 20
+
+// TRACE_ID_HEADER carries the caller's trace ID of a request, echoed in the response.
+const TRACE_ID_HEADER = "X-Trace-Id"
+
+// apiHandler translates REST requests to chaincode invocations, using the ApiDescriptor to
+// encode the arguments and decode the response.
+type apiHandler struct {
+	registry      *client.Client
+	apiDescriptor *client.ApiDescriptor
+	// The functions that may be submitted, any if nil
+	submitFunctions map[string]bool
+}
+
+var httpStatuses = map[client.ErrorCode]int{
+	client.NotFound:         http.StatusNotFound,
+	client.AlreadyExists:    http.StatusConflict,
+	client.PermissionDenied: http.StatusForbidden,
+	client.InvalidArgument:  http.StatusBadRequest,
+	client.Paused:           http.StatusServiceUnavailable,
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	writeJSON(w, status, map[string]string{"error": fmt.Sprintf(format, args...)})
+}
+
+func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	function := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api"), "/")
+	if len(function) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "%s is not allowed on /api", r.Method)
+			return
+		}
+		writeJSON(w, http.StatusOK, h.apiDescriptor)
+		return
+	}
+	apiFunction, ok := h.apiDescriptor.Functions[function]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown function %s", function)
+		return
+	}
+	if !apiFunction.Query && h.submitFunctions != nil && !h.submitFunctions[function] {
+		writeError(w, http.StatusForbidden, "%s may not be submitted through this gateway", function)
+		return
+	}
+
+	var args [][]byte
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		if !apiFunction.Query {
+			writeError(w, http.StatusMethodNotAllowed, "%s is not a query function and must be POSTed", function)
+			return
+		}
+		args, err = queryArguments(apiFunction, r.URL.Query())
+	case http.MethodPost:
+		var body []byte
+		if body, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MAX_REQUEST_BYTES)); err == nil {
+			args, err = bodyArguments(apiFunction, body)
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "%s is not allowed on /api/%s", r.Method, function)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+
+	// The caller's trace ID is passed to the chaincode, which records it with the invocation
+	registry := h.registry
+	if traceId := r.Header.Get(TRACE_ID_HEADER); len(traceId) != 0 {
+		registry = registry.WithTraceId(traceId)
+		w.Header().Set(TRACE_ID_HEADER, traceId)
+	}
+	var payload []byte
+	if apiFunction.Query {
+		payload, err = registry.Evaluate(function, args...)
+	} else {
+		payload, err = registry.Submit(function, args...)
+	}
+	if err != nil {
+		status, ok := httpStatuses[client.Code(err)]
+		if !ok {
+			status = http.StatusBadGateway
+		}
+		writeError(w, status, "%s", err)
+		return
+	}
+
+	if apiFunction.Response == nil {
+		writeJSON(w, http.StatusOK, map[string][]byte{"payload": payload})
+		return
+	}
+	response, err := newMessage(apiFunction.Response.Ref)
+	if err == nil {
+		err = proto.Unmarshal(payload, response)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "cannot decode the response of %s: %s", function, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// newMessage returns an empty message of the type a schema reference refers to.
+func newMessage(ref string) (proto.Message, error) {
+	name := strings.TrimPrefix(ref, "#/definitions/")
+	messageType := proto.MessageType("main." + name)
+	if messageType == nil {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	return reflect.New(messageType.Elem()).Interface().(proto.Message), nil
+}
+
+// encodeMessage marshals the encoding/json form of a message of the referenced type.
+func encodeMessage(ref string, raw json.RawMessage) ([]byte, error) {
+	message, err := newMessage(ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, message); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(message)
+}
+
+// encodeArgument converts the JSON value of an argument to its chaincode argument.
+func encodeArgument(argument *client.ApiArgument, raw json.RawMessage) ([]byte, error) {
+	switch argument.Encoding {
+	case "proto":
+		return encodeMessage(argument.Schema.Ref, raw)
+	case "bytes":
+		var value []byte
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case "bool":
+		var value bool
+		err := json.Unmarshal(raw, &value)
+		return []byte(strconv.FormatBool(value)), err
+	case "decimal":
+		// Numbers are passed through in decimal, so large values keep their precision
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case json.Number:
+			return []byte(v.String()), nil
+		case string:
+			return []byte(v), nil
+		}
+		return nil, fmt.Errorf("want a number")
+	default:
+		var value string
+		err := json.Unmarshal(raw, &value)
+		return []byte(value), err
+	}
+}
+
+// bodyArguments converts a request body to chaincode arguments: an object of the named
+// arguments if the function is completely described, otherwise {"args": [...]}.
+func bodyArguments(apiFunction *client.ApiFunction, body []byte) ([][]byte, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		body = []byte("{}")
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("the request body must be a JSON object: %s", err)
+	}
+
+	if !apiFunction.Complete {
+		var positional []json.RawMessage
+		if raw, ok := fields["args"]; ok {
+			if err := json.Unmarshal(raw, &positional); err != nil {
+				return nil, fmt.Errorf("args must be an array: %s", err)
+			}
+		}
+		var args [][]byte
+		for i, raw := range positional {
+			var argument *client.ApiArgument
+			for _, described := range apiFunction.Arguments {
+				if described.Index == i+1 {
+					argument = described
+				}
+			}
+			if argument == nil {
+				argument = &client.ApiArgument{Index: i + 1, Encoding: "string"}
+			}
+			arg, err := encodeArgument(argument, raw)
+			if err != nil {
+				return nil, fmt.Errorf("args[%d]: %s", i, err)
+			}
+			args = append(args, arg)
+		}
+		return args, nil
+	}
+
+	// Omitted arguments are passed empty, and trailing ones not at all
+	args := make([][]byte, len(apiFunction.Arguments))
+	count := 0
+	for i, argument := range apiFunction.Arguments {
+		raw, ok := fields[argument.Name]
+		if !ok {
+			continue
+		}
+		delete(fields, argument.Name)
+		arg, err := encodeArgument(argument, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", argument.Name, err)
+		}
+		args[i] = arg
+		count = i + 1
+	}
+	for name := range fields {
+		return nil, fmt.Errorf("unknown argument %s", name)
+	}
+	return args[:count], nil
+}
+
+// queryArguments converts the query parameters of a GET to chaincode arguments: the named
+// arguments if the function is completely described, otherwise the repeated "arg" parameter.
+func queryArguments(apiFunction *client.ApiFunction, query url.Values) ([][]byte, error) {
+	if !apiFunction.Complete {
+		var args [][]byte
+		for _, value := range query["arg"] {
+			args = append(args, []byte(value))
+		}
+		return args, nil
+	}
+	args := make([][]byte, len(apiFunction.Arguments))
+	count := 0
+	for i, argument := range apiFunction.Arguments {
+		values, ok := query[argument.Name]
+		if !ok {
+			continue
+		}
+		if argument.Encoding == "proto" {
+			return nil, fmt.Errorf("%s is a message and must be POSTed", argument.Name)
+		}
+		args[i] = []byte(values[0])
+		count = i + 1
+	}
+	return args[:count], nil
+}
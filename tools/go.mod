@@ -0,0 +1,26 @@
+// The tools talk to the app_mgr chaincode through the Fabric Gateway. They are a module of their
+// own, outside the chaincode's tree, so that the chaincode is built without their dependencies.
+module github.com/hyperledger/fabric/examples/chaincode/go/marketplace/tools
+
+go 1.22.0
+
+replace github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client => ../app_mgr/client
+
+require (
+	github.com/golang/protobuf v1.5.4
+	github.com/hyperledger/fabric-gateway v1.7.1
+	github.com/hyperledger/fabric/examples/chaincode/go/marketplace/app_mgr/client v0.0.0-00010101000000-000000000000
+	golang.org/x/net v0.33.0
+	google.golang.org/grpc v1.69.2
+	gopkg.in/yaml.v2 v2.4.0
+)
+
+require (
+	github.com/hyperledger/fabric-protos-go-apiv2 v0.3.4 // indirect
+	github.com/miekg/pkcs11 v1.1.1 // indirect
+	golang.org/x/crypto v0.31.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20241015192408-796eee8c2d53 // indirect
+	google.golang.org/protobuf v1.36.0 // indirect
+)